@@ -22,9 +22,11 @@ import (
 	"github.com/cossacklabs/acra/acra-censor/handlers"
 	"github.com/cossacklabs/acra/logging"
 	"github.com/cossacklabs/acra/utils"
+	"github.com/go-redis/redis/v7"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 	"strings"
+	"time"
 )
 
 // MinimalCensorConfigVersion min version of config that support acra-censor
@@ -38,20 +40,68 @@ const (
 	AllowAllConfigStr     = "allowall"
 	QueryCaptureConfigStr = "query_capture"
 	QueryIgnoreConfigStr  = "query_ignore"
+	RateLimitConfigStr    = "ratelimit"
 )
 
-// Config shows handlers configuration: queries, tables, patterns
+// Storages of ratelimit handler
+const (
+	MemoryRateLimitStorageStr = "memory"
+	RedisRateLimitStorageStr  = "redis"
+)
+
+// ErrInvalidRateLimitStorage returned for unknown storage of ratelimit handler or redis storage without redis settings
+var ErrInvalidRateLimitStorage = errors.New("invalid storage of ratelimit handler")
+
+// HandlerConfig shows configuration of one handler: queries, tables, columns in "table.column" format, patterns,
+// limits and their storage for ratelimit handler, mode which overrides mode of AcraCensor
+type HandlerConfig struct {
+	Handler  string            `yaml:"handler"`
+	Mode     string            `yaml:"mode,omitempty"`
+	Queries  []string          `yaml:"queries,omitempty"`
+	Tables   []string          `yaml:"tables,omitempty"`
+	Columns  []string          `yaml:"columns,omitempty"`
+	Patterns []string          `yaml:"patterns,omitempty"`
+	FilePath string            `yaml:"filepath,omitempty"`
+	Storage  string            `yaml:"storage,omitempty"`
+	Redis    *RedisConfig      `yaml:"redis,omitempty"`
+	Limits   []RateLimitConfig `yaml:"limits,omitempty"`
+}
+
+// RateLimitConfig shows configuration of one limit of ratelimit handler: Rate queries per Period (1s by default)
+// with Burst queries (Rate by default) for each ClientID
+type RateLimitConfig struct {
+	Queries  []string      `yaml:"queries,omitempty"`
+	Tables   []string      `yaml:"tables,omitempty"`
+	Patterns []string      `yaml:"patterns,omitempty"`
+	Rate     float64       `yaml:"rate"`
+	Period   time.Duration `yaml:"period,omitempty"`
+	Burst    int           `yaml:"burst,omitempty"`
+	Action   string        `yaml:"action,omitempty"`
+	MaxDelay time.Duration `yaml:"max_delay,omitempty"`
+}
+
+// RedisConfig shows connection settings of Redis used to share rate limits between several AcraServers
+type RedisConfig struct {
+	HostPort string `yaml:"host_port"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+}
+
+// ClientHandlersConfig shows handlers used instead of default ones for ClientIDs matched by ClientID. ClientID may be
+// exact ClientID, glob pattern (report_*) or regular expression with "regex:" prefix
+type ClientHandlersConfig struct {
+	ClientID string          `yaml:"client_id"`
+	Handlers []HandlerConfig `yaml:"handlers"`
+}
+
+// Config shows handlers configuration: default handlers and handlers of ClientIDs, mode of all handlers
 type Config struct {
-	Version          string `yaml:"version"`
-	IgnoreParseError bool   `yaml:"ignore_parse_error"`
-	ParseErrorsLog   string `yaml:"parse_errors_log"`
-	Handlers         []struct {
-		Handler  string
-		Queries  []string
-		Tables   []string
-		Patterns []string
-		FilePath string
-	}
+	Version          string                 `yaml:"version"`
+	Mode             string                 `yaml:"mode,omitempty"`
+	IgnoreParseError bool                   `yaml:"ignore_parse_error"`
+	ParseErrorsLog   string                 `yaml:"parse_errors_log,omitempty"`
+	Handlers         []HandlerConfig        `yaml:"handlers"`
+	Clients          []ClientHandlersConfig `yaml:"clients,omitempty"`
 }
 
 // ErrUnsupportedConfigVersion acra-censor's config has version less than MinimalCensorConfigVersion
@@ -84,6 +134,11 @@ func (acraCensor *AcraCensor) LoadConfiguration(configuration []byte) error {
 		return ErrUnsupportedConfigVersion
 	}
 	acraCensor.ignoreParseError = censorConfiguration.IgnoreParseError
+	if err := acraCensor.SetMode(censorConfiguration.Mode); err != nil {
+		acraCensor.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorSetupError).
+			WithField("mode", censorConfiguration.Mode).Errorln("Invalid mode in configuration")
+		return err
+	}
 	if !strings.EqualFold(censorConfiguration.ParseErrorsLog, "") {
 		queryWriter, err := common.NewFileQueryWriter(censorConfiguration.ParseErrorsLog)
 		if err != nil {
@@ -93,55 +148,156 @@ func (acraCensor *AcraCensor) LoadConfiguration(configuration []byte) error {
 		acraCensor.unparsedQueriesWriter = queryWriter
 	}
 
-	for _, handlerConfiguration := range censorConfiguration.Handlers {
+	defaultHandlers, err := acraCensor.newHandlers(censorConfiguration.Handlers)
+	if err != nil {
+		return err
+	}
+	for _, handler := range defaultHandlers {
+		acraCensor.AddHandler(handler)
+	}
+	for _, clientConfiguration := range censorConfiguration.Clients {
+		clientHandlers, err := acraCensor.newHandlers(clientConfiguration.Handlers)
+		if err != nil {
+			return err
+		}
+		if err := acraCensor.AddClientHandlers(clientConfiguration.ClientID, clientHandlers); err != nil {
+			acraCensor.logger.WithError(err).
+				WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorSetupError).
+				WithField("client_id", clientConfiguration.ClientID).Errorln("Invalid client handlers in configuration")
+			return err
+		}
+	}
+	return nil
+}
+
+// newHandlers creates chain of handlers from configuration
+func (acraCensor *AcraCensor) newHandlers(configuration []HandlerConfig) ([]QueryHandlerInterface, error) {
+	var err error
+	queryHandlers := make([]QueryHandlerInterface, 0, len(configuration))
+	for _, handlerConfiguration := range configuration {
 		switch handlerConfiguration.Handler {
 		case AllowConfigStr:
 			allow := handlers.NewAllowHandler(acraCensor.parser)
 			err = allow.AddQueries(handlerConfiguration.Queries)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			allow.AddTables(handlerConfiguration.Tables)
+			err = allow.AddColumns(handlerConfiguration.Columns)
+			if err != nil {
+				return nil, err
+			}
+			allow.SetTableSchemaStore(acraCensor.schemaStore)
 			err = allow.AddPatterns(handlerConfiguration.Patterns)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			acraCensor.AddHandler(allow)
+			queryHandlers = append(queryHandlers, allow)
 		case DenyConfigStr:
 			deny := handlers.NewDenyHandler(acraCensor.parser)
 			err = deny.AddQueries(handlerConfiguration.Queries)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			deny.AddTables(handlerConfiguration.Tables)
+			err = deny.AddColumns(handlerConfiguration.Columns)
+			if err != nil {
+				return nil, err
+			}
+			deny.SetTableSchemaStore(acraCensor.schemaStore)
 			err = deny.AddPatterns(handlerConfiguration.Patterns)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			acraCensor.AddHandler(deny)
+			queryHandlers = append(queryHandlers, deny)
 		case AllowAllConfigStr:
 			allowAll := handlers.NewAllowallHandler()
-			acraCensor.AddHandler(allowAll)
+			queryHandlers = append(queryHandlers, allowAll)
 		case DenyAllConfigStr:
 			denyAll := handlers.NewDenyallHandler()
-			acraCensor.AddHandler(denyAll)
+			queryHandlers = append(queryHandlers, denyAll)
 		case QueryIgnoreConfigStr:
 			queryIgnoreHandler := handlers.NewQueryIgnoreHandler(acraCensor.parser)
 			queryIgnoreHandler.AddQueries(handlerConfiguration.Queries)
-			acraCensor.AddHandler(queryIgnoreHandler)
+			queryHandlers = append(queryHandlers, queryIgnoreHandler)
+		case RateLimitConfigStr:
+			rateLimit, err := acraCensor.newRateLimitHandler(handlerConfiguration)
+			if err != nil {
+				acraCensor.logger.WithError(err).
+					WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorSetupError).
+					Errorln("Can't setup ratelimit handler")
+				return nil, err
+			}
+			queryHandlers = append(queryHandlers, rateLimit)
 		case QueryCaptureConfigStr:
 			queryCaptureHandler, err := handlers.NewQueryCaptureHandler(handlerConfiguration.FilePath, acraCensor.parser)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			go queryCaptureHandler.Start()
-			acraCensor.AddHandler(queryCaptureHandler)
+			queryHandlers = append(queryHandlers, queryCaptureHandler)
 		default:
 			acraCensor.logger.
 				WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorSetupError).
 				Errorln("Unexpected handler in configuration: probably AcraCensor configuration (acra-censor.yaml) is outdated")
-			return common.ErrCensorConfigurationError
+			return nil, common.ErrCensorConfigurationError
+		}
+		if handlerConfiguration.Mode != "" {
+			if err := acraCensor.SetHandlerMode(queryHandlers[len(queryHandlers)-1], handlerConfiguration.Mode); err != nil {
+				acraCensor.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorSetupError).
+					WithField("mode", handlerConfiguration.Mode).Errorln("Invalid mode of handler in configuration")
+				return nil, err
+			}
 		}
 	}
-	return nil
+	return queryHandlers, nil
+}
+
+// newRateLimitHandler creates ratelimit handler with storage and limits from configuration
+func (acraCensor *AcraCensor) newRateLimitHandler(configuration HandlerConfig) (*handlers.RateLimitHandler, error) {
+	var storage handlers.RateLimitStorage
+	switch configuration.Storage {
+	case "", MemoryRateLimitStorageStr:
+		storage = handlers.NewMemoryRateLimitStorage()
+	case RedisRateLimitStorageStr:
+		if configuration.Redis == nil {
+			return nil, ErrInvalidRateLimitStorage
+		}
+		client := redis.NewClient(&redis.Options{
+			Addr:     configuration.Redis.HostPort,
+			Password: configuration.Redis.Password,
+			DB:       configuration.Redis.DB,
+		})
+		if err := client.Ping().Err(); err != nil {
+			client.Close()
+			return nil, err
+		}
+		storage = handlers.NewRedisRateLimitStorage(client)
+	default:
+		return nil, ErrInvalidRateLimitStorage
+	}
+	rateLimit := handlers.NewRateLimitHandler(acraCensor.parser, storage)
+	for _, limit := range configuration.Limits {
+		period := limit.Period
+		if period == 0 {
+			period = time.Second
+		}
+		settings := handlers.RateLimitSettings{
+			Queries:  limit.Queries,
+			Tables:   limit.Tables,
+			Patterns: limit.Patterns,
+			Rate:     limit.Rate / period.Seconds(),
+			Burst:    limit.Burst,
+			Action:   limit.Action,
+			MaxDelay: limit.MaxDelay,
+		}
+		if settings.Burst == 0 {
+			settings.Burst = int(limit.Rate)
+		}
+		if err := rateLimit.AddLimit(settings); err != nil {
+			rateLimit.Release()
+			return nil, err
+		}
+	}
+	return rateLimit, nil
 }
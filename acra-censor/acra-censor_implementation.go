@@ -19,6 +19,7 @@ package acracensor
 import (
 	"github.com/cossacklabs/acra/acra-censor/common"
 	"github.com/cossacklabs/acra/acra-censor/handlers"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/logging"
 	"github.com/cossacklabs/acra/sqlparser"
 	log "github.com/sirupsen/logrus"
@@ -27,13 +28,18 @@ import (
 // ServiceName to use in logs
 const ServiceName = "acra-censor"
 
-// AcraCensor describes censor data: query handler, logger and reaction on parsing errors.
+// AcraCensor describes censor data: query handlers, logger and reaction on parsing errors. Default handlers are used
+// for ClientIDs without own handlers. Handlers in monitor mode don't affect queries, their verdicts are only reported.
 type AcraCensor struct {
 	handlers              []QueryHandlerInterface
+	clientHandlers        *clientHandlersMatcher
+	monitor               bool
+	handlerModes          map[QueryHandlerInterface]bool
 	ignoreParseError      bool
 	unparsedQueriesWriter *common.QueryWriter
 	logger                *log.Entry
 	parser                *sqlparser.Parser
+	schemaStore           config.TableSchemaStore
 }
 
 // NewAcraCensor creates new censor object.
@@ -45,12 +51,49 @@ func NewAcraCensor() *AcraCensor {
 		logger:           log.WithField("service", ServiceName),
 		ignoreParseError: false,
 		parser:           sqlparser.New(sqlparser.ModeStrict),
+		clientHandlers:   newClientHandlersMatcher(),
+		handlerModes:     make(map[QueryHandlerInterface]bool),
 	}
 }
 
 // AddHandler adds handler to the list of Censor handlers.
 func (acraCensor *AcraCensor) AddHandler(handler QueryHandlerInterface) {
 	acraCensor.handlers = append(acraCensor.handlers, handler)
+	acraCensor.updateMonitorMode(handler)
+}
+
+// SetTableSchemaStore sets table schema used by allow/deny handlers to expand star expressions of checked queries to
+// the list of table columns. Should be called before LoadConfiguration
+func (acraCensor *AcraCensor) SetTableSchemaStore(schemaStore config.TableSchemaStore) {
+	acraCensor.schemaStore = schemaStore
+}
+
+// AddClientHandlers sets list of handlers used instead of default ones for ClientIDs matched by clientID. clientID
+// may be exact ClientID, glob pattern (report_*) or regular expression with "regex:" prefix. Exact ClientIDs have
+// precedence over patterns which are matched in the order of addition.
+func (acraCensor *AcraCensor) AddClientHandlers(clientID string, handlers []QueryHandlerInterface) error {
+	chain, err := newClientHandlers(clientID, handlers)
+	if err != nil {
+		return err
+	}
+	if err := acraCensor.clientHandlers.add(chain); err != nil {
+		return err
+	}
+	for _, handler := range handlers {
+		acraCensor.updateMonitorMode(handler)
+	}
+	return nil
+}
+
+// getHandlers returns handlers of the ClientID or default handlers
+func (acraCensor *AcraCensor) getHandlers(clientID []byte) []QueryHandlerInterface {
+	if acraCensor.clientHandlers == nil {
+		return acraCensor.handlers
+	}
+	if chain := acraCensor.clientHandlers.match(clientID); chain != nil {
+		return chain.handlers
+	}
+	return acraCensor.handlers
 }
 
 // RemoveHandler removes handler from the list of Censor handlers.
@@ -58,6 +101,7 @@ func (acraCensor *AcraCensor) RemoveHandler(handler QueryHandlerInterface) {
 	for index, handlerFromRange := range acraCensor.handlers {
 		if handlerFromRange == handler {
 			acraCensor.handlers = append(acraCensor.handlers[:index], acraCensor.handlers[index+1:]...)
+			delete(acraCensor.handlerModes, handler)
 		}
 	}
 }
@@ -68,85 +112,107 @@ func (acraCensor *AcraCensor) ReleaseAll() {
 	for _, handler := range acraCensor.handlers {
 		handler.Release()
 	}
+	if acraCensor.clientHandlers != nil {
+		for _, chain := range acraCensor.clientHandlers.all() {
+			for _, handler := range chain.handlers {
+				handler.Release()
+			}
+		}
+	}
 	if acraCensor.unparsedQueriesWriter != nil {
 		acraCensor.unparsedQueriesWriter.Free()
 	}
 
 }
 
-// HandleQuery processes every query through each handler.
-func (acraCensor *AcraCensor) HandleQuery(rawQuery string) error {
-	if len(acraCensor.handlers) == 0 && acraCensor.unparsedQueriesWriter == nil {
+// HandleQuery processes every query through each handler of the client. Verdicts of handlers in monitor mode are
+// reported until one of them allows or denies the query, as enforced chain would stop there, but only handlers in
+// enforce mode decide whether query is forwarded.
+func (acraCensor *AcraCensor) HandleQuery(accessContext *common.AccessContext, rawQuery string) error {
+	queryHandlers := acraCensor.getHandlers(accessContext.ClientID())
+	if len(queryHandlers) == 0 && acraCensor.unparsedQueriesWriter == nil {
 		// no handlers, AcraCensor won't work
 		return nil
 	}
+	logger := acraCensor.logger.WithField("client_id", string(accessContext.ClientID()))
 	normalizedQuery, queryWithHiddenValues, parsedQuery, err := acraCensor.parser.HandleRawSQLQuery(rawQuery)
 	// Unparsed query handling
 	if err == sqlparser.ErrQuerySyntaxError {
 		acraCensor.saveUnparsedQuery(rawQuery)
 		if acraCensor.ignoreParseError {
 			// log warning if we ignore such errors
-			acraCensor.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryParseError).Warning("Failed to parse input query")
+			logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryParseError).Warning("Failed to parse input query")
+		} else if acraCensor.monitor {
+			logMonitorVerdict(logger, parserVerdictName, common.SyntaxRule, "", denyVerdict, "")
 		} else {
-			acraCensor.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryParseError).Errorln("Unparsed query has been denied")
+			logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryParseError).Errorln("Unparsed query has been denied")
 			return err
 		}
 	}
+	// verdict of handler in monitor mode which would decide about the query in enforce mode was already reported
+	monitorDecided := false
 	// Handlers work
-	for _, handler := range acraCensor.handlers {
+	for _, handler := range queryHandlers {
 		if queryCaptureHandler, ok := handler.(*handlers.QueryCaptureHandler); ok {
-			queryCaptureHandler.CheckQuery(queryWithHiddenValues, parsedQuery)
+			queryCaptureHandler.CheckQuery(accessContext, queryWithHiddenValues, parsedQuery)
 			continue
 		}
-		if queryIgnoreHandler, ok := handler.(*handlers.QueryIgnoreHandler); ok {
-			continueHandling, _ := queryIgnoreHandler.CheckQuery(rawQuery, nil)
-			if !continueHandling {
-				acraCensor.logAllowedQuery(queryWithHiddenValues, parsedQuery)
-				return nil
-			}
+		monitored := acraCensor.isMonitored(handler)
+		if monitored && monitorDecided {
 			continue
 		}
 		// Security checks (allow/deny handlers)
-		continueHandling, err := handler.CheckQuery(normalizedQuery, parsedQuery)
+		continueHandling, rule, matched, err := checkQuery(handler, accessContext, rawQuery, normalizedQuery, parsedQuery)
+		if monitored {
+			verdict := continueVerdict
+			if err != nil {
+				verdict = denyVerdict
+			} else if !continueHandling {
+				verdict = allowVerdict
+			}
+			logMonitorVerdict(logger, handlerName(handler), rule, matched, verdict, queryWithHiddenValues)
+			monitorDecided = verdict != continueVerdict
+			continue
+		}
 		if err != nil {
-			acraCensor.logDeniedQuery(queryWithHiddenValues, handler, parsedQuery)
+			logDeniedQuery(logger, queryWithHiddenValues, handler, parsedQuery)
 			return err
 		}
 		//we don't have errors so allow query
 		if !continueHandling {
-			acraCensor.logAllowedQuery(queryWithHiddenValues, parsedQuery)
+			logAllowedQuery(logger, queryWithHiddenValues, parsedQuery)
 			return nil
 		}
 	}
-	acraCensor.logAllowedQuery(queryWithHiddenValues, parsedQuery)
+	logAllowedQuery(logger, queryWithHiddenValues, parsedQuery)
 	return nil
 }
 
-func (acraCensor *AcraCensor) logAllowedQuery(queryWithHiddenValues string, parsedQuery sqlparser.Statement) {
+func logAllowedQuery(logger *log.Entry, queryWithHiddenValues string, parsedQuery sqlparser.Statement) {
 	if parsedQuery != nil && queryWithHiddenValues != "" {
-		acraCensor.logger.Infof("Allowed query: '%s'", common.TrimStringToN(queryWithHiddenValues, common.LogQueryLength))
+		logger.Infof("Allowed query: '%s'", common.TrimStringToN(queryWithHiddenValues, common.LogQueryLength))
 		return
 	}
 	if parsedQuery == nil && queryWithHiddenValues == "" {
-		acraCensor.logger.Infoln("Allowed query can't be shown in plaintext")
+		logger.Infoln("Allowed query can't be shown in plaintext")
 		return
 	}
-	acraCensor.logger.Debugf("parsedQuery: %T, queryWithHiddenValues: %s", parsedQuery, queryWithHiddenValues)
+	logger.Debugf("parsedQuery: %T, queryWithHiddenValues: %s", parsedQuery, queryWithHiddenValues)
 	return
 }
 
-func (acraCensor *AcraCensor) logDeniedQuery(queryWithHiddenValues string, handler QueryHandlerInterface, parsedQuery sqlparser.Statement) {
+func logDeniedQuery(logger *log.Entry, queryWithHiddenValues string, handler QueryHandlerInterface, parsedQuery sqlparser.Statement) {
 	if parsedQuery != nil && queryWithHiddenValues != "" {
-		acraCensor.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Errorf("Denied query: '%s'", common.TrimStringToN(queryWithHiddenValues, common.LogQueryLength))
-		acraCensor.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Debugf("Denied query by %T", handler)
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Errorf("Denied query: '%s'", common.TrimStringToN(queryWithHiddenValues, common.LogQueryLength))
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Debugf("Denied query by %T", handler)
 		return
 	}
 	if parsedQuery == nil && queryWithHiddenValues == "" {
-		acraCensor.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Errorln("Denied query can't be shown in plaintext")
-		acraCensor.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Debugf("Denied query by %T", handler)
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Errorln("Denied query can't be shown in plaintext")
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Debugf("Denied query by %T", handler)
 		return
 	}
-	acraCensor.logger.Debugf("parsedQuery: %T, queryWithHiddenValues: %s", parsedQuery, queryWithHiddenValues)
+	logger.Debugf("parsedQuery: %T, queryWithHiddenValues: %s", parsedQuery, queryWithHiddenValues)
 	return
 }
 
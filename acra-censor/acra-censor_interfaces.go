@@ -17,24 +17,26 @@ limitations under the License.
 // Package acracensor represents separate firewall module for Acra. AcraCensor handles each query that
 // gets through AcraServer. You can setup the whitelist and the blacklist separately or simultaneously.
 // The order of priority for the lists is defined by their order in the configuration file.
-// Priority of work for one of the lists is the following: queries, followed by tables, followed by rules.
+// Priority of work for one of the lists is the following: queries, followed by tables, followed by columns, followed by rules.
 //
 // https://github.com/cossacklabs/acra/wiki/AcraCensor
 package acracensor
 
 import (
+	"github.com/cossacklabs/acra/acra-censor/common"
 	"github.com/cossacklabs/acra/sqlparser"
 )
 
 // QueryHandlerInterface describes what actions are available for queries.
 type QueryHandlerInterface interface {
-	CheckQuery(sqlQuery string, parsedQuery sqlparser.Statement) (bool, error) //1st return arg specifies whether continue verification or not, 2nd specifies whether query is forbidden
+	CheckQuery(accessContext *common.AccessContext, sqlQuery string, parsedQuery sqlparser.Statement) (bool, error) //1st return arg specifies whether continue verification or not, 2nd specifies whether query is forbidden
 	Release()
 }
 
 // AcraCensorInterface describes main AcraCensor methods: adding and removing query handlers and processing query
+// with handlers of the client described by access context
 type AcraCensorInterface interface {
-	HandleQuery(sqlQuery string) error
+	HandleQuery(accessContext *common.AccessContext, sqlQuery string) error
 	AddHandler(handler QueryHandlerInterface)
 	RemoveHandler(handler QueryHandlerInterface)
 	ReleaseAll()
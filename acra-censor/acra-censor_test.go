@@ -37,9 +37,13 @@ import (
 
 	"fmt"
 	"github.com/cossacklabs/acra/acra-censor/handlers"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/utils"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
+var testAccessContext = common.NewAccessContext([]byte("client"), "")
+
 func TestAllowQueries(t *testing.T) {
 	var err error
 	sqlSelectQueries := []string{
@@ -79,42 +83,42 @@ func TestAllowQueries(t *testing.T) {
 	acraCensor.AddHandler(handlers.NewDenyallHandler())
 	//acracensor should not block those queries
 	for _, query := range sqlSelectQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
 	}
 	for _, query := range sqlInsertQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
 	}
 	//acracensor should block this query because it is not in whitelist
-	err = acraCensor.HandleQuery("SELECT * FROM testDB.testTbl;")
+	err = acraCensor.HandleQuery(testAccessContext, "SELECT * FROM testDB.testTbl;")
 	if err != common.ErrDenyAllError {
 		t.Fatal(err)
 	}
 	//ditto
-	err = acraCensor.HandleQuery("INSERT INTO SalesStaff1 VALUES (1, 'Stephen', 'Jiang');")
+	err = acraCensor.HandleQuery(testAccessContext, "INSERT INTO SalesStaff1 VALUES (1, 'Stephen', 'Jiang');")
 	if err != common.ErrDenyAllError {
 		t.Fatal(err)
 	}
 
 	//acracensor should NOT block this query because its the same as in whitelist, but lower-cased and without ";"
 	lowerCaseWhiteListedQuery := "select * from STUDENT"
-	err = acraCensor.HandleQuery(lowerCaseWhiteListedQuery)
+	err = acraCensor.HandleQuery(testAccessContext, lowerCaseWhiteListedQuery)
 	if err != nil {
 		t.Fatal(err)
 	}
 	//acracensor should NOT block this query because its the same as in whitelist, but lower-cased and without ;
-	err = acraCensor.HandleQuery("select EMP_ID, LAST_NAME from EMPLOYEE where CITY = 'Seattle' order BY EMP_ID")
+	err = acraCensor.HandleQuery(testAccessContext, "select EMP_ID, LAST_NAME from EMPLOYEE where CITY = 'Seattle' order BY EMP_ID")
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	whitelistHandler.RemoveQueries([]string{lowerCaseWhiteListedQuery})
-	err = acraCensor.HandleQuery(lowerCaseWhiteListedQuery)
+	err = acraCensor.HandleQuery(testAccessContext, lowerCaseWhiteListedQuery)
 	//now acracensor should block this query because it is not in whitelist anymore
 	if err != common.ErrDenyAllError {
 		t.Fatal(err)
@@ -144,7 +148,7 @@ func TestAllowTables(t *testing.T) {
 	queryIndexesToBlock := []int{0, 2, 3, 4, 5, 6}
 	//acracensor should block those queries
 	for _, i := range queryIndexesToBlock {
-		err := censor.HandleQuery(testQueries[i])
+		err := censor.HandleQuery(testAccessContext, testQueries[i])
 		if err != common.ErrDenyAllError {
 			t.Fatal(err)
 		}
@@ -153,7 +157,7 @@ func TestAllowTables(t *testing.T) {
 	// now we should allow query that access EMPLOYEE or Customers tables and deny all others
 	queryIndexesToPass := []int{1, 4, 5}
 	for _, i := range queryIndexesToPass {
-		err = censor.HandleQuery(testQueries[i])
+		err = censor.HandleQuery(testAccessContext, testQueries[i])
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -161,20 +165,20 @@ func TestAllowTables(t *testing.T) {
 	//Now we have no tables in whitelist, so censor should block all queries
 	whitelistHandler.RemoveTables([]string{"EMPLOYEE", "Customers"})
 	for _, query := range testQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err)
 		}
 	}
 	testQuery := "SELECT EMP_ID, LAST_NAME FROM EMPLOYEE, EMPLOYEE_TBL, CUSTOMERS WHERE CITY = 'INDIANAPOLIS' ORDER BY EMP_ID asc;"
 	whitelistHandler.AddTables([]string{"EMPLOYEE", "EMPLOYEE_TBL"})
-	err = censor.HandleQuery(testQuery)
+	err = censor.HandleQuery(testAccessContext, testQuery)
 	//acracensor should block this query
 	if err != common.ErrDenyAllError {
 		t.Fatal(err)
 	}
 	whitelistHandler.AddTables([]string{"CUSTOMERS"})
-	err = censor.HandleQuery(testQuery)
+	err = censor.HandleQuery(testAccessContext, testQuery)
 	//acracensor should not block this query
 	if err != nil {
 		t.Fatal(err)
@@ -215,7 +219,7 @@ func TestAllowSelectPattern(t *testing.T) {
 	}
 
 	for i, query := range testQueries {
-		err := censor.HandleQuery(query)
+		err := censor.HandleQuery(testAccessContext, query)
 		if !strings.HasPrefix(strings.ToLower(query), "select") {
 			if err != common.ErrDenyAllError {
 				t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", testQueries[i])
@@ -251,13 +255,13 @@ func TestAllowColumnsPattern(t *testing.T) {
 		"SELECT A, B, C",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -280,13 +284,13 @@ func TestAllowColumnsPattern(t *testing.T) {
 		"SELECT A, B, C FROM testTable",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -309,13 +313,13 @@ func TestAllowColumnsPattern(t *testing.T) {
 		"SELECT A, B, C FROM testTable",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -342,13 +346,13 @@ func TestAllowColumnsPattern(t *testing.T) {
 		//"SELECT A FROM testTable ORDER BY (case when f1 then 1 when f1 is null then 2 else 3 end)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -378,13 +382,13 @@ func TestAllowColumnsPattern(t *testing.T) {
 		//"SELECT A FROM testTable GROUP BY (case when f1 then 1 when f1 is null then 2 else 3 end)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -418,13 +422,13 @@ func TestAllowColumnsPattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -475,13 +479,13 @@ func TestAllowWherePattern(t *testing.T) {
 		"SELECT a, b, c FROM z INNER JOIN (SELECT age FROM company WHERE id = 1) AS t ON t.id=another_table.id WHERE AGE NOT IN (25, 27)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -534,13 +538,13 @@ func TestAllowValuePattern(t *testing.T) {
 		//"SELECT a, b FROM t WHERE ID = '123'::integer",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern: ", pattern, "\nQuery: ", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern: ", pattern, "\nQuery: ", query)
 		}
@@ -573,13 +577,13 @@ func TestAllowValuePattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -613,13 +617,13 @@ func TestAllowValuePattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -653,13 +657,13 @@ func TestAllowValuePattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -691,13 +695,13 @@ func TestAllowValuePattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern:", pattern, "\nQuery:", query)
 		}
@@ -752,13 +756,13 @@ func TestAllowStarPattern(t *testing.T) {
 		"SELECT * FROM another_table INNER JOIN (SELECT * FROM company WHERE id = 1) AS t ON t.id=another_table.id WHERE AGE NOT IN (25, 27)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Unexpected result. \nPattern: ", pattern, "\nQuery: ", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err, "Unexpected result. \nPattern: ", pattern, "\nQuery: ", query)
 		}
@@ -801,13 +805,13 @@ func TestDenyQueries(t *testing.T) {
 	acraCensor.AddHandler(blacklist)
 	//acracensor should not block those queries
 	for _, query := range sqlSelectQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
 	}
 	for _, query := range sqlInsertQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -815,26 +819,26 @@ func TestDenyQueries(t *testing.T) {
 
 	testQuery := "insert INTO Customers (CustomerName, City, Country) VALUES ('Cardinal', 'Stavanger', 'Norway');"
 	blacklist.AddQueries([]string{testQuery})
-	err = acraCensor.HandleQuery(testQuery)
+	err = acraCensor.HandleQuery(testAccessContext, testQuery)
 	//acracensor should block this query because it's in blacklist
 	if err != common.ErrDenyByQueryError {
 		t.Fatal(err)
 	}
 	acraCensor.RemoveHandler(blacklist)
-	err = acraCensor.HandleQuery(testQuery)
+	err = acraCensor.HandleQuery(testAccessContext, testQuery)
 	//acracensor should not block this query because we removed blacklist handler, err should be nil
 	if err != nil {
 		t.Fatal(err)
 	}
 	//again set our acracensor to use blacklist for query evaluating
 	acraCensor.AddHandler(blacklist)
-	err = acraCensor.HandleQuery(testQuery)
+	err = acraCensor.HandleQuery(testAccessContext, testQuery)
 	//now acracensor should block testQuery because it's in blacklist
 	if err != common.ErrDenyByQueryError {
 		t.Fatal(err)
 	}
 	blacklist.RemoveQueries([]string{testQuery})
-	err = acraCensor.HandleQuery(testQuery)
+	err = acraCensor.HandleQuery(testAccessContext, testQuery)
 	//now acracensor should not block testQuery
 	if err != nil {
 		t.Fatal(err)
@@ -861,7 +865,7 @@ func TestDenyTables(t *testing.T) {
 	//acracensor should block these queries
 	queryIndexesToBlock := []int{0, 2, 4, 5, 6}
 	for _, i := range queryIndexesToBlock {
-		err = censor.HandleQuery(testQueries[i])
+		err = censor.HandleQuery(testAccessContext, testQueries[i])
 		if err != common.ErrDenyByTableError {
 			t.Fatal(err)
 		}
@@ -869,18 +873,18 @@ func TestDenyTables(t *testing.T) {
 	//acracensor should not block these queries
 	queryIndexesToPass := []int{1, 3}
 	for _, i := range queryIndexesToPass {
-		err = censor.HandleQuery(testQueries[i])
+		err = censor.HandleQuery(testAccessContext, testQueries[i])
 		if err != nil {
 			t.Fatal(err)
 		}
 	}
 	blacklist.RemoveTables([]string{"EMPLOYEE_TBL"})
-	err = censor.HandleQuery(testQueries[0])
+	err = censor.HandleQuery(testAccessContext, testQueries[0])
 	//acracensor should not block this query
 	if err != nil {
 		t.Fatal(err)
 	}
-	err = censor.HandleQuery(testQueries[2])
+	err = censor.HandleQuery(testAccessContext, testQueries[2])
 	//acracensor should not block this query
 	if err != nil {
 		t.Fatal(err)
@@ -919,7 +923,7 @@ func TestDenySelectPattern(t *testing.T) {
 	}
 	//Queries that should be blocked by specified pattern have indexes: [0 .. 12] (all select queries)
 	for i, query := range testQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if strings.HasPrefix(strings.ToLower(query), "select") {
 			if err != common.ErrDenyByPatternError {
 				t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern+"\nQuery:", testQueries[i])
@@ -955,13 +959,13 @@ func TestDenyColumnsPattern(t *testing.T) {
 		"SELECT col1, col2",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -985,13 +989,13 @@ func TestDenyColumnsPattern(t *testing.T) {
 		"SELECT A, col2 FROM testTable",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal("Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1015,13 +1019,13 @@ func TestDenyColumnsPattern(t *testing.T) {
 		"SELECT col2, B FROM testTable",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1049,13 +1053,13 @@ func TestDenyColumnsPattern(t *testing.T) {
 		"SELECT * FROM testTable ORDER BY (case when f1 then 1 when f1 is null then 2 else 3 end)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1085,13 +1089,13 @@ func TestDenyColumnsPattern(t *testing.T) {
 		"SELECT * FROM testTable GROUP BY (case when f1 then 1 when f1 is null then 2 else 3 end)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1125,13 +1129,13 @@ func TestDenyColumnsPattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1184,13 +1188,13 @@ func TestDenyWherePattern(t *testing.T) {
 		"SELECT a, b, c FROM z WHERE A=(SELECT AGE FROM company WHERE SALARY > 65000 limit 1) and B=(SELECT AGE FROM company123 WHERE SALARY > 65000 limit 1)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1223,13 +1227,13 @@ func TestDenyValuePattern(t *testing.T) {
 		"SELECT a, b FROM t WHERE ID = 'someValue'",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1250,13 +1254,13 @@ func TestDenyValuePattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1287,13 +1291,13 @@ func TestDenyValuePattern(t *testing.T) {
 	}
 
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern blocked query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1347,13 +1351,13 @@ func TestDenyStarPattern(t *testing.T) {
 		"SELECT age FROM company WHERE EXISTS (SELECT age FROM company WHERE SALARY > 65000)",
 	}
 	for _, query := range acceptableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
 	}
 	for _, query := range blockableQueries {
-		err = censor.HandleQuery(query)
+		err = censor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err, "Blacklist pattern passed query. \nPattern:", blacklistPattern, "\nQuery:", query)
 		}
@@ -1395,7 +1399,7 @@ func TestAddingCapturedQueriesIntoBlacklist(t *testing.T) {
 	acraCensor.AddHandler(queryCaptureHandler)
 	acraCensor.AddHandler(blacklist)
 	for _, testQuery := range testQueries {
-		err = acraCensor.HandleQuery(testQuery)
+		err = acraCensor.HandleQuery(testAccessContext, testQuery)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1418,7 +1422,7 @@ func TestAddingCapturedQueriesIntoBlacklist(t *testing.T) {
 
 	blacklist.AddQueries(queryCaptureHandler.GetForbiddenQueries())
 	for _, forbiddenQueryIndex := range indexesOfForbiddenQueries {
-		err = acraCensor.HandleQuery(testQueries[forbiddenQueryIndex])
+		err = acraCensor.HandleQuery(testAccessContext, testQueries[forbiddenQueryIndex])
 		if err != common.ErrDenyByQueryError {
 			t.Fatal(err)
 		}
@@ -1426,7 +1430,7 @@ func TestAddingCapturedQueriesIntoBlacklist(t *testing.T) {
 
 	//zero, first and second query are forbidden
 	for index := 3; index < len(testQueries); index++ {
-		err = acraCensor.HandleQuery(testQueries[index])
+		err = acraCensor.HandleQuery(testAccessContext, testQueries[index])
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1472,7 +1476,7 @@ func TestQueryIgnoring(t *testing.T) {
 	acraCensor.AddHandler(blacklist)
 	//should not block
 	for _, query := range testQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1480,7 +1484,7 @@ func TestQueryIgnoring(t *testing.T) {
 	ignoreQueryHandler.Reset()
 	//should block
 	for _, query := range testQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByQueryError {
 			t.Fatal(err)
 		}
@@ -1493,14 +1497,14 @@ func TestQueryIgnoring(t *testing.T) {
 	acraCensor.ignoreParseError = true
 	ignoreQueryHandler.AddQueries(testUnparsableQueries)
 	for _, query := range testUnparsableQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
 	}
 	acraCensor.ignoreParseError = false
 	for _, query := range testUnparsableQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != sqlparser.ErrQuerySyntaxError {
 			t.Fatal(err)
 		}
@@ -1547,7 +1551,7 @@ func TestConfigurationProvider(t *testing.T) {
 	}
 	//acracensor should block those queries
 	for _, queryToBlock := range testQueries {
-		err = acraCensor.HandleQuery(queryToBlock)
+		err = acraCensor.HandleQuery(testAccessContext, queryToBlock)
 		if err != common.ErrDenyByQueryError {
 			t.Fatal(err)
 		}
@@ -1558,7 +1562,7 @@ func TestConfigurationProvider(t *testing.T) {
 	}
 	//acracensor should block those tables
 	for _, queryToBlock := range testQueries {
-		err = acraCensor.HandleQuery(queryToBlock)
+		err = acraCensor.HandleQuery(testAccessContext, queryToBlock)
 		if err != common.ErrDenyByTableError {
 			t.Fatal(err)
 		}
@@ -1569,7 +1573,7 @@ func TestConfigurationProvider(t *testing.T) {
 	}
 	//acracensor should block those queries by pattern
 	for _, queryToBlock := range testQueries {
-		err = acraCensor.HandleQuery(queryToBlock)
+		err = acraCensor.HandleQuery(testAccessContext, queryToBlock)
 		if err != common.ErrDenyByPatternError {
 			t.Fatal(err)
 		}
@@ -1623,12 +1627,12 @@ func TestDifferentTablesParsing(t *testing.T) {
 	//set our acracensor to use denyHandler for query evaluating
 	acraCensor.AddHandler(denyHandler)
 
-	err := acraCensor.HandleQuery(testQuery)
+	err := acraCensor.HandleQuery(testAccessContext, testQuery)
 	if err != nil {
 		t.Fatal(err)
 	}
 	denyHandler.AddTables([]string{"z", "Shippers"})
-	err = acraCensor.HandleQuery(testQuery)
+	err = acraCensor.HandleQuery(testAccessContext, testQuery)
 	if err != common.ErrDenyByTableError {
 		t.Fatal(err)
 	}
@@ -1641,12 +1645,12 @@ func TestDifferentTablesParsing(t *testing.T) {
 	//set our acracensor to use allowHandler for query evaluating
 	acraCensor.AddHandler(allowHandler)
 	acraCensor.AddHandler(handlers.NewDenyallHandler())
-	err = acraCensor.HandleQuery(testQuery)
+	err = acraCensor.HandleQuery(testAccessContext, testQuery)
 	if err != common.ErrDenyAllError {
 		t.Fatal(err)
 	}
 	allowHandler.AddTables([]string{"Shippers"})
-	err = acraCensor.HandleQuery(testQuery)
+	err = acraCensor.HandleQuery(testAccessContext, testQuery)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1668,7 +1672,7 @@ func TestIgnoringQueryParseErrors(t *testing.T) {
 			acraCensor.AddHandler(handler)
 		}
 		for _, query := range queriesWithSyntaxErrors {
-			err := acraCensor.HandleQuery(query)
+			err := acraCensor.HandleQuery(testAccessContext, query)
 			if err != expectedError {
 				t.Fatalf("unexpected error value - %v", err)
 			}
@@ -1746,7 +1750,7 @@ handlers:
 		"qwerty_xxx",
 	}
 	for _, query := range testQueries {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1794,7 +1798,7 @@ func TestAllowAllDenyAll(t *testing.T) {
 	}
 	allowallHandler := handlers.NewAllowallHandler()
 	for _, query := range allowQueries {
-		if _, err := allowallHandler.CheckQuery(query, nil); err != nil {
+		if _, err := allowallHandler.CheckQuery(testAccessContext, query, nil); err != nil {
 			t.Fatal("Unexpected deny on query: ", query)
 		}
 	}
@@ -1814,7 +1818,7 @@ func TestAllowAllDenyAll(t *testing.T) {
 	}
 	denyallHandler := handlers.NewDenyallHandler()
 	for _, query := range denyQueries {
-		if _, err := denyallHandler.CheckQuery(query, nil); err != common.ErrDenyAllError {
+		if _, err := denyallHandler.CheckQuery(testAccessContext, query, nil); err != common.ErrDenyAllError {
 			t.Fatal("Unexpected allow on query: ", query)
 		}
 	}
@@ -1849,7 +1853,7 @@ handlers:
 		"select * from x2",
 	}
 	for _, query := range queriesToAllow {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1858,7 +1862,7 @@ handlers:
 		"select * from x1",
 	}
 	for _, query := range queriesToDeny {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		if err != common.ErrDenyByTableError {
 			t.Fatal(err)
 		}
@@ -1868,10 +1872,447 @@ handlers:
 		"select * from anyNotWhitelistedQuery",
 	}
 	for _, query := range queriesToDenyAll {
-		err = acraCensor.HandleQuery(query)
+		err = acraCensor.HandleQuery(testAccessContext, query)
 		fmt.Println(err)
 		if err != common.ErrDenyAllError {
 			t.Fatal(err)
 		}
 	}
 }
+
+func TestClientHandlers(t *testing.T) {
+	configuration := fmt.Sprintf(`version: %s
+handlers:
+  - handler: allowall
+clients:
+  - client_id: reporting
+    handlers:
+      - handler: allow
+        tables:
+          - sales
+          - orders
+      - handler: denyall
+  - client_id: report*
+    handlers:
+      - handler: deny
+        tables:
+          - users
+      - handler: allowall
+  - client_id: "regex:^audit[0-9]+$"
+    handlers:
+      - handler: denyall
+`, MinimalCensorConfigVersion)
+	acraCensor := NewAcraCensor()
+	defer acraCensor.ReleaseAll()
+	if err := acraCensor.LoadConfiguration([]byte(configuration)); err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		clientID string
+		query    string
+		err      error
+	}{
+		// default chain
+		{"client", "select * from users", nil},
+		{"old_reporting", "select * from users", nil},
+		// exact client id has precedence over patterns
+		{"reporting", "select * from sales", nil},
+		{"reporting", "select * from users", common.ErrDenyAllError},
+		// glob
+		{"report_1", "select * from orders", nil},
+		{"report_1", "select * from users", common.ErrDenyByTableError},
+		// regex
+		{"audit1", "select * from orders", common.ErrDenyAllError},
+		{"audit", "select * from orders", nil},
+	}
+	for i, tcase := range testcases {
+		accessContext := common.NewAccessContext([]byte(tcase.clientID), "")
+		if err := acraCensor.HandleQuery(accessContext, tcase.query); err != tcase.err {
+			t.Fatalf("[%d] Expected %v, took %v", i, tcase.err, err)
+		}
+	}
+	// queries without access context processed with default chain
+	if err := acraCensor.HandleQuery(nil, "select * from users"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInvalidClientHandlers(t *testing.T) {
+	testcases := []struct {
+		clients string
+		err     error
+	}{
+		{`
+  - handlers:
+      - handler: denyall`, ErrEmptyClientID},
+		{`
+  - client_id: "regex:["
+    handlers:
+      - handler: denyall`, ErrInvalidClientIDPattern},
+		{`
+  - client_id: "client[a-"
+    handlers:
+      - handler: denyall`, ErrInvalidClientIDPattern},
+		{`
+  - client_id: client
+    handlers:
+      - handler: denyall
+  - client_id: client
+    handlers:
+      - handler: allowall`, ErrDuplicateClientID},
+		{`
+  - client_id: client
+    handlers:
+      - handler: unknown`, common.ErrCensorConfigurationError},
+	}
+	for i, tcase := range testcases {
+		configuration := fmt.Sprintf("version: %s\nclients:%s", MinimalCensorConfigVersion, tcase.clients)
+		acraCensor := NewAcraCensor()
+		err := acraCensor.LoadConfiguration([]byte(configuration))
+		acraCensor.ReleaseAll()
+		if err != tcase.err {
+			t.Fatalf("[%d] Expected %v, took %v", i, tcase.err, err)
+		}
+	}
+}
+
+func TestColumnsHandlers(t *testing.T) {
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(`
+schemas:
+  - table: users
+    columns:
+      - id
+      - name
+      - ssn
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	configuration := fmt.Sprintf(`version: %s
+handlers:
+  - handler: deny
+    columns:
+      - users.ssn
+  - handler: allow
+    columns:
+      - users.id
+      - users.name
+  - handler: denyall
+`, MinimalCensorConfigVersion)
+	acraCensor := NewAcraCensor()
+	defer acraCensor.ReleaseAll()
+	acraCensor.SetTableSchemaStore(schemaStore)
+	if err := acraCensor.LoadConfiguration([]byte(configuration)); err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		query string
+		err   error
+	}{
+		{"SELECT name FROM users", nil},
+		{"SELECT u.id, u.name FROM users u WHERE u.name = 'a' ORDER BY u.id", nil},
+		{"SELECT ssn FROM users", common.ErrDenyByColumnError},
+		{"SELECT name FROM users WHERE ssn = '1'", common.ErrDenyByColumnError},
+		{"SELECT * FROM users", common.ErrDenyByColumnError},
+		{"SELECT name FROM users ORDER BY ssn", common.ErrDenyByColumnError},
+		{"SELECT balance FROM accounts", common.ErrDenyAllError},
+		{"WITH x AS (SELECT ssn FROM users) SELECT * FROM x", common.ErrDenyByColumnError},
+		{"WITH x AS (SELECT name FROM users) SELECT * FROM x", nil},
+		{"SELECT name FROM users GROUP BY ssn", common.ErrDenyByColumnError},
+		{"SELECT name FROM users GROUP BY name HAVING max(ssn) > 1", common.ErrDenyByColumnError},
+		{"SELECT ssn FROM public.users", common.ErrDenyByColumnError},
+	}
+	for i, tcase := range testcases {
+		if err := acraCensor.HandleQuery(testAccessContext, tcase.query); err != tcase.err {
+			t.Fatalf("[%d] Expected %v, took %v", i, tcase.err, err)
+		}
+	}
+
+	// allow rules don't pass columns read by common table expressions
+	configuration = fmt.Sprintf(`version: %s
+handlers:
+  - handler: allow
+    columns:
+      - public.users.id
+      - public.users.name
+  - handler: denyall
+`, MinimalCensorConfigVersion)
+	allowCensor := NewAcraCensor()
+	defer allowCensor.ReleaseAll()
+	allowCensor.SetTableSchemaStore(schemaStore)
+	if err := allowCensor.LoadConfiguration([]byte(configuration)); err != nil {
+		t.Fatal(err)
+	}
+	testcases = []struct {
+		query string
+		err   error
+	}{
+		{"SELECT name FROM users", nil},
+		{"WITH x AS (SELECT id, name FROM users) SELECT * FROM x", nil},
+		{"WITH x AS (SELECT ssn FROM users) SELECT * FROM x", common.ErrDenyAllError},
+		{"WITH x AS (SELECT name FROM users) SELECT x.name FROM x JOIN users ON users.ssn = x.name", common.ErrDenyAllError},
+		{"SELECT name FROM users GROUP BY ssn", common.ErrDenyAllError},
+	}
+	for i, tcase := range testcases {
+		if err := allowCensor.HandleQuery(testAccessContext, tcase.query); err != tcase.err {
+			t.Fatalf("[%d] Expected %v, took %v", i, tcase.err, err)
+		}
+	}
+
+	configuration = fmt.Sprintf(`version: %s
+handlers:
+  - handler: deny
+    columns:
+      - ssn
+`, MinimalCensorConfigVersion)
+	invalidCensor := NewAcraCensor()
+	defer invalidCensor.ReleaseAll()
+	if err := invalidCensor.LoadConfiguration([]byte(configuration)); err != common.ErrColumnRuleSyntaxError {
+		t.Fatalf("Expected ErrColumnRuleSyntaxError, took %v", err)
+	}
+}
+
+func TestRateLimitConfiguration(t *testing.T) {
+	configuration := fmt.Sprintf(`version: %s
+handlers:
+  - handler: ratelimit
+    storage: memory
+    limits:
+      - queries:
+          - SELECT * FROM users
+        rate: 2
+        period: 1h
+      - tables:
+          - orders
+        rate: 1
+        burst: 1
+        action: alert
+  - handler: allowall
+`, MinimalCensorConfigVersion)
+	acraCensor := NewAcraCensor()
+	defer acraCensor.ReleaseAll()
+	if err := acraCensor.LoadConfiguration([]byte(configuration)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := acraCensor.HandleQuery(testAccessContext, "SELECT * FROM users"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := acraCensor.HandleQuery(testAccessContext, "SELECT * FROM users"); err != common.ErrDenyByRateLimitError {
+		t.Fatalf("Expected ErrDenyByRateLimitError, took %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := acraCensor.HandleQuery(testAccessContext, "SELECT * FROM orders"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testcases := []struct {
+		handler string
+		err     error
+	}{
+		{`
+  - handler: ratelimit
+    storage: file`, ErrInvalidRateLimitStorage},
+		{`
+  - handler: ratelimit
+    storage: redis`, ErrInvalidRateLimitStorage},
+		{`
+  - handler: ratelimit
+    limits:
+      - rate: 1
+        action: block`, handlers.ErrInvalidRateLimitAction},
+		{`
+  - handler: ratelimit
+    limits:
+      - rate: 1
+        period: -1s`, handlers.ErrInvalidRateLimit},
+	}
+	for i, tcase := range testcases {
+		configuration := fmt.Sprintf("version: %s\nhandlers:%s", MinimalCensorConfigVersion, tcase.handler)
+		acraCensor := NewAcraCensor()
+		err := acraCensor.LoadConfiguration([]byte(configuration))
+		acraCensor.ReleaseAll()
+		if err != tcase.err {
+			t.Fatalf("[%d] Expected %v, took %v", i, tcase.err, err)
+		}
+	}
+}
+
+func TestMonitorMode(t *testing.T) {
+	verdicts := func(handler, rule, verdict string) float64 {
+		return testutil.ToFloat64(common.MonitorVerdictsCounter.WithLabelValues(handler, rule, verdict))
+	}
+	testcases := []struct {
+		handlers string
+		query    string
+		err      error
+		// expected increments of verdicts counters
+		verdicts map[[3]string]float64
+	}{
+		// global monitor mode forwards denied queries
+		{`mode: monitor
+handlers:
+  - handler: deny
+    tables:
+      - users
+  - handler: allowall`, "SELECT * FROM users", nil, map[[3]string]float64{
+			{DenyConfigStr, common.TablesRule, denyVerdict}:   1,
+			{AllowAllConfigStr, common.AllRule, allowVerdict}: 0,
+		}},
+		// unparsed queries are forwarded in global monitor mode
+		{`mode: monitor
+handlers:
+  - handler: allowall`, "SELECT * FROM", nil, map[[3]string]float64{
+			{parserVerdictName, common.SyntaxRule, denyVerdict}: 1,
+			{AllowAllConfigStr, common.AllRule, allowVerdict}:   1,
+		}},
+		// handler in monitor mode with enforced handlers
+		{`handlers:
+  - handler: deny
+    mode: monitor
+    tables:
+      - users
+  - handler: denyall`, "SELECT * FROM users", common.ErrDenyAllError, map[[3]string]float64{
+			{DenyConfigStr, common.TablesRule, denyVerdict}: 1,
+		}},
+		// allow verdict of handler in monitor mode doesn't allow query
+		{`handlers:
+  - handler: allow
+    mode: monitor
+    patterns:
+      - SELECT * FROM users %%WHERE%%
+  - handler: denyall`, "SELECT * FROM users WHERE id = 1", common.ErrDenyAllError, map[[3]string]float64{
+			{AllowConfigStr, common.PatternsRule, allowVerdict}: 1,
+		}},
+		// verdicts of handlers after the first decision aren't reported
+		{`mode: monitor
+handlers:
+  - handler: allow
+    queries:
+      - SELECT * FROM users
+  - handler: denyall`, "SELECT * FROM users", nil, map[[3]string]float64{
+			{AllowConfigStr, common.QueriesRule, allowVerdict}: 1,
+			{DenyAllConfigStr, common.AllRule, denyVerdict}:    0,
+		}},
+		// own mode of handler overrides global mode
+		{`mode: monitor
+handlers:
+  - handler: deny
+    mode: enforce
+    patterns:
+      - SELECT * FROM users %%WHERE%%
+  - handler: denyall`, "SELECT * FROM users WHERE id = 1", common.ErrDenyByPatternError, map[[3]string]float64{
+			{DenyConfigStr, common.PatternsRule, denyVerdict}: 0,
+			{DenyAllConfigStr, common.AllRule, denyVerdict}:   0,
+		}},
+		// client handlers use global mode
+		{`mode: monitor
+handlers:
+  - handler: allowall
+clients:
+  - client_id: client
+    handlers:
+      - handler: ratelimit
+        limits:
+          - rate: 1
+            burst: 1
+      - handler: denyall`, "SELECT 1", nil, map[[3]string]float64{
+			{RateLimitConfigStr, "", continueVerdict}:       1,
+			{DenyAllConfigStr, common.AllRule, denyVerdict}: 1,
+		}},
+	}
+	for i, tcase := range testcases {
+		acraCensor := NewAcraCensor()
+		configuration := fmt.Sprintf("version: %s\n%s\n", MinimalCensorConfigVersion, tcase.handlers)
+		if err := acraCensor.LoadConfiguration([]byte(configuration)); err != nil {
+			t.Fatalf("[%d] %v", i, err)
+		}
+		before := make(map[[3]string]float64, len(tcase.verdicts))
+		for labels := range tcase.verdicts {
+			before[labels] = verdicts(labels[0], labels[1], labels[2])
+		}
+		err := acraCensor.HandleQuery(testAccessContext, tcase.query)
+		acraCensor.ReleaseAll()
+		if err != tcase.err {
+			t.Fatalf("[%d] Expected %v, took %v", i, tcase.err, err)
+		}
+		for labels, expected := range tcase.verdicts {
+			if took := verdicts(labels[0], labels[1], labels[2]) - before[labels]; took != expected {
+				t.Fatalf("[%d] Expected %v verdicts %v, took %v", i, expected, labels, took)
+			}
+		}
+	}
+
+	for i, configuration := range []string{
+		"mode: shadow\nhandlers:\n  - handler: allowall",
+		"handlers:\n  - handler: allowall\n    mode: shadow",
+	} {
+		acraCensor := NewAcraCensor()
+		err := acraCensor.LoadConfiguration([]byte(fmt.Sprintf("version: %s\n%s\n", MinimalCensorConfigVersion, configuration)))
+		acraCensor.ReleaseAll()
+		if err != ErrInvalidCensorMode {
+			t.Fatalf("[%d] Expected ErrInvalidCensorMode, took %v", i, err)
+		}
+	}
+}
+
+func TestCheckQueryMatchedRule(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	newAllowHandler := func() *handlers.AllowHandler {
+		handler := handlers.NewAllowHandler(parser)
+		if err := handler.AddQueries([]string{"SELECT 1"}); err != nil {
+			t.Fatal(err)
+		}
+		handler.AddTables([]string{"users", "orders"})
+		if err := handler.AddColumns([]string{"accounts.id"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := handler.AddPatterns([]string{"SELECT * FROM logs %%WHERE%%"}); err != nil {
+			t.Fatal(err)
+		}
+		return handler
+	}
+	newDenyHandler := func() *handlers.DenyHandler {
+		handler := handlers.NewDenyHandler(parser)
+		if err := handler.AddQueries([]string{"SELECT 1"}); err != nil {
+			t.Fatal(err)
+		}
+		handler.AddTables([]string{"users"})
+		if err := handler.AddColumns([]string{"accounts.secret"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := handler.AddPatterns([]string{"SELECT * FROM logs %%WHERE%%"}); err != nil {
+			t.Fatal(err)
+		}
+		return handler
+	}
+	testcases := []struct {
+		handler QueryHandlerInterface
+		query   string
+		rule    string
+		matched string
+	}{
+		{newAllowHandler(), "SELECT 1", common.QueriesRule, "select 1 from dual"},
+		{newAllowHandler(), "SELECT a FROM users JOIN orders ON users.id = orders.user_id", common.TablesRule, "users,orders"},
+		{newAllowHandler(), "SELECT id FROM accounts WHERE id > 1", common.ColumnsRule, "accounts.id"},
+		{newAllowHandler(), "SELECT * FROM logs WHERE id = 1", common.PatternsRule, "SELECT * FROM logs %%WHERE%%"},
+		{newAllowHandler(), "SELECT secret FROM accounts", "", ""},
+		{newDenyHandler(), "SELECT 1", common.QueriesRule, "select 1 from dual"},
+		{newDenyHandler(), "SELECT a FROM orders JOIN users ON users.id = orders.user_id", common.TablesRule, "users"},
+		{newDenyHandler(), "SELECT id, secret FROM accounts", common.ColumnsRule, "accounts.secret"},
+		{newDenyHandler(), "SELECT * FROM logs WHERE id = 1", common.PatternsRule, "SELECT * FROM logs %%WHERE%%"},
+		{newDenyHandler(), "SELECT id FROM accounts", "", ""},
+	}
+	for i, tcase := range testcases {
+		normalizedQuery, _, parsedQuery, err := parser.HandleRawSQLQuery(tcase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, rule, matched, _ := checkQuery(tcase.handler, testAccessContext, tcase.query, normalizedQuery, parsedQuery)
+		if rule != tcase.rule || matched != tcase.matched {
+			t.Fatalf("[%d] Expected rule %q with %q, took %q with %q", i, tcase.rule, tcase.matched, rule, matched)
+		}
+	}
+}
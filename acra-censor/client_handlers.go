@@ -0,0 +1,131 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acracensor
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RegexClientIDPrefix marks client_id in config as regular expression
+const RegexClientIDPrefix = "regex:"
+
+// Errors related to ClientIDs of handler chains
+var (
+	ErrEmptyClientID          = errors.New("client handlers should have client_id")
+	ErrInvalidClientIDPattern = errors.New("invalid client_id pattern")
+	ErrDuplicateClientID      = errors.New("several client handlers with the same client_id")
+)
+
+// clientHandlers is a chain of handlers used for queries of ClientIDs matched by exact value, glob pattern or
+// regular expression
+type clientHandlers struct {
+	clientID string
+	glob     bool
+	regex    *regexp.Regexp
+	handlers []QueryHandlerInterface
+}
+
+func newClientHandlers(clientID string, handlers []QueryHandlerInterface) (*clientHandlers, error) {
+	if clientID == "" {
+		return nil, ErrEmptyClientID
+	}
+	chain := &clientHandlers{clientID: clientID, handlers: handlers}
+	if strings.HasPrefix(clientID, RegexClientIDPrefix) {
+		regex, err := regexp.Compile(strings.TrimPrefix(clientID, RegexClientIDPrefix))
+		if err != nil {
+			return nil, ErrInvalidClientIDPattern
+		}
+		chain.regex = regex
+		return chain, nil
+	}
+	if strings.ContainsAny(clientID, "*?[") {
+		// path.Match validates whole pattern only on matching
+		if _, err := path.Match(clientID, ""); err != nil {
+			return nil, ErrInvalidClientIDPattern
+		}
+		chain.glob = true
+	}
+	return chain, nil
+}
+
+func (chain *clientHandlers) isPattern() bool {
+	return chain.glob || chain.regex != nil
+}
+
+func (chain *clientHandlers) match(clientID string) bool {
+	if chain.regex != nil {
+		return chain.regex.MatchString(clientID)
+	}
+	if chain.glob {
+		matched, _ := path.Match(chain.clientID, clientID)
+		return matched
+	}
+	return chain.clientID == clientID
+}
+
+// clientHandlersMatcher finds handler chain of the ClientID. Exact ClientIDs have precedence over patterns which
+// are matched in config order
+type clientHandlersMatcher struct {
+	exact    map[string]*clientHandlers
+	patterns []*clientHandlers
+}
+
+func newClientHandlersMatcher() *clientHandlersMatcher {
+	return &clientHandlersMatcher{exact: make(map[string]*clientHandlers)}
+}
+
+func (matcher *clientHandlersMatcher) add(chain *clientHandlers) error {
+	if chain.isPattern() {
+		for _, pattern := range matcher.patterns {
+			if pattern.clientID == chain.clientID {
+				return ErrDuplicateClientID
+			}
+		}
+		matcher.patterns = append(matcher.patterns, chain)
+		return nil
+	}
+	if _, ok := matcher.exact[chain.clientID]; ok {
+		return ErrDuplicateClientID
+	}
+	matcher.exact[chain.clientID] = chain
+	return nil
+}
+
+// match returns handler chain of the ClientID or nil if there is no chain for it
+func (matcher *clientHandlersMatcher) match(clientID []byte) *clientHandlers {
+	if chain, ok := matcher.exact[string(clientID)]; ok {
+		return chain
+	}
+	for _, chain := range matcher.patterns {
+		if chain.match(string(clientID)) {
+			return chain
+		}
+	}
+	return nil
+}
+
+// all returns all handler chains
+func (matcher *clientHandlersMatcher) all() []*clientHandlers {
+	chains := make([]*clientHandlers, 0, len(matcher.exact)+len(matcher.patterns))
+	for _, chain := range matcher.exact {
+		chains = append(chains, chain)
+	}
+	return append(chains, matcher.patterns...)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+// AccessContext describes the client which sent the query to AcraCensor
+type AccessContext struct {
+	clientID    []byte
+	tlsIdentity string
+}
+
+// NewAccessContext returns AccessContext with ClientID of the connection and subject of the client's verified TLS
+// certificate, empty if client doesn't use TLS
+func NewAccessContext(clientID []byte, tlsIdentity string) *AccessContext {
+	return &AccessContext{clientID: clientID, tlsIdentity: tlsIdentity}
+}
+
+// ClientID returns ClientID of the connection, nil for nil AccessContext
+func (ctx *AccessContext) ClientID() []byte {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.clientID
+}
+
+// TLSIdentity returns subject of the client's TLS certificate, empty for nil AccessContext
+func (ctx *AccessContext) TLSIdentity() string {
+	if ctx == nil {
+		return ""
+	}
+	return ctx.tlsIdentity
+}
@@ -0,0 +1,463 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"strings"
+
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/sqlparser"
+)
+
+// anyColumn used for star expressions of tables which columns are unknown
+const anyColumn = "*"
+
+// DefaultTableSchema is schema of tables used in queries without schema (PostgreSQL's default search_path). Column
+// rules with table without schema match the table in any schema as table names in encryptor config
+const DefaultTableSchema = "public"
+
+// tableRuleNames returns names of the table which may be used in column rules
+func tableRuleNames(table string) []string {
+	schema, name := config.SplitQualifiedTableName(table)
+	if schema == "" {
+		return []string{name, config.QualifiedTableName(DefaultTableSchema, name)}
+	}
+	return []string{table, name}
+}
+
+// ParseColumnRules validates columns in "table.column" format and returns them as set of lowercased names
+func ParseColumnRules(columns []string) (map[string]bool, error) {
+	setOfColumns := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		separator := strings.LastIndexByte(column, '.')
+		if separator <= 0 || separator == len(column)-1 || strings.Contains(column, anyColumn) {
+			return nil, ErrColumnRuleSyntaxError
+		}
+		setOfColumns[strings.ToLower(column)] = true
+	}
+	return setOfColumns, nil
+}
+
+// columnReference is column used in query with tables it may belong to. Unqualified column of the query with several
+// tables may belong to any of them if table schema doesn't tell otherwise
+type columnReference struct {
+	tables []string
+	column string
+}
+
+// matchTable returns true if the column of the table is in the set
+func matchTable(table, column string, setOfColumns map[string]bool) bool {
+	for _, name := range tableRuleNames(table) {
+		if column == anyColumn {
+			// columns of the table are unknown, so "*" may refer to any of them
+			prefix := name + "."
+			for ruleColumn := range setOfColumns {
+				if strings.HasPrefix(ruleColumn, prefix) && !strings.Contains(ruleColumn[len(prefix):], ".") {
+					return true
+				}
+			}
+			continue
+		}
+		if setOfColumns[name+"."+column] {
+			return true
+		}
+	}
+	return false
+}
+
+func (reference columnReference) matchOne(setOfColumns map[string]bool) bool {
+	for _, table := range reference.tables {
+		if matchTable(table, reference.column, setOfColumns) {
+			return true
+		}
+	}
+	return false
+}
+
+func (reference columnReference) matchAll(setOfColumns map[string]bool) bool {
+	if reference.column == anyColumn {
+		return false
+	}
+	for _, table := range reference.tables {
+		if !matchTable(table, reference.column, setOfColumns) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckColumnNamesMatch evaluates if query uses columns presented in specified set of "table.column" names. It returns
+// whether at least one column matches and whether all columns match. Columns are taken from SELECT lists, WHERE,
+// JOIN conditions, GROUP BY, HAVING, ORDER BY, SET, VALUES and RETURNING with resolved table aliases, including
+// subqueries and common table expressions of WITH clause. Star expressions are expanded with columns from schemaStore,
+// star over table absent in schemaStore matches any column of the table in the set
+func CheckColumnNamesMatch(parsedQuery sqlparser.Statement, setOfColumns map[string]bool, schemaStore config.TableSchemaStore) (bool, bool) {
+	collector := &columnCollector{schemaStore: schemaStore}
+	collector.collectStatement(parsedQuery, nil)
+	if len(collector.references) == 0 {
+		return false, false
+	}
+	atLeastOneColumnMatch := false
+	allColumnsMatch := true
+	for _, reference := range collector.references {
+		if reference.matchOne(setOfColumns) {
+			atLeastOneColumnMatch = true
+		}
+		if !reference.matchAll(setOfColumns) {
+			allColumnsMatch = false
+		}
+	}
+	return atLeastOneColumnMatch, allColumnsMatch
+}
+
+// MatchedColumnNames returns columns of the query in "table.column" format which are presented in specified set of
+// "table.column" names, star over table absent in schemaStore returned as "table.*"
+func MatchedColumnNames(parsedQuery sqlparser.Statement, setOfColumns map[string]bool, schemaStore config.TableSchemaStore) []string {
+	collector := &columnCollector{schemaStore: schemaStore}
+	collector.collectStatement(parsedQuery, nil)
+	var matched []string
+	seen := make(map[string]bool, len(collector.references))
+	for _, reference := range collector.references {
+		for _, table := range reference.tables {
+			name := table + "." + reference.column
+			if !seen[name] && matchTable(table, reference.column, setOfColumns) {
+				seen[name] = true
+				matched = append(matched, name)
+			}
+		}
+	}
+	return matched
+}
+
+// columnScope stores tables of FROM clause of one query which columns may refer to
+type columnScope struct {
+	parent *columnScope
+	// tables in FROM clause in order
+	tables []string
+	// aliases maps table names and aliases to table names
+	aliases map[string]string
+	// derived stores aliases of subqueries in FROM clause, their columns are checked with subqueries
+	derived map[string]bool
+	// selectAliases stores aliases of SELECT list which ORDER BY may refer to
+	selectAliases map[string]bool
+	// ctes stores names of common table expressions of WITH clause, their columns are checked with CTE statements
+	ctes map[string]bool
+}
+
+func newColumnScope(parent *columnScope) *columnScope {
+	return &columnScope{parent: parent, aliases: make(map[string]string), derived: make(map[string]bool), selectAliases: make(map[string]bool), ctes: make(map[string]bool)}
+}
+
+// isCTE returns true if table without schema refers to common table expression
+func (scope *columnScope) isCTE(table sqlparser.TableName) bool {
+	if !table.Qualifier.IsEmpty() {
+		return false
+	}
+	name := strings.ToLower(table.Name.String())
+	for current := scope; current != nil; current = current.parent {
+		if current.ctes[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTable returns table with name or alias or false if it is subquery
+func (scope *columnScope) resolveTable(name string) (string, bool) {
+	for current := scope; current != nil; current = current.parent {
+		if current.derived[name] {
+			return "", false
+		}
+		if table, ok := current.aliases[name]; ok {
+			return table, true
+		}
+	}
+	return name, true
+}
+
+type columnCollector struct {
+	schemaStore config.TableSchemaStore
+	references  []columnReference
+}
+
+func (collector *columnCollector) tableColumns(table string) []string {
+	if collector.schemaStore == nil {
+		return nil
+	}
+	schema := collector.schemaStore.GetTableSchema(table)
+	if schema == nil {
+		return nil
+	}
+	columns := make([]string, 0, len(schema.Columns()))
+	for _, column := range schema.Columns() {
+		columns = append(columns, strings.ToLower(column))
+	}
+	return columns
+}
+
+func (collector *columnCollector) addStar(table string) {
+	columns := collector.tableColumns(table)
+	if len(columns) == 0 {
+		collector.references = append(collector.references, columnReference{tables: []string{table}, column: anyColumn})
+		return
+	}
+	for _, column := range columns {
+		collector.references = append(collector.references, columnReference{tables: []string{table}, column: column})
+	}
+}
+
+func (collector *columnCollector) addColumn(column *sqlparser.ColName, scope *columnScope) {
+	name := column.Name.Lowered()
+	if !column.Qualifier.IsEmpty() {
+		table, ok := scope.resolveTable(tableNameToString(column.Qualifier))
+		if !ok {
+			return
+		}
+		collector.references = append(collector.references, columnReference{tables: []string{table}, column: name})
+		return
+	}
+	if len(scope.tables) == 0 {
+		return
+	}
+	tables := scope.tables
+	if len(tables) > 1 {
+		// use table schema to find out which table has the column
+		filtered := make([]string, 0, len(tables))
+		for _, table := range tables {
+			for _, tableColumn := range collector.tableColumns(table) {
+				if tableColumn == name {
+					filtered = append(filtered, table)
+					break
+				}
+			}
+		}
+		if len(filtered) > 0 {
+			tables = filtered
+		}
+	}
+	collector.references = append(collector.references, columnReference{tables: tables, column: name})
+}
+
+func (collector *columnCollector) collectStatement(statement sqlparser.SQLNode, parent *columnScope) {
+	switch query := statement.(type) {
+	case *sqlparser.Select:
+		scope := newColumnScope(parent)
+		collector.collectWith(query.With, scope)
+		collector.collectTableExprs(query.From, scope)
+		for _, expr := range query.SelectExprs {
+			switch selectExpr := expr.(type) {
+			case *sqlparser.StarExpr:
+				if selectExpr.TableName.IsEmpty() {
+					for _, table := range scope.tables {
+						collector.addStar(table)
+					}
+					continue
+				}
+				if table, ok := scope.resolveTable(tableNameToString(selectExpr.TableName)); ok {
+					collector.addStar(table)
+				}
+			case *sqlparser.AliasedExpr:
+				if !selectExpr.As.IsEmpty() {
+					scope.selectAliases[selectExpr.As.Lowered()] = true
+				}
+				collector.collectExpr(selectExpr.Expr, scope)
+			}
+		}
+		if query.Where != nil {
+			collector.collectExpr(query.Where.Expr, scope)
+		}
+		for _, expr := range query.GroupBy {
+			collector.collectGroupingExpr(expr, scope)
+		}
+		if query.Having != nil {
+			collector.collectGroupingExpr(query.Having.Expr, scope)
+		}
+		collector.collectOrderBy(query.OrderBy, scope)
+	case *sqlparser.Union:
+		scope := newColumnScope(parent)
+		collector.collectWith(query.With, scope)
+		collector.collectStatement(query.Left, scope)
+		collector.collectStatement(query.Right, scope)
+	case *sqlparser.ParenSelect:
+		collector.collectStatement(query.Select, parent)
+	case *sqlparser.Insert:
+		scope := newColumnScope(parent)
+		collector.collectWith(query.With, scope)
+		table := tableNameToString(query.Table)
+		scope.tables = append(scope.tables, table)
+		scope.aliases[table] = table
+		for _, expr := range query.Returning {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				collector.addStar(table)
+				continue
+			}
+			collector.collectExpr(expr, scope)
+		}
+		switch rows := query.Rows.(type) {
+		case sqlparser.SelectStatement:
+			collector.collectStatement(rows, scope)
+		case sqlparser.Values:
+			// values may contain subqueries
+			for _, tuple := range rows {
+				for _, expr := range tuple {
+					collector.collectExpr(expr, scope)
+				}
+			}
+		}
+	case *sqlparser.Update:
+		scope := newColumnScope(parent)
+		collector.collectWith(query.With, scope)
+		collector.collectTableExprs(query.TableExprs, scope)
+		for _, updateExpr := range query.Exprs {
+			collector.collectExpr(updateExpr.Expr, scope)
+		}
+		if query.Where != nil {
+			collector.collectExpr(query.Where.Expr, scope)
+		}
+		collector.collectOrderBy(query.OrderBy, scope)
+	case *sqlparser.Delete:
+		scope := newColumnScope(parent)
+		collector.collectWith(query.With, scope)
+		collector.collectTableExprs(query.TableExprs, scope)
+		if query.Where != nil {
+			collector.collectExpr(query.Where.Expr, scope)
+		}
+		collector.collectOrderBy(query.OrderBy, scope)
+	}
+}
+
+// collectWith registers names of common table expressions in scope and collects columns of their statements
+func (collector *columnCollector) collectWith(with *sqlparser.With, scope *columnScope) {
+	if with == nil {
+		return
+	}
+	// names are registered first because recursive CTE refers to itself
+	for _, cte := range with.CTEs {
+		scope.ctes[strings.ToLower(cte.Name.String())] = true
+	}
+	for _, cte := range with.CTEs {
+		collector.collectStatement(cte.Statement, scope)
+	}
+}
+
+func (collector *columnCollector) collectOrderBy(orderBy sqlparser.OrderBy, scope *columnScope) {
+	for _, order := range orderBy {
+		// ORDER BY alias refers to the expression of SELECT list which is already collected
+		if column, ok := order.Expr.(*sqlparser.ColName); ok && column.Qualifier.IsEmpty() && scope.selectAliases[column.Name.Lowered()] {
+			continue
+		}
+		collector.collectExpr(order.Expr, scope)
+	}
+}
+
+func (collector *columnCollector) collectTableExprs(tables sqlparser.TableExprs, scope *columnScope) {
+	for _, tableExpr := range tables {
+		collector.collectTableExpr(tableExpr, scope)
+	}
+}
+
+func (collector *columnCollector) collectTableExpr(tableExpr sqlparser.TableExpr, scope *columnScope) {
+	switch tbl := tableExpr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		switch expr := tbl.Expr.(type) {
+		case sqlparser.TableName:
+			if scope.isCTE(expr) {
+				name := strings.ToLower(expr.Name.String())
+				scope.derived[name] = true
+				if !tbl.As.IsEmpty() {
+					scope.derived[strings.ToLower(tbl.As.String())] = true
+				}
+				return
+			}
+			table := tableNameToString(expr)
+			scope.tables = append(scope.tables, table)
+			scope.aliases[table] = table
+			scope.aliases[strings.ToLower(expr.Name.String())] = table
+			if !tbl.As.IsEmpty() {
+				scope.aliases[strings.ToLower(tbl.As.String())] = table
+			}
+		case *sqlparser.Subquery:
+			collector.collectStatement(expr.Select, scope.parent)
+			if !tbl.As.IsEmpty() {
+				scope.derived[strings.ToLower(tbl.As.String())] = true
+			}
+		}
+	case *sqlparser.JoinTableExpr:
+		collector.collectTableExpr(tbl.LeftExpr, scope)
+		collector.collectTableExpr(tbl.RightExpr, scope)
+		if tbl.Condition.On != nil {
+			collector.collectExpr(tbl.Condition.On, scope)
+		}
+	case *sqlparser.ParenTableExpr:
+		collector.collectTableExprs(tbl.Exprs, scope)
+	}
+}
+
+func (collector *columnCollector) collectExpr(expr sqlparser.Expr, scope *columnScope) {
+	collector.walkExpr(expr, scope, false)
+}
+
+// collectGroupingExpr collects columns of GROUP BY and HAVING expressions which may refer to aliases of SELECT list
+func (collector *columnCollector) collectGroupingExpr(expr sqlparser.Expr, scope *columnScope) {
+	collector.walkExpr(expr, scope, true)
+}
+
+// isGroupingAlias returns true if column of GROUP BY or HAVING refers to alias of SELECT list. Columns of tables have
+// precedence over aliases there, so alias is skipped only if columns of all tables are known and don't include it
+func (collector *columnCollector) isGroupingAlias(column *sqlparser.ColName, scope *columnScope) bool {
+	name := column.Name.Lowered()
+	if !column.Qualifier.IsEmpty() || !scope.selectAliases[name] {
+		return false
+	}
+	for _, table := range scope.tables {
+		columns := collector.tableColumns(table)
+		if len(columns) == 0 {
+			return false
+		}
+		for _, tableColumn := range columns {
+			if tableColumn == name {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (collector *columnCollector) walkExpr(expr sqlparser.Expr, scope *columnScope, grouping bool) {
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch value := node.(type) {
+		case *sqlparser.ColName:
+			if grouping && collector.isGroupingAlias(value, scope) {
+				return false, nil
+			}
+			collector.addColumn(value, scope)
+			return false, nil
+		case *sqlparser.Subquery:
+			collector.collectStatement(value.Select, scope)
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+}
+
+// tableNameToString returns lowercased table name qualified with schema if it is specified
+func tableNameToString(table sqlparser.TableName) string {
+	if table.Qualifier.IsEmpty() {
+		return strings.ToLower(table.Name.String())
+	}
+	return strings.ToLower(table.Qualifier.String() + "." + table.Name.String())
+}
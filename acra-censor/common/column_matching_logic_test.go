@@ -0,0 +1,154 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/sqlparser"
+)
+
+func TestParseColumnRules(t *testing.T) {
+	setOfColumns, err := ParseColumnRules([]string{"users.SSN", "public.orders.card"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(setOfColumns) != 2 || !setOfColumns["users.ssn"] || !setOfColumns["public.orders.card"] {
+		t.Fatalf("Unexpected columns: %v", setOfColumns)
+	}
+	for _, column := range []string{"ssn", ".ssn", "users.", "users.*", ""} {
+		if _, err := ParseColumnRules([]string{column}); err != ErrColumnRuleSyntaxError {
+			t.Fatalf("Expected ErrColumnRuleSyntaxError for '%s', took %v", column, err)
+		}
+	}
+}
+
+func TestCheckColumnNamesMatch(t *testing.T) {
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(`
+schemas:
+  - table: users
+    columns:
+      - id
+      - name
+      - ssn
+  - table: orders
+    columns:
+      - id
+      - user_id
+      - total
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	setOfColumns, err := ParseColumnRules([]string{"users.ssn", "users.id", "users.name", "accounts.balance"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		query       string
+		atLeastOne  bool
+		all         bool
+		schemaStore config.TableSchemaStore
+	}{
+		{"SELECT name FROM users", true, true, schemaStore},
+		{"SELECT ssn FROM users", true, true, schemaStore},
+		{"SELECT total FROM orders", false, false, schemaStore},
+		{"SELECT 1", false, false, schemaStore},
+		// case insensitive identifiers
+		{"SELECT SSN FROM Users", true, true, schemaStore},
+		// table aliases
+		{"SELECT u.ssn FROM users AS u", true, true, schemaStore},
+		{"SELECT o.total FROM users u JOIN orders o ON u.id = o.user_id", true, false, schemaStore},
+		// unqualified columns resolved with table schema
+		{"SELECT total FROM users, orders WHERE name = 'a'", true, false, schemaStore},
+		// column without schema may belong to any table
+		{"SELECT name FROM users, accounts", true, false, nil},
+		// where and order by
+		{"SELECT total FROM orders WHERE user_id IN (SELECT id FROM users WHERE ssn = '1')", true, false, schemaStore},
+		{"SELECT id FROM accounts ORDER BY balance", true, false, schemaStore},
+		// order by select alias
+		{"SELECT ssn AS s FROM users ORDER BY s", true, true, schemaStore},
+		// star expansion
+		{"SELECT * FROM users", true, true, schemaStore},
+		{"SELECT * FROM orders", false, false, schemaStore},
+		{"SELECT o.* FROM users u, orders o", false, false, schemaStore},
+		// star over unknown table matches any configured column of the table, but can't be allowed
+		{"SELECT * FROM accounts", true, false, schemaStore},
+		{"SELECT * FROM users", true, false, nil},
+		// derived tables checked by subquery
+		{"SELECT t.x FROM (SELECT ssn AS x FROM users) AS t", true, true, schemaStore},
+		{"SELECT name FROM users UNION SELECT total FROM orders", true, false, schemaStore},
+		{"UPDATE orders SET total = 1 WHERE user_id = 1", false, false, schemaStore},
+		{"DELETE FROM users WHERE ssn = '1'", true, true, schemaStore},
+		{"INSERT INTO users (name) VALUES ('a') RETURNING ssn", true, true, schemaStore},
+		{"INSERT INTO orders (total) VALUES (1) RETURNING *", false, false, schemaStore},
+		// common table expressions checked by their statements
+		{"WITH x AS (SELECT ssn FROM users) SELECT * FROM x", true, true, schemaStore},
+		{"WITH x AS (SELECT ssn FROM users) SELECT x.ssn FROM x", true, true, schemaStore},
+		{"WITH x AS (SELECT total FROM orders) SELECT * FROM x", false, false, schemaStore},
+		{"WITH x AS (SELECT ssn FROM users) SELECT total FROM orders, x", true, false, schemaStore},
+		{"WITH x AS (SELECT ssn FROM users) SELECT name FROM users UNION SELECT * FROM x", true, true, schemaStore},
+		{"WITH x AS (SELECT ssn FROM users) INSERT INTO orders (total) SELECT * FROM x", true, true, schemaStore},
+		{"WITH x AS (SELECT ssn FROM users) UPDATE orders SET total = 1 WHERE user_id IN (SELECT id FROM x)", true, false, schemaStore},
+		{"WITH x AS (SELECT ssn FROM users) DELETE FROM orders WHERE user_id IN (SELECT id FROM x)", true, false, schemaStore},
+		// subqueries in SET and VALUES
+		{"UPDATE orders SET total = (SELECT ssn FROM users) WHERE id = 1", true, false, schemaStore},
+		{"INSERT INTO orders (total) VALUES ((SELECT ssn FROM users))", true, true, schemaStore},
+		// group by and having
+		{"SELECT name FROM users GROUP BY ssn", true, true, schemaStore},
+		{"SELECT count(*) FROM users HAVING max(ssn) > 1", true, true, schemaStore},
+		{"SELECT total FROM orders GROUP BY total HAVING count(user_id) > 1", false, false, schemaStore},
+		// group by select alias, but columns of tables have precedence
+		{"SELECT ssn AS s FROM users GROUP BY s", true, true, schemaStore},
+		{"SELECT total AS ssn FROM orders, users GROUP BY ssn", true, false, schemaStore},
+		{"SELECT total AS ssn FROM orders, users GROUP BY ssn", true, false, nil},
+	}
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	for i, tcase := range testcases {
+		statement, err := parser.Parse(tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		atLeastOne, all := CheckColumnNamesMatch(statement, setOfColumns, tcase.schemaStore)
+		if atLeastOne != tcase.atLeastOne || all != tcase.all {
+			t.Fatalf("[%d] Expected (%t, %t), took (%t, %t) for '%s'", i, tcase.atLeastOne, tcase.all, atLeastOne, all, tcase.query)
+		}
+	}
+}
+
+func TestCheckColumnNamesMatchWithSchemas(t *testing.T) {
+	testcases := []struct {
+		rule       string
+		query      string
+		atLeastOne bool
+		all        bool
+	}{
+		// rule without schema matches table in any schema
+		{"users.ssn", "SELECT ssn FROM public.users", true, true},
+		{"users.ssn", "SELECT ssn FROM shop.users", true, true},
+		{"users.ssn", "SELECT u.ssn FROM public.users AS u", true, true},
+		{"users.ssn", "SELECT public.users.ssn FROM public.users", true, true},
+		{"users.ssn", "SELECT * FROM public.users", true, false},
+		// table without schema in query is in default schema
+		{"public.users.ssn", "SELECT ssn FROM users", true, true},
+		{"public.users.ssn", "SELECT ssn FROM public.users", true, true},
+		{"public.users.ssn", "SELECT * FROM users", true, false},
+		{"public.users.ssn", "SELECT ssn FROM shop.users", false, false},
+		{"shop.users.ssn", "SELECT ssn FROM users", false, false},
+		{"shop.users.ssn", "SELECT ssn FROM Shop.Users", true, true},
+	}
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	for i, tcase := range testcases {
+		setOfColumns, err := ParseColumnRules([]string{tcase.rule})
+		if err != nil {
+			t.Fatal(err)
+		}
+		statement, err := parser.Parse(tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		atLeastOne, all := CheckColumnNamesMatch(statement, setOfColumns, nil)
+		if atLeastOne != tcase.atLeastOne || all != tcase.all {
+			t.Fatalf("[%d] Expected (%t, %t), took (%t, %t) for '%s' and rule '%s'", i, tcase.atLeastOne, tcase.all, atLeastOne, all, tcase.query, tcase.rule)
+		}
+	}
+}
@@ -34,7 +34,10 @@ var (
 	ErrDenyByQueryError                = errors.New("deny by query")
 	ErrDenyByTableError                = errors.New("deny by table")
 	ErrDenyByPatternError              = errors.New("deny by pattern")
+	ErrDenyByColumnError               = errors.New("deny by column")
+	ErrDenyByRateLimitError            = errors.New("deny by rate limit")
 	ErrPatternSyntaxError              = errors.New("fail to parse specified pattern")
+	ErrColumnRuleSyntaxError           = errors.New("column should be specified as table.column")
 	ErrPatternCheckError               = errors.New("failed to check specified pattern match")
 	ErrCantReadQueriesFromStorageError = errors.New("can't read queries from storage")
 	ErrUnexpectedTypeError             = errors.New("should never appear")
@@ -45,6 +48,17 @@ var (
 // LogQueryLength is maximum query length for logging to syslog.
 const LogQueryLength = 100
 
+// Kinds of handler rules which decide about queries, used to report verdicts of handlers
+const (
+	QueriesRule  = "queries"
+	TablesRule   = "tables"
+	ColumnsRule  = "columns"
+	PatternsRule = "patterns"
+	LimitsRule   = "limits"
+	AllRule      = "all"
+	SyntaxRule   = "syntax"
+)
+
 const (
 	// UnionPlaceholder is used when matching %%UNION%% pattern
 	UnionPlaceholder = "%%UNION%%"
@@ -180,12 +194,17 @@ func TrimStringToN(query string, n int) string {
 
 // CheckPatternsMatching evaluates if parsed query matches specified set of patterns
 func CheckPatternsMatching(patterns []sqlparser.Statement, parsedQuery sqlparser.Statement) bool {
-	for _, pattern := range patterns {
+	return MatchedPatternIndex(patterns, parsedQuery) >= 0
+}
+
+// MatchedPatternIndex returns index of the first pattern which parsed query matches or -1 if there is no such pattern
+func MatchedPatternIndex(patterns []sqlparser.Statement, parsedQuery sqlparser.Statement) int {
+	for i, pattern := range patterns {
 		if checkSinglePatternMatch(parsedQuery, pattern) {
-			return true
+			return i
 		}
 	}
-	return false
+	return -1
 }
 
 // CheckExactQueriesMatch evaluates if query presents in set of queries
@@ -222,6 +241,44 @@ func CheckTableNamesMatch(parsedQuery sqlparser.Statement, setOfTables map[strin
 	return atLeastOneTableNameMatch, allTableNamesMatch
 }
 
+// MatchedTableNames returns tables of the query presented in specified set of tables
+func MatchedTableNames(parsedQuery sqlparser.Statement, setOfTables map[string]bool) []string {
+	var tables []string
+	switch query := parsedQuery.(type) {
+	case *sqlparser.Select:
+		tables = tableExprsNames(query.From, nil)
+	case *sqlparser.Insert:
+		tables = []string{query.Table.Name.String()}
+	}
+	matched := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if setOfTables[table] {
+			matched = append(matched, table)
+		}
+	}
+	return matched
+}
+
+func tableExprsNames(tables sqlparser.TableExprs, names []string) []string {
+	for _, tableExpr := range tables {
+		names = tableExprNames(tableExpr, names)
+	}
+	return names
+}
+
+func tableExprNames(table sqlparser.TableExpr, names []string) []string {
+	switch tbl := table.(type) {
+	case *sqlparser.AliasedTableExpr:
+		return append(names, sqlparser.String(tbl.Expr))
+	case *sqlparser.JoinTableExpr:
+		names = tableExprNames(tbl.LeftExpr, names)
+		return tableExprNames(tbl.RightExpr, names)
+	case *sqlparser.ParenTableExpr:
+		return tableExprsNames(tbl.Exprs, names)
+	}
+	return names
+}
+
 // Tables matchers
 func checkTableExprsMatch(tables sqlparser.TableExprs, setOfTables map[string]bool) (bool, bool) {
 	oneTableMatch := false
@@ -234,7 +234,7 @@ func handleInsertStatement(query, pattern sqlparser.Statement) bool {
 	if !match {
 		return false
 	}
-	return false
+	return true
 }
 func handleUpdateStatement(query, pattern sqlparser.Statement) bool {
 	var match bool
@@ -1383,6 +1383,9 @@ func isSubqueryPattern(pattern *sqlparser.Subquery) bool {
 	return reflect.DeepEqual(pattern.Select, SubqueryPatternStatement.(*sqlparser.Select))
 }
 func isWherePattern(pattern *sqlparser.Where) bool {
+	if pattern == nil {
+		return false
+	}
 	if !strings.EqualFold(pattern.Type, WherePatternStatement.(*sqlparser.Select).Where.Type) {
 		return false
 	}
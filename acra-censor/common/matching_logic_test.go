@@ -99,6 +99,49 @@ func contains(s []int, e int) bool {
 	return false
 }
 
+// TestInsertPatterns checks matching of INSERT queries with value placeholders
+func TestInsertPatterns(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	parsedPatterns, err := ParsePatterns([]string{"INSERT INTO users (id, name) VALUES (%%VALUE%%, %%VALUE%%)"}, parser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		query string
+		match bool
+	}{
+		{"INSERT INTO users (id, name) VALUES (1, 'a')", true},
+		{"INSERT INTO users (id, email) VALUES (1, 'a')", false},
+		{"INSERT INTO accounts (id, name) VALUES (1, 'a')", false},
+		{"INSERT INTO users (id, name) VALUES (1, 'a'), (2, 'b')", false},
+	}
+	for i, tcase := range testcases {
+		stmt, err := parser.Parse(tcase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if CheckPatternsMatching(parsedPatterns, stmt) != tcase.match {
+			t.Fatalf("[%d] Expected match %t for query <%s>", i, tcase.match, tcase.query)
+		}
+	}
+}
+
+// TestPatternWithoutWhere checks that pattern without WHERE clause doesn't match query with WHERE clause
+func TestPatternWithoutWhere(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	parsedPatterns, err := ParsePatterns([]string{"SELECT * FROM users"}, parser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := parser.Parse("SELECT name FROM users WHERE id = 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if CheckPatternsMatching(parsedPatterns, stmt) {
+		t.Fatal("Expected not match of query with WHERE clause and pattern without it")
+	}
+}
+
 // TestHandleRangeCondition test queries with "column BETWEEN VALUE1 and VALUE2"
 func TestHandleRangeCondition(t *testing.T) {
 	patterns := []string{
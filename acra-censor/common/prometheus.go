@@ -0,0 +1,58 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RateLimitActionLabel is label of the action taken on exceeded rate limit
+const RateLimitActionLabel = "action"
+
+// RateLimitExceededCounter collects queries which exceeded rate limits of AcraCensor
+var RateLimitExceededCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "acra_censor_ratelimit_exceeded_total",
+		Help: "number of queries exceeded AcraCensor rate limits",
+	}, []string{RateLimitActionLabel})
+
+// Labels of handler verdicts in monitor mode
+const (
+	MonitorHandlerLabel = "handler"
+	MonitorRuleLabel    = "rule"
+	MonitorVerdictLabel = "verdict"
+)
+
+// MonitorVerdictsCounter collects verdicts of AcraCensor handlers in monitor mode for queries forwarded regardless
+// of them
+var MonitorVerdictsCounter = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "acra_censor_monitor_verdicts_total",
+		Help: "number of verdicts of AcraCensor handlers in monitor mode",
+	}, []string{MonitorHandlerLabel, MonitorRuleLabel, MonitorVerdictLabel})
+
+var censorRegisterLock = sync.Once{}
+
+// RegisterCensorMetrics register in default prometheus registry metrics related with AcraCensor
+func RegisterCensorMetrics() {
+	censorRegisterLock.Do(func() {
+		prometheus.MustRegister(RateLimitExceededCounter)
+		prometheus.MustRegister(MonitorVerdictsCounter)
+	})
+}
@@ -17,19 +17,25 @@ limitations under the License.
 package handlers
 
 import (
+	"strings"
+
 	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/logging"
 	"github.com/cossacklabs/acra/sqlparser"
 	log "github.com/sirupsen/logrus"
 )
 
-// AllowHandler allows query/pattern/table and restricts/forbids everything else
+// AllowHandler allows query/pattern/table/column and restricts/forbids everything else
 type AllowHandler struct {
-	queries  map[string]bool
-	tables   map[string]bool
-	patterns []sqlparser.Statement
-	logger   *log.Entry
-	parser   *sqlparser.Parser
+	queries     map[string]bool
+	tables      map[string]bool
+	columns     map[string]bool
+	patterns    []sqlparser.Statement
+	rawPatterns []string
+	logger      *log.Entry
+	parser      *sqlparser.Parser
+	schemaStore config.TableSchemaStore
 }
 
 // NewAllowHandler creates new whitelist instance
@@ -37,6 +43,7 @@ func NewAllowHandler(parser *sqlparser.Parser) *AllowHandler {
 	handler := &AllowHandler{}
 	handler.queries = make(map[string]bool)
 	handler.tables = make(map[string]bool)
+	handler.columns = make(map[string]bool)
 	handler.patterns = make([]sqlparser.Statement, 0)
 	handler.logger = log.WithField("handler", "allow")
 	handler.parser = parser
@@ -44,41 +51,56 @@ func NewAllowHandler(parser *sqlparser.Parser) *AllowHandler {
 }
 
 // CheckQuery checks each query, returns false and error if query is not whitelisted or
-// if query tries to access to non-whitelisted table
-func (handler *AllowHandler) CheckQuery(normalizedQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+// if query tries to access to non-whitelisted table or column
+func (handler *AllowHandler) CheckQuery(accessContext *common.AccessContext, normalizedQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+	rule, _ := handler.MatchedRule(normalizedQuery, parsedQuery)
+	return rule == "", nil
+}
+
+// MatchedRule returns kind and value of the first rule which allows query: the query, comma-separated tables or
+// columns of the query, or the pattern. It returns empty strings if query isn't allowed
+func (handler *AllowHandler) MatchedRule(normalizedQuery string, parsedQuery sqlparser.Statement) (string, string) {
 	// skip unparsed queries
 	if parsedQuery == nil {
-		return true, nil
+		return "", ""
 	}
 	//Check exact queries
 	if len(handler.queries) != 0 {
 		queryMatch := common.CheckExactQueriesMatch(normalizedQuery, handler.queries)
 		if queryMatch {
-			return false, nil
+			return common.QueriesRule, normalizedQuery
 		}
 	}
 	//Check tables
 	if len(handler.tables) != 0 {
 		_, allTablesInWhitelist := common.CheckTableNamesMatch(parsedQuery, handler.tables)
 		if allTablesInWhitelist {
-			return false, nil
+			return common.TablesRule, strings.Join(common.MatchedTableNames(parsedQuery, handler.tables), ",")
+		}
+	}
+	//Check columns
+	if len(handler.columns) != 0 {
+		_, allColumnsInWhitelist := common.CheckColumnNamesMatch(parsedQuery, handler.columns, handler.schemaStore)
+		if allColumnsInWhitelist {
+			return common.ColumnsRule, strings.Join(common.MatchedColumnNames(parsedQuery, handler.columns, handler.schemaStore), ",")
 		}
 	}
 	//Check patterns
 	if len(handler.patterns) != 0 {
-		matchingOccurred := common.CheckPatternsMatching(handler.patterns, parsedQuery)
-		if matchingOccurred {
-			return false, nil
+		if index := common.MatchedPatternIndex(handler.patterns, parsedQuery); index >= 0 {
+			return common.PatternsRule, handler.rawPatterns[index]
 		}
 	}
-	return true, nil
+	return "", ""
 }
 
 // Reset resets whitelist to initial state
 func (handler *AllowHandler) Reset() {
 	handler.queries = make(map[string]bool)
 	handler.tables = make(map[string]bool)
+	handler.columns = make(map[string]bool)
 	handler.patterns = nil
+	handler.rawPatterns = nil
 }
 
 // Release releases all resources
@@ -134,5 +156,36 @@ func (handler *AllowHandler) AddPatterns(patterns []string) error {
 		return err
 	}
 	handler.patterns = parsedPatterns
+	handler.rawPatterns = patterns
 	return nil
 }
+
+// AddColumns adds columns in "table.column" format that should be whitelisted
+func (handler *AllowHandler) AddColumns(columns []string) error {
+	setOfColumns, err := common.ParseColumnRules(columns)
+	if err != nil {
+		handler.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorSetupError).Errorln("Can't add columns")
+		return err
+	}
+	for column := range setOfColumns {
+		handler.columns[column] = true
+	}
+	return nil
+}
+
+// RemoveColumns removes whitelisted columns
+func (handler *AllowHandler) RemoveColumns(columns []string) error {
+	setOfColumns, err := common.ParseColumnRules(columns)
+	if err != nil {
+		return err
+	}
+	for column := range setOfColumns {
+		delete(handler.columns, column)
+	}
+	return nil
+}
+
+// SetTableSchemaStore sets table schema used to expand star expressions to the list of table columns
+func (handler *AllowHandler) SetTableSchemaStore(schemaStore config.TableSchemaStore) {
+	handler.schemaStore = schemaStore
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"github.com/cossacklabs/acra/acra-censor/common"
 	"github.com/cossacklabs/acra/sqlparser"
 	log "github.com/sirupsen/logrus"
 )
@@ -17,7 +18,7 @@ func NewAllowallHandler() *AllowAllHandler {
 }
 
 // CheckQuery passes any input query
-func (handler *AllowAllHandler) CheckQuery(sqlQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+func (handler *AllowAllHandler) CheckQuery(accessContext *common.AccessContext, sqlQuery string, parsedQuery sqlparser.Statement) (bool, error) {
 	// allow any query and stop further checks
 	handler.logger.Infof("Query has been allowed by Allowall handler")
 	return false, nil
@@ -17,19 +17,25 @@ limitations under the License.
 package handlers
 
 import (
+	"strings"
+
 	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/logging"
 	"github.com/cossacklabs/acra/sqlparser"
 	log "github.com/sirupsen/logrus"
 )
 
-// DenyHandler allows everything and forbids specific query/pattern/table
+// DenyHandler allows everything and forbids specific query/pattern/table/column
 type DenyHandler struct {
-	queries  map[string]bool
-	tables   map[string]bool
-	patterns []sqlparser.Statement
-	logger   *log.Entry
-	parser   *sqlparser.Parser
+	queries     map[string]bool
+	tables      map[string]bool
+	columns     map[string]bool
+	patterns    []sqlparser.Statement
+	rawPatterns []string
+	logger      *log.Entry
+	parser      *sqlparser.Parser
+	schemaStore config.TableSchemaStore
 }
 
 // NewDenyHandler creates new blacklist instance
@@ -37,6 +43,7 @@ func NewDenyHandler(parser *sqlparser.Parser) *DenyHandler {
 	handler := &DenyHandler{}
 	handler.queries = make(map[string]bool)
 	handler.tables = make(map[string]bool)
+	handler.columns = make(map[string]bool)
 	handler.patterns = make([]sqlparser.Statement, 0)
 	handler.logger = log.WithField("handler", "blacklist")
 	handler.parser = parser
@@ -44,44 +51,70 @@ func NewDenyHandler(parser *sqlparser.Parser) *DenyHandler {
 }
 
 // CheckQuery checks each query, returns false and error if query is blacklisted or
-// if query tries to access to forbidden table
-func (handler *DenyHandler) CheckQuery(normalizedQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+// if query tries to access to forbidden table or column
+func (handler *DenyHandler) CheckQuery(accessContext *common.AccessContext, normalizedQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+	rule, _ := handler.MatchedRule(normalizedQuery, parsedQuery)
+	var err error
+	switch rule {
+	case "":
+		return true, nil
+	case common.QueriesRule:
+		err = common.ErrDenyByQueryError
+	case common.TablesRule:
+		err = common.ErrDenyByTableError
+	case common.ColumnsRule:
+		err = common.ErrDenyByColumnError
+	case common.PatternsRule:
+		err = common.ErrDenyByPatternError
+	}
+	handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).WithError(err).Errorf("Query has been blocked by DENY [%s]", rule)
+	return false, err
+}
+
+// MatchedRule returns kind and value of the first rule which denies query: the query, comma-separated tables or
+// columns of the query, or the pattern. It returns empty strings if query isn't denied
+func (handler *DenyHandler) MatchedRule(normalizedQuery string, parsedQuery sqlparser.Statement) (string, string) {
 	// skip unparsed queries
 	if parsedQuery == nil {
-		return true, nil
+		return "", ""
 	}
 	//Check exact queries
 	if len(handler.queries) != 0 {
 		queryMatch := common.CheckExactQueriesMatch(normalizedQuery, handler.queries)
 		if queryMatch {
-			handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).WithError(common.ErrDenyByQueryError).Errorln("Query has been blocked by DENY [queries]")
-			return false, common.ErrDenyByQueryError
+			return common.QueriesRule, normalizedQuery
 		}
 	}
 	//Check tables
 	if len(handler.tables) != 0 {
 		atLeastOneTableInBlacklist, _ := common.CheckTableNamesMatch(parsedQuery, handler.tables)
 		if atLeastOneTableInBlacklist {
-			handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).WithError(common.ErrDenyByQueryError).Errorln("Query has been blocked by DENY [tables]")
-			return false, common.ErrDenyByTableError
+			return common.TablesRule, strings.Join(common.MatchedTableNames(parsedQuery, handler.tables), ",")
+		}
+	}
+	//Check columns
+	if len(handler.columns) != 0 {
+		atLeastOneColumnInBlacklist, _ := common.CheckColumnNamesMatch(parsedQuery, handler.columns, handler.schemaStore)
+		if atLeastOneColumnInBlacklist {
+			return common.ColumnsRule, strings.Join(common.MatchedColumnNames(parsedQuery, handler.columns, handler.schemaStore), ",")
 		}
 	}
 	//Check patterns
 	if len(handler.patterns) != 0 {
-		matchingOccurred := common.CheckPatternsMatching(handler.patterns, parsedQuery)
-		if matchingOccurred {
-			handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).WithError(common.ErrDenyByQueryError).Errorln("Query has been blocked by DENY [patterns]")
-			return false, common.ErrDenyByPatternError
+		if index := common.MatchedPatternIndex(handler.patterns, parsedQuery); index >= 0 {
+			return common.PatternsRule, handler.rawPatterns[index]
 		}
 	}
-	return true, nil
+	return "", ""
 }
 
 // Reset resets blacklist to initial state
 func (handler *DenyHandler) Reset() {
 	handler.queries = make(map[string]bool)
 	handler.tables = make(map[string]bool)
+	handler.columns = make(map[string]bool)
 	handler.patterns = make([]sqlparser.Statement, 0)
+	handler.rawPatterns = nil
 	handler.logger = log.WithField("handler", "deny")
 }
 
@@ -135,5 +168,36 @@ func (handler *DenyHandler) AddPatterns(patterns []string) error {
 		return err
 	}
 	handler.patterns = parsedPatterns
+	handler.rawPatterns = patterns
+	return nil
+}
+
+// AddColumns adds columns in "table.column" format that should be blacklisted
+func (handler *DenyHandler) AddColumns(columns []string) error {
+	setOfColumns, err := common.ParseColumnRules(columns)
+	if err != nil {
+		handler.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorSetupError).Errorln("Can't add columns")
+		return err
+	}
+	for column := range setOfColumns {
+		handler.columns[column] = true
+	}
+	return nil
+}
+
+// RemoveColumns removes blacklisted columns
+func (handler *DenyHandler) RemoveColumns(columns []string) error {
+	setOfColumns, err := common.ParseColumnRules(columns)
+	if err != nil {
+		return err
+	}
+	for column := range setOfColumns {
+		delete(handler.columns, column)
+	}
 	return nil
 }
+
+// SetTableSchemaStore sets table schema used to expand star expressions to the list of table columns
+func (handler *DenyHandler) SetTableSchemaStore(schemaStore config.TableSchemaStore) {
+	handler.schemaStore = schemaStore
+}
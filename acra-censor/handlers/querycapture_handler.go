@@ -35,7 +35,7 @@ func (handler *QueryCaptureHandler) Start() {
 }
 
 // CheckQuery sends query to internal writer to save
-func (handler *QueryCaptureHandler) CheckQuery(sqlQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+func (handler *QueryCaptureHandler) CheckQuery(accessContext *common.AccessContext, sqlQuery string, parsedQuery sqlparser.Statement) (bool, error) {
 	// skip unparsed queries
 	if parsedQuery == nil {
 		return true, nil
@@ -17,6 +17,7 @@ limitations under the License.
 package handlers
 
 import (
+	"github.com/cossacklabs/acra/acra-censor/common"
 	"github.com/cossacklabs/acra/sqlparser"
 	log "github.com/sirupsen/logrus"
 )
@@ -38,7 +39,7 @@ func NewQueryIgnoreHandler(parser *sqlparser.Parser) *QueryIgnoreHandler {
 }
 
 // CheckQuery checks each query, returns false if query handling should be ignored.
-func (handler *QueryIgnoreHandler) CheckQuery(rawQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+func (handler *QueryIgnoreHandler) CheckQuery(accessContext *common.AccessContext, rawQuery string, parsedQuery sqlparser.Statement) (bool, error) {
 	normalizedQ := sqlparser.String(parsedQuery)
 	if handler.ignoredQueries[normalizedQ] || handler.ignoredQueries[rawQuery] {
 		//do not continue query handling
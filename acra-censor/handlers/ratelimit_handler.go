@@ -0,0 +1,234 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/logging"
+	"github.com/cossacklabs/acra/sqlparser"
+	log "github.com/sirupsen/logrus"
+)
+
+// Actions on exceeded rate limit
+const (
+	RateLimitActionDeny  = "deny"
+	RateLimitActionDelay = "delay"
+	RateLimitActionAlert = "alert"
+)
+
+// DefaultRateLimitMaxDelay is max time to delay query with "delay" action, queries which should wait longer are denied
+const DefaultRateLimitMaxDelay = time.Second
+
+// Errors related to rate limits configuration
+var (
+	ErrInvalidRateLimit       = errors.New("rate limit should have positive rate and non-negative burst")
+	ErrInvalidRateLimitAction = errors.New("unsupported rate limit action")
+)
+
+// RateLimitSettings describes limit of queries matched by queries, tables or patterns, all queries if none of them is
+// set. Each ClientID has own bucket of Burst queries refilled with Rate queries per second
+type RateLimitSettings struct {
+	Queries  []string
+	Tables   []string
+	Patterns []string
+	Rate     float64
+	Burst    int
+	Action   string
+	MaxDelay time.Duration
+}
+
+type rateLimit struct {
+	key      string
+	queries  map[string]bool
+	tables   map[string]bool
+	patterns []sqlparser.Statement
+	bucket   TokenBucket
+	action   string
+	maxDelay time.Duration
+}
+
+func (limit *rateLimit) match(normalizedQuery string, parsedQuery sqlparser.Statement) bool {
+	if len(limit.queries) == 0 && len(limit.tables) == 0 && len(limit.patterns) == 0 {
+		return true
+	}
+	if len(limit.queries) != 0 && common.CheckExactQueriesMatch(normalizedQuery, limit.queries) {
+		return true
+	}
+	// tables and patterns can't be matched for unparsed queries
+	if parsedQuery == nil {
+		return false
+	}
+	if len(limit.tables) != 0 {
+		if atLeastOneTableMatch, _ := common.CheckTableNamesMatch(parsedQuery, limit.tables); atLeastOneTableMatch {
+			return true
+		}
+	}
+	return len(limit.patterns) != 0 && common.CheckPatternsMatching(limit.patterns, parsedQuery)
+}
+
+// RateLimitHandler limits amount of queries of each ClientID with token buckets. Queries exceeded limits are denied,
+// delayed or just reported with event code and prometheus counter according to the action of the limit
+type RateLimitHandler struct {
+	limits  []*rateLimit
+	storage RateLimitStorage
+	logger  *log.Entry
+	parser  *sqlparser.Parser
+	now     func() time.Time
+	sleep   func(time.Duration)
+	// monitor turns off delays because AcraCensor forwards queries regardless of verdicts in monitor mode
+	monitor bool
+}
+
+// NewRateLimitHandler creates new rate limit handler which stores buckets in storage
+func NewRateLimitHandler(parser *sqlparser.Parser, storage RateLimitStorage) *RateLimitHandler {
+	return &RateLimitHandler{
+		storage: storage,
+		logger:  log.WithField("handler", "ratelimit"),
+		parser:  parser,
+		now:     time.Now,
+		sleep:   time.Sleep,
+	}
+}
+
+// AddLimit adds new limit
+func (handler *RateLimitHandler) AddLimit(settings RateLimitSettings) error {
+	if settings.Rate <= 0 || settings.Burst < 0 {
+		return ErrInvalidRateLimit
+	}
+	if settings.Burst == 0 {
+		settings.Burst = int(math.Max(1, math.Ceil(settings.Rate)))
+	}
+	if settings.Action == "" {
+		settings.Action = RateLimitActionDeny
+	}
+	limit := &rateLimit{
+		queries: make(map[string]bool),
+		tables:  make(map[string]bool),
+		bucket:  TokenBucket{Rate: settings.Rate, Burst: settings.Burst},
+		action:  settings.Action,
+	}
+	switch settings.Action {
+	case RateLimitActionDeny, RateLimitActionAlert:
+	case RateLimitActionDelay:
+		limit.maxDelay = settings.MaxDelay
+		if limit.maxDelay <= 0 {
+			limit.maxDelay = DefaultRateLimitMaxDelay
+		}
+	default:
+		return ErrInvalidRateLimitAction
+	}
+	for _, query := range settings.Queries {
+		normalizedQuery, _, _, err := handler.parser.HandleRawSQLQuery(query)
+		if err != nil {
+			handler.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryParseError).Errorln("Can't add queries")
+			return err
+		}
+		limit.queries[normalizedQuery] = true
+	}
+	for _, table := range settings.Tables {
+		limit.tables[table] = true
+	}
+	if len(settings.Patterns) != 0 {
+		parsedPatterns, err := common.ParsePatterns(settings.Patterns, handler.parser)
+		if err != nil {
+			handler.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryParseError).Errorln("Can't add patterns")
+			return err
+		}
+		limit.patterns = parsedPatterns
+	}
+	limit.key = rateLimitKey(settings)
+	handler.limits = append(handler.limits, limit)
+	return nil
+}
+
+// rateLimitKey returns the same bucket key for the same limit settings to share buckets between AcraServers
+// with the same configuration
+func rateLimitKey(settings RateLimitSettings) string {
+	hash := sha256.New()
+	for _, values := range [][]string{settings.Queries, settings.Tables, settings.Patterns} {
+		sorted := append([]string{}, values...)
+		sort.Strings(sorted)
+		fmt.Fprintf(hash, "%d:%s;", len(sorted), strings.Join(sorted, "\x00"))
+	}
+	fmt.Fprintf(hash, "%v;%d;%s", settings.Rate, settings.Burst, settings.Action)
+	return hex.EncodeToString(hash.Sum(nil)[:16])
+}
+
+// CheckQuery takes token from the buckets of matched limits, returns false and error if query is denied due to
+// exceeded limit. Delayed queries are returned after waiting for the token
+func (handler *RateLimitHandler) CheckQuery(accessContext *common.AccessContext, normalizedQuery string, parsedQuery sqlparser.Statement) (bool, error) {
+	clientID := accessContext.ClientID()
+	for _, limit := range handler.limits {
+		if !limit.match(normalizedQuery, parsedQuery) {
+			continue
+		}
+		logger := handler.logger.WithField("client_id", string(clientID)).WithField("action", limit.action)
+		wait, ok, err := handler.storage.Take(limit.key+"/"+hex.EncodeToString(clientID), limit.bucket, limit.maxDelay, handler.now())
+		if err != nil {
+			// don't block queries if storage is unavailable
+			logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorBackgroundError).Errorln("Can't check rate limit")
+			continue
+		}
+		if ok && wait == 0 {
+			continue
+		}
+		common.RateLimitExceededCounter.WithLabelValues(limit.action).Inc()
+		switch {
+		case ok && handler.monitor:
+			logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorRateLimitExceeded).WithField("delay", wait).Warningln("Query would be delayed by RATELIMIT")
+		case ok:
+			logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorRateLimitExceeded).WithField("delay", wait).Warningln("Query has been delayed by RATELIMIT")
+			handler.sleep(wait)
+		case limit.action == RateLimitActionAlert:
+			logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorRateLimitExceeded).Warningln("Query exceeded RATELIMIT")
+		default:
+			logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorRateLimitExceeded).WithError(common.ErrDenyByRateLimitError).Errorln("Query has been blocked by RATELIMIT")
+			return false, common.ErrDenyByRateLimitError
+		}
+	}
+	return true, nil
+}
+
+// SetMonitorMode turns off delays of queries with "delay" action, they are only reported as exceeded limits
+func (handler *RateLimitHandler) SetMonitorMode(monitor bool) {
+	handler.monitor = monitor
+}
+
+// Reset removes all limits
+func (handler *RateLimitHandler) Reset() {
+	handler.limits = nil
+}
+
+// Release releases all resources
+func (handler *RateLimitHandler) Release() {
+	handler.Reset()
+	if closer, ok := handler.storage.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			handler.logger.WithError(err).Warningln("Can't close rate limit storage")
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/go-redis/redis/v7"
+)
+
+func testRateLimitStorage(storage RateLimitStorage, t *testing.T) {
+	bucket := TokenBucket{Rate: 2, Burst: 3}
+	now := time.Unix(1600000000, 0)
+	for i := 0; i < bucket.Burst; i++ {
+		wait, ok, err := storage.Take("key", bucket, 0, now)
+		if err != nil || !ok || wait != 0 {
+			t.Fatalf("[%d] Expected free token, took %v, %t, %v", i, wait, ok, err)
+		}
+	}
+	wait, ok, err := storage.Take("key", bucket, 0, now)
+	if err != nil || ok || wait != time.Second/2 {
+		t.Fatalf("Expected empty bucket, took %v, %t, %v", wait, ok, err)
+	}
+	// other keys have own buckets
+	if wait, ok, err := storage.Take("other", bucket, 0, now); err != nil || !ok || wait != 0 {
+		t.Fatalf("Expected free token, took %v, %t, %v", wait, ok, err)
+	}
+	// reserve token
+	wait, ok, err = storage.Take("key", bucket, time.Second, now)
+	if err != nil || !ok || wait != time.Second/2 {
+		t.Fatalf("Expected reserved token, took %v, %t, %v", wait, ok, err)
+	}
+	// next token after reserved
+	wait, ok, err = storage.Take("key", bucket, 0, now.Add(time.Second/2))
+	if err != nil || ok || wait != time.Second/2 {
+		t.Fatalf("Expected empty bucket, took %v, %t, %v", wait, ok, err)
+	}
+	// refilled bucket
+	wait, ok, err = storage.Take("key", bucket, 0, now.Add(time.Second))
+	if err != nil || !ok || wait != 0 {
+		t.Fatalf("Expected refilled token, took %v, %t, %v", wait, ok, err)
+	}
+}
+
+func TestMemoryRateLimitStorage(t *testing.T) {
+	testRateLimitStorage(NewMemoryRateLimitStorage(), t)
+}
+
+// TestRedisRateLimitStorage runs takeTokenScript with Lua interpreter of in-memory Redis server
+func TestRedisRateLimitStorage(t *testing.T) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	storage := NewRedisRateLimitStorage(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+	defer storage.Close()
+	testRateLimitStorage(storage, t)
+	for _, key := range []string{"key", "other"} {
+		if !server.Exists(redisRateLimitPrefix + key) {
+			t.Fatalf("Expected bucket %s in Redis", key)
+		}
+		// bucket expires when it is refilled
+		if ttl := server.TTL(redisRateLimitPrefix + key); ttl <= 0 {
+			t.Fatalf("Expected expiring bucket %s, took TTL %v", key, ttl)
+		}
+	}
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	handler := NewRateLimitHandler(parser, NewMemoryRateLimitStorage())
+	defer handler.Release()
+	now := time.Unix(1600000000, 0)
+	var delays []time.Duration
+	handler.now = func() time.Time { return now }
+	handler.sleep = func(delay time.Duration) { delays = append(delays, delay) }
+
+	limits := []RateLimitSettings{
+		{Queries: []string{"SELECT * FROM users"}, Rate: 1, Burst: 2, Action: RateLimitActionDeny},
+		{Tables: []string{"orders"}, Rate: 1, Burst: 1, Action: RateLimitActionDelay, MaxDelay: 2 * time.Second},
+		{Patterns: []string{"SELECT * FROM logs %%WHERE%%"}, Rate: 1, Burst: 1, Action: RateLimitActionAlert},
+	}
+	for _, limit := range limits {
+		if err := handler.AddLimit(limit); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client1 := common.NewAccessContext([]byte("client1"), "")
+	client2 := common.NewAccessContext([]byte("client2"), "")
+	check := func(accessContext *common.AccessContext, query string) error {
+		normalizedQuery, _, parsedQuery, err := parser.HandleRawSQLQuery(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		continueHandling, err := handler.CheckQuery(accessContext, normalizedQuery, parsedQuery)
+		if continueHandling != (err == nil) {
+			t.Fatalf("Unexpected result %t with error %v", continueHandling, err)
+		}
+		return err
+	}
+
+	// deny
+	for i := 0; i < 2; i++ {
+		if err := check(client1, "SELECT * FROM users"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := check(client1, "SELECT * FROM users"); err != common.ErrDenyByRateLimitError {
+		t.Fatalf("Expected ErrDenyByRateLimitError, took %v", err)
+	}
+	// other ClientID has own bucket
+	if err := check(client2, "SELECT * FROM users"); err != nil {
+		t.Fatal(err)
+	}
+	// queries not matched by limits
+	for i := 0; i < 5; i++ {
+		if err := check(client1, "SELECT id FROM users"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// delay
+	for i := 0; i < 3; i++ {
+		if err := check(client1, "SELECT * FROM orders"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(delays) != 2 || delays[0] != time.Second || delays[1] != 2*time.Second {
+		t.Fatalf("Unexpected delays: %v", delays)
+	}
+	// denied if delay exceeds max_delay
+	if err := check(client1, "SELECT * FROM orders"); err != common.ErrDenyByRateLimitError {
+		t.Fatalf("Expected ErrDenyByRateLimitError, took %v", err)
+	}
+
+	// alert
+	for i := 0; i < 3; i++ {
+		if err := check(client1, "SELECT * FROM logs WHERE id = 1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// refilled buckets
+	now = now.Add(3 * time.Second)
+	if err := check(client1, "SELECT * FROM users"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRateLimitHandlerMonitorMode(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	handler := NewRateLimitHandler(parser, NewMemoryRateLimitStorage())
+	defer handler.Release()
+	now := time.Unix(1600000000, 0)
+	var delays []time.Duration
+	handler.now = func() time.Time { return now }
+	handler.sleep = func(delay time.Duration) { delays = append(delays, delay) }
+	handler.SetMonitorMode(true)
+	if err := handler.AddLimit(RateLimitSettings{Rate: 1, Burst: 1, Action: RateLimitActionDelay, MaxDelay: 2 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	normalizedQuery, _, parsedQuery, err := parser.HandleRawSQLQuery("SELECT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if continueHandling, err := handler.CheckQuery(common.NewAccessContext([]byte("client"), ""), normalizedQuery, parsedQuery); !continueHandling || err != nil {
+			t.Fatalf("[%d] Expected delayed query, took %t, %v", i, continueHandling, err)
+		}
+	}
+	if len(delays) != 0 {
+		t.Fatalf("Queries shouldn't be delayed in monitor mode, took %v", delays)
+	}
+}
+
+func TestRateLimitSettingsValidation(t *testing.T) {
+	handler := NewRateLimitHandler(sqlparser.New(sqlparser.ModeStrict), NewMemoryRateLimitStorage())
+	defer handler.Release()
+	testcases := []struct {
+		settings RateLimitSettings
+		err      error
+	}{
+		{RateLimitSettings{Rate: 0}, ErrInvalidRateLimit},
+		{RateLimitSettings{Rate: 1, Burst: -1}, ErrInvalidRateLimit},
+		{RateLimitSettings{Rate: 1, Action: "block"}, ErrInvalidRateLimitAction},
+		{RateLimitSettings{Rate: 1, Patterns: []string{"SELECT * ROM users"}}, common.ErrPatternSyntaxError},
+		{RateLimitSettings{Rate: 0.5}, nil},
+	}
+	for i, tcase := range testcases {
+		if err := handler.AddLimit(tcase.settings); err != tcase.err {
+			t.Fatalf("[%d] Expected %v, took %v", i, tcase.err, err)
+		}
+	}
+	if handler.limits[0].bucket.Burst != 1 {
+		t.Fatalf("Expected default burst 1, took %d", handler.limits[0].bucket.Burst)
+	}
+}
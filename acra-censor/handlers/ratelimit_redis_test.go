@@ -0,0 +1,34 @@
+//go:build integration && redis
+// +build integration,redis
+
+package handlers
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/go-redis/redis/v7"
+)
+
+func TestRedisRateLimitStorageIntegration(t *testing.T) {
+	hostport := os.Getenv("TEST_REDIS_HOSTPORT")
+	if hostport == "" {
+		hostport = "localhost:6379"
+	}
+	dbNum := os.Getenv("TEST_REDIS_DB")
+	if dbNum == "" {
+		dbNum = "0"
+	}
+	dbInt, err := strconv.ParseInt(dbNum, 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := redis.NewClient(&redis.Options{Addr: hostport, Password: os.Getenv("TEST_REDIS_PASSWORD"), DB: int(dbInt)})
+	if err := client.Del(redisRateLimitPrefix+"key", redisRateLimitPrefix+"other").Err(); err != nil {
+		t.Fatal(err)
+	}
+	storage := NewRedisRateLimitStorage(client)
+	defer storage.Close()
+	testRateLimitStorage(storage, t)
+}
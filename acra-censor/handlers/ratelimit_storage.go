@@ -0,0 +1,184 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+)
+
+// TokenBucket describes limit as bucket of Burst tokens refilled with Rate tokens per second. Every query takes one
+// token from the bucket
+type TokenBucket struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitStorage stores state of token buckets
+type RateLimitStorage interface {
+	// Take takes token from the bucket with the key. If the bucket is empty and token will be refilled not later than
+	// maxDelay, token is reserved and time to wait for it is returned. Otherwise it returns false and doesn't take token
+	Take(key string, bucket TokenBucket, maxDelay time.Duration, now time.Time) (time.Duration, bool, error)
+}
+
+// takeToken refills tokens for elapsed time and takes one token
+func takeToken(tokens float64, elapsed time.Duration, bucket TokenBucket, maxDelay time.Duration) (float64, time.Duration, bool) {
+	if elapsed > 0 {
+		tokens = math.Min(float64(bucket.Burst), tokens+elapsed.Seconds()*bucket.Rate)
+	}
+	if tokens >= 1 {
+		return tokens - 1, 0, true
+	}
+	wait := time.Duration(math.Ceil((1 - tokens) / bucket.Rate * float64(time.Second)))
+	if wait > maxDelay {
+		return tokens, wait, false
+	}
+	return tokens - 1, wait, true
+}
+
+type memoryTokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// MemoryRateLimitStorage stores token buckets in memory of the process
+type MemoryRateLimitStorage struct {
+	mutex   sync.Mutex
+	buckets map[string]*memoryTokenBucket
+}
+
+// NewMemoryRateLimitStorage returns new in-memory storage
+func NewMemoryRateLimitStorage() *MemoryRateLimitStorage {
+	return &MemoryRateLimitStorage{buckets: make(map[string]*memoryTokenBucket)}
+}
+
+// Take takes token from the bucket with the key
+func (storage *MemoryRateLimitStorage) Take(key string, bucket TokenBucket, maxDelay time.Duration, now time.Time) (time.Duration, bool, error) {
+	storage.mutex.Lock()
+	defer storage.mutex.Unlock()
+	state, ok := storage.buckets[key]
+	if !ok {
+		state = &memoryTokenBucket{tokens: float64(bucket.Burst), updated: now}
+		storage.buckets[key] = state
+	}
+	tokens, wait, ok := takeToken(state.tokens, now.Sub(state.updated), bucket, maxDelay)
+	state.tokens = tokens
+	if now.After(state.updated) {
+		state.updated = now
+	}
+	return wait, ok, nil
+}
+
+// RedisScriptClient is a part of Redis client used to run scripts
+type RedisScriptClient interface {
+	Eval(script string, keys []string, args ...interface{}) *redis.Cmd
+	EvalSha(sha1 string, keys []string, args ...interface{}) *redis.Cmd
+	ScriptExists(hashes ...string) *redis.BoolSliceCmd
+	ScriptLoad(script string) *redis.StringCmd
+}
+
+// takeTokenScript does the same as takeToken with time in microseconds. Bucket expires when it is full
+var takeTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local max_delay = tonumber(ARGV[3])
+local now = tonumber(ARGV[4])
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+local updated_value = state[2]
+if tokens == nil or updated == nil then
+  tokens = burst
+  updated = now
+  updated_value = ARGV[4]
+end
+if now > updated then
+  tokens = math.min(burst, tokens + (now - updated) * rate / 1000000)
+  updated_value = ARGV[4]
+end
+local wait = 0
+local allowed = 1
+if tokens < 1 then
+  wait = math.ceil((1 - tokens) * 1000000 / rate)
+  if wait > max_delay then
+    allowed = 0
+  end
+end
+if allowed == 1 then
+  tokens = tokens - 1
+end
+redis.call('HMSET', KEYS[1], 'tokens', tostring(tokens), 'updated', updated_value)
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) * 1000 / rate) + 1000)
+return {allowed, wait}
+`)
+
+// ErrUnexpectedRedisResult returned if script returned unexpected result
+var ErrUnexpectedRedisResult = errors.New("unexpected result of rate limit script")
+
+const redisRateLimitPrefix = "acra-censor/ratelimit/"
+
+// RedisRateLimitStorage stores token buckets in Redis to share them between several AcraServers. Time is taken
+// from AcraServers, so their clocks should be synchronized
+type RedisRateLimitStorage struct {
+	client RedisScriptClient
+}
+
+// NewRedisRateLimitStorage returns new storage which uses client
+func NewRedisRateLimitStorage(client RedisScriptClient) *RedisRateLimitStorage {
+	return &RedisRateLimitStorage{client: client}
+}
+
+// Take takes token from the bucket with the key
+func (storage *RedisRateLimitStorage) Take(key string, bucket TokenBucket, maxDelay time.Duration, now time.Time) (time.Duration, bool, error) {
+	args := []interface{}{
+		strconv.FormatFloat(bucket.Rate, 'f', -1, 64),
+		bucket.Burst,
+		maxDelay.Microseconds(),
+		now.UnixNano() / int64(time.Microsecond),
+	}
+	result, err := takeTokenScript.Run(storage.client, []string{redisRateLimitPrefix + key}, args...).Result()
+	if err != nil {
+		return 0, false, err
+	}
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		return 0, false, ErrUnexpectedRedisResult
+	}
+	allowed, ok := values[0].(int64)
+	if !ok {
+		return 0, false, ErrUnexpectedRedisResult
+	}
+	wait, ok := values[1].(int64)
+	if !ok {
+		return 0, false, ErrUnexpectedRedisResult
+	}
+	return time.Duration(wait) * time.Microsecond, allowed == 1, nil
+}
+
+// Close closes Redis client if it can be closed
+func (storage *RedisRateLimitStorage) Close() error {
+	if closer, ok := storage.client.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
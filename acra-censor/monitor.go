@@ -0,0 +1,206 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acracensor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/acra-censor/handlers"
+	"github.com/cossacklabs/acra/logging"
+	"github.com/cossacklabs/acra/sqlparser"
+	log "github.com/sirupsen/logrus"
+)
+
+// Modes of AcraCensor and its handlers. Queries denied by handlers in monitor mode are forwarded anyway, verdicts of
+// such handlers are only logged and exported as prometheus metrics
+const (
+	EnforceModeStr = "enforce"
+	MonitorModeStr = "monitor"
+)
+
+// ErrInvalidCensorMode returned for unknown mode of AcraCensor or handler
+var ErrInvalidCensorMode = errors.New("unsupported AcraCensor mode")
+
+// Verdicts of handlers reported in monitor mode
+const (
+	allowVerdict    = "allow"
+	denyVerdict     = "deny"
+	continueVerdict = "continue"
+)
+
+// parserVerdictName used as handler name in verdicts about unparsed queries
+const parserVerdictName = "parser"
+
+// monitorModeHandler is implemented by handlers which change their own behaviour in monitor mode
+type monitorModeHandler interface {
+	SetMonitorMode(monitor bool)
+}
+
+func parseMode(mode string) (bool, error) {
+	switch mode {
+	case "", EnforceModeStr:
+		return false, nil
+	case MonitorModeStr:
+		return true, nil
+	default:
+		return false, ErrInvalidCensorMode
+	}
+}
+
+// SetMode sets mode of all handlers which don't have own mode
+func (acraCensor *AcraCensor) SetMode(mode string) error {
+	monitor, err := parseMode(mode)
+	if err != nil {
+		return err
+	}
+	acraCensor.monitor = monitor
+	for _, handler := range acraCensor.handlers {
+		acraCensor.updateMonitorMode(handler)
+	}
+	if acraCensor.clientHandlers != nil {
+		for _, chain := range acraCensor.clientHandlers.all() {
+			for _, handler := range chain.handlers {
+				acraCensor.updateMonitorMode(handler)
+			}
+		}
+	}
+	return nil
+}
+
+// SetHandlerMode sets own mode of the handler which overrides mode of AcraCensor, empty mode resets it
+func (acraCensor *AcraCensor) SetHandlerMode(handler QueryHandlerInterface, mode string) error {
+	monitor, err := parseMode(mode)
+	if err != nil {
+		return err
+	}
+	if mode == "" {
+		delete(acraCensor.handlerModes, handler)
+	} else {
+		acraCensor.handlerModes[handler] = monitor
+	}
+	acraCensor.updateMonitorMode(handler)
+	return nil
+}
+
+// isMonitored returns true if verdicts of the handler shouldn't affect queries
+func (acraCensor *AcraCensor) isMonitored(handler QueryHandlerInterface) bool {
+	if monitor, ok := acraCensor.handlerModes[handler]; ok {
+		return monitor
+	}
+	return acraCensor.monitor
+}
+
+func (acraCensor *AcraCensor) updateMonitorMode(handler QueryHandlerInterface) {
+	if monitorHandler, ok := handler.(monitorModeHandler); ok {
+		monitorHandler.SetMonitorMode(acraCensor.isMonitored(handler))
+	}
+}
+
+// checkQuery returns result of the handler, kind and value of the rule which decided whether query is allowed or denied
+func checkQuery(handler QueryHandlerInterface, accessContext *common.AccessContext, rawQuery, normalizedQuery string, parsedQuery sqlparser.Statement) (bool, string, string, error) {
+	switch handler := handler.(type) {
+	case *handlers.QueryIgnoreHandler:
+		continueHandling, _ := handler.CheckQuery(accessContext, rawQuery, nil)
+		return continueHandling, common.QueriesRule, "", nil
+	case *handlers.AllowHandler:
+		rule, matched := handler.MatchedRule(normalizedQuery, parsedQuery)
+		return rule == "", rule, matched, nil
+	}
+	continueHandling, err := handler.CheckQuery(accessContext, normalizedQuery, parsedQuery)
+	matched := ""
+	if denyHandler, ok := handler.(*handlers.DenyHandler); ok && err != nil {
+		_, matched = denyHandler.MatchedRule(normalizedQuery, parsedQuery)
+	}
+	switch err {
+	case nil:
+		if continueHandling {
+			return true, "", "", nil
+		}
+		return false, common.AllRule, "", nil
+	case common.ErrDenyByQueryError:
+		return continueHandling, common.QueriesRule, matched, err
+	case common.ErrDenyByTableError:
+		return continueHandling, common.TablesRule, matched, err
+	case common.ErrDenyByColumnError:
+		return continueHandling, common.ColumnsRule, matched, err
+	case common.ErrDenyByPatternError:
+		return continueHandling, common.PatternsRule, matched, err
+	case common.ErrDenyByRateLimitError:
+		return continueHandling, common.LimitsRule, matched, err
+	case common.ErrDenyAllError:
+		return continueHandling, common.AllRule, matched, err
+	}
+	return continueHandling, "", matched, err
+}
+
+// handlerName returns name of the handler used in configuration
+func handlerName(handler QueryHandlerInterface) string {
+	switch handler.(type) {
+	case *handlers.AllowHandler:
+		return AllowConfigStr
+	case *handlers.DenyHandler:
+		return DenyConfigStr
+	case *handlers.AllowAllHandler:
+		return AllowAllConfigStr
+	case *handlers.DenyAllHandler:
+		return DenyAllConfigStr
+	case *handlers.QueryIgnoreHandler:
+		return QueryIgnoreConfigStr
+	case *handlers.RateLimitHandler:
+		return RateLimitConfigStr
+	}
+	return fmt.Sprintf("%T", handler)
+}
+
+// queryFingerprint returns short hash of query with hidden values to group logged verdicts of the same queries
+func queryFingerprint(queryWithHiddenValues string) string {
+	if queryWithHiddenValues == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(queryWithHiddenValues))
+	return hex.EncodeToString(hash[:8])
+}
+
+// logMonitorVerdict logs verdict of the handler in monitor mode with the matched value of the rule and updates
+// prometheus counter
+func logMonitorVerdict(logger *log.Entry, name, rule, matched, verdict, queryWithHiddenValues string) {
+	common.MonitorVerdictsCounter.WithLabelValues(name, rule, verdict).Inc()
+	logger = logger.WithFields(log.Fields{
+		"mode":        MonitorModeStr,
+		"handler":     name,
+		"rule":        rule,
+		"verdict":     verdict,
+		"fingerprint": queryFingerprint(queryWithHiddenValues),
+	})
+	if matched != "" {
+		logger = logger.WithField("matched", common.TrimStringToN(matched, common.LogQueryLength))
+	}
+	query := common.TrimStringToN(queryWithHiddenValues, common.LogQueryLength)
+	switch verdict {
+	case denyVerdict:
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorMonitoredQueryDenied).
+			Warningf("Query would be denied: '%s'", query)
+	case allowVerdict:
+		logger.Infof("Query would be allowed: '%s'", query)
+	default:
+		logger.Debugf("Query passed to next handlers: '%s'", query)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package main is entry point for AcraCensorLearn utility. AcraCensorLearn reads queries captured by query_capture
+// handler of AcraCensor, clusters them by structure with redacted values and generates AcraCensor config which allows
+// patterns of captured queries and denies everything else. Generated config should be reviewed before use.
+// With existing config it also reports which learned patterns are new, which are already allowed and which rules of
+// allow handlers aren't used by captured queries.
+//
+// https://github.com/cossacklabs/acra/wiki/AcraCensor
+package main
+
+import (
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/cossacklabs/acra/cmd"
+	"github.com/cossacklabs/acra/logging"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/cossacklabs/acra/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// Constants used by AcraCensorLearn
+var (
+	// defaultConfigPath relative path to config which will be parsed as default
+	defaultConfigPath = utils.GetConfigPathByName("acra-censor-learn")
+	serviceName       = "acra-censor-learn"
+)
+
+func main() {
+	captureFile := flag.String("capture_file", "", "Path to file with queries captured by query_capture handler of AcraCensor")
+	outputFile := flag.String("output_file", "", "Path to write generated AcraCensor config, stdout if empty")
+	compareConfigFile := flag.String("compare_config_file", "", "Path to existing AcraCensor config to compare learned patterns with")
+	reportFile := flag.String("report_file", "", "Path to write report about learned patterns and comparison with existing config, stderr if empty")
+	whereThreshold := flag.Int("where_threshold", 0, "Merge SELECT patterns which differ only in WHERE clause into one pattern with %%WHERE%% placeholder if there are at least this amount of them, 0 to turn off")
+
+	logging.SetLogLevel(logging.LogDiscard)
+
+	err := cmd.Parse(defaultConfigPath, serviceName)
+	if err != nil {
+		log.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCantReadServiceConfig).
+			Errorln("Can't parse args")
+		os.Exit(1)
+	}
+	logging.SetLogLevel(logging.LogVerbose)
+	if *captureFile == "" {
+		log.Errorln("capture_file should be specified")
+		os.Exit(1)
+	}
+	if *whereThreshold < 0 {
+		log.Errorln("where_threshold should be non-negative")
+		os.Exit(1)
+	}
+
+	queries, err := readCapturedQueries(*captureFile)
+	if err != nil {
+		log.WithError(err).Errorln("Can't read captured queries")
+		os.Exit(1)
+	}
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	result := learnPatterns(queries, parser, *whereThreshold)
+
+	var report *diffReport
+	if *compareConfigFile != "" {
+		configData, err := ioutil.ReadFile(*compareConfigFile)
+		if err != nil {
+			log.WithError(err).Errorln("Can't read AcraCensor config to compare")
+			os.Exit(1)
+		}
+		// don't mix logs of AcraCensor with the report
+		logging.SetLogLevel(logging.LogDiscard)
+		report, err = compareWithConfig(configData, result.patterns, parser)
+		logging.SetLogLevel(logging.LogVerbose)
+		if err != nil {
+			log.WithError(err).Errorln("Can't compare learned patterns with AcraCensor config")
+			os.Exit(1)
+		}
+	}
+
+	config, err := generateConfig(result.patterns)
+	if err != nil {
+		log.WithError(err).Errorln("Can't generate AcraCensor config")
+		os.Exit(1)
+	}
+	if *outputFile == "" {
+		_, err = os.Stdout.Write(config)
+	} else {
+		err = ioutil.WriteFile(*outputFile, config, 0600)
+	}
+	if err != nil {
+		log.WithError(err).Errorln("Can't write AcraCensor config")
+		os.Exit(1)
+	}
+
+	var reportWriter io.Writer = os.Stderr
+	if *reportFile != "" {
+		file, err := os.OpenFile(*reportFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			log.WithError(err).Errorln("Can't open report file")
+			os.Exit(1)
+		}
+		defer file.Close()
+		reportWriter = file
+	}
+	if err := writeReport(reportWriter, result, report, *compareConfigFile); err != nil {
+		log.WithError(err).Errorln("Can't write report")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	acracensor "github.com/cossacklabs/acra/acra-censor"
+	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/sqlparser"
+	"gopkg.in/yaml.v2"
+)
+
+// redacted values and lists of values, see sqlparser.ValueMask
+var (
+	redactedListRegexp  = regexp.MustCompile(`::` + sqlparser.ValueMask + `\d+`)
+	redactedValueRegexp = regexp.MustCompile(`:` + sqlparser.ValueMask + `\d+`)
+)
+
+// learnedPattern is a pattern of the cluster of captured queries with the same structure
+type learnedPattern struct {
+	pattern  string
+	redacted string
+	// sample is a query of the cluster with redacted values replaced with literals
+	sample string
+	count  int
+}
+
+// learnResult is a result of clustering captured queries
+type learnResult struct {
+	patterns []*learnedPattern
+	// total amount of captured queries
+	total int
+	// forbidden queries are marked by "_blacklisted_by_web_config" and never added to patterns
+	forbidden []string
+	unparsed  []string
+	// unmatched are patterns which AcraCensor can't match with their queries
+	unmatched []string
+}
+
+// readCapturedQueries reads queries from the file written by query_capture handler
+func readCapturedQueries(path string) ([]*common.QueryInfo, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var queries []*common.QueryInfo
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		query := &common.QueryInfo{}
+		if err := json.Unmarshal(line, query); err != nil {
+			return nil, err
+		}
+		queries = append(queries, query)
+	}
+	return queries, nil
+}
+
+// redactedToPattern replaces redacted values with %%VALUE%% and lists of values with %%LIST_OF_VALUES%% placeholders
+func redactedToPattern(query string) string {
+	query = redactedListRegexp.ReplaceAllString(query, "("+common.ListOfValuesPlaceholder+")")
+	return redactedValueRegexp.ReplaceAllString(query, common.ValuePlaceholder)
+}
+
+// redactedToSample replaces redacted values with literals to get query which may be checked by AcraCensor
+func redactedToSample(query string) string {
+	query = redactedListRegexp.ReplaceAllString(query, "(1)")
+	return redactedValueRegexp.ReplaceAllString(query, "1")
+}
+
+// learnPatterns clusters captured queries by their structure with redacted values and returns pattern for each
+// cluster. SELECT patterns which differ only in WHERE clause are merged into one pattern with %%WHERE%% placeholder
+// if there are at least whereThreshold of them, whereThreshold = 0 turns off merging
+func learnPatterns(queries []*common.QueryInfo, parser *sqlparser.Parser, whereThreshold int) *learnResult {
+	result := &learnResult{total: len(queries)}
+	clusters := make(map[string]*learnedPattern)
+	for _, query := range queries {
+		if query.IsForbidden {
+			result.forbidden = append(result.forbidden, query.RawQuery)
+			continue
+		}
+		// captured queries are already redacted, but it also normalizes queries captured in other way
+		_, redactedQuery, _, err := parser.HandleRawSQLQuery(query.RawQuery)
+		if err != nil {
+			result.unparsed = append(result.unparsed, query.RawQuery)
+			continue
+		}
+		pattern := redactedToPattern(redactedQuery)
+		if cluster, ok := clusters[pattern]; ok {
+			cluster.count++
+			continue
+		}
+		cluster := &learnedPattern{pattern: pattern, redacted: redactedQuery, sample: redactedToSample(redactedQuery), count: 1}
+		if !patternMatches(cluster.pattern, cluster.sample, parser) {
+			result.unmatched = append(result.unmatched, pattern)
+			continue
+		}
+		clusters[pattern] = cluster
+		result.patterns = append(result.patterns, cluster)
+	}
+	if whereThreshold > 0 {
+		result.patterns = mergeWherePatterns(result.patterns, parser, whereThreshold)
+	}
+	// most frequent queries first
+	sort.SliceStable(result.patterns, func(i, j int) bool {
+		return result.patterns[i].count > result.patterns[j].count
+	})
+	return result
+}
+
+// patternMatches returns true if AcraCensor matches the query with the pattern
+func patternMatches(pattern, query string, parser *sqlparser.Parser) bool {
+	parsedPatterns, err := common.ParsePatterns([]string{pattern}, parser)
+	if err != nil {
+		return false
+	}
+	parsedQuery, err := parser.Parse(query)
+	if err != nil {
+		return false
+	}
+	return common.CheckPatternsMatching(parsedPatterns, parsedQuery)
+}
+
+// mergeWherePatterns replaces WHERE clauses of SELECT patterns with %%WHERE%% placeholder if there are at least
+// threshold patterns with the same query except WHERE clause
+func mergeWherePatterns(patterns []*learnedPattern, parser *sqlparser.Parser, threshold int) []*learnedPattern {
+	whereNode := common.WherePatternStatement.(*sqlparser.Select).Where
+	groups := make(map[string][]*learnedPattern)
+	keys := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		statement, err := parser.Parse(pattern.redacted)
+		if err != nil {
+			continue
+		}
+		selectStatement, ok := statement.(*sqlparser.Select)
+		if !ok || selectStatement.Where == nil {
+			continue
+		}
+		selectStatement.Where = whereNode
+		key := strings.Replace(sqlparser.String(selectStatement), common.WhereReplacer, common.WherePlaceholder, 1)
+		key = redactedToPattern(key)
+		if !patternMatches(key, pattern.sample, parser) {
+			continue
+		}
+		keys[i] = key
+		groups[key] = append(groups[key], pattern)
+	}
+	merged := make([]*learnedPattern, 0, len(patterns))
+	added := make(map[string]bool)
+	for i, pattern := range patterns {
+		group := groups[keys[i]]
+		if keys[i] == "" || len(group) < threshold {
+			merged = append(merged, pattern)
+			continue
+		}
+		if added[keys[i]] {
+			continue
+		}
+		added[keys[i]] = true
+		mergedPattern := &learnedPattern{pattern: keys[i], sample: group[0].sample}
+		for _, member := range group {
+			mergedPattern.count += member.count
+		}
+		merged = append(merged, mergedPattern)
+	}
+	return merged
+}
+
+// generateConfig returns AcraCensor config which allows learned patterns and denies other queries
+func generateConfig(patterns []*learnedPattern) ([]byte, error) {
+	allow := acracensor.HandlerConfig{Handler: acracensor.AllowConfigStr}
+	for _, pattern := range patterns {
+		allow.Patterns = append(allow.Patterns, pattern.pattern)
+	}
+	config := acracensor.Config{
+		Version: acracensor.MinimalCensorConfigVersion,
+		Handlers: []acracensor.HandlerConfig{
+			allow,
+			{Handler: acracensor.DenyAllConfigStr},
+		},
+	}
+	return yaml.Marshal(config)
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	acracensor "github.com/cossacklabs/acra/acra-censor"
+	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/sqlparser"
+)
+
+func capturedQueries(t *testing.T, queries ...string) []*common.QueryInfo {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	result := make([]*common.QueryInfo, 0, len(queries))
+	for _, query := range queries {
+		// the same as QueryCaptureHandler stores
+		_, redacted, _, err := parser.HandleRawSQLQuery(query)
+		if err != nil {
+			result = append(result, &common.QueryInfo{RawQuery: query})
+			continue
+		}
+		result = append(result, &common.QueryInfo{RawQuery: redacted})
+	}
+	return result
+}
+
+func TestReadCapturedQueries(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "censor_learn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	path := filepath.Join(tmpDir, "censor.log")
+	data := `{"raw_query":"select * from t where id = :replaced1","_blacklisted_by_web_config":false}
+
+{"raw_query":"delete from t","_blacklisted_by_web_config":true}
+`
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	queries, err := readCapturedQueries(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queries) != 2 || queries[0].RawQuery != "select * from t where id = :replaced1" || queries[0].IsForbidden || !queries[1].IsForbidden {
+		t.Fatalf("Unexpected queries: %v, %v", queries[0], queries[1])
+	}
+	if err := ioutil.WriteFile(path, []byte("not json\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readCapturedQueries(path); err == nil {
+		t.Fatal("Expected error for invalid capture file")
+	}
+}
+
+func TestLearnPatterns(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	queries := capturedQueries(t,
+		"SELECT name FROM users WHERE id = 1",
+		"SELECT name FROM users WHERE id = 2",
+		"SELECT name FROM users WHERE id IN (1, 2, 3)",
+		"INSERT INTO orders (user_id, total) VALUES (1, 100)",
+		"SELECT name FROM users WHERE id = 3",
+		"UPDATE orders SET total = 5 WHERE id = 10 LIMIT 1",
+		"SELECT * FROM ORDER",
+	)
+	queries = append(queries, &common.QueryInfo{RawQuery: "DELETE FROM users", IsForbidden: true})
+	result := learnPatterns(queries, parser, 0)
+	expected := []struct {
+		pattern string
+		count   int
+	}{
+		{"select name from users where id = %%VALUE%%", 3},
+		{"select name from users where id in (%%LIST_OF_VALUES%%)", 1},
+		{"insert into orders(user_id, total) values (%%VALUE%%, %%VALUE%%)", 1},
+		{"update orders set total = %%VALUE%% where id = %%VALUE%% limit %%VALUE%%", 1},
+	}
+	if len(result.patterns) != len(expected) {
+		t.Fatalf("Expected %d patterns, took %d", len(expected), len(result.patterns))
+	}
+	for i, pattern := range result.patterns {
+		if pattern.pattern != expected[i].pattern || pattern.count != expected[i].count {
+			t.Fatalf("[%d] Expected '%s' (%d), took '%s' (%d)", i, expected[i].pattern, expected[i].count, pattern.pattern, pattern.count)
+		}
+	}
+	if result.total != 8 || len(result.forbidden) != 1 || len(result.unparsed) != 1 || len(result.unmatched) != 0 {
+		t.Fatalf("Unexpected result: %d, %v, %v, %v", result.total, result.forbidden, result.unparsed, result.unmatched)
+	}
+
+	// generated config allows captured queries with other values and denies other queries
+	config, err := generateConfig(result.patterns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	censor := acracensor.NewAcraCensor()
+	defer censor.ReleaseAll()
+	if err := censor.LoadConfiguration(config); err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range []string{
+		"SELECT name FROM users WHERE id = 100",
+		"SELECT name FROM users WHERE id IN (5)",
+		"INSERT INTO orders (user_id, total) VALUES (2, 'x')",
+	} {
+		if err := censor.HandleQuery(nil, query); err != nil {
+			t.Fatalf("Expected allowed query '%s', took %v", query, err)
+		}
+	}
+	for _, query := range []string{
+		"SELECT ssn FROM users WHERE id = 100",
+		"DELETE FROM users",
+	} {
+		if err := censor.HandleQuery(nil, query); err != common.ErrDenyAllError {
+			t.Fatalf("Expected denied query '%s', took %v", query, err)
+		}
+	}
+}
+
+func TestMergeWherePatterns(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	queries := capturedQueries(t,
+		"SELECT name FROM users WHERE id = 1",
+		"SELECT name FROM users WHERE email = 'a'",
+		"SELECT name FROM users WHERE id = 1 AND email = 'a'",
+		"SELECT id FROM orders WHERE total > 10",
+		"SELECT id FROM orders WHERE user_id = 10",
+		"SELECT name FROM users",
+	)
+	testcases := []struct {
+		threshold int
+		patterns  []string
+	}{
+		{0, []string{
+			"select name from users where id = %%VALUE%%",
+			"select name from users where email = %%VALUE%%",
+			"select name from users where id = %%VALUE%% and email = %%VALUE%%",
+			"select id from orders where total > %%VALUE%%",
+			"select id from orders where user_id = %%VALUE%%",
+			"select name from users",
+		}},
+		{3, []string{
+			"select name from users %%WHERE%%",
+			"select id from orders where total > %%VALUE%%",
+			"select id from orders where user_id = %%VALUE%%",
+			"select name from users",
+		}},
+		{2, []string{
+			"select name from users %%WHERE%%",
+			"select id from orders %%WHERE%%",
+			"select name from users",
+		}},
+	}
+	for i, tcase := range testcases {
+		result := learnPatterns(queries, parser, tcase.threshold)
+		patterns := make([]string, 0, len(result.patterns))
+		for _, pattern := range result.patterns {
+			patterns = append(patterns, pattern.pattern)
+		}
+		if strings.Join(patterns, "\n") != strings.Join(tcase.patterns, "\n") {
+			t.Fatalf("[%d] Unexpected patterns:\n%s", i, strings.Join(patterns, "\n"))
+		}
+	}
+	result := learnPatterns(queries, parser, 3)
+	if result.patterns[0].count != 3 {
+		t.Fatalf("Expected 3 queries of merged pattern, took %d", result.patterns[0].count)
+	}
+}
+
+func TestCompareWithConfig(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	queries := capturedQueries(t,
+		"SELECT name FROM users WHERE id = 1",
+		"SELECT total FROM orders WHERE id = 1",
+		"INSERT INTO logs (message) VALUES ('a')",
+	)
+	result := learnPatterns(queries, parser, 0)
+	config := fmt.Sprintf(`version: %s
+mode: monitor
+parse_errors_log: unparsed.log
+handlers:
+  - handler: query_capture
+    filepath: censor.log
+  - handler: allow
+    queries:
+      - INSERT INTO logs (message) VALUES ('b')
+      - DELETE FROM logs
+    tables:
+      - orders
+      - accounts
+    patterns:
+      - SELECT name FROM users WHERE id = %%%%VALUE%%%%
+      - SELECT * FROM users
+  - handler: denyall
+`, acracensor.MinimalCensorConfigVersion)
+	report, err := compareWithConfig([]byte(config), result.patterns, parser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// query_capture and parse errors log are skipped
+	for _, path := range []string{"censor.log", "unparsed.log"} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			os.Remove(path)
+			t.Fatalf("%s shouldn't be created", path)
+		}
+	}
+	if len(report.added) != 1 || report.added[0].pattern != "insert into logs(message) values (%%VALUE%%)" {
+		t.Fatalf("Unexpected new patterns: %v", report.added)
+	}
+	if len(report.covered) != 2 {
+		t.Fatalf("Unexpected covered patterns: %v", report.covered)
+	}
+	expectedUnused := []string{"query: DELETE FROM logs", "table: accounts", "pattern: SELECT * FROM users"}
+	if strings.Join(report.unused, "\n") != strings.Join(expectedUnused, "\n") {
+		t.Fatalf("Unexpected unused rules: %v", report.unused)
+	}
+
+	output := &bytes.Buffer{}
+	if err := writeReport(output, result, report, "acra-censor.yaml"); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"# captured queries: 3, learned patterns: 3, forbidden: 0, unparsed: 0, unmatched: 0",
+		"# compared with acra-censor.yaml: new: 1, already allowed: 2, unused: 3",
+		"+ insert into logs(message) values (%%VALUE%%) (1 queries)",
+		"- table: accounts",
+	} {
+		if !strings.Contains(output.String(), line+"\n") {
+			t.Fatalf("Report doesn't contain '%s':\n%s", line, output.String())
+		}
+	}
+
+	if _, err := compareWithConfig([]byte("handlers: ["), result.patterns, parser); err == nil {
+		t.Fatal("Expected error for invalid config")
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	acracensor "github.com/cossacklabs/acra/acra-censor"
+	"github.com/cossacklabs/acra/acra-censor/common"
+	"github.com/cossacklabs/acra/sqlparser"
+	"gopkg.in/yaml.v2"
+)
+
+// diffReport compares learned patterns with existing AcraCensor config
+type diffReport struct {
+	// added are learned patterns which queries are denied by existing config
+	added []*learnedPattern
+	// covered are learned patterns which queries are allowed by existing config
+	covered []*learnedPattern
+	// unused are rules of allow handlers of existing config which don't match any captured query
+	unused []string
+}
+
+// compareWithConfig checks queries of learned patterns with default handlers of existing config. Handlers which
+// don't decide whether query is allowed (query_capture, ratelimit) are skipped to not touch their files and storages.
+// Handlers in monitor mode are checked as enforced
+func compareWithConfig(configData []byte, patterns []*learnedPattern, parser *sqlparser.Parser) (*diffReport, error) {
+	config := acracensor.Config{}
+	if err := yaml.Unmarshal(configData, &config); err != nil {
+		return nil, err
+	}
+	config.ParseErrorsLog = ""
+	config.Clients = nil
+	config.Mode = ""
+	handlers := make([]acracensor.HandlerConfig, 0, len(config.Handlers))
+	for _, handler := range config.Handlers {
+		if handler.Handler == acracensor.QueryCaptureConfigStr || handler.Handler == acracensor.RateLimitConfigStr {
+			continue
+		}
+		handler.Mode = ""
+		handlers = append(handlers, handler)
+	}
+	config.Handlers = handlers
+	strippedConfig, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	censor := acracensor.NewAcraCensor()
+	defer censor.ReleaseAll()
+	if err := censor.LoadConfiguration(strippedConfig); err != nil {
+		return nil, err
+	}
+
+	report := &diffReport{}
+	samples := make([]sqlparser.Statement, 0, len(patterns))
+	redactedSamples := make(map[string]bool, len(patterns))
+	for _, pattern := range patterns {
+		if err := censor.HandleQuery(nil, pattern.sample); err != nil {
+			report.added = append(report.added, pattern)
+		} else {
+			report.covered = append(report.covered, pattern)
+		}
+		_, redacted, parsed, err := parser.HandleRawSQLQuery(pattern.sample)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, parsed)
+		redactedSamples[redacted] = true
+	}
+
+	for _, handler := range config.Handlers {
+		if handler.Handler != acracensor.AllowConfigStr {
+			continue
+		}
+		for _, query := range handler.Queries {
+			_, redacted, _, err := parser.HandleRawSQLQuery(query)
+			if err != nil || !redactedSamples[redacted] {
+				report.unused = append(report.unused, "query: "+query)
+			}
+		}
+		for _, table := range handler.Tables {
+			if !anySampleMatches(samples, func(sample sqlparser.Statement) bool {
+				atLeastOneTableMatch, _ := common.CheckTableNamesMatch(sample, map[string]bool{table: true})
+				return atLeastOneTableMatch
+			}) {
+				report.unused = append(report.unused, "table: "+table)
+			}
+		}
+		for _, pattern := range handler.Patterns {
+			parsedPatterns, err := common.ParsePatterns([]string{pattern}, parser)
+			if err != nil || !anySampleMatches(samples, func(sample sqlparser.Statement) bool {
+				return common.CheckPatternsMatching(parsedPatterns, sample)
+			}) {
+				report.unused = append(report.unused, "pattern: "+pattern)
+			}
+		}
+	}
+	return report, nil
+}
+
+func anySampleMatches(samples []sqlparser.Statement, match func(sqlparser.Statement) bool) bool {
+	for _, sample := range samples {
+		if match(sample) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeReport writes summary of learning and diff report if it is not nil in diff-like format
+func writeReport(writer io.Writer, result *learnResult, report *diffReport, configPath string) error {
+	lines := []string{fmt.Sprintf("# captured queries: %d, learned patterns: %d, forbidden: %d, unparsed: %d, unmatched: %d",
+		result.total, len(result.patterns), len(result.forbidden), len(result.unparsed), len(result.unmatched))}
+	for _, query := range result.forbidden {
+		lines = append(lines, "! forbidden: "+query)
+	}
+	for _, query := range result.unparsed {
+		lines = append(lines, "! unparsed: "+query)
+	}
+	for _, pattern := range result.unmatched {
+		lines = append(lines, "! unmatched: "+pattern)
+	}
+	if report != nil {
+		lines = append(lines, fmt.Sprintf("# compared with %s: new: %d, already allowed: %d, unused: %d",
+			configPath, len(report.added), len(report.covered), len(report.unused)))
+		for _, pattern := range report.added {
+			lines = append(lines, fmt.Sprintf("+ %s (%d queries)", pattern.pattern, pattern.count))
+		}
+		for _, pattern := range report.covered {
+			lines = append(lines, fmt.Sprintf("= %s (%d queries)", pattern.pattern, pattern.count))
+		}
+		for _, rule := range report.unused {
+			lines = append(lines, "- "+rule)
+		}
+	}
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(writer, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
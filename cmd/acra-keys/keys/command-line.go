@@ -66,6 +66,8 @@ const (
 	KeySymmetric     = "symmetric-key"
 	KeyZoneSymmetric = "symmetric-zone-key"
 
+	KeyRotatedHmac = "rotated-hmac-keys"
+
 	KeyTransportConnector  = "transport-connector"
 	KeyTransportServer     = "transport-server"
 	KeyTransportTranslator = "transport-translator"
@@ -176,6 +178,8 @@ func ParseKeyKind(keyID string) (string, []byte, error) {
 				return KeySymmetric, id, nil
 			case "storage":
 				return KeyStorageKeypair, id, nil
+			case "hmac-rotated":
+				return KeyRotatedHmac, id, nil
 			}
 		}
 		if parts[0] == "zone" {
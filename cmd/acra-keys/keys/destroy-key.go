@@ -31,6 +31,7 @@ var SupportedDestroyKeyKinds = []string{
 	KeyTransportConnector,
 	KeyTransportServer,
 	KeyTransportTranslator,
+	KeyRotatedHmac,
 }
 
 // DestroyKeyParams are parameters of "acra-keys destroy" subcommand.
@@ -91,7 +92,7 @@ func (p *DestroyKeySubcommand) Parse(arguments []string) error {
 		return err
 	}
 	switch coarseKind {
-	case KeyTransportConnector, KeyTransportServer, KeyTransportTranslator:
+	case KeyTransportConnector, KeyTransportServer, KeyTransportTranslator, KeyRotatedHmac:
 		p.destroyKeyKind = coarseKind
 		p.contextID = id
 
@@ -148,6 +149,15 @@ func DestroyKey(params DestroyKeyParams, keyStore keystore.KeyMaking) error {
 		}
 		return nil
 
+	case KeyRotatedHmac:
+		// searchable data should be re-hashed with current key before, otherwise it can't be found
+		err := keyStore.DestroyRotatedHmacKeys(params.ClientID())
+		if err != nil {
+			log.WithError(err).Error("Cannot destroy rotated HMAC keys")
+			return err
+		}
+		return nil
+
 	default:
 		log.WithField("expected", SupportedDestroyKeyKinds).Errorf("Unknown key kind: %s", kind)
 		return ErrUnknownKeyKind
@@ -0,0 +1,152 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keys
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/cossacklabs/acra/keystore"
+	"github.com/cossacklabs/acra/keystore/keyloader"
+	keystoreV2 "github.com/cossacklabs/acra/keystore/v2/keystore"
+)
+
+func TestParseKeyKind(t *testing.T) {
+	testcases := []struct {
+		keyID string
+		kind  string
+		id    []byte
+		err   error
+	}{
+		{"client/alice/hmac-rotated", KeyRotatedHmac, []byte("alice"), nil},
+		{"client/alice/symmetric", KeySymmetric, []byte("alice"), nil},
+		{"client/alice/transport/server", KeyTransportServer, []byte("alice"), nil},
+		{"poison-record", KeyPoisonKeypair, nil, nil},
+		// rotated HMAC keys exist only for clients
+		{"zone/alice/hmac-rotated", "", nil, ErrUnknownKeyKind},
+		{"client/alice/hmac", "", nil, ErrUnknownKeyKind},
+		{"client/hmac-rotated", "", nil, ErrUnknownKeyKind},
+	}
+	for i, tcase := range testcases {
+		kind, id, err := ParseKeyKind(tcase.keyID)
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.err, err)
+		}
+		if kind != tcase.kind || !bytes.Equal(id, tcase.id) {
+			t.Fatalf("[%d] Expect %s %s, took %s %s\n", i, tcase.kind, tcase.id, kind, id)
+		}
+	}
+}
+
+// hmacKeyStore generates and reads HMAC keys of a keystore of any version
+type hmacKeyStore interface {
+	keystore.KeyMaking
+	GetHMACSecretKeys(id []byte) ([][]byte, error)
+}
+
+func testDestroyRotatedHmacKeys(t *testing.T, keyStore hmacKeyStore) {
+	clientID := []byte("testclientid")
+	for i := 0; i < 3; i++ {
+		if err := keyStore.GenerateHmacKey(clientID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	keys, err := keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("Expect 3 HMAC keys, took %d\n", len(keys))
+	}
+	currentKey := keys[0]
+
+	params := &DestroyKeySubcommand{destroyKeyKind: KeyRotatedHmac, contextID: clientID}
+	if err := DestroyKey(params, keyStore); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || !bytes.Equal(keys[0], currentKey) {
+		t.Fatalf("Expect only current HMAC key, took %d keys\n", len(keys))
+	}
+
+	// destroying without rotated keys keeps current key
+	if err := DestroyKey(params, keyStore); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || !bytes.Equal(keys[0], currentKey) {
+		t.Fatalf("Expect only current HMAC key, took %d keys\n", len(keys))
+	}
+
+	params = &DestroyKeySubcommand{destroyKeyKind: KeyPoisonKeypair, contextID: clientID}
+	if err := DestroyKey(params, keyStore); err != ErrUnknownKeyKind {
+		t.Fatalf("Expect ErrUnknownKeyKind, took %v\n", err)
+	}
+}
+
+func TestDestroyRotatedHmacKeysV1(t *testing.T) {
+	keyLoader := keyloader.NewEnvLoader(keystore.AcraMasterKeyVarName)
+	masterKey, err := keystore.GenerateSymmetricKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(keystore.AcraMasterKeyVarName, base64.StdEncoding.EncodeToString(masterKey))
+
+	dirName, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dirName)
+
+	params := &DestroyKeySubcommand{CommonKeyStoreParameters: CommonKeyStoreParameters{keyDir: dirName}}
+	keyStore, err := openKeyStoreV1(params, keyLoader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDestroyRotatedHmacKeys(t, keyStore)
+}
+
+func TestDestroyRotatedHmacKeysV2(t *testing.T) {
+	keyLoader := keyloader.NewEnvLoader(keystore.AcraMasterKeyVarName)
+	masterKey, err := keystoreV2.NewSerializedMasterKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(keystore.AcraMasterKeyVarName, base64.StdEncoding.EncodeToString(masterKey))
+
+	dirName, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dirName)
+
+	params := &DestroyKeySubcommand{CommonKeyStoreParameters: CommonKeyStoreParameters{keyDir: dirName}}
+	keyStore, err := openKeyStoreV2(params, keyLoader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testDestroyRotatedHmacKeys(t, keyStore)
+}
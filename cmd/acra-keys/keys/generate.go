@@ -48,6 +48,7 @@ type GenerateKeyParams interface {
 	GenerateSearchHMAC() bool
 	GeneratePoisonRecord() bool
 	GenerateAuditLog() bool
+	GenerateClientTokenSymmetricKey() bool
 	SetClientID(clientID string)
 	TLSClientCert() string
 	TLSIdentifierExtractorType() string
@@ -56,6 +57,7 @@ type GenerateKeyParams interface {
 	GenerateNewZone() bool
 	GenerateZoneKeys() bool
 	GenerateZoneSymmetricKey() bool
+	GenerateZoneTokenSymmetricKey() bool
 
 	SpecificKeysRequested() bool
 }
@@ -91,6 +93,8 @@ type GenerateKeySubcommand struct {
 	auditLog        bool
 	searchHMAC      bool
 	poisonRecord    bool
+	clientTokenSym  bool
+	zoneTokenSym    bool
 }
 
 //GenerateAuditLog get auditLog flag
@@ -154,6 +158,11 @@ func (g *GenerateKeySubcommand) GenerateSearchHMAC() bool {
 	return g.searchHMAC
 }
 
+// GenerateClientTokenSymmetricKey returns true if a new token symmetric key for a client was requested.
+func (g *GenerateKeySubcommand) GenerateClientTokenSymmetricKey() bool {
+	return g.clientTokenSym
+}
+
 // ZoneID returns zone ID.
 func (g *GenerateKeySubcommand) ZoneID() []byte {
 	return []byte(g.zoneID)
@@ -174,11 +183,17 @@ func (g *GenerateKeySubcommand) GenerateZoneSymmetricKey() bool {
 	return g.rotateZoneSym
 }
 
+// GenerateZoneTokenSymmetricKey returns true if a new token symmetric key for a zone was requested.
+func (g *GenerateKeySubcommand) GenerateZoneTokenSymmetricKey() bool {
+	return g.zoneTokenSym
+}
+
 // SpecificKeysRequested returns true if the user has requested any key specifically.
 // It returns false if no keys were requested.
 func (g *GenerateKeySubcommand) SpecificKeysRequested() bool {
 	return g.acraConnector || g.acraServer || g.acraTranslator || g.acraWriter || g.newZone ||
-		g.rotateZone || g.acraBlocks || g.auditLog || g.searchHMAC || g.poisonRecord || g.rotateZoneSym
+		g.rotateZone || g.acraBlocks || g.auditLog || g.searchHMAC || g.poisonRecord || g.rotateZoneSym ||
+		g.clientTokenSym || g.zoneTokenSym
 }
 
 // Name returns the same of this subcommand.
@@ -211,6 +226,8 @@ func (g *GenerateKeySubcommand) RegisterFlags() {
 	g.flagSet.BoolVar(&g.auditLog, "audit_log_symmetric_key", false, "Generate symmetric key for log integrity checks")
 	g.flagSet.BoolVar(&g.searchHMAC, "search_hmac_symmetric_key", false, "Generate symmetric key for searchable encryption HMAC")
 	g.flagSet.BoolVar(&g.poisonRecord, "poison_record_keys", false, "Generate keypair and symmetric key for poison records")
+	g.flagSet.BoolVar(&g.clientTokenSym, "client_token_symmetric_key", false, "Generate symmetric key for format-preserving tokenization (for a client)")
+	g.flagSet.BoolVar(&g.zoneTokenSym, "zone_token_symmetric_key", false, "Generate symmetric key for format-preserving tokenization (for a zone)")
 	g.flagSet.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Command \"%s\": generate new keys\n", CmdGenerate)
 		fmt.Fprintf(os.Stderr, "\n\t%s %s [options...]\n", os.Args[0], CmdGenerate)
@@ -261,9 +278,10 @@ func ValidateClientID(params GenerateKeyParams) error {
 		masterKey := params.GenerateMasterKeyFile() != ""
 		firstGeneration := params.KeystoreVersion() != ""
 		requestedClientKeys := params.GenerateAcraConnector() || params.GenerateAcraServer() ||
-			params.GenerateAcraTranslator() || params.GenerateAcraWriter() || params.GenerateAcraBlocks() || params.GenerateSearchHMAC()
+			params.GenerateAcraTranslator() || params.GenerateAcraWriter() || params.GenerateAcraBlocks() || params.GenerateSearchHMAC() ||
+			params.GenerateClientTokenSymmetricKey()
 
-		requestedNonClientKeys := params.GeneratePoisonRecord() || params.GenerateAuditLog()
+		requestedNonClientKeys := params.GeneratePoisonRecord() || params.GenerateAuditLog() || params.GenerateZoneTokenSymmetricKey()
 
 		// skip clientID validation if only non-clientID based keys requested to generate
 		if requestedNonClientKeys && !requestedClientKeys {
@@ -293,7 +311,7 @@ func ValidateZoneID(params GenerateKeyParams) error {
 	zoneID := params.ZoneID()
 	// Zone ID is required to generate some of the keys.
 	if len(zoneID) == 0 {
-		if params.GenerateZoneKeys() {
+		if params.GenerateZoneKeys() || params.GenerateZoneTokenSymmetricKey() {
 			log.Error("--zone_id is required to generate zone keys")
 			return ErrMissingZoneID
 		}
@@ -528,6 +546,26 @@ func GenerateAcraKeys(params GenerateKeyParams, keyStore keystore.KeyMaking, def
 		didSomething = true
 	}
 
+	if params.GenerateClientTokenSymmetricKey() {
+		err := keyStore.GenerateTokenSymmetricKey(params.ClientID(), keystore.KeyOwnerTypeClient)
+		if err != nil {
+			log.WithError(err).Error("Failed to generate client token symmetric key")
+			return didSomething, err
+		}
+		log.Info("Generated client token symmetric key")
+		didSomething = true
+	}
+
+	if params.GenerateZoneTokenSymmetricKey() {
+		err := keyStore.GenerateTokenSymmetricKey(params.ZoneID(), keystore.KeyOwnerTypeZone)
+		if err != nil {
+			log.WithError(err).Error("Failed to generate zone token symmetric key")
+			return didSomething, err
+		}
+		log.Info("Generated zone token symmetric key")
+		didSomething = true
+	}
+
 	if generateAuditLogKey {
 		err := keyStore.GenerateLogKey()
 		if err != nil {
@@ -129,6 +129,7 @@ func main() {
 	zoneMode := flag.Bool("zonemode_enable", true, "Rotate acrastructs as it was encrypted with zonemode or without. With zonemode_enable=true will be used zoneID for encryption/decryption. If false then key id will not be used")
 	_ = flag.Bool("postgresql_enable", false, "Handle Postgresql connections")
 	dryRun := flag.Bool("dry-run", false, "perform rotation without saving rotated AcraStructs and keys")
+	rehashHmac := flag.Bool("rehash_hmac_enable", false, "Re-hash searchable data with current HMAC keys instead of rotation of AcraStructs. sql_select should return ClientId and searchable data as last columns, sql_update gets re-hashed data into first placeholder and previous searchable data into last placeholder to update only unchanged rows")
 	logging.SetLogLevel(logging.LogVerbose)
 
 	hashicorp.RegisterVaultCLIParameters()
@@ -190,6 +191,13 @@ func main() {
 			log.WithError(err).Errorln("Error on pinging database", *connectionString)
 			os.Exit(1)
 		}
+		if *rehashHmac {
+			log.WithFields(log.Fields{"select_query": *sqlSelect, "update_query": *sqlUpdate}).Infoln("Re-hash searchable data in database")
+			if !rehashDb(*sqlSelect, *sqlUpdate, db, keystorage, encoder, *dryRun) {
+				os.Exit(1)
+			}
+			return
+		}
 		log.WithFields(log.Fields{"select_query": *sqlSelect, "update_query": *sqlUpdate}).Infoln("Rotate data in database")
 		if !rotateDb(*sqlSelect, *sqlUpdate, db, keystorage, encoder, *zoneMode, *dryRun) {
 			os.Exit(1)
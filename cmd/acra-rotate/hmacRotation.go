@@ -0,0 +1,170 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+
+	"github.com/cossacklabs/acra/crypto"
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/hmac"
+	"github.com/cossacklabs/acra/keystore"
+	"github.com/cossacklabs/acra/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrNotSearchableData returned for data without HMAC
+var ErrNotSearchableData = errors.New("data doesn't start with HMAC")
+
+// rehashSearchableData returns searchable data with HMAC and prefix index calculated with current HMAC key of the
+// client or nil if HMAC already calculated with current key. Encrypted container is kept as is
+func rehashSearchableData(keyStore keystore.ServerKeyStore, clientID, data []byte) ([]byte, error) {
+	hash, container := hmac.ExtractHashAndData(data)
+	if hash == nil {
+		return nil, ErrNotSearchableData
+	}
+	serialized, envelopeID, err := crypto.DeserializeEncryptedData(container)
+	if err != nil {
+		return nil, err
+	}
+	handler, err := crypto.GetHandlerByEnvelopeID(envelopeID)
+	if err != nil {
+		return nil, err
+	}
+	accessContext := base.NewAccessContext(base.WithClientID(clientID))
+	dataContext := &base.DataProcessorContext{Keystore: keyStore,
+		Context: base.SetAccessContextToContext(context.Background(), accessContext)}
+	decrypted, err := handler.Decrypt(serialized, dataContext)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.ZeroizeBytes(decrypted)
+
+	key, err := keyStore.GetHMACSecretKey(clientID)
+	if err != nil {
+		return nil, err
+	}
+	previousHash := hash.Marshal()
+	newHash, err := hmac.RegenerateSearchableHash(key, decrypted, previousHash)
+	if err != nil {
+		return nil, err
+	}
+	// prefix index of short values contains random bytes, so compare only HMACs
+	size := hmac.GetDefaultHashSize()
+	if bytes.Equal(newHash[:size], previousHash[:size]) {
+		return nil, nil
+	}
+	return append(newHash, container...), nil
+}
+
+// rehashDb execute selectQuery to fetch searchable data with related client ids, replace HMACs calculated with
+// rotated keys with HMACs calculated with current keys and save them with updateQuery. updateQuery gets previous
+// searchable data into the last placeholder to update only rows which weren't changed after selectQuery, such rows
+// are counted as missed and should be checked by next run
+func rehashDb(selectQuery, updateQuery string, db *sql.DB, keyStore keystore.ServerKeyStore, encoder utils.BinaryEncoder, dryRun bool) bool {
+	rows, err := db.Query(selectQuery)
+	if err != nil {
+		log.WithError(err).Errorf("Can't fetch result with sql_select query")
+		return false
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		log.WithError(err).Errorln("Can't fetch metadata for result columns")
+		return false
+	}
+	if len(columns) < 2 {
+		log.Errorln("Result has < 2 columns. Expected at least ClientId and searchable data")
+		return false
+	}
+
+	row := make([]interface{}, len(columns))
+	rowPointers := make([]interface{}, len(columns))
+	// dynamically create list of pointers to interface to pass to row.Scan method to fetch all values
+	for i := 0; i < len(columns); i++ {
+		rowPointers[i] = &row[i]
+	}
+	clientIDIndex := len(columns) - 2 // last but one item
+	dataIndex := len(columns) - 1     // last item
+	rehashedCount, skippedCount, missedCount := 0, 0, 0
+	for rows.Next() {
+		err = rows.Scan(rowPointers...)
+		if err != nil {
+			log.WithError(err).Errorln("Can't read row from result")
+			return false
+		}
+		clientID, ok := row[clientIDIndex].([]byte)
+		if !ok {
+			log.Errorf("ClientId column has incorrect type (bytes expected, took %s)", reflect.TypeOf(row[clientIDIndex]))
+			return false
+		}
+		data, ok := row[dataIndex].([]byte)
+		if !ok {
+			log.Errorf("Searchable data column has incorrect type (bytes expected, took %s)", reflect.TypeOf(row[dataIndex]))
+			return false
+		}
+		logger := log.WithFields(log.Fields{"Key ID": string(clientID)})
+
+		rehashed, err := rehashSearchableData(keyStore, clientID, data)
+		if err != nil {
+			logger.WithError(err).Errorln("Can't re-hash searchable data")
+			return false
+		}
+		if rehashed == nil {
+			skippedCount++
+			continue
+		}
+		logger.Infof("Re-hash searchable data")
+		args := append([]interface{}{encoder.Encode(rehashed)}, row[:clientIDIndex]...)
+		args = append(args, encoder.Encode(data))
+		if dryRun {
+			rehashedCount++
+			continue
+		}
+		result, err := db.Exec(updateQuery, args...)
+		if err != nil {
+			logger.WithError(err).Errorln("Can't update data in db via sql_update query")
+			return false
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			logger.WithError(err).Errorln("Can't get count of rows updated by sql_update query")
+			return false
+		}
+		if affected == 0 {
+			logger.Warningln("Searchable data was changed or removed after sql_select query, skip it")
+			missedCount++
+			continue
+		}
+		rehashedCount++
+	}
+	if err := rows.Err(); err != nil {
+		log.WithError(err).Errorln("Can't read rows from result")
+		return false
+	}
+	logger := log.WithFields(log.Fields{"rehashed": rehashedCount, "skipped": skippedCount, "missed": missedCount})
+	if missedCount > 0 {
+		logger.Errorln("Some searchable data was changed concurrently, run re-hash again before destroying rotated HMAC keys")
+		return false
+	}
+	logger.Infoln("Searchable data re-hashed, rotated HMAC keys may be destroyed with acra-keys destroy")
+	return true
+}
@@ -126,6 +126,13 @@ func (clientSession *ClientCommandsSession) HandleSession() {
 		clientSession.keystore.Reset()
 		response = "HTTP/1.1 200 OK Found\r\n\r\n"
 		logger.Debugln("Cleared key storage cache")
+	case "/reloadEncryptorConfig":
+		logger.Debugln("Got /reloadEncryptorConfig request")
+		if err := clientSession.config.ReloadMapTableSchemaConfig(); err != nil {
+			response = Response500Error
+		} else {
+			response = "HTTP/1.1 200 OK Found\r\n\r\n"
+		}
 	default:
 		requestSpan.AddAttributes(trace.StringAttribute("http.url", "undefined"))
 	}
@@ -19,6 +19,7 @@ package common
 import (
 	"errors"
 	"io/ioutil"
+	"sync"
 
 	acracensor "github.com/cossacklabs/acra/acra-censor"
 	"github.com/cossacklabs/acra/encryptor"
@@ -48,12 +49,16 @@ type Config struct {
 	censor                  acracensor.AcraCensorInterface
 	withConnector           bool
 	TraceToLog              bool
-	tableSchema             encryptorConfig.TableSchemaStore
-	dataEncryptor           encryptor.DataEncryptor
-	keystore                keystore.ServerKeyStore
-	traceOptions            []trace.StartOption
-	serviceName             string
-	configPath              string
+	tableSchema             *encryptorConfig.ReloadableTableSchemaStore
+	encryptorConfigPath     string
+	// connectionFeatures are features of encryptor config which have processors registered on every connection
+	connectionFeatures encryptorConfig.SettingMask
+	reloadLock         sync.Mutex
+	dataEncryptor      encryptor.DataEncryptor
+	keystore           keystore.ServerKeyStore
+	traceOptions       []trace.StartOption
+	serviceName        string
+	configPath         string
 }
 
 // NewConfig returns new Config object
@@ -63,7 +68,7 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 	traceOptions := []trace.StartOption{trace.WithSpanKind(trace.SpanKindServer), trace.WithSampler(trace.AlwaysSample())}
-	return &Config{withZone: false, mysql: false, postgresql: false, withConnector: true, tableSchema: schemaStore, traceOptions: traceOptions}, nil
+	return &Config{withZone: false, mysql: false, postgresql: false, withConnector: true, tableSchema: encryptorConfig.NewReloadableTableSchemaStore(schemaStore), traceOptions: traceOptions}, nil
 }
 
 // ErrTwoDBSetup shows that AcraServer can connects only to one database at the same time
@@ -85,19 +90,73 @@ func (config *Config) SetWithConnector(v bool) {
 	config.withConnector = v
 }
 
-// LoadMapTableSchemaConfig load table schemas from config file
-func (config *Config) LoadMapTableSchemaConfig(path string) error {
+// Errors returned on reload of encryptor config
+var (
+	ErrEncryptorConfigNotLoaded   = errors.New("encryptor config wasn't loaded")
+	ErrEncryptorConfigNewFeatures = errors.New("encryptor config uses features which aren't enabled for existing connections, restart is required")
+)
+
+// connectionFeaturesMask settings which enable extra processors for new connections
+const connectionFeaturesMask = encryptorConfig.SettingMaskingFlag | encryptorConfig.SettingTokenizationFlag |
+	encryptorConfig.SettingSearchFlag | encryptorConfig.SettingDataTypeFlag | encryptorConfig.SettingAccessPolicyFlag
+
+func readMapTableSchemaConfig(path string) (*encryptorConfig.MapTableSchemaStore, error) {
 	mapConfig, err := ioutil.ReadFile(path)
 	if err != nil {
 		log.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorWrongConfiguration).WithError(err).Errorln("Can't read config for encryptor")
-		return err
+		return nil, err
 	}
 	schema, err := encryptorConfig.MapTableSchemaStoreFromConfig(mapConfig)
 	if err != nil {
 		log.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorWrongConfiguration).WithError(err).Errorln("Can't parse table schemas from config")
+		return nil, err
+	}
+	return schema, nil
+}
+
+// LoadMapTableSchemaConfig load table schemas from config file
+func (config *Config) LoadMapTableSchemaConfig(path string) error {
+	schema, err := readMapTableSchemaConfig(path)
+	if err != nil {
 		return err
 	}
-	config.tableSchema = schema
+	config.tableSchema.Store(schema)
+	config.connectionFeatures = schema.GetGlobalSettingsMask() & connectionFeaturesMask
+	config.encryptorConfigPath = path
+	return nil
+}
+
+// ReloadMapTableSchemaConfig re-reads table schemas from the config file loaded by LoadMapTableSchemaConfig and replaces
+// table schema used by new queries. Current table schema stays unchanged if new config is invalid or enables
+// masking/tokenization/searchable encryption/type awareness/access policies which weren't used by all previous configs,
+// because their processors are registered only on connection start
+func (config *Config) ReloadMapTableSchemaConfig() error {
+	config.reloadLock.Lock()
+	defer config.reloadLock.Unlock()
+	logger := log.WithField("path", config.encryptorConfigPath)
+	if config.encryptorConfigPath == "" {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptorConfigReload).
+			Errorln("Can't reload encryptor config because it wasn't loaded on start")
+		return ErrEncryptorConfigNotLoaded
+	}
+	schema, err := readMapTableSchemaConfig(config.encryptorConfigPath)
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptorConfigReload).WithError(err).
+			Errorln("Can't reload encryptor config, continue to use previous one")
+		return err
+	}
+	newFeatures := schema.GetGlobalSettingsMask() & connectionFeaturesMask
+	if newFeatures&^config.connectionFeatures != 0 {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptorConfigReload).
+			Errorln("Reloaded encryptor config uses new features (masking/tokenization/searchable encryption/type awareness/access policies), " +
+				"restart AcraServer to apply it, continue to use previous one")
+		return ErrEncryptorConfigNewFeatures
+	}
+	config.tableSchema.Store(schema)
+	// connections started with this config don't have processors of disabled features, so they can't be enabled again
+	// until restart
+	config.connectionFeatures = newFeatures
+	logger.Infoln("Encryptor config reloaded")
 	return nil
 }
 
@@ -110,6 +169,7 @@ func (config *Config) GetTableSchema() encryptorConfig.TableSchemaStore {
 func (config *Config) SetCensor(censorConfigPath string) error {
 	censor := acracensor.NewAcraCensor()
 	config.censor = censor
+	censor.SetTableSchemaStore(config.tableSchema)
 	//skip if flag not specified
 	if censorConfigPath == "" {
 		return nil
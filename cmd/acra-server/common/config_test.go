@@ -0,0 +1,175 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestReloadMapTableSchemaConfig(t *testing.T) {
+	config, err := NewConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.ReloadMapTableSchemaConfig(); err != ErrEncryptorConfigNotLoaded {
+		t.Fatalf("Expect ErrEncryptorConfigNotLoaded, took %v\n", err)
+	}
+	configFile, err := ioutil.TempFile("", "encryptor_config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(configFile.Name())
+	writeConfig := func(data string) {
+		if err := ioutil.WriteFile(configFile.Name(), []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeConfig(`
+schemas:
+  - table: table1
+    columns:
+      - data
+    encrypted:
+      - column: data
+`)
+	if err := config.LoadMapTableSchemaConfig(configFile.Name()); err != nil {
+		t.Fatal(err)
+	}
+	if config.GetTableSchema().GetTableSchema("table1") == nil {
+		t.Fatal("Expect loaded table schema")
+	}
+
+	writeConfig(`
+schemas:
+  - table: table2
+    columns:
+      - data
+    encrypted:
+      - column: data
+`)
+	if err := config.ReloadMapTableSchemaConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if config.GetTableSchema().GetTableSchema("table1") != nil || config.GetTableSchema().GetTableSchema("table2") == nil {
+		t.Fatal("Expect table schema from reloaded config")
+	}
+
+	// invalid config shouldn't replace current one
+	writeConfig(`
+schemas:
+  - table: table3
+    columns:
+      - data
+    encrypted:
+      - column: data
+        tokenized: true
+        token_type: invalid
+`)
+	if err := config.ReloadMapTableSchemaConfig(); err == nil {
+		t.Fatal("Expect error on invalid config")
+	}
+	if config.GetTableSchema().GetTableSchema("table2") == nil || config.GetTableSchema().GetTableSchema("table3") != nil {
+		t.Fatal("Expect previous table schema after failed reload")
+	}
+
+	// features without processors on existing connections can't be enabled by reload
+	for i, newConfig := range []string{`
+schemas:
+  - table: table3
+    columns:
+      - data
+    encrypted:
+      - column: data
+        tokenized: true
+        token_type: str
+`, `
+schemas:
+  - table: table3
+    columns:
+      - data
+    encrypted:
+      - column: data
+        searchable: true
+`, `
+schemas:
+  - table: table3
+    columns:
+      - data
+    encrypted:
+      - column: data
+        masking: "xxxx"
+        plaintext_length: 2
+        plaintext_side: "right"
+`, `
+schemas:
+  - table: table3
+    columns:
+      - data
+    encrypted:
+      - column: data
+access_policies:
+  - client_id: client
+    columns:
+      - table: table3
+        column: data
+        action: deny
+`} {
+		writeConfig(newConfig)
+		if err := config.ReloadMapTableSchemaConfig(); err != ErrEncryptorConfigNewFeatures {
+			t.Fatalf("[%d] Expect ErrEncryptorConfigNewFeatures, took %v\n", i, err)
+		}
+		if config.GetTableSchema().GetTableSchema("table2") == nil || config.GetTableSchema().GetTableSchema("table3") != nil {
+			t.Fatalf("[%d] Expect previous table schema after rejected reload", i)
+		}
+	}
+}
+
+func TestReloadMapTableSchemaConfigDisabledFeatures(t *testing.T) {
+	config, err := NewConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	configFile, err := ioutil.TempFile("", "encryptor_config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(configFile.Name())
+	writeConfig := func(data string) {
+		if err := ioutil.WriteFile(configFile.Name(), []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	searchableConfig := `
+schemas:
+  - table: table1
+    columns:
+      - data
+    encrypted:
+      - column: data
+        searchable: true
+`
+	writeConfig(searchableConfig)
+	if err := config.LoadMapTableSchemaConfig(configFile.Name()); err != nil {
+		t.Fatal(err)
+	}
+	// the same features may be reloaded and disabled
+	if err := config.ReloadMapTableSchemaConfig(); err != nil {
+		t.Fatal(err)
+	}
+	writeConfig(`
+schemas:
+  - table: table1
+    columns:
+      - data
+    encrypted:
+      - column: data
+`)
+	if err := config.ReloadMapTableSchemaConfig(); err != nil {
+		t.Fatal(err)
+	}
+	// connections started after previous reload don't process searchable columns
+	writeConfig(searchableConfig)
+	if err := config.ReloadMapTableSchemaConfig(); err != ErrEncryptorConfigNewFeatures {
+		t.Fatalf("Expect ErrEncryptorConfigNewFeatures, took %v\n", err)
+	}
+}
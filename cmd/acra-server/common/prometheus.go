@@ -19,6 +19,7 @@ package common
 import (
 	"sync"
 
+	censorCommon "github.com/cossacklabs/acra/acra-censor/common"
 	"github.com/cossacklabs/acra/cmd"
 	"github.com/cossacklabs/acra/decryptor/base"
 	"github.com/cossacklabs/acra/utils"
@@ -54,6 +55,7 @@ func RegisterMetrics(serviceName string, version *utils.Version, edition utils.P
 		prometheus.MustRegister(connectionProcessingTimeHistogram)
 		base.RegisterAcraStructProcessingMetrics()
 		base.RegisterDbProcessingMetrics()
+		censorCommon.RegisterCensorMetrics()
 		cmd.RegisterVersionMetrics(serviceName, version)
 		cmd.RegisterBuildInfoMetrics(serviceName, edition)
 	})
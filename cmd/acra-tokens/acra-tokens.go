@@ -27,6 +27,10 @@ func main() {
 		&tokens.DisableSubcommand{},
 		&tokens.EnableSubcommand{},
 		&tokens.RemoveSubcommand{},
+		&tokens.ExportSubcommand{},
+		&tokens.ImportSubcommand{},
+		&tokens.MigrateSubcommand{},
+		&tokens.RekeySubcommand{},
 	}
 	subcommand := tokens.ParseParameters(subcommands)
 	if subcommand != nil {
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+
+	"github.com/cossacklabs/acra/keystore"
+	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
+	tokenStorage "github.com/cossacklabs/acra/pseudonymization/storage"
+	"github.com/cossacklabs/themis/gothemis/keys"
+	log "github.com/sirupsen/logrus"
+)
+
+// ArchiveKeyVarName is the name of environment variable with base64-encoded key used to encrypt token archives.
+const ArchiveKeyVarName = "ACRA_TOKENS_ARCHIVE_KEY"
+
+// ArchiveSigningKeyVarName is the name of environment variable with base64-encoded Themis EC private key used to sign
+// token archives.
+const ArchiveSigningKeyVarName = "ACRA_TOKENS_ARCHIVE_SIGNING_KEY"
+
+// ArchiveVerificationKeyVarName is the name of environment variable with base64-encoded Themis EC public key used to
+// verify signature of token archives.
+const ArchiveVerificationKeyVarName = "ACRA_TOKENS_ARCHIVE_VERIFICATION_KEY"
+
+// archive files may contain sensitive data, so they are readable only by the owner
+const archiveFileMode = os.FileMode(0600)
+
+// loadArchiveKey loads key of token archives from environment.
+func loadArchiveKey() []byte {
+	key, err := keystore.GetMasterKeyFromEnvironmentVariable(ArchiveKeyVarName)
+	if err != nil {
+		log.WithError(err).Fatalf("Cannot load token archive key from %s", ArchiveKeyVarName)
+	}
+	return key
+}
+
+// loadBase64Key loads base64-encoded key from environment variable.
+func loadBase64Key(varname string) []byte {
+	b64value := os.Getenv(varname)
+	if len(b64value) == 0 {
+		log.Fatalf("%s environment variable is not set", varname)
+	}
+	key, err := base64.StdEncoding.DecodeString(b64value)
+	if err != nil {
+		log.WithError(err).Fatalf("Cannot decode %s", varname)
+	}
+	return key
+}
+
+// loadArchiveSigningKey loads private key which signs token archives from environment.
+func loadArchiveSigningKey() *keys.PrivateKey {
+	return &keys.PrivateKey{Value: loadBase64Key(ArchiveSigningKeyVarName)}
+}
+
+// loadArchiveVerificationKey loads public key which verifies token archives from environment.
+func loadArchiveVerificationKey() *keys.PublicKey {
+	return &keys.PublicKey{Value: loadBase64Key(ArchiveVerificationKeyVarName)}
+}
+
+// openEntryStorage opens token storage which supports export and import of entries.
+func openEntryStorage(parameters *CommonTokenStorageParameters) tokenCommon.TokenEntryStorage {
+	tokens, err := parameters.Open()
+	if err != nil {
+		log.WithError(err).Fatal("Cannot open token storage")
+	}
+	entries, err := tokenStorage.AsTokenEntryStorage(tokens)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot use token storage")
+	}
+	return entries
+}
+
+// logSummary outputs count and checksum of entries.
+func logSummary(message string, summary tokenStorage.TokenEntrySummary) {
+	log.WithField("count", summary.Count).WithField("checksum", hex.EncodeToString(summary.Checksum[:])).Info(message)
+}
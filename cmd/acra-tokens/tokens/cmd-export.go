@@ -0,0 +1,263 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cossacklabs/acra/cmd"
+	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
+	tokenStorage "github.com/cossacklabs/acra/pseudonymization/storage"
+	"github.com/cossacklabs/themis/gothemis/keys"
+	log "github.com/sirupsen/logrus"
+)
+
+// ExportSubcommand is the "acra-tokens export" subcommand.
+type ExportSubcommand struct {
+	flagSet *flag.FlagSet
+	storage CommonTokenStorageParameters
+
+	output string
+	resume bool
+	dryRun bool
+}
+
+// CmdTokenExport is the name of "acra-tokens export" subcommand.
+const CmdTokenExport = "export"
+
+// Errors returned by "acra-tokens export" subcommand.
+var (
+	ErrExportOutputMissing = errors.New("output archive not specified")
+)
+
+// Name returns the same of this subcommand.
+func (s *ExportSubcommand) Name() string {
+	return CmdTokenExport
+}
+
+// FlagSet returns flag set of this subcommand.
+func (s *ExportSubcommand) FlagSet() *flag.FlagSet {
+	return s.flagSet
+}
+
+// RegisterFlags registers command-line flags of this subcommand.
+func (s *ExportSubcommand) RegisterFlags() {
+	s.flagSet = flag.NewFlagSet(CmdTokenExport, flag.ContinueOnError)
+	s.storage.Register(s.flagSet)
+	s.flagSet.StringVar(&s.output, "output", "", "path to archive file where tokens are exported")
+	s.flagSet.BoolVar(&s.resume, "resume", false, "continue interrupted export into existing incomplete archive")
+	s.flagSet.BoolVar(&s.dryRun, "dry_run", false, "do not actually write the archive, only output count and checksum of tokens")
+	s.flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Command \"%s\": export tokens into encrypted archive\n", CmdTokenExport)
+		fmt.Fprintf(os.Stderr, "\n\t%s %s --output=<archive> [options...]\n", os.Args[0], CmdTokenExport)
+		fmt.Fprintf(os.Stderr, "\nArchive is encrypted with base64-encoded key from %s environment variable.\n", ArchiveKeyVarName)
+		fmt.Fprintf(os.Stderr, "Archive is signed with base64-encoded Themis EC private key from %s and verified with\n", ArchiveSigningKeyVarName)
+		fmt.Fprintf(os.Stderr, "the public key from %s environment variables.\n", ArchiveVerificationKeyVarName)
+		fmt.Fprintf(os.Stderr, "\nOptions:\n")
+		cmd.PrintFlags(s.flagSet)
+	}
+}
+
+// Parse command-line parameters of the subcommand.
+func (s *ExportSubcommand) Parse(arguments []string) error {
+	err := cmd.ParseFlagsWithConfig(s.flagSet, arguments, DefaultConfigPath, ServiceName)
+	if err != nil {
+		return err
+	}
+	err = s.storage.Validate()
+	if err != nil {
+		return err
+	}
+	if s.output == "" && !s.dryRun {
+		log.Warning("--output is required")
+		return ErrExportOutputMissing
+	}
+	return nil
+}
+
+// Execute this subcommand.
+func (s *ExportSubcommand) Execute() {
+	var key []byte
+	var signingKey *keys.PrivateKey
+	var verificationKey *keys.PublicKey
+	if !s.dryRun {
+		key = loadArchiveKey()
+		signingKey = loadArchiveSigningKey()
+		verificationKey = loadArchiveVerificationKey()
+	}
+	tokens := openEntryStorage(&s.storage)
+
+	if s.dryRun {
+		var summary tokenStorage.TokenEntrySummary
+		visited := make(map[string]struct{})
+		err := tokens.VisitEntries(func(entry tokenCommon.TokenEntry) error {
+			entryKey := tokenStorage.TokenEntryKey(entry)
+			if _, ok := visited[entryKey]; !ok {
+				visited[entryKey] = struct{}{}
+				summary.Add(entry)
+			}
+			return nil
+		})
+		if err != nil {
+			log.WithError(err).Fatal("Failed to scan token storage")
+		}
+		logSummary("Tokens to export", summary)
+		log.Infof("Now run without --dry_run to actually export the tokens")
+		return
+	}
+
+	file, buffer, writer, exported := s.openArchive(key, signingKey, verificationKey)
+	var source tokenStorage.TokenEntrySummary
+	visited := make(map[string]struct{})
+	written := 0
+	err := tokens.VisitEntries(func(entry tokenCommon.TokenEntry) error {
+		entryKey := tokenStorage.TokenEntryKey(entry)
+		if _, ok := visited[entryKey]; ok {
+			return nil
+		}
+		visited[entryKey] = struct{}{}
+		source.Add(entry)
+		if _, ok := exported[entryKey]; ok {
+			return nil
+		}
+		written++
+		return writer.WriteEntry(entry)
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Failed to export tokens")
+	}
+	if err := writer.Close(); err != nil {
+		log.WithError(err).Fatal("Failed to finish token archive")
+	}
+	if err := buffer.Flush(); err != nil {
+		log.WithError(err).Fatal("Failed to write token archive")
+	}
+	if err := file.Sync(); err != nil {
+		log.WithError(err).Fatal("Failed to write token archive")
+	}
+	if err := file.Close(); err != nil {
+		log.WithError(err).Fatal("Failed to write token archive")
+	}
+	log.Infof("Exported %d tokens (%d exported before resume)", written, len(exported))
+
+	// read the archive back to verify it is complete and contains the same entries as the storage
+	archive, err := s.readArchive(key, verificationKey)
+	if err != nil {
+		log.WithError(err).Fatal("Failed to verify token archive")
+	}
+	logSummary("Tokens in storage", source)
+	logSummary("Tokens in archive", archive)
+	if !archive.Equal(source) {
+		log.Fatal("Token archive doesn't match token storage, tokens were probably modified during export")
+	}
+	log.Infof("Token archive verified")
+}
+
+// openArchive creates new archive or opens incomplete archive to continue export. It returns keys of entries exported
+// before resume.
+func (s *ExportSubcommand) openArchive(key []byte, signingKey *keys.PrivateKey, verificationKey *keys.PublicKey) (*os.File, *bufio.Writer, *tokenStorage.TokenArchiveWriter, map[string]struct{}) {
+	exported := make(map[string]struct{})
+	if !s.resume {
+		file, err := os.OpenFile(s.output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, archiveFileMode)
+		if err != nil {
+			log.WithError(err).Fatal("Cannot create token archive, use --resume to continue interrupted export")
+		}
+		buffer := bufio.NewWriter(file)
+		writer, err := tokenStorage.NewTokenArchiveWriter(buffer, key, signingKey)
+		if err != nil {
+			log.WithError(err).Fatal("Cannot write token archive")
+		}
+		return file, buffer, writer, exported
+	}
+	file, err := os.OpenFile(s.output, os.O_RDWR|os.O_CREATE, archiveFileMode)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot open token archive")
+	}
+	reader, err := tokenStorage.NewTokenArchiveReader(bufio.NewReader(file), key, verificationKey)
+	if err == tokenStorage.ErrTokenArchiveTruncated {
+		// interrupted before the header was written, start from scratch
+		if err := file.Truncate(0); err != nil {
+			log.WithError(err).Fatal("Cannot truncate token archive")
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			log.WithError(err).Fatal("Cannot truncate token archive")
+		}
+		buffer := bufio.NewWriter(file)
+		writer, err := tokenStorage.NewTokenArchiveWriter(buffer, key, signingKey)
+		if err != nil {
+			log.WithError(err).Fatal("Cannot write token archive")
+		}
+		return file, buffer, writer, exported
+	}
+	if err != nil {
+		log.WithError(err).Fatal("Cannot read token archive")
+	}
+	for {
+		entry, err := reader.Next()
+		if err == tokenStorage.ErrTokenArchiveTruncated {
+			break
+		}
+		if err == io.EOF {
+			log.Fatal("Token archive is already complete")
+		}
+		if err != nil {
+			log.WithError(err).Fatal("Cannot read token archive")
+		}
+		exported[tokenStorage.TokenEntryKey(entry)] = struct{}{}
+	}
+	// drop partially written record and continue after the last complete one
+	if err := file.Truncate(reader.Offset()); err != nil {
+		log.WithError(err).Fatal("Cannot truncate token archive")
+	}
+	if _, err := file.Seek(reader.Offset(), io.SeekStart); err != nil {
+		log.WithError(err).Fatal("Cannot truncate token archive")
+	}
+	buffer := bufio.NewWriter(file)
+	writer, err := tokenStorage.ResumeTokenArchiveWriter(buffer, key, signingKey, reader)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot resume token archive")
+	}
+	log.Infof("Resuming export after %d tokens", len(exported))
+	return file, buffer, writer, exported
+}
+
+// readArchive reads all entries of the archive and return their summary.
+func (s *ExportSubcommand) readArchive(key []byte, verificationKey *keys.PublicKey) (tokenStorage.TokenEntrySummary, error) {
+	file, err := os.Open(s.output)
+	if err != nil {
+		return tokenStorage.TokenEntrySummary{}, err
+	}
+	defer file.Close()
+	reader, err := tokenStorage.NewTokenArchiveReader(bufio.NewReader(file), key, verificationKey)
+	if err != nil {
+		return tokenStorage.TokenEntrySummary{}, err
+	}
+	for {
+		_, err := reader.Next()
+		if err == io.EOF {
+			return reader.Summary(), nil
+		}
+		if err != nil {
+			return tokenStorage.TokenEntrySummary{}, err
+		}
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cossacklabs/acra/cmd"
+	tokenStorage "github.com/cossacklabs/acra/pseudonymization/storage"
+	log "github.com/sirupsen/logrus"
+)
+
+// ImportSubcommand is the "acra-tokens import" subcommand.
+type ImportSubcommand struct {
+	flagSet *flag.FlagSet
+	storage CommonTokenStorageParameters
+
+	input  string
+	resume bool
+	dryRun bool
+}
+
+// CmdTokenImport is the name of "acra-tokens import" subcommand.
+const CmdTokenImport = "import"
+
+// Errors returned by "acra-tokens import" subcommand.
+var (
+	ErrImportInputMissing = errors.New("input archive not specified")
+)
+
+// Name returns the same of this subcommand.
+func (s *ImportSubcommand) Name() string {
+	return CmdTokenImport
+}
+
+// FlagSet returns flag set of this subcommand.
+func (s *ImportSubcommand) FlagSet() *flag.FlagSet {
+	return s.flagSet
+}
+
+// RegisterFlags registers command-line flags of this subcommand.
+func (s *ImportSubcommand) RegisterFlags() {
+	s.flagSet = flag.NewFlagSet(CmdTokenImport, flag.ContinueOnError)
+	s.storage.Register(s.flagSet)
+	s.flagSet.StringVar(&s.input, "input", "", "path to archive file with tokens written by \"export\" command")
+	s.flagSet.BoolVar(&s.resume, "resume", false, "continue interrupted import skipping tokens which already exist in the storage")
+	s.flagSet.BoolVar(&s.dryRun, "dry_run", false, "do not actually import tokens, only verify the archive and output count and checksum of tokens")
+	s.flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Command \"%s\": import tokens from encrypted archive\n", CmdTokenImport)
+		fmt.Fprintf(os.Stderr, "\n\t%s %s --input=<archive> [options...]\n", os.Args[0], CmdTokenImport)
+		fmt.Fprintf(os.Stderr, "\nArchive is decrypted with base64-encoded key from %s environment variable.\n", ArchiveKeyVarName)
+		fmt.Fprintf(os.Stderr, "Signature of archive is verified with base64-encoded Themis EC public key from %s\n", ArchiveVerificationKeyVarName)
+		fmt.Fprintf(os.Stderr, "environment variable.\n")
+		fmt.Fprintf(os.Stderr, "\nOptions:\n")
+		cmd.PrintFlags(s.flagSet)
+	}
+}
+
+// Parse command-line parameters of the subcommand.
+func (s *ImportSubcommand) Parse(arguments []string) error {
+	err := cmd.ParseFlagsWithConfig(s.flagSet, arguments, DefaultConfigPath, ServiceName)
+	if err != nil {
+		return err
+	}
+	err = s.storage.Validate()
+	if err != nil {
+		return err
+	}
+	if s.input == "" {
+		log.Warning("--input is required")
+		return ErrImportInputMissing
+	}
+	return nil
+}
+
+// Execute this subcommand.
+func (s *ImportSubcommand) Execute() {
+	key := loadArchiveKey()
+	verificationKey := loadArchiveVerificationKey()
+	file, err := os.Open(s.input)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot open token archive")
+	}
+	defer file.Close()
+	reader, err := tokenStorage.NewTokenArchiveReader(bufio.NewReader(file), key, verificationKey)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot read token archive")
+	}
+	tokens := openEntryStorage(&s.storage)
+
+	// Entries are saved while the archive is read, each of them is authenticated, and the whole archive is verified
+	// with the signed trailer at the end. Interrupted or failed import may be continued with --resume.
+	copier := tokenStorage.NewTokenEntryCopier(tokens, s.resume, s.dryRun)
+	for {
+		entry, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.WithError(err).Fatalf("Failed to read token archive, imported %d tokens", copier.Saved())
+		}
+		if err := copier.Save(entry); err != nil {
+			log.WithError(err).Fatalf("Failed to import tokens, imported %d tokens", copier.Saved())
+		}
+	}
+	logSummary("Tokens in archive", reader.Summary())
+	if s.dryRun {
+		log.Infof("Now run without --dry_run to actually import the tokens")
+		return
+	}
+	log.Infof("Imported %d tokens, skipped %d existing tokens", copier.Saved(), copier.Skipped())
+	if err := copier.Verify(); err != nil {
+		log.WithError(err).Fatal("Imported tokens don't match token archive")
+	}
+	log.Infof("Imported tokens verified")
+}
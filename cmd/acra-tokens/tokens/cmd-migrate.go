@@ -0,0 +1,99 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/cossacklabs/acra/cmd"
+	tokenStorage "github.com/cossacklabs/acra/pseudonymization/storage"
+	log "github.com/sirupsen/logrus"
+)
+
+// MigrateSubcommand is the "acra-tokens migrate" subcommand.
+type MigrateSubcommand struct {
+	flagSet *flag.FlagSet
+	from    CommonTokenStorageParameters
+	to      CommonTokenStorageParameters
+
+	resume bool
+	dryRun bool
+}
+
+// CmdTokenMigrate is the name of "acra-tokens migrate" subcommand.
+const CmdTokenMigrate = "migrate"
+
+// Name returns the same of this subcommand.
+func (s *MigrateSubcommand) Name() string {
+	return CmdTokenMigrate
+}
+
+// FlagSet returns flag set of this subcommand.
+func (s *MigrateSubcommand) FlagSet() *flag.FlagSet {
+	return s.flagSet
+}
+
+// RegisterFlags registers command-line flags of this subcommand.
+func (s *MigrateSubcommand) RegisterFlags() {
+	s.flagSet = flag.NewFlagSet(CmdTokenMigrate, flag.ContinueOnError)
+	s.from.RegisterWithPrefix(s.flagSet, "from_", "source")
+	s.to.RegisterWithPrefix(s.flagSet, "to_", "destination")
+	s.flagSet.BoolVar(&s.resume, "resume", false, "continue interrupted migration skipping tokens which already exist in the destination")
+	s.flagSet.BoolVar(&s.dryRun, "dry_run", false, "do not actually copy tokens, only output count and checksum of tokens")
+	s.flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Command \"%s\": copy tokens from one storage to another\n", CmdTokenMigrate)
+		fmt.Fprintf(os.Stderr, "\n\t%s %s --from_<storage options> --to_<storage options> [options...]\n", os.Args[0], CmdTokenMigrate)
+		fmt.Fprintf(os.Stderr, "\nOptions:\n")
+		cmd.PrintFlags(s.flagSet)
+	}
+}
+
+// Parse command-line parameters of the subcommand.
+func (s *MigrateSubcommand) Parse(arguments []string) error {
+	err := cmd.ParseFlagsWithConfig(s.flagSet, arguments, DefaultConfigPath, ServiceName)
+	if err != nil {
+		return err
+	}
+	err = s.from.Validate()
+	if err != nil {
+		return err
+	}
+	return s.to.Validate()
+}
+
+// Execute this subcommand.
+func (s *MigrateSubcommand) Execute() {
+	source := openEntryStorage(&s.from)
+	destination := openEntryStorage(&s.to)
+
+	copier := tokenStorage.NewTokenEntryCopier(destination, s.resume, s.dryRun)
+	if err := source.VisitEntries(copier.Save); err != nil {
+		log.WithError(err).Fatalf("Failed to copy tokens, copied %d tokens", copier.Saved())
+	}
+	logSummary("Tokens in source storage", copier.Summary())
+	if s.dryRun {
+		log.Infof("Now run without --dry_run to actually copy the tokens")
+		return
+	}
+	log.Infof("Copied %d tokens, skipped %d existing tokens", copier.Saved(), copier.Skipped())
+	if err := copier.Verify(); err != nil {
+		log.WithError(err).Fatal("Copied tokens don't match source storage")
+	}
+	log.Infof("Copied tokens verified")
+}
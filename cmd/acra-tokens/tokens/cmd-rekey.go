@@ -0,0 +1,169 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tokens
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cossacklabs/acra/cmd"
+	"github.com/cossacklabs/acra/cmd/acra-keys/keys"
+	"github.com/cossacklabs/acra/keystore"
+	keystoreV2 "github.com/cossacklabs/acra/keystore/v2/keystore"
+	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
+	tokenStorage "github.com/cossacklabs/acra/pseudonymization/storage"
+	log "github.com/sirupsen/logrus"
+)
+
+// RekeySubcommand is the "acra-tokens rekey" subcommand.
+type RekeySubcommand struct {
+	flagSet  *flag.FlagSet
+	storage  CommonTokenStorageParameters
+	keyStore keys.CommonKeyStoreParameters
+
+	clientIDs string
+	zoneIDs   string
+	dryRun    bool
+}
+
+// CmdTokenRekey is the name of "acra-tokens rekey" subcommand.
+const CmdTokenRekey = "rekey"
+
+// Name returns the same of this subcommand.
+func (s *RekeySubcommand) Name() string {
+	return CmdTokenRekey
+}
+
+// FlagSet returns flag set of this subcommand.
+func (s *RekeySubcommand) FlagSet() *flag.FlagSet {
+	return s.flagSet
+}
+
+// RegisterFlags registers command-line flags of this subcommand.
+func (s *RekeySubcommand) RegisterFlags() {
+	s.flagSet = flag.NewFlagSet(CmdTokenRekey, flag.ContinueOnError)
+	s.storage.Register(s.flagSet)
+	s.keyStore.RegisterPrefixed(s.flagSet, keys.DefaultKeyDirectory, "", "")
+	// token storage may use Redis too, so Redis keystore parameters are prefixed
+	s.keyStore.RegisterRedisWithPrefix(s.flagSet, "keystore_", "keystore")
+	s.keyStore.RegisterVaultWithPrefix(s.flagSet, "", "")
+	s.flagSet.StringVar(&s.clientIDs, "client_id", "", "comma-separated list of ClientIDs whose tokens are re-encrypted, in addition to ClientIDs listed by keystore v2")
+	s.flagSet.StringVar(&s.zoneIDs, "zone_id", "", "comma-separated list of ZoneIDs whose tokens are re-encrypted, in addition to ZoneIDs listed by keystore v2")
+	s.flagSet.BoolVar(&s.dryRun, "dry_run", false, "do not actually re-encrypt tokens, only output status")
+	s.flagSet.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Command \"%s\": re-encrypt tokens with the current symmetric keys of ClientID/ZoneID\n", CmdTokenRekey)
+		fmt.Fprintf(os.Stderr, "\n\t%s %s [options...]\n", os.Args[0], CmdTokenRekey)
+		fmt.Fprintf(os.Stderr, "\nOptions:\n")
+		cmd.PrintFlags(s.flagSet)
+	}
+}
+
+// Parse command-line parameters of the subcommand.
+func (s *RekeySubcommand) Parse(arguments []string) error {
+	err := cmd.ParseFlagsWithConfig(s.flagSet, arguments, DefaultConfigPath, ServiceName)
+	if err != nil {
+		return err
+	}
+	return s.storage.Validate()
+}
+
+// splitIDs return non-empty IDs from comma-separated list.
+func splitIDs(value string) [][]byte {
+	var ids [][]byte
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, []byte(id))
+		}
+	}
+	return ids
+}
+
+// tokenContexts return contexts of ClientIDs/ZoneIDs from command line and symmetric keys listed by the keystore.
+func (s *RekeySubcommand) tokenContexts(keyStore keystore.ServerKeyStore) []tokenCommon.TokenContext {
+	var contexts []tokenCommon.TokenContext
+	for _, id := range splitIDs(s.clientIDs) {
+		contexts = append(contexts, tokenCommon.TokenContext{ClientID: id})
+	}
+	for _, id := range splitIDs(s.zoneIDs) {
+		contexts = append(contexts, tokenCommon.TokenContext{ZoneID: id})
+	}
+	descriptions, err := keyStore.ListKeys()
+	if err == keystore.ErrNotImplemented {
+		return contexts
+	}
+	if err != nil {
+		log.WithError(err).Fatal("Cannot list keys")
+	}
+	for _, description := range descriptions {
+		switch description.Purpose {
+		case keystoreV2.PurposeStorageClientSym:
+			contexts = append(contexts, tokenCommon.TokenContext{ClientID: description.ClientID})
+		case keystoreV2.PurposeStorageZoneSym:
+			contexts = append(contexts, tokenCommon.TokenContext{ZoneID: description.ZoneID})
+		}
+	}
+	return contexts
+}
+
+// Execute this subcommand.
+func (s *RekeySubcommand) Execute() {
+	keyStore, err := keys.OpenKeyStoreForReading(&s.keyStore)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot open keystore")
+	}
+	contexts := s.tokenContexts(keyStore)
+	if len(contexts) == 0 {
+		log.Fatal("No ClientIDs or ZoneIDs to re-encrypt tokens, use --client_id or --zone_id")
+	}
+	tokens := openEntryStorage(&s.storage)
+	encryptor, err := tokenStorage.NewSCellEncryptor(keyStore)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot initialize token encryptor")
+	}
+	rekeyer, err := tokenStorage.NewTokenRekeyer(tokens, encryptor, contexts, s.dryRun)
+	if err != nil {
+		log.WithError(err).Fatal("Cannot initialize token rekeyer")
+	}
+	stats, err := rekeyer.Rekey(func(stats tokenStorage.TokenRekeyStats) {
+		log.Infof("Processed %d tokens, re-encrypted %d tokens", stats.Total, stats.Rekeyed)
+	})
+	if err != nil {
+		log.WithError(err).Fatalf("Failed to re-encrypt tokens, re-encrypted %d tokens", stats.Rekeyed)
+	}
+	log.Infof("Re-encrypted %d tokens (out of %d in total)", stats.Rekeyed, stats.Total)
+	log.Infof("%d tokens are already encrypted with the current keys, %d tokens were removed concurrently", stats.Current, stats.Removed)
+	if stats.Unknown > 0 {
+		log.Warnf("%d tokens belong to other ClientIDs/ZoneIDs and were skipped", stats.Unknown)
+	}
+	if stats.Failed > 0 {
+		log.Errorf("%d tokens can't be decrypted with any key of their ClientID/ZoneID", stats.Failed)
+	}
+	if stats.Changed > 0 {
+		log.Errorf("%d tokens were changed concurrently and may still be encrypted with previous keys, run rekey again", stats.Changed)
+	}
+	if s.dryRun {
+		log.Infof("Now run without --dry_run to actually re-encrypt the tokens")
+		return
+	}
+	if stats.Failed > 0 || stats.Changed > 0 {
+		log.Errorf("Don't destroy previous keys of ClientIDs/ZoneIDs until all tokens are re-encrypted")
+		os.Exit(1)
+	}
+	log.Infof("All tokens of ClientIDs/ZoneIDs are encrypted with the current keys, previous keys may be destroyed")
+}
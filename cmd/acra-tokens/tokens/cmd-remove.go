@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/cossacklabs/acra/cmd"
 	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
@@ -36,6 +37,7 @@ type RemoveSubcommand struct {
 	dryRun         bool
 	removeAll      bool
 	removeDisabled bool
+	removeExpired  bool
 }
 
 // CmdTokenRemove is the name of "acra-tokens remove" subcommand.
@@ -64,6 +66,7 @@ func (s *RemoveSubcommand) RegisterFlags() {
 	s.flagSet.BoolVar(&s.dryRun, "dry_run", false, "do not actually remove tokens, only output status")
 	s.flagSet.BoolVar(&s.removeAll, "all", false, "remove all requested tokens within specified date range, regardless of their state (enabled and disabled)")
 	s.flagSet.BoolVar(&s.removeAll, "only_disabled", false, "remove only disabled tokens within specified date range")
+	s.flagSet.BoolVar(&s.removeExpired, "only_expired", false, "remove only tokens expired according to their token_ttl/idle_ttl within specified date range")
 	s.flagSet.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Command \"%s\": remove tokens from the storage\n", CmdTokenRemove)
 		fmt.Fprintf(os.Stderr, "\n\t%s %s [options...]\n", os.Args[0], CmdTokenRemove)
@@ -86,8 +89,8 @@ func (s *RemoveSubcommand) Parse(arguments []string) error {
 	if err != nil {
 		return err
 	}
-	if !(s.removeAll || s.removeDisabled) {
-		log.Warning("Either --all or --only_disabled must be specified")
+	if !(s.removeAll || s.removeDisabled || s.removeExpired) {
+		log.Warning("Either --all, --only_disabled or --only_expired must be specified")
 		return ErrRemoveNotSpecified
 	}
 	return nil
@@ -102,6 +105,7 @@ func (s *RemoveSubcommand) Execute() {
 	totalCount := 0
 	removedCount := 0
 	removedBytes := 0
+	now := time.Now().UTC()
 	err = tokens.VisitMetadata(func(dataLength int, metadata tokenCommon.TokenMetadata) (tokenCommon.TokenAction, error) {
 		totalCount++
 		if !s.limits.AccessedWithinLimits(metadata.Accessed) {
@@ -110,7 +114,7 @@ func (s *RemoveSubcommand) Execute() {
 		if !s.limits.CreatedWithinLimits(metadata.Created) {
 			return tokenCommon.TokenContinue, nil
 		}
-		if s.removeAll || (s.removeDisabled && metadata.Disabled) {
+		if s.removeAll || (s.removeDisabled && metadata.Disabled) || (s.removeExpired && metadata.Expired(now)) {
 			removedCount++
 			removedBytes += dataLength
 			// If this is a dry run, compute all the stats, but don't issue remove command.
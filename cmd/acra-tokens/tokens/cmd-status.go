@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/cossacklabs/acra/cmd"
 	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
@@ -86,6 +87,9 @@ func (s *StatusSubcommand) Execute() {
 	totalStorageSize := 0
 	disabledTokenCount := 0
 	disabledStorageSize := 0
+	expiredTokenCount := 0
+	expiredStorageSize := 0
+	now := time.Now().UTC()
 	err = tokens.VisitMetadata(func(dataLength int, metadata tokenCommon.TokenMetadata) (tokenCommon.TokenAction, error) {
 		if !s.limits.AccessedWithinLimits(metadata.Accessed) {
 			return tokenCommon.TokenContinue, nil
@@ -99,6 +103,10 @@ func (s *StatusSubcommand) Execute() {
 			disabledTokenCount++
 			disabledStorageSize += dataLength
 		}
+		if metadata.Expired(now) {
+			expiredTokenCount++
+			expiredStorageSize += dataLength
+		}
 		return tokenCommon.TokenContinue, nil
 	})
 	if err != nil {
@@ -108,6 +116,8 @@ func (s *StatusSubcommand) Execute() {
 	fmt.Printf("StorageSize: %d (%s)\n", totalStorageSize, humanReadableSize(totalStorageSize))
 	fmt.Printf("DisabledTokenCount: %d\n", disabledTokenCount)
 	fmt.Printf("DisabledStorageSize: %d (%s)\n", disabledStorageSize, humanReadableSize(disabledStorageSize))
+	fmt.Printf("ExpiredTokenCount: %d\n", expiredTokenCount)
+	fmt.Printf("ExpiredStorageSize: %d (%s)\n", expiredStorageSize, humanReadableSize(expiredStorageSize))
 }
 
 func humanReadableSize(bytes int) string {
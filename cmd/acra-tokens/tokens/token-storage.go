@@ -30,8 +30,11 @@ import (
 
 // CommonTokenStorageParameters is a mix-in of command line parameters for token storage construction.
 type CommonTokenStorageParameters struct {
-	boltDB string
-	redis  cmd.RedisOptions
+	boltDB   string
+	sqlDSN   string
+	sqlTable string
+	redis    cmd.RedisOptions
+	prefix   string
 }
 
 // default open mode with which to initialize BoltDB storage
@@ -44,8 +47,21 @@ var (
 
 // Register registers token storage flags with the given flag set.
 func (p *CommonTokenStorageParameters) Register(flags *flag.FlagSet) {
-	flags.StringVar(&p.boltDB, "token_db", "", "path to BoltDB used for token data")
-	p.redis.RegisterTokenStoreParametersWithPrefix(flags, "", "")
+	p.RegisterWithPrefix(flags, "", "")
+}
+
+// RegisterWithPrefix registers token storage flags with the given flag set using prefix for flag names and
+// description appended to flag usage.
+func (p *CommonTokenStorageParameters) RegisterWithPrefix(flags *flag.FlagSet, prefix, description string) {
+	p.prefix = prefix
+	suffix := ""
+	if description != "" {
+		suffix = " (" + description + ")"
+	}
+	flags.StringVar(&p.boltDB, prefix+"token_db", "", "path to BoltDB used for token data"+suffix)
+	flags.StringVar(&p.sqlDSN, prefix+"token_db_dsn", "", "connection string of PostgreSQL (postgresql://...) or MySQL (mysql://...) database used for token data"+suffix)
+	flags.StringVar(&p.sqlTable, prefix+"token_db_table", tokenStorage.DefaultSQLTokenTable, "name of the table with token data in database from --"+prefix+"token_db_dsn"+suffix)
+	p.redis.RegisterTokenStoreParametersWithPrefix(flags, prefix, description)
 }
 
 // BoltDBConfigured returns true if BoltDB is configured.
@@ -53,6 +69,11 @@ func (p *CommonTokenStorageParameters) BoltDBConfigured() bool {
 	return p.boltDB != ""
 }
 
+// SQLConfigured returns true if SQL database is configured.
+func (p *CommonTokenStorageParameters) SQLConfigured() bool {
+	return p.sqlDSN != ""
+}
+
 // RedisConfigured returns true if Redis is configured.
 func (p *CommonTokenStorageParameters) RedisConfigured() bool {
 	return p.redis.TokensConfigured()
@@ -60,12 +81,18 @@ func (p *CommonTokenStorageParameters) RedisConfigured() bool {
 
 // Validate token storage parameter set.
 func (p *CommonTokenStorageParameters) Validate() error {
-	if p.BoltDBConfigured() && p.RedisConfigured() {
-		log.Warn("Both --redis_host_port and --token_db cannot be used simultaneously")
+	configured := 0
+	for _, ok := range []bool{p.BoltDBConfigured(), p.SQLConfigured(), p.RedisConfigured()} {
+		if ok {
+			configured++
+		}
+	}
+	if configured > 1 {
+		log.Warnf("Only one of --%[1]sredis_host_port, --%[1]stoken_db and --%[1]stoken_db_dsn can be used", p.prefix)
 		return ErrInvalidTokenStorage
 	}
-	if !p.BoltDBConfigured() && !p.RedisConfigured() {
-		log.Warn("Either --redis_host_port or --token_db is required")
+	if configured == 0 {
+		log.Warnf("Either --%[1]sredis_host_port, --%[1]stoken_db or --%[1]stoken_db_dsn is required", p.prefix)
 		return ErrInvalidTokenStorage
 	}
 	return nil
@@ -81,6 +108,14 @@ func (p *CommonTokenStorageParameters) Open() (tokenCommon.TokenStorage, error)
 		}
 		return tokenStorage.NewBoltDBTokenStorage(db), nil
 	}
+	if p.SQLConfigured() {
+		storage, err := tokenStorage.OpenSQLStorage(p.sqlDSN, p.sqlTable)
+		if err != nil {
+			log.WithError(err).Warn("Cannot initialize SQL token storage")
+			return nil, err
+		}
+		return storage, nil
+	}
 	if p.RedisConfigured() {
 		redisClient, err := tokenStorage.NewRedisClient(p.redis.HostPort, p.redis.Password, p.redis.DBTokens, nil)
 		if err != nil {
@@ -94,5 +129,5 @@ func (p *CommonTokenStorageParameters) Open() (tokenCommon.TokenStorage, error)
 		}
 		return storage, nil
 	}
-	panic("unreachable: either BoltDB or Redis must be configured")
+	panic("unreachable: either BoltDB, SQL database or Redis must be configured")
 }
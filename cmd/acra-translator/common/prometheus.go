@@ -51,6 +51,9 @@ const (
 
 	tokenizeOperation   = "tokenize"
 	detokenizeOperation = "detokenize"
+
+	maskOperation   = "mask"
+	unmaskOperation = "unmask"
 )
 
 // Valid values of connection type for metrics for Acra-Translator API
@@ -167,6 +170,22 @@ func (wrapper *prometheusWrapper) Detokenize(ctx context.Context, data interface
 	return wrapper.ITranslatorService.Detokenize(ctx, data, dataType, clientID, zoneID)
 }
 
+// TokenizeFormatPreserving tokenize data from request with format-preserving encryption according to TokenType using
+// passed ZoneID if length > 0 otherwise use ClientID (that is required after that)
+func (wrapper *prometheusWrapper) TokenizeFormatPreserving(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, options tokenCommon.FormatPreservingOptions, clientID, zoneID []byte) (interface{}, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(RequestProcessingTimeHistogram.WithLabelValues(wrapper.metricType, tokenizeOperation).Observe))
+	defer timer.ObserveDuration()
+	return wrapper.ITranslatorService.TokenizeFormatPreserving(ctx, data, dataType, options, clientID, zoneID)
+}
+
+// DetokenizeFormatPreserving detokenize data from request with format-preserving encryption according to TokenType
+// using passed ZoneID if length > 0 otherwise use ClientID (that is required after that)
+func (wrapper *prometheusWrapper) DetokenizeFormatPreserving(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, options tokenCommon.FormatPreservingOptions, clientID, zoneID []byte) (interface{}, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(RequestProcessingTimeHistogram.WithLabelValues(wrapper.metricType, detokenizeOperation).Observe))
+	defer timer.ObserveDuration()
+	return wrapper.ITranslatorService.DetokenizeFormatPreserving(ctx, data, dataType, options, clientID, zoneID)
+}
+
 // EncryptSymSearchable encrypts data with AcraBlock using passed ZoneID if length > 0 otherwise use ClientID (that is required after that) and searchable hash
 func (wrapper *prometheusWrapper) EncryptSymSearchable(ctx context.Context, data, clientID, zoneID []byte) (SearchableResponse, error) {
 	timer := prometheus.NewTimer(prometheus.ObserverFunc(RequestProcessingTimeHistogram.WithLabelValues(wrapper.metricType, encryptSymSearchableOperation).Observe))
@@ -194,3 +213,17 @@ func (wrapper *prometheusWrapper) DecryptSym(ctx context.Context, acraBlock, cli
 	defer timer.ObserveDuration()
 	return wrapper.ITranslatorService.DecryptSym(ctx, acraBlock, clientID, zoneID)
 }
+
+// Mask encrypts masked part of data according to params using passed ZoneID if length > 0 otherwise use ClientID (that is required after that)
+func (wrapper *prometheusWrapper) Mask(ctx context.Context, data []byte, params MaskingParams, clientID, zoneID []byte) ([]byte, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(RequestProcessingTimeHistogram.WithLabelValues(wrapper.metricType, maskOperation).Observe))
+	defer timer.ObserveDuration()
+	return wrapper.ITranslatorService.Mask(ctx, data, params, clientID, zoneID)
+}
+
+// Unmask decrypts masked data using passed ZoneID if length > 0 otherwise use ClientID (that is required after that)
+func (wrapper *prometheusWrapper) Unmask(ctx context.Context, data []byte, params MaskingParams, clientID, zoneID []byte) ([]byte, error) {
+	timer := prometheus.NewTimer(prometheus.ObserverFunc(RequestProcessingTimeHistogram.WithLabelValues(wrapper.metricType, unmaskOperation).Observe))
+	defer timer.ObserveDuration()
+	return wrapper.ITranslatorService.Unmask(ctx, data, params, clientID, zoneID)
+}
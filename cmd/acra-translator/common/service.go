@@ -5,8 +5,13 @@ import (
 	"errors"
 	"github.com/cossacklabs/acra/crypto"
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/hmac"
 	"github.com/cossacklabs/acra/logging"
+	"github.com/cossacklabs/acra/masking"
+	maskingCommon "github.com/cossacklabs/acra/masking/common"
+	"github.com/cossacklabs/acra/pseudonymization"
 	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
 	"github.com/sirupsen/logrus"
 )
@@ -20,10 +25,14 @@ type ITranslatorService interface {
 	GenerateQueryHash(context context.Context, data, clientID, zoneID []byte) ([]byte, error)
 	Tokenize(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, clientID, zoneID []byte) (interface{}, error)
 	Detokenize(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, clientID, zoneID []byte) (interface{}, error)
+	TokenizeFormatPreserving(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, options tokenCommon.FormatPreservingOptions, clientID, zoneID []byte) (interface{}, error)
+	DetokenizeFormatPreserving(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, options tokenCommon.FormatPreservingOptions, clientID, zoneID []byte) (interface{}, error)
 	EncryptSymSearchable(ctx context.Context, data, clientID, zoneID []byte) (SearchableResponse, error)
 	DecryptSymSearchable(ctx context.Context, data, hash, clientID, zoneID []byte) ([]byte, error)
 	EncryptSym(ctx context.Context, data, clientID, zoneID []byte) ([]byte, error)
 	DecryptSym(ctx context.Context, acraBlock, clientID, zoneID []byte) ([]byte, error)
+	Mask(ctx context.Context, data []byte, params MaskingParams, clientID, zoneID []byte) ([]byte, error)
+	Unmask(ctx context.Context, data []byte, params MaskingParams, clientID, zoneID []byte) ([]byte, error)
 }
 
 // TranslatorService service that implements all Acra-Translator functions
@@ -280,8 +289,14 @@ func (service *TranslatorService) Detokenize(ctx context.Context, data interface
 		tokenContext = tokenCommon.TokenContext{ZoneID: zoneID}
 	}
 	switch dataType {
-	case tokenCommon.TokenType_Bytes, tokenCommon.TokenType_Email, tokenCommon.TokenType_Int32, tokenCommon.TokenType_Int64, tokenCommon.TokenType_String:
+	case tokenCommon.TokenType_Bytes, tokenCommon.TokenType_Email, tokenCommon.TokenType_Int32, tokenCommon.TokenType_Int64, tokenCommon.TokenType_String,
+		tokenCommon.TokenType_Phone, tokenCommon.TokenType_UUID, tokenCommon.TokenType_Date, tokenCommon.TokenType_Timestamp, tokenCommon.TokenType_CardNumber:
 		sourceData, err := service.data.Tokenizer.Deanonymize(data, tokenContext, dataType)
+		if err == tokenCommon.ErrTokenExpired {
+			logger.WithField("type", dataType).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorTokenExpired).
+				Warningln("Refused to detokenize expired token")
+			return nil, err
+		}
 		if err != nil {
 			logger.WithField("type", dataType).WithError(err).Errorln("Can't tokenize data")
 			return nil, ErrDetokenize
@@ -293,6 +308,62 @@ func (service *TranslatorService) Detokenize(ctx context.Context, data interface
 	}
 }
 
+// TokenizeFormatPreserving tokenize data from request with format-preserving encryption according to TokenType using
+// passed ZoneID if length > 0 otherwise use ClientID (that is required after that)
+func (service *TranslatorService) TokenizeFormatPreserving(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, options tokenCommon.FormatPreservingOptions, clientID, zoneID []byte) (interface{}, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+	logger = logger.WithFields(logrus.Fields{"client_id": string(clientID), "zone_id": string(zoneID), "operation": "TokenizeFormatPreserving"})
+	logger.Debugln("New request")
+	defer logger.Debugln("End processing request")
+	tokenContext := tokenCommon.TokenContext{ClientID: clientID}
+	if len(zoneID) > 0 {
+		tokenContext = tokenCommon.TokenContext{ZoneID: zoneID}
+	}
+	if err := options.Validate(dataType); err != nil {
+		logger.WithField("type", dataType).WithError(err).Errorln("Unsupported format-preserving tokenization options")
+		return nil, err
+	}
+	tokenizer, err := pseudonymization.NewFormatPreservingPseudoanonymizer(service.data.Keystorage, options)
+	if err != nil {
+		logger.WithError(err).Errorln("Can't initialize format-preserving tokenizer")
+		return nil, ErrTokenize
+	}
+	response, err := tokenizer.AnonymizeConsistently(data, tokenContext, dataType)
+	if err != nil {
+		logger.WithError(err).Errorln("Can't tokenize")
+		return nil, ErrTokenize
+	}
+	return response, nil
+}
+
+// DetokenizeFormatPreserving detokenize data from request with format-preserving encryption according to TokenType
+// using passed ZoneID if length > 0 otherwise use ClientID (that is required after that)
+func (service *TranslatorService) DetokenizeFormatPreserving(ctx context.Context, data interface{}, dataType tokenCommon.TokenType, options tokenCommon.FormatPreservingOptions, clientID, zoneID []byte) (interface{}, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+	logger = logger.WithFields(logrus.Fields{"client_id": string(clientID), "zone_id": string(zoneID), "operation": "DetokenizeFormatPreserving"})
+	logger.Debugln("New request")
+	defer logger.Debugln("End processing request to detokenize token")
+	tokenContext := tokenCommon.TokenContext{ClientID: clientID}
+	if len(zoneID) > 0 {
+		tokenContext = tokenCommon.TokenContext{ZoneID: zoneID}
+	}
+	if err := options.Validate(dataType); err != nil {
+		logger.WithField("type", dataType).WithError(err).Errorln("Unsupported format-preserving tokenization options")
+		return nil, err
+	}
+	tokenizer, err := pseudonymization.NewFormatPreservingPseudoanonymizer(service.data.Keystorage, options)
+	if err != nil {
+		logger.WithError(err).Errorln("Can't initialize format-preserving tokenizer")
+		return nil, ErrDetokenize
+	}
+	sourceData, err := tokenizer.Deanonymize(data, tokenContext, dataType)
+	if err != nil {
+		logger.WithField("type", dataType).WithError(err).Errorln("Can't detokenize data")
+		return nil, ErrDetokenize
+	}
+	return sourceData, nil
+}
+
 // Errors related with gRPC requests
 var (
 	ErrKeysNotFound     = errors.New("keys not found")
@@ -467,3 +538,121 @@ func (service *TranslatorService) DecryptSym(ctx context.Context, acraBlock, cli
 	}
 	return decrypted, nil
 }
+
+// MaskingParams describes masking of data with the same parameters as masking settings of columns in encryptor config
+type MaskingParams struct {
+	Pattern         string
+	PlaintextLength int
+	PlaintextSide   maskingCommon.PlainTextSide
+	Mode            maskingCommon.MaskingMode
+	Regex           string
+	CryptoEnvelope  config.CryptoEnvelopeType
+}
+
+// maskingColumnName used as column name of encryption setting built from MaskingParams because it is required
+const maskingColumnName = "acra-translator"
+
+// encryptionSetting validates params and returns them as initialized encryption setting
+func (params MaskingParams) encryptionSetting() (*config.BasicColumnEncryptionSetting, error) {
+	if params.Pattern == "" {
+		return nil, maskingCommon.ErrInvalidMaskingPattern
+	}
+	cryptoEnvelope := params.CryptoEnvelope
+	if cryptoEnvelope == "" {
+		cryptoEnvelope = config.CryptoEnvelopeTypeAcraStruct
+	}
+	// encryptor config accepts masking only together with re-encryption to AcraBlock
+	reEncryptToAcraBlock := true
+	setting := &config.BasicColumnEncryptionSetting{
+		Name:                     maskingColumnName,
+		MaskingPattern:           params.Pattern,
+		PartialPlaintextLenBytes: params.PlaintextLength,
+		PlaintextSide:            params.PlaintextSide,
+		MaskingMode:              params.Mode,
+		MaskingRegex:             params.Regex,
+		CryptoEnvelope:           &cryptoEnvelope,
+		ReEncryptToAcraBlock:     &reEncryptToAcraBlock,
+	}
+	if err := setting.Init(); err != nil {
+		return nil, err
+	}
+	return setting, nil
+}
+
+// Validate returns error if params can't be used for masking
+func (params MaskingParams) Validate() error {
+	_, err := params.encryptionSetting()
+	return err
+}
+
+// Mask encrypts masked part of data according to params using passed ZoneID if length > 0 otherwise use ClientID (that is required after that)
+func (service *TranslatorService) Mask(ctx context.Context, data []byte, params MaskingParams, clientID, zoneID []byte) ([]byte, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+	logger = logger.WithFields(logrus.Fields{"client_id": string(clientID), "zone_id": string(zoneID), "operation": "Mask"})
+	logger.Debugln("New request")
+	defer logger.Debugln("End processing request")
+	if len(clientID) == 0 {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorTranslatorClientIDMissing).Errorln("Request without ClientID not allowed")
+		return nil, ErrClientIDRequired
+	}
+	setting, err := params.encryptionSetting()
+	if err != nil {
+		logger.WithError(err).Errorln("Invalid masking parameters")
+		return nil, err
+	}
+	maskingEncryptor, err := masking.NewMaskingDataEncryptor(service.data.Keystorage, service.handler)
+	if err != nil {
+		return nil, ErrEncryptionFailed
+	}
+	var masked []byte
+	if len(zoneID) != 0 {
+		masked, err = maskingEncryptor.EncryptWithZoneID(zoneID, data, setting)
+	} else {
+		masked, err = maskingEncryptor.EncryptWithClientID(clientID, data, setting)
+	}
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCantEncryptData).WithError(err).Errorln("Can't mask data")
+		return nil, ErrEncryptionFailed
+	}
+	return masked, nil
+}
+
+// Unmask decrypts masked data using passed ZoneID if length > 0 otherwise use ClientID (that is required after that).
+// Parts that can't be decrypted are replaced with the masking pattern
+func (service *TranslatorService) Unmask(ctx context.Context, data []byte, params MaskingParams, clientID, zoneID []byte) ([]byte, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+	logger = logger.WithFields(logrus.Fields{"client_id": string(clientID), "zone_id": string(zoneID), "operation": "Unmask"})
+	logger.Debugln("New request")
+	defer logger.Debugln("End processing request")
+	if len(clientID) == 0 {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorTranslatorClientIDMissing).Errorln("Request without ClientID not allowed")
+		return nil, ErrClientIDRequired
+	}
+	setting, err := params.encryptionSetting()
+	if err != nil {
+		logger.WithError(err).Errorln("Invalid masking parameters")
+		return nil, err
+	}
+	var accessContext *base.AccessContext
+	if len(zoneID) != 0 {
+		accessContext = base.NewAccessContext(base.WithZoneMode(len(zoneID) > 0))
+		accessContext.SetZoneID(zoneID)
+	} else {
+		accessContext = base.NewAccessContext(base.WithClientID(clientID))
+	}
+	dataCtx := base.SetAccessContextToContext(ctx, accessContext)
+	dataCtx = encryptor.NewContextWithEncryptionSetting(dataCtx, setting)
+
+	processor, err := masking.NewProcessor(service.handler)
+	if err != nil {
+		return nil, ErrCantDecrypt
+	}
+	envelopeDetector := crypto.NewEnvelopeDetector()
+	envelopeDetector.AddCallback(crypto.NewDecryptHandler(service.data.Keystorage, processor))
+	_, unmasked, err := envelopeDetector.OnColumn(dataCtx, data)
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorDecryptorCantDecryptBinary).WithError(err).Errorln("Can't unmask data")
+		return nil, ErrCantDecrypt
+	}
+	return unmasked, nil
+}
@@ -543,6 +543,106 @@ var doc = `{
                 }
             }
         },
+        "/v2/mask": {
+            "get": {
+                "description": "Encrypt masked part of data with specified ZoneID or ClientID from connection. Parameters are the same as masking parameters of encryptor config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Mask data",
+                "parameters": [
+                    {
+                        "description": "Binary data encoded as Base64 string",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "ZoneID",
+                        "name": "zone_id",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Pattern used instead of masked data if it can't be decrypted",
+                        "name": "pattern",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Length of data left in plaintext",
+                        "name": "plaintext_length",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Side of data left in plaintext, left or right",
+                        "name": "plaintext_side",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Crypto envelope, acrastruct (default) or acrablock",
+                        "name": "crypto_envelope",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Masking mode, plaintext_side (default), email, separators or regex",
+                        "name": "masking_mode",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Regular expression with capture groups left in plaintext for regex masking mode",
+                        "name": "masking_regex",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http_api.encryptionHTTPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/http_api.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
         "/v2/tokenize": {
             "get": {
                 "description": "Tokenize data according to data type",
@@ -593,6 +693,106 @@ var doc = `{
                     }
                 }
             }
+        },
+        "/v2/unmask": {
+            "get": {
+                "description": "Decrypt masked data with specified ZoneID or ClientID from connection. Parts that can't be decrypted are replaced with pattern",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Unmask data",
+                "parameters": [
+                    {
+                        "description": "Binary data encoded as Base64 string",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "ZoneID",
+                        "name": "zone_id",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Pattern used instead of masked data if it can't be decrypted",
+                        "name": "pattern",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Length of data left in plaintext",
+                        "name": "plaintext_length",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "Side of data left in plaintext, left or right",
+                        "name": "plaintext_side",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Crypto envelope, acrastruct (default) or acrablock",
+                        "name": "crypto_envelope",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Masking mode, plaintext_side (default), email, separators or regex",
+                        "name": "masking_mode",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "Regular expression with capture groups left in plaintext for regex masking mode",
+                        "name": "masking_regex",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http_api.encryptionHTTPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http_api.HTTPError"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/http_api.HTTPError"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
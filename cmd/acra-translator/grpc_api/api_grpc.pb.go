@@ -709,3 +709,125 @@ var SearchableEncryption_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
+
+// MaskingClient is the client API for Masking service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MaskingClient interface {
+	Mask(ctx context.Context, in *MaskingRequest, opts ...grpc.CallOption) (*MaskingResponse, error)
+	Unmask(ctx context.Context, in *MaskingRequest, opts ...grpc.CallOption) (*MaskingResponse, error)
+}
+
+type maskingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMaskingClient(cc grpc.ClientConnInterface) MaskingClient {
+	return &maskingClient{cc}
+}
+
+func (c *maskingClient) Mask(ctx context.Context, in *MaskingRequest, opts ...grpc.CallOption) (*MaskingResponse, error) {
+	out := new(MaskingResponse)
+	err := c.cc.Invoke(ctx, "/grpc_api.Masking/Mask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maskingClient) Unmask(ctx context.Context, in *MaskingRequest, opts ...grpc.CallOption) (*MaskingResponse, error) {
+	out := new(MaskingResponse)
+	err := c.cc.Invoke(ctx, "/grpc_api.Masking/Unmask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaskingServer is the server API for Masking service.
+// All implementations must embed UnimplementedMaskingServer
+// for forward compatibility
+type MaskingServer interface {
+	Mask(context.Context, *MaskingRequest) (*MaskingResponse, error)
+	Unmask(context.Context, *MaskingRequest) (*MaskingResponse, error)
+	mustEmbedUnimplementedMaskingServer()
+}
+
+// UnimplementedMaskingServer must be embedded to have forward compatible implementations.
+type UnimplementedMaskingServer struct {
+}
+
+func (UnimplementedMaskingServer) Mask(context.Context, *MaskingRequest) (*MaskingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mask not implemented")
+}
+func (UnimplementedMaskingServer) Unmask(context.Context, *MaskingRequest) (*MaskingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmask not implemented")
+}
+func (UnimplementedMaskingServer) mustEmbedUnimplementedMaskingServer() {}
+
+// UnsafeMaskingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MaskingServer will
+// result in compilation errors.
+type UnsafeMaskingServer interface {
+	mustEmbedUnimplementedMaskingServer()
+}
+
+func RegisterMaskingServer(s grpc.ServiceRegistrar, srv MaskingServer) {
+	s.RegisterService(&Masking_ServiceDesc, srv)
+}
+
+func _Masking_Mask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaskingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaskingServer).Mask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_api.Masking/Mask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaskingServer).Mask(ctx, req.(*MaskingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Masking_Unmask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaskingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaskingServer).Unmask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_api.Masking/Unmask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaskingServer).Unmask(ctx, req.(*MaskingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Masking_ServiceDesc is the grpc.ServiceDesc for Masking service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Masking_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc_api.Masking",
+	HandlerType: (*MaskingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Mask",
+			Handler:    _Masking_Mask_Handler,
+		},
+		{
+			MethodName: "Unmask",
+			Handler:    _Masking_Unmask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
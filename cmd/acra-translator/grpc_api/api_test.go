@@ -67,10 +67,22 @@ func (keystore *testKeystore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	panic("implement me")
 }
 
+func (keystore *testKeystore) GetHMACSecretKeys(id []byte) ([][]byte, error) {
+	panic("implement me")
+}
+
 func (keystore *testKeystore) GetClientIDSymmetricKeys(id []byte) ([][]byte, error) {
 	panic("implement me")
 }
 
+func (*testKeystore) GetEncryptionTokenSymmetricKey(id []byte, ownerType keystore.KeyOwnerType) ([]byte, error) {
+	panic("implement me")
+}
+
+func (*testKeystore) GetDecryptionTokenSymmetricKeys(id []byte, ownerType keystore.KeyOwnerType) ([][]byte, error) {
+	panic("implement me")
+}
+
 func (keystore *testKeystore) GetZoneIDSymmetricKeys(id []byte) ([][]byte, error) {
 	panic("implement me")
 }
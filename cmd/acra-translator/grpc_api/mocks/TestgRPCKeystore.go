@@ -81,6 +81,29 @@ func (_m *TestgRPCKeystore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	return r0, r1
 }
 
+// GetHMACSecretKeys provides a mock function with given fields: id
+func (_m *TestgRPCKeystore) GetHMACSecretKeys(id []byte) ([][]byte, error) {
+	ret := _m.Called(id)
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func([]byte) [][]byte); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogSecretKey provides a mock function with given fields:
 func (_m *TestgRPCKeystore) GetLogSecretKey() ([]byte, error) {
 	ret := _m.Called()
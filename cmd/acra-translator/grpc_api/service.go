@@ -21,11 +21,15 @@ import (
 	"github.com/cossacklabs/acra/acrablock"
 	"github.com/cossacklabs/acra/cmd/acra-translator/common"
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/hmac"
 	"github.com/cossacklabs/acra/logging"
+	maskingCommon "github.com/cossacklabs/acra/masking/common"
+	"github.com/cossacklabs/acra/pseudonymization"
 	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"time"
 )
 
 // ErrEmptyClientID error used if ClientID required in request but not provided
@@ -45,6 +49,7 @@ type TranslatorService struct {
 	UnimplementedSearchableEncryptionServer
 	UnimplementedWriterServer
 	UnimplementedWriterSymServer
+	UnimplementedMaskingServer
 }
 
 // NewTranslatorService return new TranslatorService instance
@@ -55,7 +60,8 @@ func NewTranslatorService(service common.ITranslatorService, translatorData *com
 	}
 	return &TranslatorService{translatorData, logger, service,
 		UnimplementedReaderServer{}, UnimplementedReaderSymServer{}, UnimplementedTokenizatorServer{},
-		UnimplementedSearchableEncryptionServer{}, UnimplementedWriterServer{}, UnimplementedWriterSymServer{}}, nil
+		UnimplementedSearchableEncryptionServer{}, UnimplementedWriterServer{}, UnimplementedWriterSymServer{},
+		UnimplementedMaskingServer{}}, nil
 }
 
 // Errors possible during decrypting AcraStructs.
@@ -136,41 +142,53 @@ func (service *TranslatorService) GenerateQueryHash(ctx context.Context, request
 	return &QueryHashResponse{Hash: response}, nil
 }
 
-// Tokenize data from request
-func (service *TranslatorService) Tokenize(ctx context.Context, request *TokenizeRequest) (*TokenizeResponse, error) {
-	logger := service.logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Tokenize"})
-	logger.Debugln("New request")
-	defer logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Tokenize"}).Debugln("End processing request")
+// formatPreservingOptionsFromRequest converts options from request to options used by tokenizer
+func formatPreservingOptionsFromRequest(options *FormatPreservingOptions) tokenCommon.FormatPreservingOptions {
+	return tokenCommon.FormatPreservingOptions{Algorithm: tokenCommon.FormatPreservingAlgorithm(options.GetAlgorithm()), LuhnValid: options.GetLuhn(), KeyVersion: int(options.GetKeyVersion())}
+}
+
+// ErrUnsupportedValueType returned for values of unknown type in tokenization requests
+var ErrUnsupportedValueType = errors.New("unsupported value type")
 
-	var data interface{}
-	var tokenType tokenCommon.TokenType
+// tokenValueFromRequest return value from request converted to type expected by tokenizer and its token type
+func tokenValueFromRequest(request *TokenizeRequest) (interface{}, tokenCommon.TokenType, error) {
 	switch val := request.GetValue().(type) {
 	case *TokenizeRequest_BytesValue:
-		data = val.BytesValue
-		tokenType = tokenCommon.TokenType_Bytes
+		return val.BytesValue, tokenCommon.TokenType_Bytes, nil
 	case *TokenizeRequest_EmailValue:
-		data = tokenCommon.Email(val.EmailValue)
-		tokenType = tokenCommon.TokenType_Email
+		return tokenCommon.Email(val.EmailValue), tokenCommon.TokenType_Email, nil
 	case *TokenizeRequest_Int32Value:
-		data = val.Int32Value
-		tokenType = tokenCommon.TokenType_Int32
+		return val.Int32Value, tokenCommon.TokenType_Int32, nil
 	case *TokenizeRequest_Int64Value:
-		data = val.Int64Value
-		tokenType = tokenCommon.TokenType_Int64
+		return val.Int64Value, tokenCommon.TokenType_Int64, nil
 	case *TokenizeRequest_StrValue:
-		data = val.StrValue
-		tokenType = tokenCommon.TokenType_String
+		return val.StrValue, tokenCommon.TokenType_String, nil
+	case *TokenizeRequest_PhoneValue:
+		return tokenCommon.Phone(val.PhoneValue), tokenCommon.TokenType_Phone, nil
+	case *TokenizeRequest_UuidValue:
+		return tokenCommon.UUID(val.UuidValue), tokenCommon.TokenType_UUID, nil
+	case *TokenizeRequest_CardNumberValue:
+		return tokenCommon.CardNumber(val.CardNumberValue), tokenCommon.TokenType_CardNumber, nil
+	case *TokenizeRequest_DateValue:
+		date, err := time.Parse(pseudonymization.DateLayout, val.DateValue)
+		if err != nil {
+			return nil, tokenCommon.TokenType_Unknown, err
+		}
+		return date, tokenCommon.TokenType_Date, nil
+	case *TokenizeRequest_TimestampValue:
+		timestamp, err := time.Parse(time.RFC3339Nano, val.TimestampValue)
+		if err != nil {
+			return nil, tokenCommon.TokenType_Unknown, err
+		}
+		return timestamp, tokenCommon.TokenType_Timestamp, nil
 	default:
-		logger.Errorln("Unsupported token type")
-		return nil, errors.New("unsupported value type")
+		return nil, tokenCommon.TokenType_Unknown, ErrUnsupportedValueType
 	}
+}
 
-	response, err := service.service.Tokenize(ctx, data, tokenType, request.ClientId, request.ZoneId)
-	if err != nil {
-		logger.WithError(err).Errorln("Can't tokenize data")
-		return nil, err
-	}
-	switch val := response.(type) {
+// tokenizeResponseFromValue return response with value returned by tokenizer
+func tokenizeResponseFromValue(value interface{}, tokenType tokenCommon.TokenType) (*TokenizeResponse, error) {
+	switch val := value.(type) {
 	case []byte:
 		return &TokenizeResponse{Response: &TokenizeResponse_BytesToken{BytesToken: val}}, nil
 	case int32:
@@ -181,10 +199,50 @@ func (service *TranslatorService) Tokenize(ctx context.Context, request *Tokeniz
 		return &TokenizeResponse{Response: &TokenizeResponse_StrToken{StrToken: val}}, nil
 	case tokenCommon.Email:
 		return &TokenizeResponse{Response: &TokenizeResponse_EmailToken{EmailToken: string(val)}}, nil
+	case tokenCommon.Phone:
+		return &TokenizeResponse{Response: &TokenizeResponse_PhoneToken{PhoneToken: string(val)}}, nil
+	case tokenCommon.UUID:
+		return &TokenizeResponse{Response: &TokenizeResponse_UuidToken{UuidToken: string(val)}}, nil
+	case tokenCommon.CardNumber:
+		return &TokenizeResponse{Response: &TokenizeResponse_CardNumberToken{CardNumberToken: string(val)}}, nil
+	case time.Time:
+		if tokenType == tokenCommon.TokenType_Date {
+			return &TokenizeResponse{Response: &TokenizeResponse_DateToken{DateToken: val.Format(pseudonymization.DateLayout)}}, nil
+		}
+		return &TokenizeResponse{Response: &TokenizeResponse_TimestampToken{TimestampToken: val.Format(time.RFC3339Nano)}}, nil
 	default:
-		logger.Errorln("Unsupported token type")
-		return nil, errors.New("unsupported value type")
+		return nil, ErrUnsupportedValueType
+	}
+}
+
+// Tokenize data from request
+func (service *TranslatorService) Tokenize(ctx context.Context, request *TokenizeRequest) (*TokenizeResponse, error) {
+	logger := service.logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Tokenize"})
+	logger.Debugln("New request")
+	defer logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Tokenize"}).Debugln("End processing request")
+
+	data, tokenType, err := tokenValueFromRequest(request)
+	if err != nil {
+		logger.WithError(err).Errorln("Unsupported token type")
+		return nil, err
+	}
+
+	var response interface{}
+	if options := request.GetFormatPreserving(); options != nil {
+		response, err = service.service.TokenizeFormatPreserving(ctx, data, tokenType, formatPreservingOptionsFromRequest(options), request.ClientId, request.ZoneId)
+	} else {
+		response, err = service.service.Tokenize(ctx, data, tokenType, request.ClientId, request.ZoneId)
+	}
+	if err != nil {
+		logger.WithError(err).Errorln("Can't tokenize data")
+		return nil, err
 	}
+	tokenizeResponse, err := tokenizeResponseFromValue(response, tokenType)
+	if err != nil {
+		logger.WithError(err).Errorln("Unsupported token type")
+		return nil, err
+	}
+	return tokenizeResponse, nil
 }
 
 // Detokenize data from request
@@ -193,49 +251,28 @@ func (service *TranslatorService) Detokenize(ctx context.Context, request *Token
 	logger.Debugln("New request")
 	defer logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Detokenize"}).Debugln("End processing request to detokenize token")
 
-	var data interface{}
-	var tokenType tokenCommon.TokenType
-	switch val := request.GetValue().(type) {
-	case *TokenizeRequest_BytesValue:
-		data = val.BytesValue
-		tokenType = tokenCommon.TokenType_Bytes
-	case *TokenizeRequest_EmailValue:
-		data = tokenCommon.Email(val.EmailValue)
-		tokenType = tokenCommon.TokenType_Email
-	case *TokenizeRequest_Int32Value:
-		data = val.Int32Value
-		tokenType = tokenCommon.TokenType_Int32
-	case *TokenizeRequest_Int64Value:
-		data = val.Int64Value
-		tokenType = tokenCommon.TokenType_Int64
-	case *TokenizeRequest_StrValue:
-		data = val.StrValue
-		tokenType = tokenCommon.TokenType_String
-	default:
-		logger.Errorln("Unsupported token type")
-		return nil, errors.New("unsupported value type")
+	data, tokenType, err := tokenValueFromRequest(request)
+	if err != nil {
+		logger.WithError(err).Errorln("Unsupported token type")
+		return nil, err
 	}
 
-	response, err := service.service.Detokenize(ctx, data, tokenType, request.ClientId, request.ZoneId)
+	var response interface{}
+	if options := request.GetFormatPreserving(); options != nil {
+		response, err = service.service.DetokenizeFormatPreserving(ctx, data, tokenType, formatPreservingOptionsFromRequest(options), request.ClientId, request.ZoneId)
+	} else {
+		response, err = service.service.Detokenize(ctx, data, tokenType, request.ClientId, request.ZoneId)
+	}
 	if err != nil {
 		logger.WithError(err).Errorln("Can't detokenize data")
 		return nil, err
 	}
-	switch val := response.(type) {
-	case []byte:
-		return &TokenizeResponse{Response: &TokenizeResponse_BytesToken{BytesToken: val}}, nil
-	case int32:
-		return &TokenizeResponse{Response: &TokenizeResponse_Int32Token{Int32Token: val}}, nil
-	case int64:
-		return &TokenizeResponse{Response: &TokenizeResponse_Int64Token{Int64Token: val}}, nil
-	case string:
-		return &TokenizeResponse{Response: &TokenizeResponse_StrToken{StrToken: val}}, nil
-	case tokenCommon.Email:
-		return &TokenizeResponse{Response: &TokenizeResponse_EmailToken{EmailToken: string(val)}}, nil
-	default:
-		logger.Errorln("Unsupported token type")
-		return nil, errors.New("unsupported value type")
+	tokenizeResponse, err := tokenizeResponseFromValue(response, tokenType)
+	if err != nil {
+		logger.WithError(err).Errorln("Unsupported token type")
+		return nil, err
 	}
+	return tokenizeResponse, nil
 }
 
 // Errors related with gRPC requests
@@ -330,3 +367,40 @@ func (service *TranslatorService) DecryptSym(ctx context.Context, request *Decry
 	}
 	return &DecryptSymResponse{Data: response}, nil
 }
+
+func maskingParamsFromRequest(request *MaskingRequest) common.MaskingParams {
+	return common.MaskingParams{
+		Pattern:         request.Pattern,
+		PlaintextLength: int(request.PlaintextLength),
+		PlaintextSide:   maskingCommon.PlainTextSide(request.PlaintextSide),
+		Mode:            maskingCommon.MaskingMode(request.MaskingMode),
+		Regex:           request.MaskingRegex,
+		CryptoEnvelope:  config.CryptoEnvelopeType(request.CryptoEnvelope),
+	}
+}
+
+// Mask encrypts masked part of data according to masking parameters from request
+func (service *TranslatorService) Mask(ctx context.Context, request *MaskingRequest) (*MaskingResponse, error) {
+	logger := service.logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Mask"})
+	logger.Debugln("New request")
+	defer logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Mask"}).Debugln("End processing request")
+	response, err := service.service.Mask(ctx, request.Data, maskingParamsFromRequest(request), request.ClientId, request.ZoneId)
+	if err != nil {
+		logger.WithError(err).Errorln("Can't mask data")
+		return nil, err
+	}
+	return &MaskingResponse{Data: response}, nil
+}
+
+// Unmask decrypts masked data from request and leaves masking pattern instead of parts that can't be decrypted
+func (service *TranslatorService) Unmask(ctx context.Context, request *MaskingRequest) (*MaskingResponse, error) {
+	logger := service.logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Unmask"})
+	logger.Debugln("New request")
+	defer logger.WithFields(logrus.Fields{"client_id": string(request.ClientId), "zone_id": string(request.ZoneId), "operation": "Unmask"}).Debugln("End processing request")
+	response, err := service.service.Unmask(ctx, request.Data, maskingParamsFromRequest(request), request.ClientId, request.ZoneId)
+	if err != nil {
+		logger.WithError(err).Errorln("Can't unmask data")
+		return nil, err
+	}
+	return &MaskingResponse{Data: response}, nil
+}
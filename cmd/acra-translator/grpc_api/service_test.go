@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"io/ioutil"
 	"net"
 	"os"
@@ -189,6 +190,7 @@ func TestTranslatorService_Search(t *testing.T) {
 	keystore := &mocks.ServerKeyStore{}
 	// return copy of all private keys because of their erasing on every usage by clients
 	keystore.On("GetHMACSecretKey", mock.MatchedBy(func([]byte) bool { return true })).Return(func([]byte) []byte { return append([]byte{}, hmacKey...) }, nil)
+	keystore.On("GetHMACSecretKeys", mock.MatchedBy(func([]byte) bool { return true })).Return(func([]byte) [][]byte { return [][]byte{append([]byte{}, hmacKey...)} }, nil)
 	keystore.On("GetZonePrivateKeys", mock.MatchedBy(func([]byte) bool { return true })).Return(
 		func([]byte) []*keys.PrivateKey {
 			return []*keys.PrivateKey{&keys.PrivateKey{Value: append([]byte{}, zoneIDKeypair.Private.Value...)}}
@@ -275,6 +277,7 @@ func TestTranslatorService_SearchSym(t *testing.T) {
 	}
 	keystore := &mocks.ServerKeyStore{}
 	keystore.On("GetHMACSecretKey", mock.MatchedBy(func([]byte) bool { return true })).Return(func([]byte) []byte { return append([]byte{}, hmacKey...) }, nil)
+	keystore.On("GetHMACSecretKeys", mock.MatchedBy(func([]byte) bool { return true })).Return(func([]byte) [][]byte { return [][]byte{append([]byte{}, hmacKey...)} }, nil)
 	keystore.On("GetZoneIDSymmetricKeys", mock.MatchedBy(func([]byte) bool { return true })).Return(
 		func([]byte) [][]byte {
 			return [][]byte{append([]byte{}, zoneIDSymKey...)}
@@ -348,6 +351,114 @@ func (t *testPoisonCallback) Call() error {
 	return nil
 }
 
+func TestTranslatorService_Masking(t *testing.T) {
+	clientIDSymKey, err := keystore.GenerateSymmetricKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientIDKeypair, err := keys.New(keys.TypeEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientID := []byte(`client id 1`)
+	unknownClientID := []byte(`client id 2`)
+	isKnownClientID := func(id []byte) bool { return bytes.Equal(id, clientID) }
+	keystore := &mocks.ServerKeyStore{}
+	keystore.On("GetClientIDSymmetricKeys", mock.MatchedBy(isKnownClientID)).Return(
+		func([]byte) [][]byte {
+			return [][]byte{append([]byte{}, clientIDSymKey...)}
+		},
+		nil)
+	keystore.On("GetClientIDSymmetricKeys", mock.MatchedBy(func(id []byte) bool { return !isKnownClientID(id) })).Return(nil, translatorCommon.ErrKeysNotFound)
+	keystore.On("GetServerDecryptionPrivateKeys", mock.MatchedBy(isKnownClientID)).Return(
+		func([]byte) []*keys.PrivateKey {
+			return []*keys.PrivateKey{&keys.PrivateKey{Value: append([]byte{}, clientIDKeypair.Private.Value...)}}
+		},
+		nil)
+	keystore.On("GetServerDecryptionPrivateKeys", mock.MatchedBy(func(id []byte) bool { return !isKnownClientID(id) })).Return(nil, translatorCommon.ErrKeysNotFound)
+	keystore.On("GetClientIDEncryptionPublicKey", mock.MatchedBy(func([]byte) bool { return true })).Return(clientIDKeypair.Public, nil)
+
+	translatorData := &translatorCommon.TranslatorData{Keystorage: keystore}
+	serviceImplementation, err := translatorCommon.NewTranslatorService(translatorData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := NewTranslatorService(serviceImplementation, translatorData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type testCase struct {
+		Request   *MaskingRequest
+		Masked    string
+		Plaintext string
+	}
+	testCases := []testCase{
+		{
+			Request:   &MaskingRequest{Data: []byte(`4111111111111111`), Pattern: "xxxx", PlaintextLength: 4, PlaintextSide: "right"},
+			Masked:    "xxxx1111",
+			Plaintext: "1111",
+		},
+		{
+			Request:   &MaskingRequest{Data: []byte(`+1 (555) 123-4567`), Pattern: "*", PlaintextLength: 4, PlaintextSide: "right", MaskingMode: "separators", CryptoEnvelope: "acrablock"},
+			Masked:    "+* (*) *-4567",
+			Plaintext: "4567",
+		},
+		{
+			Request:   &MaskingRequest{Data: []byte(`user@example.com`), Pattern: "xxx", MaskingMode: "email", CryptoEnvelope: "acrablock"},
+			Masked:    "xxx@example.com",
+			Plaintext: "@example.com",
+		},
+	}
+	ctx := context.Background()
+	for i, tcase := range testCases {
+		tcase.Request.ClientId = clientID
+		masked, err := service.Mask(ctx, tcase.Request)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if bytes.Equal(masked.Data, tcase.Request.Data) || !bytes.Contains(masked.Data, []byte(tcase.Plaintext)) {
+			t.Fatalf("[%d] Incorrectly masked data: %v", i, masked.Data)
+		}
+
+		unmaskRequest := proto.Clone(tcase.Request).(*MaskingRequest)
+		unmaskRequest.Data = masked.Data
+		unmasked, err := service.Unmask(ctx, unmaskRequest)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if !bytes.Equal(unmasked.Data, tcase.Request.Data) {
+			t.Fatalf("[%d] Unmasked data not equal to raw data, took %s", i, unmasked.Data)
+		}
+
+		unmaskRequest.ClientId = unknownClientID
+		unmasked, err = service.Unmask(ctx, unmaskRequest)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if string(unmasked.Data) != tcase.Masked {
+			t.Fatalf("[%d] Expect %s for ClientID without keys, took %s", i, tcase.Masked, unmasked.Data)
+		}
+	}
+
+	invalidRequests := []*MaskingRequest{
+		// without pattern
+		{ClientId: clientID, Data: []byte(`data`), PlaintextLength: 1, PlaintextSide: "right"},
+		{ClientId: clientID, Data: []byte(`data`), Pattern: "xxx", PlaintextLength: 1, PlaintextSide: "middle"},
+		{ClientId: clientID, Data: []byte(`data`), Pattern: "xxx", PlaintextLength: 1, PlaintextSide: "right", CryptoEnvelope: "unknown"},
+		{ClientId: clientID, Data: []byte(`data`), Pattern: "xxx", MaskingMode: "regex", MaskingRegex: "("},
+		// without ClientID
+		{Data: []byte(`data`), Pattern: "xxx", PlaintextLength: 1, PlaintextSide: "right"},
+	}
+	for i, request := range invalidRequests {
+		if _, err := service.Mask(ctx, request); err == nil {
+			t.Fatalf("[%d] Expect error on mask with invalid request", i)
+		}
+		if _, err := service.Unmask(ctx, request); err == nil {
+			t.Fatalf("[%d] Expect error on unmask with invalid request", i)
+		}
+	}
+}
+
 func TestTranslatorService_DecryptionPoisonRecord(t *testing.T) {
 	type testCase struct {
 		ClientID []byte
@@ -396,6 +507,7 @@ func TestTranslatorService_DecryptionPoisonRecord(t *testing.T) {
 		keyStorage.ExpectedCalls = nil
 		keyStorage.On("GetPoisonSymmetricKeys").Return(func() [][]byte { return [][]byte{append([]byte{}, poisonSymKey...)} }, nil)
 		keyStorage.On("GetHMACSecretKey", mock.MatchedBy(func([]byte) bool { return true })).Return(func([]byte) []byte { return append([]byte{}, hmacKey...) }, nil)
+		keyStorage.On("GetHMACSecretKeys", mock.MatchedBy(func([]byte) bool { return true })).Return(func([]byte) [][]byte { return [][]byte{append([]byte{}, hmacKey...)} }, nil)
 		keyStorage.On("GetZoneIDSymmetricKeys", mock.MatchedBy(func([]byte) bool { return true })).Return(
 			func([]byte) [][]byte {
 				return [][]byte{append([]byte{}, someSymKey...)}
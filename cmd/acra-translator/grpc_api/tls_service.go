@@ -14,6 +14,7 @@ type DecryptService interface {
 	ReaderSymServer
 	WriterSymServer
 	SearchableEncryptionServer
+	MaskingServer
 }
 
 // TLSDecryptServiceWrapper wraps DecryptService and replace clientID in requests with clientID from connection info
@@ -26,6 +27,7 @@ type TLSDecryptServiceWrapper struct {
 	UnimplementedSearchableEncryptionServer
 	UnimplementedWriterServer
 	UnimplementedWriterSymServer
+	UnimplementedMaskingServer
 }
 
 func getClientID(ctx context.Context, extractor network.TLSClientIDExtractor) ([]byte, error) {
@@ -146,9 +148,30 @@ func (wrapper *TLSDecryptServiceWrapper) GenerateQueryHash(ctx context.Context,
 	return wrapper.decryptor.GenerateQueryHash(ctx, request)
 }
 
+// Mask masks data with clientID from connection info
+func (wrapper *TLSDecryptServiceWrapper) Mask(ctx context.Context, request *MaskingRequest) (*MaskingResponse, error) {
+	clientID, err := getClientID(ctx, wrapper.tlsClientIDExtractor)
+	if err != nil {
+		return nil, err
+	}
+	request.ClientId = clientID
+	return wrapper.decryptor.Mask(ctx, request)
+}
+
+// Unmask unmasks data with clientID from connection info
+func (wrapper *TLSDecryptServiceWrapper) Unmask(ctx context.Context, request *MaskingRequest) (*MaskingResponse, error) {
+	clientID, err := getClientID(ctx, wrapper.tlsClientIDExtractor)
+	if err != nil {
+		return nil, err
+	}
+	request.ClientId = clientID
+	return wrapper.decryptor.Unmask(ctx, request)
+}
+
 // NewTLSDecryptServiceWrapper return new service wrapper which use clientID from TLS certificates
 func NewTLSDecryptServiceWrapper(service DecryptService, tlsClientIDExtractor network.TLSClientIDExtractor) (*TLSDecryptServiceWrapper, error) {
 	return &TLSDecryptServiceWrapper{service, tlsClientIDExtractor,
 		UnimplementedReaderServer{}, UnimplementedReaderSymServer{}, UnimplementedTokenizatorServer{},
-		UnimplementedSearchableEncryptionServer{}, UnimplementedWriterServer{}, UnimplementedWriterSymServer{}}, nil
+		UnimplementedSearchableEncryptionServer{}, UnimplementedWriterServer{}, UnimplementedWriterSymServer{},
+		UnimplementedMaskingServer{}}, nil
 }
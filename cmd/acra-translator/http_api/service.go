@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"github.com/cossacklabs/acra/cmd/acra-translator/common"
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/hmac"
 	"github.com/cossacklabs/acra/logging"
+	maskingCommon "github.com/cossacklabs/acra/masking/common"
 	"github.com/cossacklabs/acra/network"
+	"github.com/cossacklabs/acra/pseudonymization"
 	pseudonymizationCommon "github.com/cossacklabs/acra/pseudonymization/common"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -197,6 +200,8 @@ func NewHTTPService(service common.ITranslatorService, translatorData *common.Tr
 		v2.GET("/generateQueryHash", newHTTPService.generateQueryHash)
 		v2.GET("/tokenize", newHTTPService.tokenize)
 		v2.GET("/detokenize", newHTTPService.detokenize)
+		v2.GET("/mask", newHTTPService.mask)
+		v2.GET("/unmask", newHTTPService.unmask)
 
 		// new with POST method
 		v2.POST("/decrypt", newHTTPService.decrypt)
@@ -212,6 +217,8 @@ func NewHTTPService(service common.ITranslatorService, translatorData *common.Tr
 		v2.POST("/generateQueryHash", newHTTPService.generateQueryHash)
 		v2.POST("/tokenize", newHTTPService.tokenize)
 		v2.POST("/detokenize", newHTTPService.detokenize)
+		v2.POST("/mask", newHTTPService.mask)
+		v2.POST("/unmask", newHTTPService.unmask)
 
 		var confs []func(config *ginSwagger.Config)
 		if url, ok := os.LookupEnv("ACRA_TRANSLATOR_SWAGGER_SCHEMA_URL"); ok {
@@ -265,6 +272,9 @@ const (
 
 	tokenizeOperation   = "tokenize"
 	detokenizeOperation = "detokenize"
+
+	maskOperation   = "mask"
+	unmaskOperation = "unmask"
 )
 
 func (service *HTTPService) operationToFunc(operation string) (operationFunc, error) {
@@ -291,6 +301,10 @@ func (service *HTTPService) operationToFunc(operation string) (operationFunc, er
 		return convertTokenizationFuncToOperation(service._tokenize), nil
 	case detokenizeOperation:
 		return convertTokenizationFuncToOperation(service._detokenize), nil
+	case maskOperation:
+		return convertEncryptionFuncToOperation(service._mask), nil
+	case unmaskOperation:
+		return convertEncryptionFuncToOperation(service._unmask), nil
 	}
 	return nil, errors.New("unsupported operation type")
 }
@@ -828,6 +842,20 @@ type tokenizationHTTPRequest struct {
 	ZoneID zoneIDType                       `json:"zone_id" example:"DDDDDDDDMatNOMYjqVOuhACC"`
 	Data   json.RawMessage                  `json:"data" swaggertype:"string,integer" example:"ZGF0YQo="`
 	Type   pseudonymizationCommon.TokenType `json:"type" example:"1"`
+	// FormatPreserving used to generate tokens with format-preserving encryption without storage if set
+	FormatPreserving *formatPreservingHTTPOptions `json:"format_preserving"`
+}
+
+// formatPreservingHTTPOptions options of format-preserving tokenization
+type formatPreservingHTTPOptions struct {
+	Algorithm  pseudonymizationCommon.FormatPreservingAlgorithm `json:"algorithm" example:"ff1"`
+	Luhn       bool                                             `json:"luhn" example:"false"`
+	KeyVersion int                                              `json:"key_version" example:"0"`
+}
+
+// toTokenizerOptions converts options from request to options used by tokenizer
+func (options *formatPreservingHTTPOptions) toTokenizerOptions() pseudonymizationCommon.FormatPreservingOptions {
+	return pseudonymizationCommon.FormatPreservingOptions{Algorithm: options.Algorithm, LuhnValid: options.Luhn, KeyVersion: options.KeyVersion}
 }
 
 type tokenizationHTTPResponse struct {
@@ -843,6 +871,16 @@ func prepareTokenizeResponse(obj interface{}, tokenType pseudonymizationCommon.T
 			return tokenizationHTTPResponse{}, pseudonymizationCommon.ErrUnknownTokenType
 		}
 		obj = base64.StdEncoding.EncodeToString(bytesValue)
+	case pseudonymizationCommon.TokenType_Date, pseudonymizationCommon.TokenType_Timestamp:
+		timeValue, ok := obj.(time.Time)
+		if !ok {
+			return tokenizationHTTPResponse{}, pseudonymizationCommon.ErrUnknownTokenType
+		}
+		if tokenType == pseudonymizationCommon.TokenType_Date {
+			obj = timeValue.Format(pseudonymization.DateLayout)
+		} else {
+			obj = timeValue.Format(time.RFC3339Nano)
+		}
 	}
 	return tokenizationHTTPResponse{Data: obj}, nil
 }
@@ -879,6 +917,24 @@ func prepareDataToTokenization(data json.RawMessage, tokenType pseudonymizationC
 			return data, err
 		}
 		return intValue, nil
+	case pseudonymizationCommon.TokenType_Phone, pseudonymizationCommon.TokenType_UUID, pseudonymizationCommon.TokenType_CardNumber,
+		pseudonymizationCommon.TokenType_Date, pseudonymizationCommon.TokenType_Timestamp:
+		var strValue string
+		if err := json.Unmarshal(data, &strValue); err != nil {
+			return data, err
+		}
+		switch tokenType {
+		case pseudonymizationCommon.TokenType_Phone:
+			return pseudonymizationCommon.Phone(strValue), nil
+		case pseudonymizationCommon.TokenType_UUID:
+			return pseudonymizationCommon.UUID(strValue), nil
+		case pseudonymizationCommon.TokenType_CardNumber:
+			return pseudonymizationCommon.CardNumber(strValue), nil
+		case pseudonymizationCommon.TokenType_Date:
+			return time.Parse(pseudonymization.DateLayout, strValue)
+		default:
+			return time.Parse(time.RFC3339Nano, strValue)
+		}
 	}
 	return data, pseudonymizationCommon.ErrUnknownTokenType
 }
@@ -922,7 +978,12 @@ func (service *HTTPService) _tokenize(ctx *gin.Context, data []byte) (response t
 		httpErr = NewHTTPError(http.StatusBadRequest, "Invalid request data")
 		return
 	}
-	tokenizedData, err := service.service.Tokenize(service.ctx, dataToTokenize, request.Type, connectionClientID, request.ZoneID)
+	var tokenizedData interface{}
+	if request.FormatPreserving != nil {
+		tokenizedData, err = service.service.TokenizeFormatPreserving(service.ctx, dataToTokenize, request.Type, request.FormatPreserving.toTokenizerOptions(), connectionClientID, request.ZoneID)
+	} else {
+		tokenizedData, err = service.service.Tokenize(service.ctx, dataToTokenize, request.Type, connectionClientID, request.ZoneID)
+	}
 	if err != nil {
 		// TODO lagovas(2021-06-24) add and use proper metric
 		//base.AcrastructDecryptionCounter.WithLabelValues(base.EncryptionTypeFail).Inc()
@@ -985,7 +1046,12 @@ func (service *HTTPService) _detokenize(ctx *gin.Context, data []byte) (response
 		return
 	}
 
-	detokenizedData, err := service.service.Detokenize(service.ctx, dataToDetokenize, request.Type, connectionClientID, request.ZoneID)
+	var detokenizedData interface{}
+	if request.FormatPreserving != nil {
+		detokenizedData, err = service.service.DetokenizeFormatPreserving(service.ctx, dataToDetokenize, request.Type, request.FormatPreserving.toTokenizerOptions(), connectionClientID, request.ZoneID)
+	} else {
+		detokenizedData, err = service.service.Detokenize(service.ctx, dataToDetokenize, request.Type, connectionClientID, request.ZoneID)
+	}
 	if err != nil {
 		// TODO lagovas(2021-06-24) add and use proper metric
 		//base.AcrastructDecryptionCounter.WithLabelValues(base.EncryptionTypeFail).Inc()
@@ -1013,6 +1079,138 @@ func (service *HTTPService) _detokenize(ctx *gin.Context, data []byte) (response
 	return
 }
 
+// maskingHTTPRequest used to map json/xml/form data from HTTP requests with masking parameters
+type maskingHTTPRequest struct {
+	ZoneID          zoneIDType                  `json:"zone_id" example:"DDDDDDDDMatNOMYjqVOuhACC"`
+	Data            binaryType                  `json:"data" swaggertype:"string" format:"base64" example:"ZGF0YQo="`
+	Pattern         string                      `json:"pattern" example:"xxxx"`
+	PlaintextLength int                         `json:"plaintext_length" example:"4"`
+	PlaintextSide   maskingCommon.PlainTextSide `json:"plaintext_side" example:"right"`
+	CryptoEnvelope  config.CryptoEnvelopeType   `json:"crypto_envelope" example:"acrastruct"`
+	MaskingMode     maskingCommon.MaskingMode   `json:"masking_mode" example:"plaintext_side"`
+	MaskingRegex    string                      `json:"masking_regex"`
+}
+
+// toMaskingParams converts masking parameters from request to parameters used by service
+func (request *maskingHTTPRequest) toMaskingParams() common.MaskingParams {
+	return common.MaskingParams{
+		Pattern:         request.Pattern,
+		PlaintextLength: request.PlaintextLength,
+		PlaintextSide:   request.PlaintextSide,
+		Mode:            request.MaskingMode,
+		Regex:           request.MaskingRegex,
+		CryptoEnvelope:  request.CryptoEnvelope,
+	}
+}
+
+// mask godoc
+// @Summary Mask data
+// @Description Encrypt masked part of data with specified ZoneID or ClientID from connection. Parameters are the same as masking parameters of encryptor config
+// @Accept  json
+// @Produce  json
+// @Param data body string true "Binary data encoded as Base64 string"
+// @Param zone_id body string false "ZoneID"
+// @Param pattern body string true "Pattern used instead of masked data if it can't be decrypted"
+// @Param plaintext_length body integer false "Length of data left in plaintext"
+// @Param plaintext_side body string false "Side of data left in plaintext, left or right"
+// @Param crypto_envelope body string false "Crypto envelope, acrastruct (default) or acrablock"
+// @Param masking_mode body string false "Masking mode, plaintext_side (default), email, separators or regex"
+// @Param masking_regex body string false "Regular expression with capture groups left in plaintext for regex masking mode"
+// @Success 200 {object} http_api.encryptionHTTPResponse
+// @Failure 400 {object} http_api.HTTPError
+// @Failure 422 {object} http_api.HTTPError
+// @Router /v2/mask [get]
+func (service *HTTPService) mask(ctx *gin.Context) {
+	callOperationImplementation(ctx, convertEncryptionFuncToOperation(service._mask))
+}
+
+func (service *HTTPService) _mask(ctx *gin.Context, data []byte) (response encryptionHTTPResponse, httpErr HTTPError) {
+	logger := logging.GetLoggerFromContext(ctx.Request.Context()).WithField("operation", "mask")
+	logger.Debugln("Process HTTP request to mask data")
+	connection := common.GetConnectionFromHTTPContext(ctx.Request.Context())
+	connectionClientID, ok := network.GetClientIDFromConnection(connection, service.translatorData.TLSClientIDExtractor)
+	if !ok {
+		connectionClientID = nil
+	}
+	request := maskingHTTPRequest{}
+	if err := bindData(&request, data, ctx); err != nil {
+		logger.WithError(err).WithField("content_type", ctx.ContentType()).Errorln("Can't bind data")
+		httpErr = NewHTTPError(http.StatusBadRequest, "Invalid request data")
+		return
+	}
+	params := request.toMaskingParams()
+	if err := params.Validate(); err != nil {
+		logger.WithError(err).Errorln("Invalid masking parameters")
+		httpErr = NewHTTPError(http.StatusBadRequest, "Invalid masking parameters")
+		return
+	}
+
+	maskedData, err := service.service.Mask(service.ctx, request.Data, params, connectionClientID, request.ZoneID)
+	if err != nil {
+		msg := fmt.Sprintf("Can't mask data")
+		logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCantEncryptData).Warningln(msg)
+		httpErr = NewHTTPError(http.StatusUnprocessableEntity, msg)
+		return
+	}
+	logger.Infoln("Masked data")
+	response = encryptionHTTPResponse{Data: maskedData}
+	return
+}
+
+// unmask godoc
+// @Summary Unmask data
+// @Description Decrypt masked data with specified ZoneID or ClientID from connection. Parts that can't be decrypted are replaced with pattern
+// @Accept  json
+// @Produce  json
+// @Param data body string true "Binary data encoded as Base64 string"
+// @Param zone_id body string false "ZoneID"
+// @Param pattern body string true "Pattern used instead of masked data if it can't be decrypted"
+// @Param plaintext_length body integer false "Length of data left in plaintext"
+// @Param plaintext_side body string false "Side of data left in plaintext, left or right"
+// @Param crypto_envelope body string false "Crypto envelope, acrastruct (default) or acrablock"
+// @Param masking_mode body string false "Masking mode, plaintext_side (default), email, separators or regex"
+// @Param masking_regex body string false "Regular expression with capture groups left in plaintext for regex masking mode"
+// @Success 200 {object} http_api.encryptionHTTPResponse
+// @Failure 400 {object} http_api.HTTPError
+// @Failure 422 {object} http_api.HTTPError
+// @Router /v2/unmask [get]
+func (service *HTTPService) unmask(ctx *gin.Context) {
+	callOperationImplementation(ctx, convertEncryptionFuncToOperation(service._unmask))
+}
+
+func (service *HTTPService) _unmask(ctx *gin.Context, data []byte) (response encryptionHTTPResponse, httpErr HTTPError) {
+	logger := logging.GetLoggerFromContext(ctx.Request.Context()).WithField("operation", "unmask")
+	logger.Debugln("Process HTTP request to unmask data")
+	connection := common.GetConnectionFromHTTPContext(ctx.Request.Context())
+	connectionClientID, ok := network.GetClientIDFromConnection(connection, service.translatorData.TLSClientIDExtractor)
+	if !ok {
+		connectionClientID = nil
+	}
+	request := maskingHTTPRequest{}
+	if err := bindData(&request, data, ctx); err != nil {
+		logger.WithError(err).WithField("content_type", ctx.ContentType()).Errorln("Can't bind data")
+		httpErr = NewHTTPError(http.StatusBadRequest, "Invalid request data")
+		return
+	}
+	params := request.toMaskingParams()
+	if err := params.Validate(); err != nil {
+		logger.WithError(err).Errorln("Invalid masking parameters")
+		httpErr = NewHTTPError(http.StatusBadRequest, "Invalid masking parameters")
+		return
+	}
+
+	unmaskedData, err := service.service.Unmask(service.ctx, request.Data, params, connectionClientID, request.ZoneID)
+	if err != nil {
+		msg := fmt.Sprintf("Can't unmask data")
+		logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorTranslatorCantHandleHTTPRequest).Warningln(msg)
+		httpErr = NewHTTPError(http.StatusUnprocessableEntity, msg)
+		return
+	}
+	logger.Infoln("Unmasked data")
+	response = encryptionHTTPResponse{Data: unmaskedData}
+	return
+}
+
 func renderResponse(obj interface{}, ctx *gin.Context, logger *log.Entry) {
 	switch ctx.ContentType() {
 	case gin.MIMEJSON:
@@ -134,6 +134,9 @@ func initKeyStore(clientID, zoneID []byte, keyStorage *mocks.ServerKeyStore, t *
 	keyStorage.On("GetHMACSecretKey", mock.MatchedBy(func(id []byte) bool {
 		return bytes.Equal(id, clientID)
 	})).Return(func([]byte) []byte { return append([]byte{}, hmacSymKey...) }, nil)
+	keyStorage.On("GetHMACSecretKeys", mock.MatchedBy(func(id []byte) bool {
+		return bytes.Equal(id, clientID)
+	})).Return(func([]byte) [][]byte { return [][]byte{append([]byte{}, hmacSymKey...)} }, nil)
 
 	keyStorage.On("GetZonePublicKey", mock.MatchedBy(func(id []byte) bool {
 		return bytes.Equal(id, zoneID)
@@ -433,6 +436,21 @@ func testHTTPAPIEndpoints(testContext apiTestContext, t *testing.T) {
 		testTokenizeDetokenize(testContext.endpoint, http.MethodGet, testTokenData, testContext.client, t)
 		testTokenizeDetokenize(testContext.endpoint, http.MethodPost, testTokenData, testContext.client, t)
 	}
+	testMaskingData := []maskingData{
+		{Data: []byte(`4111111111111111`), Params: map[string]interface{}{"pattern": "xxxx", "plaintext_length": 4, "plaintext_side": "right"}},
+		{Data: []byte(`user@example.com`), Params: map[string]interface{}{"pattern": "xxxx", "masking_mode": "email", "crypto_envelope": "acrablock"}},
+	}
+	for _, testData := range testMaskingData {
+		testData.ZoneID = nil
+		// test without zoneID
+		testMaskUnmask(testContext.endpoint, http.MethodGet, testData, testContext.client, t)
+		testMaskUnmask(testContext.endpoint, http.MethodPost, testData, testContext.client, t)
+
+		// test with ZoneID
+		testData.ZoneID = testContext.zoneID
+		testMaskUnmask(testContext.endpoint, http.MethodGet, testData, testContext.client, t)
+		testMaskUnmask(testContext.endpoint, http.MethodPost, testData, testContext.client, t)
+	}
 }
 
 type encryptData struct {
@@ -608,3 +626,66 @@ func init() {
 		panic(err)
 	}
 }
+
+type maskingData struct {
+	ZoneID []byte
+	Data   []byte
+	Params map[string]interface{}
+}
+
+func doMaskingRequest(endpoint, method, operation string, data maskingData, client *http.Client, t *testing.T) (int, encryptionHTTPResponse) {
+	requestObject := map[string]interface{}{"data": base64.StdEncoding.EncodeToString(data.Data), "zone_id": string(data.ZoneID)}
+	for key, value := range data.Params {
+		requestObject[key] = value
+	}
+	requestJSON, err := json.Marshal(requestObject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request, err := http.NewRequest(method, fmt.Sprintf("%s/v2/%s", endpoint, operation), bytes.NewReader(requestJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Add("Content-Type", gin.MIMEJSON)
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	responseObject := encryptionHTTPResponse{}
+	if response.StatusCode == http.StatusOK {
+		if err := json.Unmarshal(responseBody, &responseObject); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return response.StatusCode, responseObject
+}
+
+func testMaskUnmask(endpoint, method string, data maskingData, client *http.Client, t *testing.T) {
+	status, maskResponse := doMaskingRequest(endpoint, method, maskOperation, data, client, t)
+	assert.Equal(t, http.StatusOK, status)
+	if bytes.Equal(maskResponse.Data, data.Data) {
+		t.Fatal("Masked data equal to source data")
+	}
+
+	unmaskData := data
+	unmaskData.Data = maskResponse.Data
+	status, unmaskResponse := doMaskingRequest(endpoint, method, unmaskOperation, unmaskData, client, t)
+	assert.Equal(t, http.StatusOK, status)
+	if !bytes.Equal(unmaskResponse.Data, data.Data) {
+		t.Fatal("Unmasked data not equal to source data")
+	}
+
+	invalidData := data
+	invalidData.Params = map[string]interface{}{"plaintext_length": 4, "plaintext_side": "right"}
+	status, _ = doMaskingRequest(endpoint, method, maskOperation, invalidData, client, t)
+	assert.Equal(t, http.StatusBadRequest, status)
+	status, _ = doMaskingRequest(endpoint, method, unmaskOperation, invalidData, client, t)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package access_policy applies actions of access policies from encryptor config to the column values returned to
+// the client according to ClientID of the connection.
+package access_policy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/logging"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/sirupsen/logrus"
+)
+
+// AccessDeniedError returned when access policy denies access to the column for the ClientID
+type AccessDeniedError struct {
+	column string
+}
+
+// NewAccessDeniedError return new AccessDeniedError for the column
+func NewAccessDeniedError(column string) *AccessDeniedError {
+	return &AccessDeniedError{column: column}
+}
+
+// Error implementation of error interface
+func (err *AccessDeniedError) Error() string {
+	return fmt.Sprintf("access denied to column %q", err.column)
+}
+
+// IsAccessDeniedError return true if err is AccessDeniedError or wraps it
+func IsAccessDeniedError(err error) bool {
+	var accessDeniedError *AccessDeniedError
+	return errors.As(err, &accessDeniedError)
+}
+
+// Processor logs decisions of access policies and rejects values of denied columns. Masked and tokenized actions are
+// applied by masking and tokenization processors. Values of columns with clear action are decrypted and detokenized
+// with keys of the column's ClientID/ZoneID. Should be registered right after the processor which assigns column
+// encryption settings to the context and as query observer to log decisions once per query and column
+type Processor struct {
+	mutex sync.Mutex
+	// columns which decisions were logged for the current query
+	loggedColumns map[config.ColumnEncryptionSetting]struct{}
+}
+
+// NewProcessor return new Processor
+func NewProcessor() *Processor {
+	return &Processor{loggedColumns: make(map[config.ColumnEncryptionSetting]struct{})}
+}
+
+// ID return name of processor
+func (p *Processor) ID() string {
+	return "AccessPolicyProcessor"
+}
+
+// OnQuery reset logged decisions for the new query
+func (p *Processor) OnQuery(ctx context.Context, query base.OnQueryObject) (base.OnQueryObject, bool, error) {
+	p.resetLoggedColumns()
+	return query, false, nil
+}
+
+// OnBind reset logged decisions for the new execution of prepared statement
+func (p *Processor) OnBind(ctx context.Context, statement sqlparser.Statement, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+	p.resetLoggedColumns()
+	return values, false, nil
+}
+
+func (p *Processor) resetLoggedColumns() {
+	p.mutex.Lock()
+	p.loggedColumns = make(map[config.ColumnEncryptionSetting]struct{})
+	p.mutex.Unlock()
+}
+
+// shouldLogDecision return true only for the first value of the column in the current query
+func (p *Processor) shouldLogDecision(setting config.ColumnEncryptionSetting) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if _, ok := p.loggedColumns[setting]; ok {
+		return false
+	}
+	p.loggedColumns[setting] = struct{}{}
+	return true
+}
+
+// OnColumn log action of access policy for the column and ClientID of the connection and return AccessDeniedError
+// if the access is denied
+func (p *Processor) OnColumn(ctx context.Context, data []byte) (context.Context, []byte, error) {
+	setting, ok := encryptor.EncryptionSettingFromContext(ctx)
+	if !ok {
+		return ctx, data, nil
+	}
+	accessContext := base.AccessContextFromContext(ctx)
+	clientID := accessContext.GetClientID()
+	action := setting.GetAccessAction(clientID)
+	if action == config.AccessActionDefault {
+		return ctx, data, nil
+	}
+	base.AccessPolicyDecisionCounter.WithLabelValues(string(action)).Inc()
+	logger := logging.GetLoggerFromContext(ctx).WithFields(logrus.Fields{
+		"client_id": string(clientID), "column": setting.ColumnName(), "action": action})
+	if action == config.AccessActionDeny {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorAccessPolicyDenied).
+			Warningln("Access to column denied by access policy")
+		return ctx, data, NewAccessDeniedError(setting.ColumnName())
+	}
+	// decisions are made for every value of the column and counted by prometheus metric, but logged once per query
+	if p.shouldLogDecision(setting) {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeAccessPolicyDecision).
+			Infoln("Column access decided by access policy")
+	}
+	ctx = context.WithValue(ctx, accessActionKey{}, action)
+	if action == config.AccessActionClear {
+		ctx = base.SetAccessContextToContext(ctx, keyOwnerAccessContext(accessContext, setting))
+	}
+	return ctx, data, nil
+}
+
+// keyOwnerAccessContext return AccessContext with ZoneID or ClientID of the column used to encrypt and tokenize its
+// values if they differ from ClientID of the connection. Otherwise return accessContext as is
+func keyOwnerAccessContext(accessContext *base.AccessContext, setting config.ColumnEncryptionSetting) *base.AccessContext {
+	var ownerContext *base.AccessContext
+	if zoneID := setting.ZoneID(); len(zoneID) != 0 {
+		ownerContext = base.NewAccessContext(base.WithClientID(accessContext.GetClientID()), base.WithZoneMode(true))
+		ownerContext.SetZoneID(zoneID)
+	} else if ownerID := setting.ClientID(); len(ownerID) != 0 && !bytes.Equal(ownerID, accessContext.GetClientID()) {
+		ownerContext = base.NewAccessContext(base.WithClientID(ownerID), base.WithZoneMode(accessContext.IsWithZone()))
+		ownerContext.SetZoneID(accessContext.GetZoneID())
+	} else {
+		return accessContext
+	}
+	ownerContext.SetColumnInfo(accessContext.GetColumnInfo())
+	return ownerContext
+}
+
+type accessActionKey struct{}
+
+// ActionFromContext return action of access policy decided by Processor for the column value or action for the ClientID
+// from AccessContext if the value wasn't processed by Processor
+func ActionFromContext(ctx context.Context, setting config.ColumnEncryptionSetting) config.AccessAction {
+	if action, ok := ctx.Value(accessActionKey{}).(config.AccessAction); ok {
+		return action
+	}
+	return setting.GetAccessAction(base.AccessContextFromContext(ctx).GetClientID())
+}
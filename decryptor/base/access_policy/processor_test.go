@@ -0,0 +1,230 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package access_policy
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/logging"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+)
+
+func TestProcessorOnColumn(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        plaintext_length: 4
+        plaintext_side: "right"
+      - column: data2
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: masked
+      - table: test_table
+        column: data2
+        action: deny
+`
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	testcases := []struct {
+		column   string
+		clientID string
+		denied   bool
+	}{
+		// masked values are processed by masking processor
+		{"data1", "support", false},
+		{"data2", "support", true},
+		// ClientIDs without rules are processed as is
+		{"data1", "billing", false},
+		{"data2", "billing", false},
+	}
+	decisions := func(action config.AccessAction) float64 {
+		return testutil.ToFloat64(base.AccessPolicyDecisionCounter.WithLabelValues(string(action)))
+	}
+	maskedBefore, deniedBefore := decisions(config.AccessActionMasked), decisions(config.AccessActionDeny)
+	processor := NewProcessor()
+	data := []byte("some data")
+	for i, tcase := range testcases {
+		ctx := encryptor.NewContextWithEncryptionSetting(context.Background(), tableSchema.GetColumnEncryptionSettings(tcase.column))
+		ctx = base.SetAccessContextToContext(ctx, base.NewAccessContext(base.WithClientID([]byte(tcase.clientID))))
+		_, output, err := processor.OnColumn(ctx, data)
+		if tcase.denied {
+			if !IsAccessDeniedError(err) {
+				t.Fatalf("[%d] Expect AccessDeniedError, took %v\n", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if !bytes.Equal(output, data) {
+			t.Fatalf("[%d] Expect unchanged data, took %q\n", i, output)
+		}
+	}
+
+	if decisions(config.AccessActionMasked)-maskedBefore != 1 || decisions(config.AccessActionDeny)-deniedBefore != 1 {
+		t.Fatal("Expect one counted decision for each non-default action")
+	}
+
+	// columns without encryption settings are processed as is
+	_, output, err := processor.OnColumn(context.Background(), data)
+	if err != nil || !bytes.Equal(output, data) {
+		t.Fatalf("Expect unchanged data without error, took %q, %v\n", output, err)
+	}
+}
+
+func TestProcessorClearWithColumnKeyOwner(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+      - data3
+      - data4
+    encrypted:
+      - column: data1
+        client_id: owner
+      - column: data2
+        zone_id: DDDDDDDDHCzqZAZNbBvybWLR
+      - column: data3
+      - column: data4
+        client_id: owner
+        masking: "xxxx"
+        plaintext_length: 4
+        plaintext_side: "right"
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: clear
+      - table: test_table
+        column: data2
+        action: clear
+      - table: test_table
+        column: data3
+        action: clear
+      - table: test_table
+        column: data4
+        action: clear
+  - client_id: owner
+    columns:
+      - table: test_table
+        column: data4
+        action: masked
+`
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	testcases := []struct {
+		column   string
+		clientID string
+		zoneID   string
+		withZone bool
+	}{
+		// decrypted with keys of the column's ClientID
+		{"data1", "owner", "", false},
+		// decrypted with keys of the column's ZoneID
+		{"data2", "support", "DDDDDDDDHCzqZAZNbBvybWLR", true},
+		// encrypted with ClientID of the connection
+		{"data3", "support", "", false},
+		// action for the owner doesn't override action for ClientID of the connection
+		{"data4", "owner", "", false},
+	}
+	processor := NewProcessor()
+	for i, tcase := range testcases {
+		setting := tableSchema.GetColumnEncryptionSettings(tcase.column)
+		ctx := encryptor.NewContextWithEncryptionSetting(context.Background(), setting)
+		ctx = base.SetAccessContextToContext(ctx, base.NewAccessContext(base.WithClientID([]byte("support"))))
+		ctx, _, err := processor.OnColumn(ctx, []byte("some data"))
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		accessContext := base.AccessContextFromContext(ctx)
+		if string(accessContext.GetClientID()) != tcase.clientID || string(accessContext.GetZoneID()) != tcase.zoneID ||
+			accessContext.IsWithZone() != tcase.withZone {
+			t.Fatalf("[%d] Expect client_id=%s, zone_id=%s, with zone=%t, took %s, %s, %t\n", i, tcase.clientID, tcase.zoneID,
+				tcase.withZone, accessContext.GetClientID(), accessContext.GetZoneID(), accessContext.IsWithZone())
+		}
+		if action := ActionFromContext(ctx, setting); action != config.AccessActionClear {
+			t.Fatalf("[%d] Expect clear action, took %q\n", i, action)
+		}
+	}
+}
+
+func TestProcessorLogsDecisionOncePerQuery(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: clear
+`
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.Out = output
+	ctx := encryptor.NewContextWithEncryptionSetting(context.Background(), schemaStore.GetTableSchema("test_table").GetColumnEncryptionSettings("data1"))
+	ctx = base.SetAccessContextToContext(ctx, base.NewAccessContext(base.WithClientID([]byte("support"))))
+	ctx = logging.SetLoggerToContext(ctx, logrus.NewEntry(logger))
+
+	processor := NewProcessor()
+	for query := 0; query < 2; query++ {
+		if _, _, err := processor.OnQuery(ctx, nil); err != nil {
+			t.Fatal(err)
+		}
+		for row := 0; row < 3; row++ {
+			if _, _, err := processor.OnColumn(ctx, []byte("some data")); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if count := strings.Count(output.String(), "Column access decided by access policy"); count != 2 {
+		t.Fatalf("Expect one logged decision per query, took %d\n", count)
+	}
+}
@@ -19,6 +19,9 @@ const (
 	EncryptionTypeFail    = "fail"
 )
 
+// AccessPolicyActionLabel is label of action of access policy applied to the column
+const AccessPolicyActionLabel = "action"
+
 // Labels and values about db type in processing
 const (
 	DecryptionDBLabel      = "db"
@@ -41,6 +44,13 @@ var (
 			Help: "number of encryptions data to AcraStruct",
 		}, []string{EncryptionTypeLabel})
 
+	// AccessPolicyDecisionCounter collect count of column values processed with non-default actions of access policies
+	AccessPolicyDecisionCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "acraserver_access_policy_decisions_total",
+			Help: "number of column values processed with non-default actions of access policies",
+		}, []string{AccessPolicyActionLabel})
+
 	// ResponseProcessingTimeHistogram collect metrics about response processing time
 	ResponseProcessingTimeHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "acraserver_response_processing_seconds",
@@ -64,6 +74,7 @@ func RegisterDbProcessingMetrics() {
 	dbRegisterLock.Do(func() {
 		prometheus.MustRegister(ResponseProcessingTimeHistogram)
 		prometheus.MustRegister(RequestProcessingTimeHistogram)
+		prometheus.MustRegister(AccessPolicyDecisionCounter)
 	})
 }
 
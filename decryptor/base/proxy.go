@@ -23,6 +23,7 @@ import (
 	"net"
 
 	acracensor "github.com/cossacklabs/acra/acra-censor"
+	censorCommon "github.com/cossacklabs/acra/acra-censor/common"
 	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/keystore"
 	"github.com/cossacklabs/acra/sqlparser"
@@ -123,6 +124,12 @@ type ClientSession interface {
 	SetProtocolState(state interface{})
 }
 
+// NewCensorAccessContext returns AcraCensor's access context with ClientID of the connection and TLS identity of
+// the client connection
+func NewCensorAccessContext(ctx context.Context, clientConnection net.Conn) *censorCommon.AccessContext {
+	return censorCommon.NewAccessContext(AccessContextFromContext(ctx).GetClientID(), network.GetTLSIdentity(clientConnection))
+}
+
 // TLSConnectionWrapper used by proxy to wrap raw connections to TLS when intercepts client/database request about switching to TLS
 // Reuse network.ConnectionWrapper to explicitly force TLS usage by name
 type TLSConnectionWrapper interface {
@@ -0,0 +1,140 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package type_awareness returns decrypted data of the columns with the data type configured in encryptor config
+// instead of raw bytes and applies on_fail setting if data can't be decrypted.
+package type_awareness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cossacklabs/acra/crypto"
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/hmac"
+	"github.com/cossacklabs/acra/logging"
+)
+
+// EncodingError returned when column value can't be returned to the client with configured data type
+type EncodingError struct {
+	column string
+}
+
+// NewEncodingError return new EncodingError for the column
+func NewEncodingError(column string) *EncodingError {
+	return &EncodingError{column: column}
+}
+
+// Error implementation of error interface
+func (err *EncodingError) Error() string {
+	return fmt.Sprintf("encoding error in column %q", err.column)
+}
+
+// IsEncodingError return true if err is EncodingError or wraps it
+func IsEncodingError(err error) bool {
+	var encodingError *EncodingError
+	return errors.As(err, &encodingError)
+}
+
+// ValueEncoder encodes plaintext value into database specific text or binary format of the data type
+type ValueEncoder interface {
+	EncodeValue(dataType config.EncryptedDataType, value []byte, binaryFormat bool) ([]byte, error)
+}
+
+// IsEncryptedData return true if data is serialized or old style crypto container with optional searchable hash
+func IsEncryptedData(data []byte) bool {
+	if hash := hmac.ExtractHash(data); hash != nil {
+		data = data[hash.Length():]
+	}
+	return crypto.NewRegistryHandler(nil).MatchDataSignature(data)
+}
+
+// Processor checks that column data was decrypted, applies on_fail setting and encodes value with configured data type.
+// Should be registered after all decryption subscribers
+type Processor struct {
+	encoder ValueEncoder
+}
+
+// NewProcessor return new Processor which uses encoder for database specific format
+func NewProcessor(encoder ValueEncoder) *Processor {
+	return &Processor{encoder: encoder}
+}
+
+// ID return name of processor
+func (p *Processor) ID() string {
+	return "TypeAwarenessProcessor"
+}
+
+// OnColumn encode decrypted data according to column's data type or apply on_fail action
+func (p *Processor) OnColumn(ctx context.Context, data []byte) (context.Context, []byte, error) {
+	setting, ok := encryptor.EncryptionSettingFromContext(ctx)
+	// nil used for NULL values
+	if !ok || data == nil {
+		return ctx, data, nil
+	}
+	dataType := setting.GetEncryptedDataType()
+	onFail := setting.GetResponseOnFail()
+	if dataType == config.EncryptedDataTypeDefault && onFail == config.ResponseOnFailCiphertext {
+		return ctx, data, nil
+	}
+	binaryFormat := false
+	if columnInfo, ok := base.ColumnInfoFromContext(ctx); ok {
+		binaryFormat = columnInfo.IsBinaryFormat()
+	}
+	logger := logging.GetLoggerFromContext(ctx).WithField("column", setting.ColumnName())
+	value := data
+	if IsEncryptedData(data) {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingDataTypeValueNotDecrypted).
+			WithField("on_fail", onFail).Warningln("Column data wasn't decrypted")
+		switch onFail {
+		case config.ResponseOnFailCiphertext:
+			if dataType == config.EncryptedDataTypeDefault {
+				return ctx, data, nil
+			}
+			// return ciphertext as is with binary compatible types
+			encoded, err := p.encoder.EncodeValue(config.EncryptedDataTypeBytes, data, binaryFormat)
+			if err != nil {
+				return ctx, data, NewEncodingError(setting.ColumnName())
+			}
+			return ctx, encoded, nil
+		case config.ResponseOnFailDefaultValue:
+			value = []byte(*setting.GetDefaultDataValue())
+		default:
+			return ctx, data, NewEncodingError(setting.ColumnName())
+		}
+	}
+	if dataType == config.EncryptedDataTypeDefault {
+		return ctx, value, nil
+	}
+	if err := config.ValidateDataTypeValue(dataType, value); err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingCantEncodeDataTypeValue).
+			WithField("data_type", dataType).WithError(err).Warningln("Decrypted value doesn't match data type")
+		if onFail != config.ResponseOnFailDefaultValue {
+			return ctx, data, NewEncodingError(setting.ColumnName())
+		}
+		value = []byte(*setting.GetDefaultDataValue())
+	}
+	encoded, err := p.encoder.EncodeValue(dataType, value, binaryFormat)
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingCantEncodeDataTypeValue).
+			WithField("data_type", dataType).WithError(err).Errorln("Can't encode value with data type")
+		return ctx, data, NewEncodingError(setting.ColumnName())
+	}
+	return ctx, encoded, nil
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package type_awareness
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
+)
+
+// prefixEncoder marks encoded values with data type to check that encoder was called with expected type
+type prefixEncoder struct{}
+
+func (prefixEncoder) EncodeValue(dataType config.EncryptedDataType, value []byte, binaryFormat bool) ([]byte, error) {
+	return append([]byte(dataType+":"), value...), nil
+}
+
+func TestProcessorOnColumn(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+      - data3
+    encrypted:
+      - column: data1
+        data_type: int32
+      - column: data2
+        data_type: int32
+        on_fail: default_value
+        default_data_value: "-1"
+      - column: data3
+`
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	type testcase struct {
+		column   string
+		data     []byte
+		expected []byte
+		err      bool
+	}
+	testcases := []testcase{
+		{"data1", []byte("123"), []byte("int32:123"), false},
+		// NULL values returned as is
+		{"data1", nil, nil, false},
+		{"data1", []byte("not a number"), nil, true},
+		{"data2", []byte("123"), []byte("int32:123"), false},
+		{"data2", []byte("not a number"), []byte("int32:-1"), false},
+		// columns without data type returned as is
+		{"data3", []byte("some data"), []byte("some data"), false},
+	}
+	processor := NewProcessor(prefixEncoder{})
+	for i, tcase := range testcases {
+		ctx := encryptor.NewContextWithEncryptionSetting(context.Background(), tableSchema.GetColumnEncryptionSettings(tcase.column))
+		_, output, err := processor.OnColumn(ctx, tcase.data)
+		if tcase.err {
+			if !IsEncodingError(err) {
+				t.Fatalf("[%d] Expect EncodingError, took %v\n", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if !bytes.Equal(output, tcase.expected) {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.expected, output)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mysql
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"github.com/cossacklabs/acra/encryptor/config"
+)
+
+// Column definition flags https://dev.mysql.com/doc/internals/en/com-query-response.html#column-definition
+const (
+	binaryFlag uint16 = 0x0080
+	blobFlag   uint16 = 0x0010
+	numFlag    uint16 = 0x8000
+)
+
+// Character sets used in column definitions of the columns with configured data type
+const (
+	binaryCharset  uint16 = 63
+	utf8mb4Charset uint16 = 45
+)
+
+// mysqlTypeDescription column definition values used to replace description of encrypted column
+type mysqlTypeDescription struct {
+	fieldType    Type
+	charset      uint16
+	columnLength uint32
+	setFlags     uint16
+	resetFlags   uint16
+}
+
+var mysqlDataTypeDescriptions = map[config.EncryptedDataType]mysqlTypeDescription{
+	config.EncryptedDataTypeInt32:     {fieldType: TypeLong, charset: binaryCharset, columnLength: 11, setFlags: numFlag | binaryFlag, resetFlags: blobFlag},
+	config.EncryptedDataTypeInt64:     {fieldType: TypeLongLong, charset: binaryCharset, columnLength: 20, setFlags: numFlag | binaryFlag, resetFlags: blobFlag},
+	config.EncryptedDataTypeDate:      {fieldType: TypeDate, charset: binaryCharset, columnLength: 10, setFlags: binaryFlag, resetFlags: blobFlag},
+	config.EncryptedDataTypeTimestamp: {fieldType: TypeDatetime, charset: binaryCharset, columnLength: 26, setFlags: binaryFlag, resetFlags: blobFlag},
+	config.EncryptedDataTypeNumeric:   {fieldType: TypeNewDecimal, charset: binaryCharset, columnLength: 66, setFlags: numFlag | binaryFlag, resetFlags: blobFlag},
+	config.EncryptedDataTypeString:    {fieldType: TypeBlob, charset: utf8mb4Charset, resetFlags: binaryFlag},
+}
+
+// ErrUnsupportedDataType returned for data types without MySQL encoding
+var ErrUnsupportedDataType = errors.New("unsupported data type")
+
+const mysqlDatetimeTextLayout = "2006-01-02 15:04:05.999999"
+
+// DataEncoder encodes decrypted values into MySQL text or binary protocol format of configured data type
+type DataEncoder struct{}
+
+// EncodeValue implementation of type_awareness.ValueEncoder. Value expected to be validated by config.ValidateDataTypeValue.
+// Binary values of int32/int64 types returned as fixed size integers, other values should be written as length encoded strings
+func (DataEncoder) EncodeValue(dataType config.EncryptedDataType, value []byte, binaryFormat bool) ([]byte, error) {
+	switch dataType {
+	case config.EncryptedDataTypeString, config.EncryptedDataTypeBytes, config.EncryptedDataTypeNumeric:
+		return value, nil
+	case config.EncryptedDataTypeInt32, config.EncryptedDataTypeInt64:
+		bitSize := 64
+		if dataType == config.EncryptedDataTypeInt32 {
+			bitSize = 32
+		}
+		intValue, err := strconv.ParseInt(string(value), 10, bitSize)
+		if err != nil {
+			return nil, err
+		}
+		if !binaryFormat {
+			return []byte(strconv.FormatInt(intValue, 10)), nil
+		}
+		output := make([]byte, bitSize/8)
+		if bitSize == 32 {
+			binary.LittleEndian.PutUint32(output, uint32(intValue))
+		} else {
+			binary.LittleEndian.PutUint64(output, uint64(intValue))
+		}
+		return output, nil
+	case config.EncryptedDataTypeDate:
+		date, err := config.ParseDate(value)
+		if err != nil {
+			return nil, err
+		}
+		if !binaryFormat {
+			return []byte(date.Format(config.DateLayout)), nil
+		}
+		// year[2] + month[1] + day[1]
+		// https://dev.mysql.com/doc/internals/en/binary-protocol-value.html
+		output := make([]byte, 4)
+		binary.LittleEndian.PutUint16(output, uint16(date.Year()))
+		output[2] = byte(date.Month())
+		output[3] = byte(date.Day())
+		return output, nil
+	case config.EncryptedDataTypeTimestamp:
+		timestamp, err := config.ParseTimestamp(value)
+		if err != nil {
+			return nil, err
+		}
+		if !binaryFormat {
+			return []byte(timestamp.Format(mysqlDatetimeTextLayout)), nil
+		}
+		// year[2] + month[1] + day[1] + hour[1] + minute[1] + second[1] + optional microseconds[4]
+		output := make([]byte, 7, 11)
+		binary.LittleEndian.PutUint16(output, uint16(timestamp.Year()))
+		output[2] = byte(timestamp.Month())
+		output[3] = byte(timestamp.Day())
+		output[4] = byte(timestamp.Hour())
+		output[5] = byte(timestamp.Minute())
+		output[6] = byte(timestamp.Second())
+		if microseconds := timestamp.Nanosecond() / 1000; microseconds > 0 {
+			output = output[:11]
+			binary.LittleEndian.PutUint32(output[7:], uint32(microseconds))
+		}
+		return output, nil
+	}
+	return nil, ErrUnsupportedDataType
+}
+
+// isFixedSizeDataType return true if value of data type encoded in binary protocol as fixed size value instead of
+// length encoded string
+func isFixedSizeDataType(dataType config.EncryptedDataType) bool {
+	return dataType == config.EncryptedDataTypeInt32 || dataType == config.EncryptedDataTypeInt64
+}
+
+// updateFieldDataType replace description of the field according to configured data type and return true if
+// field was changed
+func updateFieldDataType(field *ColumnDescription, dataType config.EncryptedDataType) bool {
+	description, ok := mysqlDataTypeDescriptions[dataType]
+	if !ok {
+		return false
+	}
+	field.Type = description.fieldType
+	field.Charset = description.charset
+	if description.columnLength > 0 {
+		field.ColumnLength = description.columnLength
+	}
+	field.Flag = (field.Flag &^ description.resetFlags) | description.setFlags
+	field.Decimal = 0
+	field.changed = true
+	return true
+}
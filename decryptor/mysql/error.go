@@ -38,10 +38,26 @@ func newQueryInterruptedError() *SQLError {
 	return e
 }
 
+// Unknown error code constants used for errors generated by AcraServer.
+const (
+	// https://dev.mysql.com/doc/refman/5.5/en/error-messages-server.html#error_er_unknown_error
+	ErUnknownErrorCode  = 1105
+	ErUnknownErrorState = "HY000"
+)
+
 // NewQueryInterruptedError return packed QueryInterrupted error
 // https://dev.mysql.com/doc/internals/en/packet-ERR_Packet.html
 func NewQueryInterruptedError(isProtocol41 bool) []byte {
-	mysqlError := newQueryInterruptedError()
+	return packSQLError(newQueryInterruptedError(), isProtocol41)
+}
+
+// NewUnknownError return packed error with unknown error code and message
+// https://dev.mysql.com/doc/internals/en/packet-ERR_Packet.html
+func NewUnknownError(message string, isProtocol41 bool) []byte {
+	return packSQLError(&SQLError{Code: ErUnknownErrorCode, State: ErUnknownErrorState, Message: message}, isProtocol41)
+}
+
+func packSQLError(mysqlError *SQLError, isProtocol41 bool) []byte {
 	var data []byte
 	if isProtocol41 {
 		// 1 byte ErrPacket flag + 2 bytes of error code = 3
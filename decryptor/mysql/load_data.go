@@ -0,0 +1,346 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/cossacklabs/acra/sqlparser"
+)
+
+// LocalInfileRequestPacket first byte of the packet with the name of the file requested by the database for
+// LOAD DATA LOCAL INFILE https://dev.mysql.com/doc/internals/en/com-query-response.html#packet-Protocol::LOCAL_INFILE_Request
+const LocalInfileRequestPacket = 0xfb
+
+// ErrUnsupportedLoadDataFormat returned for LOAD DATA statements with options that can't be processed
+var ErrUnsupportedLoadDataFormat = errors.New("unsupported format of LOAD DATA rows")
+
+// loadDataEscapeSequences maps characters following the escape character to the decoded values
+// https://dev.mysql.com/doc/refman/8.0/en/load-data.html#load-data-field-line-handling
+var loadDataEscapeSequences = map[byte]byte{
+	'0': 0,
+	'b': '\b',
+	'n': '\n',
+	'r': '\r',
+	't': '\t',
+	'Z': 26,
+}
+
+// loadDataEncodedSequences maps characters that can't be written as is to the characters used after the escape
+// character
+var loadDataEncodedSequences = map[byte]byte{
+	0:    '0',
+	'\n': 'n',
+	'\r': 'r',
+	'\t': 't',
+	26:   'Z',
+}
+
+// loadDataFormat describes encoding of the rows of the file loaded by LOAD DATA LOCAL INFILE statement
+type loadDataFormat struct {
+	fieldTerminator []byte
+	lineTerminator  []byte
+	enclosure       byte
+	enclosed        bool
+	escape          byte
+	escaped         bool
+	ignoreLines     int
+}
+
+// newLoadDataFormat return loadDataFormat configured with FIELDS, LINES and IGNORE clauses of the statement
+func newLoadDataFormat(statement *sqlparser.LoadData) (*loadDataFormat, error) {
+	format := &loadDataFormat{fieldTerminator: []byte{'\t'}, lineTerminator: []byte{'\n'}, escape: '\\', escaped: true}
+	if statement.FieldsTerminatedBy != nil {
+		format.fieldTerminator = statement.FieldsTerminatedBy.Val
+	}
+	if statement.LinesTerminatedBy != nil {
+		format.lineTerminator = statement.LinesTerminatedBy.Val
+	}
+	// fixed-width rows and prefixes of lines are not supported
+	if len(format.fieldTerminator) == 0 || len(format.lineTerminator) == 0 {
+		return nil, ErrUnsupportedLoadDataFormat
+	}
+	if statement.LinesStartingBy != nil && len(statement.LinesStartingBy.Val) > 0 {
+		return nil, ErrUnsupportedLoadDataFormat
+	}
+	if statement.EnclosedBy != nil {
+		switch len(statement.EnclosedBy.Val) {
+		case 0:
+		case 1:
+			format.enclosure = statement.EnclosedBy.Val[0]
+			format.enclosed = true
+		default:
+			return nil, ErrUnsupportedLoadDataFormat
+		}
+	}
+	if statement.EscapedBy != nil {
+		switch len(statement.EscapedBy.Val) {
+		case 0:
+			format.escape, format.escaped = 0, false
+		case 1:
+			format.escape = statement.EscapedBy.Val[0]
+		default:
+			return nil, ErrUnsupportedLoadDataFormat
+		}
+	}
+	if statement.IgnoreLines != nil {
+		lines, err := strconv.Atoi(string(statement.IgnoreLines.Val))
+		if err != nil {
+			return nil, ErrUnsupportedLoadDataFormat
+		}
+		format.ignoreLines = lines
+	}
+	return format, nil
+}
+
+// loadDataField is a field of the row in encoded form and decoded value, value is nil for NULL
+type loadDataField struct {
+	raw   []byte
+	value []byte
+}
+
+// terminator kinds found after the field
+const (
+	loadDataNoTerminator = iota
+	loadDataFieldTerminator
+	loadDataLineTerminator
+)
+
+// matchTerminator return kind and length of the terminator at the start of data. needMore is true if data is the
+// beginning of the terminator and next data should be read to check it
+func (format *loadDataFormat) matchTerminator(data []byte, final bool) (kind, length int, needMore bool) {
+	// line terminator has priority as in MySQL
+	terminators := []struct {
+		kind  int
+		value []byte
+	}{
+		{loadDataLineTerminator, format.lineTerminator},
+		{loadDataFieldTerminator, format.fieldTerminator},
+	}
+	for _, terminator := range terminators {
+		if bytes.HasPrefix(data, terminator.value) {
+			return terminator.kind, len(terminator.value), false
+		}
+		if !final && len(data) < len(terminator.value) && bytes.HasPrefix(terminator.value, data) {
+			return loadDataNoTerminator, 0, true
+		}
+	}
+	return loadDataNoTerminator, 0, false
+}
+
+// readField return the first field of data, length of the field with terminator and kind of the terminator.
+// complete is false if data doesn't contain the whole field and next data should be read
+func (format *loadDataFormat) readField(data []byte, final bool) (field loadDataField, length, terminator int, complete bool) {
+	value := make([]byte, 0, len(data))
+	i := 0
+	inEnclosure := format.enclosed && len(data) > 0 && data[0] == format.enclosure
+	if inEnclosure {
+		i = 1
+	}
+	for {
+		if i >= len(data) {
+			if !final {
+				return field, 0, 0, false
+			}
+			field = format.newField(data[:i], value)
+			return field, i, loadDataNoTerminator, true
+		}
+		c := data[i]
+		if format.escaped && c == format.escape {
+			if i+1 >= len(data) {
+				if !final {
+					return field, 0, 0, false
+				}
+				value = append(value, c)
+				i++
+				continue
+			}
+			if decoded, ok := loadDataEscapeSequences[data[i+1]]; ok {
+				value = append(value, decoded)
+			} else {
+				value = append(value, data[i+1])
+			}
+			i += 2
+			continue
+		}
+		if inEnclosure {
+			if c != format.enclosure {
+				value = append(value, c)
+				i++
+				continue
+			}
+			if i+1 >= len(data) && !final {
+				return field, 0, 0, false
+			}
+			// doubled enclosure character is the part of the value
+			if i+1 < len(data) && data[i+1] == format.enclosure {
+				value = append(value, c)
+				i += 2
+				continue
+			}
+			// enclosure character closes the field only before terminator or at the end of data
+			kind, n, needMore := format.matchTerminator(data[i+1:], final)
+			if needMore {
+				return field, 0, 0, false
+			}
+			if kind != loadDataNoTerminator || i+1 == len(data) {
+				field = format.newField(data[:i+1], value)
+				return field, i + 1 + n, kind, true
+			}
+			value = append(value, c)
+			i++
+			continue
+		}
+		kind, n, needMore := format.matchTerminator(data[i:], final)
+		if needMore {
+			return field, 0, 0, false
+		}
+		if kind != loadDataNoTerminator {
+			field = format.newField(data[:i], value)
+			return field, i + n, kind, true
+		}
+		value = append(value, c)
+		i++
+	}
+}
+
+// newField return field with decoded value or nil value for NULL
+func (format *loadDataFormat) newField(raw, value []byte) loadDataField {
+	// \N and NULL without escape character are NULL only if not enclosed
+	isNull := (format.escaped && len(raw) == 2 && raw[0] == format.escape && raw[1] == 'N') ||
+		(!format.escaped && string(raw) == "NULL")
+	if isNull {
+		return loadDataField{raw: raw}
+	}
+	return loadDataField{raw: raw, value: value}
+}
+
+// readRow return fields of the first row of data, length of the row with line terminator and length of the row
+// without line terminator. complete is false if data doesn't contain the whole row and next data should be read
+func (format *loadDataFormat) readRow(data []byte, final bool) (fields []loadDataField, length, lineLength int, complete bool) {
+	pos := 0
+	for {
+		field, n, terminator, ok := format.readField(data[pos:], final)
+		if !ok {
+			return nil, 0, 0, false
+		}
+		fields = append(fields, field)
+		switch terminator {
+		case loadDataFieldTerminator:
+			pos += n
+		case loadDataLineTerminator:
+			return fields, pos + n, pos + len(field.raw), true
+		default:
+			return fields, pos + n, pos + n, true
+		}
+	}
+}
+
+// encodeField encode value of the field to write it into the row
+func (format *loadDataFormat) encodeField(value []byte) []byte {
+	output := make([]byte, 0, len(value)+2)
+	if format.enclosed {
+		output = append(output, format.enclosure)
+	}
+	for _, c := range value {
+		if format.escaped {
+			if encoded, ok := loadDataEncodedSequences[c]; ok {
+				output = append(output, format.escape, encoded)
+				continue
+			}
+			if c == format.escape || (format.enclosed && c == format.enclosure) ||
+				c == format.fieldTerminator[0] || c == format.lineTerminator[0] {
+				output = append(output, format.escape, c)
+				continue
+			}
+		} else if c == format.enclosure {
+			// without escape character value written only enclosed and enclosure character should be doubled
+			output = append(output, c)
+		}
+		output = append(output, c)
+	}
+	if format.enclosed {
+		output = append(output, format.enclosure)
+	}
+	return output
+}
+
+// loadDataRowFunc process fields of the row and returns new values of changed fields as map of field index to the
+// decoded value or nil if row wasn't changed
+type loadDataRowFunc func(ctx context.Context, fields []loadDataField) (map[int][]byte, error)
+
+// loadDataStream accumulates content of the file sent by client and splits it into rows because packets may contain
+// several rows or the part of row
+type loadDataStream struct {
+	format    *loadDataFormat
+	buffer    []byte
+	rowCount  int
+	processor loadDataRowFunc
+}
+
+// newLoadDataStream return loadDataStream which process rows with processor
+func newLoadDataStream(format *loadDataFormat, processor loadDataRowFunc) *loadDataStream {
+	return &loadDataStream{format: format, processor: processor}
+}
+
+// Process accumulates data and return processed complete rows. The last row without line terminator is returned only
+// if final is true
+func (stream *loadDataStream) Process(ctx context.Context, data []byte, final bool) ([]byte, error) {
+	stream.buffer = append(stream.buffer, data...)
+	output := make([]byte, 0, len(stream.buffer))
+	for len(stream.buffer) > 0 {
+		fields, length, lineLength, complete := stream.format.readRow(stream.buffer, final)
+		if !complete {
+			break
+		}
+		newRow, err := stream.processRow(ctx, stream.buffer[:length], lineLength, fields)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, newRow...)
+		stream.buffer = stream.buffer[length:]
+	}
+	// don't keep references to processed data
+	stream.buffer = append([]byte{}, stream.buffer...)
+	return output, nil
+}
+
+// processRow return row with changed fields or row as is
+func (stream *loadDataStream) processRow(ctx context.Context, row []byte, lineLength int, fields []loadDataField) ([]byte, error) {
+	stream.rowCount++
+	// lines skipped by IGNORE n LINES
+	if stream.rowCount <= stream.format.ignoreLines {
+		return row, nil
+	}
+	newValues, err := stream.processor(ctx, fields)
+	if err != nil || len(newValues) == 0 {
+		return row, err
+	}
+	encodedFields := make([][]byte, len(fields))
+	for i, field := range fields {
+		if value, ok := newValues[i]; ok {
+			encodedFields[i] = stream.format.encodeField(value)
+			continue
+		}
+		encodedFields[i] = field.raw
+	}
+	newRow := bytes.Join(encodedFields, stream.format.fieldTerminator)
+	return append(newRow, row[lineLength:]...), nil
+}
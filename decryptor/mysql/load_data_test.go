@@ -0,0 +1,276 @@
+package mysql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/cossacklabs/acra/sqlparser/dialect/mysql"
+	"github.com/sirupsen/logrus"
+)
+
+func parseLoadDataStatement(t *testing.T, query string) *sqlparser.LoadData {
+	statement, err := sqlparser.ParseWithDialect(mysql.NewMySQLDialect(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loadData, ok := statement.(*sqlparser.LoadData)
+	if !ok {
+		t.Fatalf("Expect *sqlparser.LoadData, took %T\n", statement)
+	}
+	return loadData
+}
+
+func TestNewLoadDataFormat(t *testing.T) {
+	testcases := []struct {
+		query  string
+		format *loadDataFormat
+		err    error
+	}{
+		{"load data local infile 'a' into table t",
+			&loadDataFormat{fieldTerminator: []byte("\t"), lineTerminator: []byte("\n"), escape: '\\', escaped: true}, nil},
+		{"load data local infile 'a' into table t fields terminated by ',' optionally enclosed by '\"' lines terminated by '\\r\\n' ignore 1 lines",
+			&loadDataFormat{fieldTerminator: []byte(","), lineTerminator: []byte("\r\n"), enclosure: '"', enclosed: true, escape: '\\', escaped: true, ignoreLines: 1}, nil},
+		{"load data local infile 'a' into table t fields terminated by '||' enclosed by '\\'' escaped by ''",
+			&loadDataFormat{fieldTerminator: []byte("||"), lineTerminator: []byte("\n"), enclosure: '\'', enclosed: true}, nil},
+		{"load data local infile 'a' into table t fields terminated by ''", nil, ErrUnsupportedLoadDataFormat},
+		{"load data local infile 'a' into table t lines starting by 'row'", nil, ErrUnsupportedLoadDataFormat},
+		{"load data local infile 'a' into table t fields enclosed by '\"\"'", nil, ErrUnsupportedLoadDataFormat},
+	}
+	for i, tcase := range testcases {
+		format, err := newLoadDataFormat(parseLoadDataStatement(t, tcase.query))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.err, err)
+		}
+		if tcase.format == nil {
+			continue
+		}
+		if !bytes.Equal(format.fieldTerminator, tcase.format.fieldTerminator) || !bytes.Equal(format.lineTerminator, tcase.format.lineTerminator) ||
+			format.enclosure != tcase.format.enclosure || format.enclosed != tcase.format.enclosed || format.escape != tcase.format.escape ||
+			format.escaped != tcase.format.escaped || format.ignoreLines != tcase.format.ignoreLines {
+			t.Fatalf("[%d] Expect %+v, took %+v\n", i, tcase.format, format)
+		}
+	}
+}
+
+func TestLoadDataFormatFields(t *testing.T) {
+	defaultFormat := &loadDataFormat{fieldTerminator: []byte("\t"), lineTerminator: []byte("\n"), escape: '\\', escaped: true}
+	csvFormat := &loadDataFormat{fieldTerminator: []byte(","), lineTerminator: []byte("\r\n"), enclosure: '"', enclosed: true, escape: '\\', escaped: true}
+	notEscapedFormat := &loadDataFormat{fieldTerminator: []byte("||"), lineTerminator: []byte("\n"), enclosure: '\'', enclosed: true}
+	testcases := []struct {
+		format *loadDataFormat
+		row    string
+		values [][]byte
+	}{
+		{defaultFormat, "1\tsome text\t\\N\n", [][]byte{[]byte("1"), []byte("some text"), nil}},
+		{defaultFormat, "\\0\\Z\\n\\t\\\\x\\\t\t\n", [][]byte{[]byte("\x00\x1a\n\t\\x\t"), []byte{}}},
+		{csvFormat, "1,\"with, comma\",\"with \"\"quotes\\\"\",\"\\N\",\\N\r\n", [][]byte{[]byte("1"), []byte("with, comma"), []byte(`with "quotes"`), []byte("N"), nil}},
+		{csvFormat, "\"multi\r\nline\",\"a\"b\"", [][]byte{[]byte("multi\r\nline"), []byte(`a"b`)}},
+		{notEscapedFormat, "1||'a||b\\'||NULL||'NULL'\n", [][]byte{[]byte("1"), []byte(`a||b\`), nil, []byte("NULL")}},
+	}
+	for i, tcase := range testcases {
+		fields, length, _, complete := tcase.format.readRow([]byte(tcase.row), true)
+		if !complete || length != len(tcase.row) {
+			t.Fatalf("[%d] Expect complete row with length %d, took %t %d\n", i, len(tcase.row), complete, length)
+		}
+		if len(fields) != len(tcase.values) {
+			t.Fatalf("[%d] Expect %d fields, took %d\n", i, len(tcase.values), len(fields))
+		}
+		for j, field := range fields {
+			if (field.value == nil) != (tcase.values[j] == nil) || !bytes.Equal(field.value, tcase.values[j]) {
+				t.Fatalf("[%d] Expect %q, took %q\n", i, tcase.values[j], field.value)
+			}
+			if field.value == nil {
+				continue
+			}
+			// encoded value should be decoded to the same value
+			encoded := append(tcase.format.encodeField(field.value), tcase.format.lineTerminator...)
+			decoded, _, _, _ := tcase.format.readRow(encoded, true)
+			if len(decoded) != 1 || !bytes.Equal(decoded[0].value, field.value) {
+				t.Fatalf("[%d] Expect %q after encoding %q, took %+v\n", i, field.value, encoded, decoded)
+			}
+		}
+	}
+}
+
+func TestLoadDataStreamProcess(t *testing.T) {
+	// replace value of the second column with its length to check that rows processed correctly
+	processor := func(ctx context.Context, fields []loadDataField) (map[int][]byte, error) {
+		if len(fields) < 2 || fields[1].value == nil {
+			return nil, nil
+		}
+		return map[int][]byte{1: []byte{byte('0' + len(fields[1].value))}}, nil
+	}
+	testcases := []struct {
+		query  string
+		input  string
+		output string
+	}{
+		{"load data local infile 'a' into table t", "1\tab\n2\t\\N\n3\tabc\\\nd\n4\tab", "1\t2\n2\t\\N\n3\t5\n4\t2"},
+		{"load data local infile 'a' into table t fields terminated by ',' enclosed by '\"' lines terminated by '\\r\\n' ignore 1 lines",
+			"a,b\r\n1,\"a\r\nb\"\r\n2,\"a\"\"b\"\r\n", "a,b\r\n1,\"4\"\r\n2,\"3\"\r\n"},
+		{"load data local infile 'a' into table t fields terminated by '<>' lines terminated by '<>\\n'", "1<>abc<>\n2<>a<b<>\n", "1<>3<>\n2<>3<>\n"},
+	}
+	for i, tcase := range testcases {
+		format, err := newLoadDataFormat(parseLoadDataStatement(t, tcase.query))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// whole data in one packet and the same data split into packets by one byte
+		for _, chunkSize := range []int{len(tcase.input), 1} {
+			stream := newLoadDataStream(format, processor)
+			output := make([]byte, 0, len(tcase.input))
+			for j := 0; j < len(tcase.input); j += chunkSize {
+				rows, err := stream.Process(context.Background(), []byte(tcase.input[j:j+chunkSize]), false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				output = append(output, rows...)
+			}
+			rows, err := stream.Process(context.Background(), nil, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			output = append(output, rows...)
+			if string(output) != tcase.output {
+				t.Fatalf("[%d] Expect %q, took %q\n", i, tcase.output, output)
+			}
+		}
+	}
+}
+
+type testLoadDataObserver struct{}
+
+func (testLoadDataObserver) ID() string {
+	return "testLoadDataObserver"
+}
+
+func (testLoadDataObserver) OnQuery(ctx context.Context, data base.OnQueryObject) (base.OnQueryObject, bool, error) {
+	return data, false, nil
+}
+
+// OnBind replace value of the second column with binary data
+func (testLoadDataObserver) OnBind(ctx context.Context, statement sqlparser.Statement, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+	if _, ok := statement.(*sqlparser.LoadData); !ok || len(values) < 2 || values[1].GetData(nil) == nil {
+		return values, false, nil
+	}
+	newValues := make([]base.BoundValue, len(values))
+	copy(newValues, values)
+	newValues[1] = NewMysqlCopyTextBoundValue(append([]byte{0, '\t', '\n'}, values[1].GetData(nil)...), base.TextFormat, TypeString)
+	return newValues, true, nil
+}
+
+// testWriteConnection stores data written into connection
+type testWriteConnection struct {
+	net.Conn
+	buffer bytes.Buffer
+}
+
+func (conn *testWriteConnection) Write(data []byte) (int, error) {
+	return conn.buffer.Write(data)
+}
+
+func TestLocalInfilePackets(t *testing.T) {
+	observerManager, err := base.NewArrayQueryObservableManager(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	observerManager.AddQueryObserver(testLoadDataObserver{})
+	dbConnection := &testWriteConnection{}
+	handler := &Handler{queryObserverManager: observerManager, dbConnection: dbConnection}
+	logger := logrus.NewEntry(logrus.New())
+	parser := sqlparser.New(sqlparser.ModeStrict)
+
+	handler.localInfile = handler.newLocalInfileStream(base.NewOnQueryObjectFromQuery("load data infile 'a' into table t", parser), logger)
+	if handler.localInfile != nil {
+		t.Fatal("Expect nil stream for LOAD DATA without LOCAL")
+	}
+	handler.localInfile = handler.newLocalInfileStream(base.NewOnQueryObjectFromQuery("load data local infile 'a' into table t", parser), logger)
+	if handler.localInfile == nil {
+		t.Fatal("Expect stream for LOAD DATA LOCAL INFILE")
+	}
+	handler.localInfileSequenceID = -1
+
+	// client sends the row in two packets and the empty packet at the end
+	for i, data := range []string{"1\ta", "b\n", ""} {
+		packet := NewPacket()
+		packet.header[SequenceIDIndex] = byte(i + 2)
+		packet.SetData([]byte(data))
+		if err := handler.handleLocalInfilePacket(context.Background(), packet, logger); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if handler.localInfile != nil {
+		t.Fatal("Expect reset stream after the end of file")
+	}
+	// the first packet contained only the part of row, so the row and the empty packet sent in two packets
+	expectedRow := []byte("1\t\\0\\t\\nab\n")
+	expected := append([]byte{byte(len(expectedRow)), 0, 0, 2}, expectedRow...)
+	expected = append(expected, 0, 0, 0, 3)
+	if !bytes.Equal(dbConnection.buffer.Bytes(), expected) {
+		t.Fatalf("Expect %q, took %q\n", expected, dbConnection.buffer.Bytes())
+	}
+	// client sent the last packet with sequence id 4 and expects response with 5 while database responds with 4
+	if handler.localInfileSequenceShift != 1 {
+		t.Fatalf("Expect sequence id shift 1, took %d\n", handler.localInfileSequenceShift)
+	}
+}
+
+// testFailedLoadDataObserver fails to process rows with err
+type testFailedLoadDataObserver struct {
+	testLoadDataObserver
+	err error
+}
+
+func (observer testFailedLoadDataObserver) OnBind(ctx context.Context, statement sqlparser.Statement, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+	return nil, false, observer.err
+}
+
+func TestLocalInfileAbort(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	logger := logrus.NewEntry(logrus.New())
+	query := base.NewOnQueryObjectFromQuery("load data local infile 'a' into table t", parser)
+	testcases := []struct {
+		observer base.QueryObserver
+		data     string
+	}{
+		// observer failed to encrypt the row
+		{testFailedLoadDataObserver{err: errors.New("can't encrypt value")}, "1\ta\n"},
+		// row with another count of values than columns of the table
+		{testFailedLoadDataObserver{err: encryptor.ErrRowValuesCountMismatch}, "1\ta\tb\n"},
+	}
+	for i, tcase := range testcases {
+		observerManager, err := base.NewArrayQueryObservableManager(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		observerManager.AddQueryObserver(tcase.observer)
+		dbConnection := &testWriteConnection{}
+		clientConnection := &testWriteConnection{}
+		handler := &Handler{queryObserverManager: observerManager, dbConnection: dbConnection, clientConnection: clientConnection}
+		handler.localInfile = handler.newLocalInfileStream(query, logger)
+		handler.localInfileSequenceID = -1
+
+		packet := NewPacket()
+		packet.header[SequenceIDIndex] = 2
+		packet.SetData([]byte(tcase.data))
+		if err := handler.handleLocalInfilePacket(context.Background(), packet, logger); err == nil {
+			t.Fatalf("[%d] Expect error on rows which can't be processed\n", i)
+		}
+		if dbConnection.buffer.Len() != 0 {
+			t.Fatalf("[%d] Expect no data sent to the database, took %q\n", i, dbConnection.buffer.Bytes())
+		}
+		response := clientConnection.buffer.Bytes()
+		if len(response) < 5 || response[SequenceIDIndex] != 3 || response[PacketHeaderSize] != ErrPacket {
+			t.Fatalf("[%d] Expect error packet sent to the client, took %q\n", i, response)
+		}
+		if handler.localInfile != nil {
+			t.Fatalf("[%d] Expect reset stream after aborted transfer\n", i)
+		}
+	}
+}
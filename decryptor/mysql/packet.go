@@ -20,7 +20,6 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
-	"fmt"
 	"io"
 	"net"
 	"strconv"
@@ -245,10 +244,9 @@ func (packet *Packet) readPacket(connection net.Conn) ([]byte, error) {
 		return nil, err
 	}
 
+	// empty payload is valid for the packet which ends the content of LOAD DATA LOCAL INFILE file or the packet
+	// after payload with max length
 	length := packet.GetPacketPayloadLength()
-	if length < 1 {
-		return nil, fmt.Errorf("invalid payload length %d", length)
-	}
 
 	data := make([]byte, length)
 	if _, err := io.ReadFull(connection, data); err != nil {
@@ -291,7 +289,7 @@ func (packet *Packet) IsEOF() bool {
 
 // IsErr return true if packet has ErrPacket flag
 func (packet *Packet) IsErr() bool {
-	return packet.data[0] == ErrPacket
+	return len(packet.data) > 0 && packet.data[0] == ErrPacket
 }
 
 func (packet *Packet) getServerCapabilities() int {
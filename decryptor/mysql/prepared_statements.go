@@ -10,6 +10,7 @@ import (
 
 	"github.com/cossacklabs/acra/decryptor/base"
 	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/pseudonymization"
 	"github.com/cossacklabs/acra/sqlparser"
 )
 
@@ -203,13 +204,38 @@ func (m *mysqlBoundValue) SetData(newData []byte, setting config.ColumnEncryptio
 			m.paramType = TypeLong
 		case tokens.TokenType_Int64:
 			m.paramType = TypeLongLong
+		case tokens.TokenType_Date, tokens.TokenType_Timestamp:
+			// date/timestamp params are sent in binary format and should be encoded back from text
+			if m.isDateTimeParam() {
+				encoded, err := pseudonymization.EncodeMySQLBinaryValue(setting.GetTokenType(), newData)
+				if err != nil {
+					return err
+				}
+				m.textData = encoded
+			}
 		}
 	}
 	return nil
 }
 
+// isDateTimeParam return true if value has DATE/DATETIME/TIMESTAMP type of binary protocol
+func (m *mysqlBoundValue) isDateTimeParam() bool {
+	switch m.paramType {
+	case TypeDate, TypeNewDate, TypeDatetime, TypeTimestamp:
+		return true
+	}
+	return false
+}
+
 // GetData return BoundValue using ColumnEncryptionSetting if provided
-func (m *mysqlBoundValue) GetData(_ config.ColumnEncryptionSetting) []byte {
+func (m *mysqlBoundValue) GetData(setting config.ColumnEncryptionSetting) []byte {
+	if setting == nil || !setting.IsTokenized() || m.format != base.BinaryFormat || !m.isDateTimeParam() {
+		return m.textData
+	}
+	// tokenizer expects date/timestamp values in text format, invalid value returned as is and rejected by tokenizer
+	if decoded, err := pseudonymization.DecodeMySQLBinaryValue(setting.GetTokenType(), m.textData); err == nil {
+		return decoded
+	}
 	return m.textData
 }
 
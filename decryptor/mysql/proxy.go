@@ -16,6 +16,8 @@ package mysql
 import (
 	"github.com/cossacklabs/acra/crypto"
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/decryptor/base/access_policy"
+	"github.com/cossacklabs/acra/decryptor/base/type_awareness"
 	"github.com/cossacklabs/acra/encryptor"
 	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/hmac"
@@ -64,8 +66,8 @@ func (factory *proxyFactory) New(clientID []byte, clientSession base.ClientSessi
 
 	schemaStore := factory.setting.TableSchemaStore()
 	storeMask := schemaStore.GetGlobalSettingsMask()
-	// register only if masking/tokenization/searching will be used
-	if storeMask&(config.SettingSearchFlag|config.SettingMaskingFlag|config.SettingTokenizationFlag) > 0 {
+	// register only if masking/tokenization/searching/type awareness/access policies will be used
+	if storeMask&(config.SettingSearchFlag|config.SettingMaskingFlag|config.SettingTokenizationFlag|config.SettingDataTypeFlag|config.SettingAccessPolicyFlag) > 0 {
 		// register Query processor first before other processors because it match SELECT queries for ColumnEncryptorConfig structs
 		// and store it in AccessContext for next decryptions/encryptions and all other processors rely on that
 		// use nil dataEncryptor to avoid extra computations
@@ -75,6 +77,15 @@ func (factory *proxyFactory) New(clientID []byte, clientSession base.ClientSessi
 		}
 		proxy.AddQueryObserver(queryEncryptor)
 		proxy.SubscribeOnAllColumnsDecryption(queryEncryptor)
+		if storeMask&config.SettingAccessPolicyFlag == config.SettingAccessPolicyFlag {
+			// should be right after queryEncryptor to reject denied columns before decryption
+			accessPolicyProcessor := access_policy.NewProcessor()
+			proxy.AddQueryObserver(accessPolicyProcessor)
+			proxy.SubscribeOnAllColumnsDecryption(accessPolicyProcessor)
+		}
+		if storeMask&config.SettingDataTypeFlag == config.SettingDataTypeFlag {
+			proxy.SetColumnEncryptionSettingProvider(queryEncryptor)
+		}
 	}
 
 	// poison record processor should be first
@@ -94,16 +105,29 @@ func (factory *proxyFactory) New(clientID []byte, clientSession base.ClientSessi
 
 	chainEncryptors := make([]encryptor.DataEncryptor, 0, 10)
 	if storeMask&config.SettingTokenizationFlag == config.SettingTokenizationFlag {
-		tokenizer, err := pseudonymization.NewDataTokenizer(factory.tokenizer)
+		tokenizer, err := pseudonymization.NewDataTokenizer(factory.tokenizer, factory.keystore)
+		if err != nil {
+			return nil, err
+		}
+
+		decoderProcessor, err := pseudonymization.NewMySQLDataEncoderProcessor(pseudonymization.DataEncoderModeDecode)
 		if err != nil {
 			return nil, err
 		}
+		encoderProcessor, err := pseudonymization.NewMySQLDataEncoderProcessor(pseudonymization.DataEncoderModeEncode)
+		if err != nil {
+			return nil, err
+		}
+		// register before tokenization to decode binary date/timestamp values if need
+		proxy.SubscribeOnAllColumnsDecryption(decoderProcessor)
 
 		tokenProcessor, err := pseudonymization.NewTokenProcessor(tokenizer)
 		if err != nil {
 			return nil, err
 		}
 		proxy.SubscribeOnAllColumnsDecryption(tokenProcessor)
+		// register after tokenization to encode text value to binary if need
+		proxy.SubscribeOnAllColumnsDecryption(encoderProcessor)
 
 		tokenEncryptor, err := pseudonymization.NewTokenEncryptor(tokenizer)
 		if err != nil {
@@ -151,6 +175,12 @@ func (factory *proxyFactory) New(clientID []byte, clientSession base.ClientSessi
 		// added same hmacProcessor to check hmac validation after decryption
 		proxy.SubscribeOnAllColumnsDecryption(hmacProcessor)
 	}
+
+	if storeMask&config.SettingDataTypeFlag == config.SettingDataTypeFlag {
+		// should be after all decryption subscribers to check that data was decrypted
+		proxy.SubscribeOnAllColumnsDecryption(type_awareness.NewProcessor(DataEncoder{}))
+	}
+
 	chainEncryptors = append(chainEncryptors, crypto.NewReEncryptHandler(factory.keystore))
 
 	// register query processors/encryptors only if have some
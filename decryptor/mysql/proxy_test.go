@@ -155,6 +155,10 @@ func (*tableSchemaStore) GetGlobalSettingsMask() config.SettingMask {
 	return config.SettingMask(0)
 }
 
+func (*tableSchemaStore) IsStrictMode() bool {
+	return false
+}
+
 type stubSession struct{}
 
 func (stubSession) Context() context.Context {
@@ -28,10 +28,15 @@ import (
 	"io"
 	"net"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/cossacklabs/acra/acra-censor"
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/decryptor/base/access_policy"
+	"github.com/cossacklabs/acra/decryptor/base/type_awareness"
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/logging"
 	"github.com/cossacklabs/acra/network"
 	"github.com/prometheus/client_golang/prometheus"
@@ -183,6 +188,17 @@ type Handler struct {
 	parser                  *sqlparser.Parser
 	protocolState           *ProtocolState
 	registry                *PreparedStatementRegistry
+	// used to return decrypted data with configured data types
+	columnSettingProvider encryptor.ColumnEncryptionSettingProvider
+	// rows of the file sent by client for LOAD DATA LOCAL INFILE statement
+	localInfile *loadDataStream
+	// sequence id of the next packet with file content sent to the database, -1 until the first packet
+	localInfileSequenceID int
+	// difference between sequence ids of the last packets sent by client and sent to the database, used to fix sequence
+	// id of the database response after the file transfer. Accessed atomically
+	localInfileSequenceShift int32
+	// true if the database requested the file and the next packet is the response after the file transfer
+	localInfileRequested bool
 }
 
 // NewMysqlProxy returns new Handler
@@ -230,12 +246,30 @@ func (handler *Handler) Unsubscribe(subscriber base.DecryptionSubscriber) {
 	handler.decryptionObserver.Unsubscribe(subscriber)
 }
 
-func (handler *Handler) onColumnDecryption(parentCtx context.Context, column int, data []byte) ([]byte, error) {
+func (handler *Handler) onColumnDecryption(parentCtx context.Context, column int, data []byte, binaryFormat bool) ([]byte, error) {
 	accessContext := base.AccessContextFromContext(parentCtx)
-	accessContext.SetColumnInfo(base.NewColumnInfo(column, "", false, len(data)))
+	accessContext.SetColumnInfo(base.NewColumnInfo(column, "", binaryFormat, len(data)))
 	return handler.decryptionObserver.OnColumnDecryption(parentCtx, column, data)
 }
 
+// SetColumnEncryptionSettingProvider set provider of encryption settings of result columns used to update
+// column definitions and encode column values according to configured data types
+func (handler *Handler) SetColumnEncryptionSettingProvider(provider encryptor.ColumnEncryptionSettingProvider) {
+	handler.columnSettingProvider = provider
+}
+
+// getColumnDataType return configured data type of the result column or config.EncryptedDataTypeDefault
+func (handler *Handler) getColumnDataType(index int) config.EncryptedDataType {
+	if handler.columnSettingProvider == nil {
+		return config.EncryptedDataTypeDefault
+	}
+	setting := handler.columnSettingProvider.GetColumnEncryptionSetting(index)
+	if setting == nil {
+		return config.EncryptedDataTypeDefault
+	}
+	return setting.GetEncryptedDataType()
+}
+
 // AddQueryObserver implement QueryObservable interface and proxy call to ObserverManager
 func (handler *Handler) AddQueryObserver(obs base.QueryObserver) {
 	handler.queryObserverManager.AddQueryObserver(obs)
@@ -349,7 +383,26 @@ func (handler *Handler) ProxyClientConnection(ctx context.Context, errCh chan<-
 		handler.clientSequenceNumber = int(packet.GetSequenceNumber())
 		clientLog = clientLog.WithField("sequence_number", handler.clientSequenceNumber)
 		clientLog.Debugln("New packet")
+		// content of the file requested by LOAD DATA LOCAL INFILE continues sequence of the query packets while each
+		// new command starts new sequence
+		if handler.currentCommand == CommandQuery && handler.clientSequenceNumber != 0 {
+			if err := handler.handleLocalInfilePacket(ctx, packet, clientLog); err != nil {
+				errCh <- base.NewClientProxyError(err)
+				return
+			}
+			continue
+		}
 		data := packet.GetData()
+		if len(data) == 0 {
+			clientLog.Debugln("Empty packet")
+			if _, err := handler.dbConnection.Write(packet.Dump()); err != nil {
+				clientLog.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorNetworkWrite).
+					Debugln("Can't write send packet to db")
+				errCh <- base.NewClientProxyError(err)
+				return
+			}
+			continue
+		}
 		cmd := data[0]
 		data = data[1:]
 		handler.currentCommand = cmd
@@ -384,7 +437,7 @@ func (handler *Handler) ProxyClientConnection(ctx context.Context, errCh chan<-
 				}
 			}
 
-			if err := handler.acracensor.HandleQuery(query); err != nil {
+			if err := handler.acracensor.HandleQuery(base.NewCensorAccessContext(ctx, handler.clientConnection), query); err != nil {
 				censorSpan.End()
 				clientLog.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).Errorln("Error on AcraCensor check")
 				errPacket := NewQueryInterruptedError(handler.clientProtocol41)
@@ -403,6 +456,16 @@ func (handler *Handler) ProxyClientConnection(ctx context.Context, errCh chan<-
 					errCh <- base.NewClientProxyError(err)
 					return
 				}
+				// strict mode of encryptor doesn't allow to pass such queries to the database
+				if encryptor.IsUnsupportedEncryptedWriteError(err) {
+					censorSpan.End()
+					packet.SetData(NewUnknownError(err.Error(), handler.clientProtocol41))
+					if _, err := handler.clientConnection.Write(packet.Dump()); err != nil {
+						handler.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorResponseConnectorCantWriteToClient).
+							Errorln("Can't write response with error to client")
+					}
+					continue
+				}
 				clientLog.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptQueryData).Errorln("Error occurred on query handler")
 			} else if changed {
 				packet.replaceQuery(newQuery.Query())
@@ -411,6 +474,9 @@ func (handler *Handler) ProxyClientConnection(ctx context.Context, errCh chan<-
 			switch cmd {
 			case CommandQuery:
 				handler.setQueryHandler(handler.QueryResponseHandler)
+				handler.localInfile = handler.newLocalInfileStream(queryObj, clientLog)
+				handler.localInfileSequenceID = -1
+				atomic.StoreInt32(&handler.localInfileSequenceShift, 0)
 			case CommandStatementPrepare:
 				handler.protocolState.SetPendingParse(queryObj)
 				handler.setQueryHandler(handler.PreparedStatementResponseHandler)
@@ -440,6 +506,105 @@ func (handler *Handler) ProxyClientConnection(ctx context.Context, errCh chan<-
 	}
 }
 
+// newLocalInfileStream return loadDataStream which encrypts rows of the file sent for LOAD DATA LOCAL INFILE statement
+// or nil for other queries
+func (handler *Handler) newLocalInfileStream(query base.OnQueryObject, logger *logrus.Entry) *loadDataStream {
+	statement, err := query.Statement()
+	if err != nil {
+		return nil
+	}
+	loadDataStatement, ok := statement.(*sqlparser.LoadData)
+	if !ok || !loadDataStatement.Local {
+		return nil
+	}
+	format, err := newLoadDataFormat(loadDataStatement)
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
+			Warningln("Can't process rows of LOAD DATA statement with unsupported format, data will be passed as is")
+		return nil
+	}
+	return newLoadDataStream(format, func(ctx context.Context, fields []loadDataField) (map[int][]byte, error) {
+		values := make([]base.BoundValue, len(fields))
+		for i, field := range fields {
+			values[i] = NewMysqlCopyTextBoundValue(field.value, base.TextFormat, TypeString)
+		}
+		newValues, changed, err := handler.queryObserverManager.OnBind(ctx, loadDataStatement, values)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			return nil, nil
+		}
+		result := make(map[int][]byte, len(fields))
+		for i, value := range newValues {
+			// NULL values are left as is
+			if newValue := value.GetData(nil); newValue != nil && !bytes.Equal(fields[i].value, newValue) {
+				result[i] = newValue
+			}
+		}
+		return result, nil
+	})
+}
+
+// handleLocalInfilePacket process packet with content of the file sent by client for LOAD DATA LOCAL INFILE statement.
+// Rows are sent to the database in packets with own sequence ids because processed rows may take another count of
+// packets, the empty packet marks the end of the file. If rows can't be processed, the transfer is aborted: client
+// receives error and returned error closes the connection, so the database rolls back the statement instead of loading
+// rows as is
+func (handler *Handler) handleLocalInfilePacket(ctx context.Context, packet *Packet, logger *logrus.Entry) error {
+	stream := handler.localInfile
+	if stream == nil {
+		if _, err := handler.dbConnection.Write(packet.Dump()); err != nil {
+			logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorNetworkWrite).
+				Debugln("Can't write send packet to db")
+			return err
+		}
+		return nil
+	}
+	if handler.localInfileSequenceID < 0 {
+		handler.localInfileSequenceID = int(packet.GetSequenceNumber())
+	}
+	final := len(packet.GetData()) == 0
+	rows, err := stream.Process(ctx, packet.GetData(), final)
+	if err != nil {
+		logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptQueryData).
+			Errorln("Can't process rows of LOAD DATA LOCAL INFILE, abort it")
+		handler.localInfile = nil
+		errPacket := NewPacket()
+		errPacket.header[SequenceIDIndex] = packet.GetSequenceNumber() + 1
+		errPacket.SetData(NewUnknownError(err.Error(), handler.clientProtocol41))
+		if _, writeErr := handler.clientConnection.Write(errPacket.Dump()); writeErr != nil {
+			logger.WithError(writeErr).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorResponseConnectorCantWriteToClient).
+				Errorln("Can't write response with error to client")
+		}
+		return err
+	}
+	for len(rows) > 0 || final {
+		// packets with max payload length are continued by the next packet, so keep them shorter
+		length := len(rows)
+		if length >= MaxPayloadLen {
+			length = MaxPayloadLen - 1
+		}
+		output := NewPacket()
+		output.header[SequenceIDIndex] = byte(handler.localInfileSequenceID)
+		output.SetData(rows[:length])
+		handler.localInfileSequenceID++
+		rows = rows[length:]
+		if final && length == 0 {
+			// the database responds after the empty packet, so store the shift before
+			handler.localInfile = nil
+			atomic.StoreInt32(&handler.localInfileSequenceShift, int32(packet.GetSequenceNumber()-output.GetSequenceNumber()))
+			final = false
+		}
+		if _, err := handler.dbConnection.Write(output.Dump()); err != nil {
+			logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorNetworkWrite).
+				Debugln("Can't write send packet to db")
+			return err
+		}
+	}
+	return nil
+}
+
 func (handler *Handler) handleStatementExecute(ctx context.Context, packet *Packet) error {
 	stmtID := binary.LittleEndian.Uint32(packet.GetData()[1:])
 
@@ -506,7 +671,7 @@ func (handler *Handler) processTextDataRow(ctx context.Context, rowData []byte,
 		if err != nil {
 			return nil, err
 		}
-		value, err = handler.onColumnDecryption(ctx, i, value)
+		value, err = handler.onColumnDecryption(ctx, i, value, false)
 		if err != nil {
 			fieldLogger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
 				WithError(err).Errorln("Failed to process column data")
@@ -558,15 +723,19 @@ func (handler *Handler) processBinaryDataRow(ctx context.Context, rowData []byte
 					Errorln("Can't handle length encoded string binary value")
 				return nil, err
 			}
-			value, err = handler.onColumnDecryption(ctx, i, value)
+			value, err = handler.onColumnDecryption(ctx, i, value, true)
 			if err != nil {
 				handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
 					WithField("field_index", i).WithError(err).Errorln("Failed to process column data")
 				return nil, err
 			}
-
-			output = append(output, PutLengthEncodedString(value)...)
 			pos += n
+			// integers with configured data type encoded as is, without length
+			if isFixedSizeDataType(handler.getColumnDataType(i)) {
+				output = append(output, value...)
+				continue
+			}
+			output = append(output, PutLengthEncodedString(value)...)
 			continue
 		}
 		// https://dev.mysql.com/doc/internals/en/binary-protocol-value.html
@@ -639,7 +808,7 @@ func (handler *Handler) processBinaryDataRow(ctx context.Context, rowData []byte
 					Errorln("Can't handle length encoded string non binary value")
 				return nil, err
 			}
-			value, err = handler.onColumnDecryption(ctx, i, value)
+			value, err = handler.onColumnDecryption(ctx, i, value, true)
 			if err != nil {
 				handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
 					WithField("field_index", i).WithError(err).Errorln("Failed to process column data")
@@ -725,7 +894,7 @@ func (handler *Handler) processFixedSizeNumberField(ctx context.Context, columnI
 	}
 
 	// Now show the value to the subscribers. Note that they might change it.
-	value, err = handler.onColumnDecryption(ctx, columnIndex, value)
+	value, err = handler.onColumnDecryption(ctx, columnIndex, value, false)
 	if err != nil {
 		handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
 			WithError(err).WithField("field_index", columnIndex).
@@ -809,6 +978,16 @@ func (handler *Handler) isPreparedStatementResult() bool {
 // QueryResponseHandler parses data from database response
 func (handler *Handler) QueryResponseHandler(ctx context.Context, packet *Packet, dbConnection, clientConnection net.Conn) (err error) {
 	handler.resetQueryHandler()
+	if packet.GetData()[0] == LocalInfileRequestPacket {
+		handler.logger.Debugln("Database requested file of LOAD DATA LOCAL INFILE")
+		handler.localInfileRequested = true
+		if _, err := clientConnection.Write(packet.Dump()); err != nil {
+			handler.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorNetworkWrite).
+				Debugln("Can't proxy output")
+			return err
+		}
+		return nil
+	}
 	// read fields
 	var fields []*ColumnDescription
 	var binaryFieldIndexes []int
@@ -816,6 +995,8 @@ func (handler *Handler) QueryResponseHandler(ctx context.Context, packet *Packet
 	// https://dev.mysql.com/doc/internals/en/com-query-response.html#text-resultset
 	fieldCount := int(packet.GetData()[0])
 	output := []Dumper{packet}
+	// error of returning column value with configured data type
+	var encodingErr error
 	if fieldCount != ErrPacket && fieldCount > 0 {
 		handler.logger.Debugln("Read column descriptions")
 		for i := 0; ; i++ {
@@ -847,6 +1028,12 @@ func (handler *Handler) QueryResponseHandler(ctx context.Context, packet *Packet
 				binaryFieldIndexes = append(binaryFieldIndexes, i)
 			}
 			fields = append(fields, field)
+			// description of columns with configured data types should match to values returned after decryption,
+			// but data rows will be parsed according to original description
+			typedField := *field
+			if updateFieldDataType(&typedField, handler.getColumnDataType(i)) {
+				fieldPacket.SetData(typedField.Dump())
+			}
 			if !handler.expectEOFOnColumnDefinition() && i == (fieldCount-1) {
 				break
 			}
@@ -864,7 +1051,15 @@ func (handler *Handler) QueryResponseHandler(ctx context.Context, packet *Packet
 				if fieldDataPacket.data[0] == EOFPacket {
 					break
 				}
+				// read rest of rows after failed row to return only error
+				if encodingErr != nil {
+					continue
+				}
 				newData, err := handler.processBinaryDataRow(ctx, fieldDataPacket.GetData(), fields)
+				if isColumnValueError(err) {
+					encodingErr = err
+					continue
+				}
 				if err != nil {
 					handler.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorProtocolProcessing).
 						Debugln("Can't process binary data row")
@@ -890,11 +1085,16 @@ func (handler *Handler) QueryResponseHandler(ctx context.Context, packet *Packet
 					break
 				}
 				// skip if no binary fields and nothing to decrypt
-				if len(fields) == 0 {
+				// or read rest of rows after failed row to return only error
+				if len(fields) == 0 || encodingErr != nil {
 					continue
 				}
 				dataLog.Debugln("Process data text row")
 				newData, err := handler.processTextDataRow(ctx, fieldDataPacket.GetData(), fields)
+				if isColumnValueError(err) {
+					encodingErr = err
+					continue
+				}
 				if err != nil {
 					dataLog.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorProtocolProcessing).
 						Debugln("Can't process text data row")
@@ -907,6 +1107,14 @@ func (handler *Handler) QueryResponseHandler(ctx context.Context, packet *Packet
 
 	}
 
+	if encodingErr != nil {
+		handler.logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingCantEncodeDataTypeValue).
+			WithError(encodingErr).Errorln("Can't return column value, return error to the client")
+		// replace whole result set with error packet with same sequence number as first packet of response
+		packet.SetData(NewUnknownError(encodingErr.Error(), handler.clientProtocol41))
+		output = []Dumper{packet}
+	}
+
 	// proxy output
 	handler.logger.Debugln("Proxy output")
 	for _, dumper := range output {
@@ -998,6 +1206,11 @@ func (handler *Handler) ProxyDatabaseConnection(ctx context.Context, errCh chan<
 
 		// after reading response from db response set deadline on writing data to client
 		handler.clientConnection.SetWriteDeadline(time.Now().Add(network.DefaultNetworkTimeout))
+		if handler.localInfileRequested {
+			handler.localInfileRequested = false
+			// client expects the response right after its last packet with the file content
+			packet.header[SequenceIDIndex] += byte(atomic.LoadInt32(&handler.localInfileSequenceShift))
+		}
 		handler.logger.WithField("sequence_number", packet.GetSequenceNumber()).Debugln("New packet from db to client")
 		if packet.IsErr() {
 			handler.resetQueryHandler()
@@ -1024,3 +1237,9 @@ func (handler *Handler) ProxyDatabaseConnection(ctx context.Context, errCh chan<
 func (handler *Handler) AddClientIDObserver(observer base.ClientIDObserver) {
 	handler.clientIDObserverManager.AddClientIDObserver(observer)
 }
+
+// isColumnValueError return true if the column value can't be returned to the client and the error should be returned
+// to the client instead of the rows
+func isColumnValueError(err error) bool {
+	return type_awareness.IsEncodingError(err) || access_policy.IsAccessDeniedError(err)
+}
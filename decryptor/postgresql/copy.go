@@ -0,0 +1,426 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgresql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cossacklabs/acra/sqlparser"
+)
+
+// ErrUnsupportedCopyFormat returned for COPY statements with binary format or options that can't be processed
+var ErrUnsupportedCopyFormat = errors.New("unsupported format of COPY data")
+
+// copyEndOfDataMarker is the line which may be sent by client as the last row of the COPY data
+var copyEndOfDataMarker = []byte{'\\', '.'}
+
+// copyFormat describes text or CSV encoding of rows transferred by the COPY statement
+// https://www.postgresql.org/docs/current/sql-copy.html#id-1.9.3.55.9
+type copyFormat struct {
+	csv       bool
+	delimiter byte
+	null      []byte
+	quote     byte
+	escape    byte
+	header    bool
+}
+
+// newCopyFormat return copyFormat configured with options of the statement
+func newCopyFormat(statement *sqlparser.Copy) (*copyFormat, error) {
+	format := &copyFormat{delimiter: '\t', null: []byte(`\N`)}
+	if value, ok := statement.OptionString(sqlparser.CopyFormatOption); ok {
+		switch strings.ToLower(value) {
+		case "text":
+		case "csv":
+			format.csv = true
+			format.delimiter = ','
+			format.null = []byte{}
+			format.quote = '"'
+			format.escape = '"'
+		default:
+			return nil, ErrUnsupportedCopyFormat
+		}
+	}
+	options := []struct {
+		name  string
+		value *byte
+	}{
+		{sqlparser.CopyDelimiterOption, &format.delimiter},
+		{sqlparser.CopyQuoteOption, &format.quote},
+		{sqlparser.CopyEscapeOption, &format.escape},
+	}
+	for _, option := range options {
+		value, ok := statement.OptionString(option.name)
+		if !ok {
+			continue
+		}
+		// PostgreSQL allows only single one-byte character for these options
+		if len(value) != 1 {
+			return nil, ErrUnsupportedCopyFormat
+		}
+		*option.value = value[0]
+		// escape character is the same as quote by default
+		if option.name == sqlparser.CopyQuoteOption {
+			if _, ok := statement.Option(sqlparser.CopyEscapeOption); !ok {
+				format.escape = value[0]
+			}
+		}
+	}
+	if value, ok := statement.OptionString(sqlparser.CopyNullOption); ok {
+		format.null = []byte(value)
+	}
+	if value, ok := statement.OptionString(sqlparser.CopyHeaderOption); ok {
+		switch strings.ToLower(value) {
+		case "", "true", "on", "1", "match":
+			format.header = true
+		}
+	}
+	return format, nil
+}
+
+// copyField is a value of the one column in the COPY row
+type copyField struct {
+	// raw is the field as is in the row
+	raw []byte
+	// value is the decoded field value or nil for NULL
+	value []byte
+}
+
+// splitFields decodes line without line terminator to fields
+func (format *copyFormat) splitFields(line []byte) []copyField {
+	if format.csv {
+		return format.splitCSVFields(line)
+	}
+	return format.splitTextFields(line)
+}
+
+// splitTextFields decodes line in the text format where special characters escaped with backslash
+func (format *copyFormat) splitTextFields(line []byte) []copyField {
+	fields := make([]copyField, 0, 8)
+	start := 0
+	for i := 0; i <= len(line); i++ {
+		if i < len(line) && line[i] == '\\' {
+			i++
+			continue
+		}
+		if i < len(line) && line[i] != format.delimiter {
+			continue
+		}
+		raw := line[start:i]
+		field := copyField{raw: raw}
+		if !bytes.Equal(raw, format.null) {
+			field.value = decodeCopyText(raw)
+		}
+		fields = append(fields, field)
+		start = i + 1
+	}
+	return fields
+}
+
+// decodeCopyText replace backslash escape sequences with characters they represent
+func decodeCopyText(data []byte) []byte {
+	output := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		if data[i] != '\\' || i+1 == len(data) {
+			output = append(output, data[i])
+			continue
+		}
+		i++
+		switch c := data[i]; c {
+		case 'b':
+			output = append(output, '\b')
+		case 'f':
+			output = append(output, '\f')
+		case 'n':
+			output = append(output, '\n')
+		case 'r':
+			output = append(output, '\r')
+		case 't':
+			output = append(output, '\t')
+		case 'v':
+			output = append(output, '\v')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			// up to 3 octal digits
+			value := c - '0'
+			for j := 0; j < 2 && i+1 < len(data) && data[i+1] >= '0' && data[i+1] <= '7'; j++ {
+				i++
+				value = value*8 + (data[i] - '0')
+			}
+			output = append(output, value)
+		case 'x':
+			// up to 2 hex digits, backslash with x without digits is just x
+			var value byte
+			digits := 0
+			for ; digits < 2 && i+1 < len(data); digits++ {
+				digit, ok := hexDigitValue(data[i+1])
+				if !ok {
+					break
+				}
+				i++
+				value = value*16 + digit
+			}
+			if digits == 0 {
+				output = append(output, c)
+				continue
+			}
+			output = append(output, value)
+		default:
+			output = append(output, c)
+		}
+	}
+	return output
+}
+
+func hexDigitValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// splitCSVFields decodes line in the CSV format where quoted values may contain delimiters and line breaks
+func (format *copyFormat) splitCSVFields(line []byte) []copyField {
+	fields := make([]copyField, 0, 8)
+	start := 0
+	value := make([]byte, 0, len(line))
+	quoted := false
+	inQuotes := false
+	for i := 0; i <= len(line); i++ {
+		if i == len(line) || (!inQuotes && line[i] == format.delimiter) {
+			raw := line[start:i]
+			field := copyField{raw: raw}
+			// only unquoted value may be NULL
+			if quoted || !bytes.Equal(raw, format.null) {
+				field.value = append([]byte{}, value...)
+			}
+			fields = append(fields, field)
+			start = i + 1
+			value = value[:0]
+			quoted = false
+			continue
+		}
+		c := line[i]
+		switch {
+		case inQuotes && c == format.escape && format.escape != format.quote && i+1 < len(line) &&
+			(line[i+1] == format.quote || line[i+1] == format.escape):
+			i++
+			value = append(value, line[i])
+		case inQuotes && c == format.quote:
+			// doubled quote inside quoted value is the quote character itself
+			if format.escape == format.quote && i+1 < len(line) && line[i+1] == format.quote {
+				i++
+				value = append(value, c)
+				continue
+			}
+			inQuotes = false
+		case !inQuotes && c == format.quote:
+			inQuotes = true
+			quoted = true
+		default:
+			value = append(value, c)
+		}
+	}
+	return fields
+}
+
+// encodeField return value encoded to be placed in the row
+func (format *copyFormat) encodeField(value []byte) []byte {
+	if format.csv {
+		return format.encodeCSVField(value)
+	}
+	return format.encodeTextField(value)
+}
+
+// encodeTextField escapes special characters with backslash as PostgreSQL does for output
+func (format *copyFormat) encodeTextField(value []byte) []byte {
+	output := make([]byte, 0, len(value)+8)
+	for _, c := range value {
+		switch c {
+		case '\b':
+			output = append(output, '\\', 'b')
+		case '\f':
+			output = append(output, '\\', 'f')
+		case '\n':
+			output = append(output, '\\', 'n')
+		case '\r':
+			output = append(output, '\\', 'r')
+		case '\t':
+			output = append(output, '\\', 't')
+		case '\v':
+			output = append(output, '\\', 'v')
+		case '\\', format.delimiter:
+			output = append(output, '\\', c)
+		default:
+			output = append(output, c)
+		}
+	}
+	return output
+}
+
+// encodeCSVField quotes value if it contains special characters or may be confused with NULL
+func (format *copyFormat) encodeCSVField(value []byte) []byte {
+	needQuotes := bytes.Equal(value, format.null) || bytes.Equal(value, copyEndOfDataMarker)
+	for _, c := range value {
+		if c == format.delimiter || c == format.quote || c == '\n' || c == '\r' {
+			needQuotes = true
+			break
+		}
+	}
+	if !needQuotes {
+		return value
+	}
+	output := make([]byte, 0, len(value)+8)
+	output = append(output, format.quote)
+	for _, c := range value {
+		if c == format.quote || c == format.escape {
+			output = append(output, format.escape)
+		}
+		output = append(output, c)
+	}
+	return append(output, format.quote)
+}
+
+// joinFields join encoded fields to the row without line terminator
+func (format *copyFormat) joinFields(fields [][]byte) []byte {
+	return bytes.Join(fields, []byte{format.delimiter})
+}
+
+// copyRowFunc process fields of the row and returns new values of changed fields as map of field index to the
+// decoded value or nil if row wasn't changed
+type copyRowFunc func(ctx context.Context, fields []copyField) (map[int][]byte, error)
+
+// copyStream accumulates data of CopyData messages and splits it into rows because messages may contain several rows
+// or the part of row
+type copyStream struct {
+	format    *copyFormat
+	buffer    []byte
+	rowCount  int
+	finished  bool
+	processor copyRowFunc
+}
+
+// newCopyStream return copyStream which process rows with processor
+func newCopyStream(format *copyFormat, processor copyRowFunc) *copyStream {
+	return &copyStream{format: format, processor: processor}
+}
+
+// Process accumulates data and return processed complete rows. The last row without line terminator is returned only
+// if final is true
+func (stream *copyStream) Process(ctx context.Context, data []byte, final bool) ([]byte, error) {
+	stream.buffer = append(stream.buffer, data...)
+	output := make([]byte, 0, len(stream.buffer))
+	for {
+		length, complete := stream.nextRowLength(final)
+		if !complete {
+			break
+		}
+		row := stream.buffer[:length]
+		newRow, err := stream.processRow(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, newRow...)
+		stream.buffer = stream.buffer[length:]
+	}
+	// don't keep references to processed data
+	stream.buffer = append([]byte{}, stream.buffer...)
+	return output, nil
+}
+
+// nextRowLength return length of the first complete row in the buffer including line terminator
+func (stream *copyStream) nextRowLength(final bool) (int, bool) {
+	if len(stream.buffer) == 0 {
+		return 0, false
+	}
+	inQuotes := false
+	for i := 0; i < len(stream.buffer); i++ {
+		c := stream.buffer[i]
+		switch {
+		case stream.format.csv && inQuotes && c == stream.format.escape && stream.format.escape != stream.format.quote:
+			i++
+		case stream.format.csv && c == stream.format.quote:
+			inQuotes = !inQuotes
+		case !stream.format.csv && c == '\\':
+			i++
+		case !inQuotes && c == '\n':
+			return i + 1, true
+		case !inQuotes && c == '\r':
+			if i+1 < len(stream.buffer) {
+				if stream.buffer[i+1] == '\n' {
+					return i + 2, true
+				}
+				return i + 1, true
+			}
+			// wait next data to check is it \r\n
+			if !final {
+				return 0, false
+			}
+		}
+	}
+	if final {
+		return len(stream.buffer), true
+	}
+	return 0, false
+}
+
+// processRow return row with changed fields or row as is
+func (stream *copyStream) processRow(ctx context.Context, row []byte) ([]byte, error) {
+	line := trimLineTerminator(row)
+	terminator := row[len(line):]
+	stream.rowCount++
+	if stream.finished || (stream.rowCount == 1 && stream.format.header) {
+		return row, nil
+	}
+	if bytes.Equal(line, copyEndOfDataMarker) {
+		stream.finished = true
+		return row, nil
+	}
+	fields := stream.format.splitFields(line)
+	newValues, err := stream.processor(ctx, fields)
+	if err != nil || len(newValues) == 0 {
+		return row, err
+	}
+	encodedFields := make([][]byte, len(fields))
+	for i, field := range fields {
+		if value, ok := newValues[i]; ok {
+			encodedFields[i] = stream.format.encodeField(value)
+			continue
+		}
+		encodedFields[i] = field.raw
+	}
+	newRow := stream.format.joinFields(encodedFields)
+	return append(newRow, terminator...), nil
+}
+
+// trimLineTerminator return row without \n, \r or \r\n at the end
+func trimLineTerminator(row []byte) []byte {
+	if bytes.HasSuffix(row, []byte{'\r', '\n'}) {
+		return row[:len(row)-2]
+	}
+	if bytes.HasSuffix(row, []byte{'\n'}) || bytes.HasSuffix(row, []byte{'\r'}) {
+		return row[:len(row)-1]
+	}
+	return row
+}
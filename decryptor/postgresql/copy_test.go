@@ -0,0 +1,300 @@
+package postgresql
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/cossacklabs/acra/sqlparser/dialect/postgresql"
+	"github.com/sirupsen/logrus"
+)
+
+func parseCopyStatement(t *testing.T, query string) *sqlparser.Copy {
+	statement, err := sqlparser.ParseWithDialect(postgresql.NewPostgreSQLDialect(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copyStatement, ok := statement.(*sqlparser.Copy)
+	if !ok {
+		t.Fatalf("Expect *sqlparser.Copy, took %T\n", statement)
+	}
+	return copyStatement
+}
+
+func TestNewCopyFormat(t *testing.T) {
+	testcases := []struct {
+		query  string
+		format *copyFormat
+		err    error
+	}{
+		{"copy t from stdin", &copyFormat{delimiter: '\t', null: []byte(`\N`)}, nil},
+		{"copy t from stdin with (format text, delimiter '|', null 'null')", &copyFormat{delimiter: '|', null: []byte("null")}, nil},
+		{"copy t from stdin with (format csv)", &copyFormat{csv: true, delimiter: ',', null: []byte{}, quote: '"', escape: '"'}, nil},
+		{"copy t from stdin with (format csv, header, quote '''')", &copyFormat{csv: true, delimiter: ',', null: []byte{}, quote: '\'', escape: '\'', header: true}, nil},
+		{"copy t from stdin with (format csv, header false, escape '\\\\', delimiter E'\\t')", &copyFormat{csv: true, delimiter: '\t', null: []byte{}, quote: '"', escape: '\\'}, nil},
+		{"copy t to stdout with csv header null as 'NULL'", &copyFormat{csv: true, delimiter: ',', null: []byte("NULL"), quote: '"', escape: '"', header: true}, nil},
+		{"copy t from stdin with binary", nil, ErrUnsupportedCopyFormat},
+		{"copy t from stdin with (delimiter '||')", nil, ErrUnsupportedCopyFormat},
+	}
+	for i, tcase := range testcases {
+		format, err := newCopyFormat(parseCopyStatement(t, tcase.query))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.err, err)
+		}
+		if tcase.format == nil {
+			continue
+		}
+		if format.csv != tcase.format.csv || format.delimiter != tcase.format.delimiter || !bytes.Equal(format.null, tcase.format.null) ||
+			format.quote != tcase.format.quote || format.escape != tcase.format.escape || format.header != tcase.format.header {
+			t.Fatalf("[%d] Expect %+v, took %+v\n", i, tcase.format, format)
+		}
+	}
+}
+
+func TestCopyFormatFields(t *testing.T) {
+	textFormat := &copyFormat{delimiter: '\t', null: []byte(`\N`)}
+	csvFormat := &copyFormat{csv: true, delimiter: ',', null: []byte{}, quote: '"', escape: '"'}
+	testcases := []struct {
+		format *copyFormat
+		line   string
+		values [][]byte
+	}{
+		{textFormat, "1\tsome text\t\\N", [][]byte{[]byte("1"), []byte("some text"), nil}},
+		{textFormat, "\\x01\\101\\\\x\\n\\t\t", [][]byte{[]byte("\x01A\\x\n\t"), []byte{}}},
+		{textFormat, "a\\\tb\tc", [][]byte{[]byte("a\tb"), []byte("c")}},
+		{csvFormat, `1,"with, comma","with ""quotes""",,""`, [][]byte{[]byte("1"), []byte("with, comma"), []byte(`with "quotes"`), nil, []byte{}}},
+		{csvFormat, "\"multi\nline\",\\x", [][]byte{[]byte("multi\nline"), []byte(`\x`)}},
+	}
+	for i, tcase := range testcases {
+		fields := tcase.format.splitFields([]byte(tcase.line))
+		if len(fields) != len(tcase.values) {
+			t.Fatalf("[%d] Expect %d fields, took %d\n", i, len(tcase.values), len(fields))
+		}
+		for j, field := range fields {
+			if (field.value == nil) != (tcase.values[j] == nil) || !bytes.Equal(field.value, tcase.values[j]) {
+				t.Fatalf("[%d] Expect %q, took %q\n", i, tcase.values[j], field.value)
+			}
+			if field.value == nil {
+				continue
+			}
+			// encoded value should be decoded to the same value
+			decoded := tcase.format.splitFields(tcase.format.encodeField(field.value))
+			if len(decoded) != 1 || !bytes.Equal(decoded[0].value, field.value) {
+				t.Fatalf("[%d] Expect %q after encoding, took %+v\n", i, field.value, decoded)
+			}
+		}
+	}
+}
+
+func TestCopyStreamProcess(t *testing.T) {
+	// replace value of the second column with its length to check that rows processed correctly
+	processor := func(ctx context.Context, fields []copyField) (map[int][]byte, error) {
+		if len(fields) < 2 || fields[1].value == nil {
+			return nil, nil
+		}
+		return map[int][]byte{1: []byte{byte('0' + len(fields[1].value))}}, nil
+	}
+	testcases := []struct {
+		query  string
+		input  string
+		output string
+	}{
+		{"copy t from stdin", "1\tab\n2\t\\N\n3\tabc\\\nd\r\n\\.\n4\tab\n", "1\t2\n2\t\\N\n3\t5\r\n\\.\n4\tab\n"},
+		{"copy t from stdin with (format csv, header)", "a,b\n1,\"a\nb\"\n2,abc", "a,b\n1,3\n2,3"},
+		{"copy t to stdout with (format csv, quote '''', escape '\\\\')", "1,'a\\'\nb'\r2,ab\r", "1,4\r2,2\r"},
+	}
+	for i, tcase := range testcases {
+		format, err := newCopyFormat(parseCopyStatement(t, tcase.query))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// whole data in one packet and the same data split into packets by one byte
+		for _, chunkSize := range []int{len(tcase.input), 1} {
+			stream := newCopyStream(format, processor)
+			output := make([]byte, 0, len(tcase.input))
+			for j := 0; j < len(tcase.input); j += chunkSize {
+				rows, err := stream.Process(context.Background(), []byte(tcase.input[j:j+chunkSize]), false)
+				if err != nil {
+					t.Fatal(err)
+				}
+				output = append(output, rows...)
+			}
+			rows, err := stream.Process(context.Background(), nil, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			output = append(output, rows...)
+			if string(output) != tcase.output {
+				t.Fatalf("[%d] Expect %q, took %q\n", i, tcase.output, output)
+			}
+		}
+	}
+}
+
+type testCopyObserver struct{}
+
+func (testCopyObserver) ID() string {
+	return "testCopyObserver"
+}
+
+func (testCopyObserver) OnQuery(ctx context.Context, data base.OnQueryObject) (base.OnQueryObject, bool, error) {
+	return data, false, nil
+}
+
+// OnBind replace value of the second column with binary data
+func (testCopyObserver) OnBind(ctx context.Context, statement sqlparser.Statement, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+	if _, ok := statement.(*sqlparser.Copy); !ok || len(values) < 2 || values[1].GetData(nil) == nil {
+		return values, false, nil
+	}
+	newValues := make([]base.BoundValue, len(values))
+	copy(newValues, values)
+	newValues[1] = NewPgBoundValue(append([]byte{0, '\n'}, values[1].GetData(nil)...), base.TextFormat)
+	return newValues, true, nil
+}
+
+type testCopySubscriber struct{}
+
+func (testCopySubscriber) ID() string {
+	return "testCopySubscriber"
+}
+
+// OnColumn remove first byte from the value of the second column
+func (testCopySubscriber) OnColumn(ctx context.Context, data []byte) (context.Context, []byte, error) {
+	columnInfo, ok := base.ColumnInfoFromContext(ctx)
+	if !ok || columnInfo.Index() != 1 || len(data) == 0 {
+		return ctx, data, nil
+	}
+	return ctx, data[1:], nil
+}
+
+func TestCopyInStream(t *testing.T) {
+	observerManager, err := base.NewArrayQueryObservableManager(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	observerManager.AddQueryObserver(testCopyObserver{})
+	proxy := &PgProxy{queryObserverManager: observerManager}
+	testcases := []struct {
+		query  string
+		input  string
+		output string
+	}{
+		{"copy t from stdin", "1\tab\n2\t\\N\n", "1\t\\\\x000a6162\n2\t\\N\n"},
+		// bytea value in hex format decoded before processing
+		{"copy t(a, b) from stdin with (format csv)", "1,\\x6162\n2,\n", "1,\\x000a6162\n2,\n"},
+	}
+	for i, tcase := range testcases {
+		stream := proxy.newCopyInStream(parseCopyStatement(t, tcase.query), logrus.NewEntry(logrus.New()))
+		output, err := stream.Process(context.Background(), []byte(tcase.input), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(output) != tcase.output {
+			t.Fatalf("[%d] Expect %q, took %q\n", i, tcase.output, output)
+		}
+	}
+}
+
+type testFailedCopyObserver struct {
+	testCopyObserver
+}
+
+func (testFailedCopyObserver) OnBind(ctx context.Context, statement sqlparser.Statement, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+	return nil, false, errors.New("can't encrypt value")
+}
+
+func TestCopyInStreamAbort(t *testing.T) {
+	observerManager, err := base.NewArrayQueryObservableManager(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	observerManager.AddQueryObserver(testFailedCopyObserver{})
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	proxy := &PgProxy{queryObserverManager: observerManager, protocolState: NewPgProtocolState(parser)}
+	logger := logrus.NewEntry(logrus.New())
+	proxy.copyIn = proxy.newCopyInStream(parseCopyStatement(t, "copy t from stdin"), logger)
+
+	newPacket := func(messageType byte, data string) *PacketHandler {
+		packet, err := NewClientSidePacketHandler(nil, nil, logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		packet.messageType[0] = messageType
+		packet.descriptionBuf.WriteString(data)
+		packet.updatePacketLength(len(data))
+		return packet
+	}
+
+	// rows which can't be encrypted replaced with CopyFail
+	packet := newPacket(CopyDataMessageType, "1\tab\n")
+	if _, err := proxy.handleClientPacket(context.Background(), packet, logger); err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{CopyFailMessageType, 0, 0, 0, 24}
+	expected = append(expected, []byte("can't encrypt value\x00")...)
+	if output, _ := packet.Marshal(); !bytes.Equal(output, expected) {
+		t.Fatalf("Expect %q, took %q\n", expected, output)
+	}
+	if proxy.copyIn != nil || !proxy.copyInFailed {
+		t.Fatal("Expect aborted COPY")
+	}
+
+	// the rest of rows aren't sent to the database
+	packet = newPacket(CopyDataMessageType, "2\tcd\n")
+	if _, err := proxy.handleClientPacket(context.Background(), packet, logger); err != nil {
+		t.Fatal(err)
+	}
+	expected = []byte{CopyDataMessageType, 0, 0, 0, 4}
+	if output, _ := packet.Marshal(); !bytes.Equal(output, expected) {
+		t.Fatalf("Expect %q, took %q\n", expected, output)
+	}
+
+	packet = newPacket(CopyDoneMessageType, "")
+	if _, err := proxy.handleClientPacket(context.Background(), packet, logger); err != nil {
+		t.Fatal(err)
+	}
+	if !packet.IsCopyDone() || proxy.copyInFailed {
+		t.Fatal("Expect CopyDone packet after aborted COPY")
+	}
+}
+
+func TestCopyOutStream(t *testing.T) {
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	proxy := &PgProxy{protocolState: NewPgProtocolState(parser), decryptionObserver: base.NewColumnDecryptionObserver()}
+	proxy.SubscribeOnAllColumnsDecryption(testCopySubscriber{})
+	logger := logrus.NewEntry(logrus.New())
+
+	// CopyOutResponse with text format and 2 columns
+	packet, err := NewDbSidePacketHandler(nil, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet.messageType[0] = CopyOutResponseMessageType
+	packet.descriptionBuf.Write([]byte{0, 0, 2, 0, 0, 0, 0})
+	binary.BigEndian.PutUint32(packet.descriptionLengthBuf, uint32(packet.descriptionBuf.Len()+4))
+
+	proxy.protocolState.pendingQuery = base.NewOnQueryObjectFromQuery("select a, b from t", parser)
+	if stream := proxy.newCopyOutStream(packet, logger); stream != nil {
+		t.Fatal("Expect nil stream for SELECT query")
+	}
+
+	proxy.protocolState.pendingQuery = base.NewOnQueryObjectFromQuery("copy t to stdout", parser)
+	stream := proxy.newCopyOutStream(packet, logger)
+	if stream == nil {
+		t.Fatal("Expect stream for COPY TO STDOUT query")
+	}
+	input := "1\t\\\\x00616263\n2\t\\N\n3\t_a\\tb\n"
+	expected := "1\t\\\\x616263\n2\t\\N\n3\ta\\tb\n"
+	ctx := base.SetAccessContextToContext(context.Background(), base.NewAccessContext())
+	output, err := stream.Process(ctx, []byte(input), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output) != expected {
+		t.Fatalf("Expect %q, took %q\n", expected, output)
+	}
+}
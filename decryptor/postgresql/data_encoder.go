@@ -0,0 +1,195 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgresql
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cossacklabs/acra/encryptor/config"
+)
+
+// PostgreSQL type OIDs of the data types supported by type-aware decryption
+// https://github.com/postgres/postgres/blob/master/src/include/catalog/pg_type.dat
+const (
+	pgTypeByteaOID     uint32 = 17
+	pgTypeInt8OID      uint32 = 20
+	pgTypeInt4OID      uint32 = 23
+	pgTypeTextOID      uint32 = 25
+	pgTypeDateOID      uint32 = 1082
+	pgTypeTimestampOID uint32 = 1114
+	pgTypeNumericOID   uint32 = 1700
+)
+
+// pgTypeDescription type OID and size used in RowDescription
+type pgTypeDescription struct {
+	oid  uint32
+	size int16
+}
+
+// variable length types have -1 size
+var pgDataTypeDescriptions = map[config.EncryptedDataType]pgTypeDescription{
+	config.EncryptedDataTypeInt32:     {oid: pgTypeInt4OID, size: 4},
+	config.EncryptedDataTypeInt64:     {oid: pgTypeInt8OID, size: 8},
+	config.EncryptedDataTypeString:    {oid: pgTypeTextOID, size: -1},
+	config.EncryptedDataTypeBytes:     {oid: pgTypeByteaOID, size: -1},
+	config.EncryptedDataTypeDate:      {oid: pgTypeDateOID, size: 4},
+	config.EncryptedDataTypeTimestamp: {oid: pgTypeTimestampOID, size: 8},
+	config.EncryptedDataTypeNumeric:   {oid: pgTypeNumericOID, size: -1},
+}
+
+// pgEpoch used as zero point for date and timestamp values in binary format
+var pgEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ErrUnsupportedDataType returned for data types without PostgreSQL encoding
+var ErrUnsupportedDataType = errors.New("unsupported data type")
+
+const (
+	pgTimestampTextLayout = "2006-01-02 15:04:05.999999"
+	pgNumericPositive     = 0x0000
+	pgNumericNegative     = 0x4000
+	// numeric stored as base 10000 digits
+	pgNumericDigitLength = 4
+)
+
+// DataEncoder encodes decrypted values into PostgreSQL text or binary format of configured data type
+type DataEncoder struct{}
+
+// EncodeValue implementation of type_awareness.ValueEncoder. Value expected to be validated by config.ValidateDataTypeValue
+func (DataEncoder) EncodeValue(dataType config.EncryptedDataType, value []byte, binaryFormat bool) ([]byte, error) {
+	switch dataType {
+	case config.EncryptedDataTypeString:
+		return value, nil
+	case config.EncryptedDataTypeBytes:
+		if binaryFormat {
+			return value, nil
+		}
+		output := make([]byte, 2+hex.EncodedLen(len(value)))
+		copy(output, `\x`)
+		hex.Encode(output[2:], value)
+		return output, nil
+	case config.EncryptedDataTypeInt32, config.EncryptedDataTypeInt64:
+		bitSize := 64
+		if dataType == config.EncryptedDataTypeInt32 {
+			bitSize = 32
+		}
+		intValue, err := strconv.ParseInt(string(value), 10, bitSize)
+		if err != nil {
+			return nil, err
+		}
+		if !binaryFormat {
+			return []byte(strconv.FormatInt(intValue, 10)), nil
+		}
+		output := make([]byte, bitSize/8)
+		if bitSize == 32 {
+			binary.BigEndian.PutUint32(output, uint32(intValue))
+		} else {
+			binary.BigEndian.PutUint64(output, uint64(intValue))
+		}
+		return output, nil
+	case config.EncryptedDataTypeDate:
+		date, err := config.ParseDate(value)
+		if err != nil {
+			return nil, err
+		}
+		if !binaryFormat {
+			return []byte(date.Format(config.DateLayout)), nil
+		}
+		// days since 2000-01-01
+		days := (date.Unix() - pgEpoch.Unix()) / (24 * 60 * 60)
+		output := make([]byte, 4)
+		binary.BigEndian.PutUint32(output, uint32(int32(days)))
+		return output, nil
+	case config.EncryptedDataTypeTimestamp:
+		timestamp, err := config.ParseTimestamp(value)
+		if err != nil {
+			return nil, err
+		}
+		if !binaryFormat {
+			return []byte(timestamp.Format(pgTimestampTextLayout)), nil
+		}
+		// microseconds since 2000-01-01 00:00:00
+		microseconds := (timestamp.Unix()-pgEpoch.Unix())*1000000 + int64(timestamp.Nanosecond()/1000)
+		output := make([]byte, 8)
+		binary.BigEndian.PutUint64(output, uint64(microseconds))
+		return output, nil
+	case config.EncryptedDataTypeNumeric:
+		if !binaryFormat {
+			return value, nil
+		}
+		return encodeNumericBinary(string(value)), nil
+	}
+	return nil, ErrUnsupportedDataType
+}
+
+// encodeNumericBinary encodes decimal string in PostgreSQL numeric binary format:
+// ndigits int16 + weight int16 + sign int16 + dscale int16 + ndigits * int16 base 10000 digits
+// https://github.com/postgres/postgres/blob/master/src/backend/utils/adt/numeric.c
+func encodeNumericBinary(value string) []byte {
+	var sign uint16 = pgNumericPositive
+	if strings.HasPrefix(value, "-") {
+		sign = pgNumericNegative
+		value = value[1:]
+	} else if strings.HasPrefix(value, "+") {
+		value = value[1:]
+	}
+	intPart, fracPart := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		intPart, fracPart = value[:i], value[i+1:]
+	}
+	dscale := len(fracPart)
+	intPart = strings.TrimLeft(intPart, "0")
+	// align both parts to base 10000 digits
+	if pad := len(intPart) % pgNumericDigitLength; pad > 0 {
+		intPart = strings.Repeat("0", pgNumericDigitLength-pad) + intPart
+	}
+	if pad := len(fracPart) % pgNumericDigitLength; pad > 0 {
+		fracPart += strings.Repeat("0", pgNumericDigitLength-pad)
+	}
+	weight := len(intPart)/pgNumericDigitLength - 1
+	decimalDigits := intPart + fracPart
+	digits := make([]uint16, 0, len(decimalDigits)/pgNumericDigitLength)
+	for i := 0; i < len(decimalDigits); i += pgNumericDigitLength {
+		// digits validated by config.ValidateDataTypeValue
+		digit, _ := strconv.ParseUint(decimalDigits[i:i+pgNumericDigitLength], 10, 16)
+		digits = append(digits, uint16(digit))
+	}
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight = 0
+		sign = pgNumericPositive
+	}
+	output := make([]byte, 8+2*len(digits))
+	binary.BigEndian.PutUint16(output[0:], uint16(len(digits)))
+	binary.BigEndian.PutUint16(output[2:], uint16(int16(weight)))
+	binary.BigEndian.PutUint16(output[4:], sign)
+	binary.BigEndian.PutUint16(output[6:], uint16(dscale))
+	for i, digit := range digits {
+		binary.BigEndian.PutUint16(output[8+i*2:], digit)
+	}
+	return output
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package postgresql
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/cossacklabs/acra/encryptor/config"
+)
+
+func TestDataEncoderEncodeValue(t *testing.T) {
+	type testcase struct {
+		dataType     config.EncryptedDataType
+		value        string
+		binaryFormat bool
+		expected     string
+	}
+	testcases := []testcase{
+		{config.EncryptedDataTypeString, "some data", false, hex.EncodeToString([]byte("some data"))},
+		{config.EncryptedDataTypeBytes, "\x01\x02", false, hex.EncodeToString([]byte(`\x0102`))},
+		{config.EncryptedDataTypeBytes, "\x01\x02", true, "0102"},
+		{config.EncryptedDataTypeInt32, "-1", false, hex.EncodeToString([]byte("-1"))},
+		{config.EncryptedDataTypeInt32, "-1", true, "ffffffff"},
+		{config.EncryptedDataTypeInt64, "258", true, "0000000000000102"},
+		{config.EncryptedDataTypeDate, "2000-01-02", false, hex.EncodeToString([]byte("2000-01-02"))},
+		{config.EncryptedDataTypeDate, "2000-01-02", true, "00000001"},
+		{config.EncryptedDataTypeDate, "1999-12-31", true, "ffffffff"},
+		{config.EncryptedDataTypeTimestamp, "2000-01-01T00:00:01", false, hex.EncodeToString([]byte("2000-01-01 00:00:01"))},
+		{config.EncryptedDataTypeTimestamp, "2000-01-01 00:00:01", true, "00000000000f4240"},
+		{config.EncryptedDataTypeNumeric, "-12.5", false, hex.EncodeToString([]byte("-12.5"))},
+		// ndigits=2, weight=0, sign=negative, dscale=1, digits=[12, 5000]
+		{config.EncryptedDataTypeNumeric, "-12.5", true, "000200004000000100" + "0c1388"},
+		// ndigits=1, weight=1, sign=positive, dscale=0, digits=[1]
+		{config.EncryptedDataTypeNumeric, "10000", true, "00010001000000000001"},
+		{config.EncryptedDataTypeNumeric, "0.00", true, "0000000000000002"},
+	}
+	encoder := DataEncoder{}
+	for i, tcase := range testcases {
+		expected, err := hex.DecodeString(tcase.expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encoded, err := encoder.EncodeValue(tcase.dataType, []byte(tcase.value), tcase.binaryFormat)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if !bytes.Equal(encoded, expected) {
+			t.Fatalf("[%d] Expect %x, took %x\n", i, expected, encoded)
+		}
+	}
+	if _, err := encoder.EncodeValue(config.EncryptedDataTypeDefault, []byte("data"), false); err != ErrUnsupportedDataType {
+		t.Fatalf("Expect %s, took %v\n", ErrUnsupportedDataType, err)
+	}
+}
@@ -201,6 +201,23 @@ func (column *ColumnData) readData(reader io.Reader, format base.BoundValueForma
 	return base.CheckReadWrite(n, length, err)
 }
 
+// newTextColumnData return ColumnData with value in the text format like in DataRow packets
+func newTextColumnData(data []byte) (*ColumnData, error) {
+	column := &ColumnData{}
+	binary.BigEndian.PutUint32(column.LengthBuf[:], uint32(len(data)))
+	if err := column.readData(bytes.NewReader(data), base.TextFormat); err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+// SetRawData set data to column as is, without encoding to the format of original data, and update LengthBuf with new size
+func (column *ColumnData) SetRawData(newData []byte) {
+	column.changed = true
+	column.data = utils.WrapRawDataAsDecoded(newData)
+	binary.BigEndian.PutUint32(column.LengthBuf[:], uint32(len(newData)))
+}
+
 // SetData to column and update LengthBuf with new size
 func (column *ColumnData) SetData(newData []byte) {
 	column.changed = true
@@ -263,6 +280,11 @@ func (packet *PacketHandler) IsDataRow() bool {
 	return packet.messageType[0] == DataRowMessageType
 }
 
+// IsRowDescription return true if packet has RowDescription type
+func (packet *PacketHandler) IsRowDescription() bool {
+	return packet.messageType[0] == RowDescriptionMessageType
+}
+
 // IsReadyForQuery returns true if packet has ReadyForQuery type.
 func (packet *PacketHandler) IsReadyForQuery() bool {
 	return packet.messageType[0] == ReadyForQueryMessageType
@@ -298,6 +320,69 @@ func (packet *PacketHandler) IsExecute() bool {
 	return packet.messageType[0] == ExecuteMessageType
 }
 
+// IsCopyData return true if packet has CopyData type
+func (packet *PacketHandler) IsCopyData() bool {
+	return packet.messageType[0] == CopyDataMessageType
+}
+
+// IsCopyDone return true if packet has CopyDone type
+func (packet *PacketHandler) IsCopyDone() bool {
+	return packet.messageType[0] == CopyDoneMessageType
+}
+
+// IsCopyFail return true if packet has CopyFail type
+func (packet *PacketHandler) IsCopyFail() bool {
+	return packet.messageType[0] == CopyFailMessageType
+}
+
+// IsCopyOutResponse return true if packet has CopyOutResponse type. Client sends Flush packets with the same type
+func (packet *PacketHandler) IsCopyOutResponse() bool {
+	return packet.messageType[0] == CopyOutResponseMessageType
+}
+
+// GetCopyData returns copy of rows data from CopyData packet
+// Use this only if IsCopyData() is true.
+func (packet *PacketHandler) GetCopyData() []byte {
+	return packet.descriptionBufferCopy()
+}
+
+// GetCopyOutResponseFormat returns overall format of COPY data from CopyOutResponse packet
+// Use this only if IsCopyOutResponse() is true.
+func (packet *PacketHandler) GetCopyOutResponseFormat() (base.BoundValueFormat, error) {
+	if packet.descriptionBuf.Len() < 1 {
+		return base.TextFormat, ErrPacketTruncated
+	}
+	return base.BoundValueFormat(packet.descriptionBuf.Bytes()[0]), nil
+}
+
+// ReplaceCopyData replace rows data in CopyData packet and update packet length
+func (packet *PacketHandler) ReplaceCopyData(data []byte) {
+	packet.descriptionBuf.Reset()
+	packet.descriptionBuf.Write(data)
+	packet.updatePacketLength(len(data))
+}
+
+// ReplaceWithCopyFail replaces CopyData or CopyDone packet with CopyFail packet to abort COPY ... FROM STDIN with message
+func (packet *PacketHandler) ReplaceWithCopyFail(message string) {
+	packet.messageType[0] = CopyFailMessageType
+	packet.descriptionBuf.Reset()
+	packet.descriptionBuf.WriteString(message)
+	packet.descriptionBuf.WriteByte(0)
+	packet.updatePacketLength(len(message) + 1)
+}
+
+// writeCopyData writes separate CopyData packet with data before current packet without flushing
+func (packet *PacketHandler) writeCopyData(data []byte) error {
+	header := make([]byte, 5)
+	header[0] = CopyDataMessageType
+	binary.BigEndian.PutUint32(header[1:], uint32(len(data)+DataRowLengthBufSize))
+	if _, err := packet.writer.Write(header); err != nil {
+		return err
+	}
+	_, err := packet.writer.Write(data)
+	return err
+}
+
 // GetParseData returns parsed Parse packet data.
 // Use this only if IsParse() is true.
 func (packet *PacketHandler) GetParseData() (*ParsePacket, error) {
@@ -334,6 +419,18 @@ func (packet *PacketHandler) GetExecuteData() (*ExecutePacket, error) {
 	return execute, nil
 }
 
+// GetRowDescriptionData returns parsed RowDescription packet data.
+// Use this only if IsRowDescription() is true.
+func (packet *PacketHandler) GetRowDescriptionData() (*RowDescriptionPacket, error) {
+	packet.logger.Debugln("GetRowDescriptionData")
+	rowDescription, err := NewRowDescriptionPacket(packet.descriptionBufferCopy())
+	if err != nil {
+		packet.logger.Debugln("Failed to parse RowDescription packet")
+		return nil, err
+	}
+	return rowDescription, nil
+}
+
 // ReplaceQuery query in packet with new query and update packet length
 func (packet *PacketHandler) ReplaceQuery(newQuery string) {
 	if packet.IsSimpleQuery() {
@@ -371,6 +468,27 @@ func (packet *PacketHandler) ReplaceBind(bindPacket *BindPacket) error {
 	return nil
 }
 
+// ReplaceRowDescription update RowDescription packet with new data, update packet length.
+func (packet *PacketHandler) ReplaceRowDescription(rowDescription *RowDescriptionPacket) error {
+	packet.logger.Debugln("ReplaceRowDescription")
+	buffer := new(bytes.Buffer)
+	n, err := rowDescription.MarshalInto(buffer)
+	if err != nil {
+		return err
+	}
+	packet.descriptionBuf = buffer
+	packet.updatePacketLength(n)
+	return nil
+}
+
+// ReplaceWithError replace packet with ErrorResponse packet generated by NewPgError
+func (packet *PacketHandler) ReplaceWithError(errorPacket []byte) {
+	packet.messageType[0] = errorPacket[0]
+	packet.descriptionBuf.Reset()
+	packet.descriptionBuf.Write(errorPacket[5:])
+	packet.updatePacketLength(len(errorPacket) - 5)
+}
+
 // GetSimpleQuery return query value as string from Query packet
 func (packet *PacketHandler) GetSimpleQuery() (string, error) {
 	return string(packet.descriptionBuf.Bytes()[:packet.dataLength-1]), nil
@@ -19,6 +19,7 @@ package postgresql
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
@@ -27,6 +28,10 @@ import (
 
 	acracensor "github.com/cossacklabs/acra/acra-censor"
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/decryptor/base/access_policy"
+	"github.com/cossacklabs/acra/decryptor/base/type_awareness"
+	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/keystore/filesystem"
 	"github.com/cossacklabs/acra/logging"
 	"github.com/cossacklabs/acra/network"
@@ -84,15 +89,21 @@ const (
 	// random chosen
 	OutputDefaultSize = 1024
 	// https://www.postgresql.org/docs/9.4/static/protocol-message-formats.html
-	DataRowMessageType       byte = 'D'
-	QueryMessageType         byte = 'Q'
-	ParseMessageType         byte = 'P'
-	BindMessageType          byte = 'B'
-	ExecuteMessageType       byte = 'E'
-	ParseCompleteMessageType byte = '1'
-	BindCompleteMessageType  byte = '2'
-	ReadyForQueryMessageType byte = 'Z'
-	TLSTimeout                    = time.Second * 2
+	DataRowMessageType        byte = 'D'
+	RowDescriptionMessageType byte = 'T'
+	QueryMessageType          byte = 'Q'
+	ParseMessageType          byte = 'P'
+	BindMessageType           byte = 'B'
+	ExecuteMessageType        byte = 'E'
+	ParseCompleteMessageType  byte = '1'
+	BindCompleteMessageType   byte = '2'
+	ReadyForQueryMessageType  byte = 'Z'
+	CopyDataMessageType       byte = 'd'
+	CopyDoneMessageType       byte = 'c'
+	CopyFailMessageType       byte = 'f'
+	// CopyOutResponseMessageType used by database, client uses same type for Flush packets
+	CopyOutResponseMessageType byte = 'H'
+	TLSTimeout                      = time.Second * 2
 )
 
 // Specific for PgSQL values of data format
@@ -117,6 +128,16 @@ type PgProxy struct {
 	setting                 base.ProxySetting
 	clientIDObserverManager base.ClientIDObservableManager
 	parser                  *sqlparser.Parser
+	// used to return decrypted data with configured data types
+	columnSettingProvider encryptor.ColumnEncryptionSettingProvider
+	// true after replacing data row with error until ReadyForQuery packet
+	skipUntilReadyForQuery bool
+	// rows of COPY ... FROM STDIN sent by client, used only by the client's side
+	copyIn *copyStream
+	// true after aborting COPY ... FROM STDIN until CopyDone or CopyFail packet from client
+	copyInFailed bool
+	// rows of COPY ... TO STDOUT sent by database, used only by the database's side
+	copyOut *copyStream
 }
 
 // NewPgProxy returns new PgProxy
@@ -180,6 +201,24 @@ func (proxy *PgProxy) onColumnDecryption(parentCtx context.Context, i int, data
 	return proxy.decryptionObserver.OnColumnDecryption(parentCtx, i, data)
 }
 
+// SetColumnEncryptionSettingProvider set provider of encryption settings of result columns used to update
+// RowDescription and encode column values according to configured data types
+func (proxy *PgProxy) SetColumnEncryptionSettingProvider(provider encryptor.ColumnEncryptionSettingProvider) {
+	proxy.columnSettingProvider = provider
+}
+
+// getColumnDataType return configured data type of the result column or config.EncryptedDataTypeDefault
+func (proxy *PgProxy) getColumnDataType(index int) config.EncryptedDataType {
+	if proxy.columnSettingProvider == nil {
+		return config.EncryptedDataTypeDefault
+	}
+	setting := proxy.columnSettingProvider.GetColumnEncryptionSetting(index)
+	if setting == nil {
+		return config.EncryptedDataTypeDefault
+	}
+	return setting.GetEncryptedDataType()
+}
+
 // AddQueryObserver implement QueryObservable interface and proxy call to ObserverManager
 func (proxy *PgProxy) AddQueryObserver(obs base.QueryObserver) {
 	proxy.queryObserverManager.AddQueryObserver(obs)
@@ -240,6 +279,15 @@ func (proxy *PgProxy) ProxyClientConnection(ctx context.Context, errCh chan<- ba
 		// Massage the packet. This should not normally fail. If it does, the database will not receive the packet.
 		censored, err := proxy.handleClientPacket(ctx, packet, logger)
 		if err != nil {
+			// strict mode of encryptor doesn't allow to pass such queries to the database
+			if encryptor.IsUnsupportedEncryptedWriteError(err) {
+				censorSpan.End()
+				if err := proxy.sendClientError(err.Error(), logger); err != nil {
+					errCh <- base.NewClientProxyError(err)
+					return
+				}
+				continue
+			}
 			errCh <- base.NewClientProxyError(err)
 			return
 		}
@@ -294,6 +342,43 @@ func (proxy *PgProxy) handleClientPacket(ctx context.Context, packet *PacketHand
 		// Also, remember the requested portal name for future data queries.
 		return proxy.handleBindPacket(ctx, packet, logger)
 
+	case CopyDataPacket, CopyDonePacket:
+		// Rows of COPY ... FROM STDIN may contain data for encrypted columns.
+		if proxy.copyInFailed {
+			// COPY was aborted and the database ignores the rest of the rows, so they aren't sent at all
+			if packet.IsCopyData() {
+				packet.ReplaceCopyData(nil)
+			} else {
+				proxy.copyInFailed = false
+			}
+			return false, nil
+		}
+		if proxy.copyIn == nil {
+			return false, nil
+		}
+		copyDone := packet.IsCopyDone()
+		err := proxy.handleCopyPacket(ctx, proxy.copyIn, packet, logger)
+		if copyDone {
+			proxy.copyIn = nil
+		}
+		if err != nil && !filesystem.IsKeyReadError(err) {
+			// Rows which can't be processed mustn't reach the database as is, so abort COPY instead. The database
+			// responds to the client with an error and drops the rest of the rows sent by the client.
+			logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptQueryData).
+				Errorln("Failed to handle rows of COPY statement, abort it")
+			packet.ReplaceWithCopyFail(err.Error())
+			proxy.copyIn = nil
+			proxy.copyInFailed = !copyDone
+			return false, nil
+		}
+		return false, err
+
+	case CopyFailPacket:
+		// Client cancelled COPY ... FROM STDIN, the rest of rows won't be sent.
+		proxy.copyIn = nil
+		proxy.copyInFailed = false
+		return false, nil
+
 	default:
 		// Forward all other uninteresting packets to the database without processing.
 		return false, nil
@@ -322,7 +407,7 @@ func (proxy *PgProxy) handleQueryPacket(ctx context.Context, packet *PacketHandl
 
 	// Let AcraCensor take a look at the query text.
 	// If it's not okay (and we're still alive), don't let the database see the query.
-	if censorErr := proxy.censor.HandleQuery(query.Query()); censorErr != nil {
+	if censorErr := proxy.censor.HandleQuery(base.NewCensorAccessContext(ctx, proxy.clientConnection), query.Query()); censorErr != nil {
 		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCensorQueryIsNotAllowed).
 			WithError(censorErr).Errorln("AcraCensor blocked query")
 		return true, nil
@@ -331,7 +416,7 @@ func (proxy *PgProxy) handleQueryPacket(ctx context.Context, packet *PacketHandl
 	// Let the registered observers observe the query, potentially modifying it (e.g., transparent encryption).
 	newQuery, changed, err := proxy.queryObserverManager.OnQuery(ctx, query)
 	if err != nil {
-		if filesystem.IsKeyReadError(err) {
+		if filesystem.IsKeyReadError(err) || encryptor.IsUnsupportedEncryptedWriteError(err) {
 			return false, err
 		}
 
@@ -341,9 +426,85 @@ func (proxy *PgProxy) handleQueryPacket(ctx context.Context, packet *PacketHandl
 	if changed {
 		packet.ReplaceQuery(newQuery.Query())
 	}
+	// Remember the format of COPY ... FROM STDIN rows to process data which client sends after the query.
+	proxy.copyIn = nil
+	proxy.copyInFailed = false
+	if statement, err := query.Statement(); err == nil {
+		if copyStatement, ok := statement.(*sqlparser.Copy); ok && copyStatement.Direction == sqlparser.CopyFromStdinStr {
+			proxy.copyIn = proxy.newCopyInStream(copyStatement, logger)
+		}
+	}
 	return false, nil
 }
 
+// newCopyInStream return copyStream which encrypts rows of COPY ... FROM STDIN with registered observers as values
+// bound to the statement or nil if rows format is unsupported
+func (proxy *PgProxy) newCopyInStream(statement *sqlparser.Copy, logger *log.Entry) *copyStream {
+	format, err := newCopyFormat(statement)
+	if err != nil {
+		logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptQueryData).
+			Warningln("Can't process data of COPY statement, data will be passed as is")
+		return nil
+	}
+	coder := &encryptor.PostgresqlDBDataCoder{}
+	return newCopyStream(format, func(ctx context.Context, fields []copyField) (map[int][]byte, error) {
+		values := make([]base.BoundValue, len(fields))
+		for i, field := range fields {
+			value := field.value
+			if value != nil {
+				// values of bytea columns decoded as string literals of the query
+				if decoded, err := coder.Decode(sqlparser.NewStrVal(value)); err == nil {
+					value = decoded
+				}
+			}
+			values[i] = NewPgBoundValue(value, base.TextFormat)
+		}
+		newValues, changed, err := proxy.queryObserverManager.OnBind(ctx, statement, values)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			return nil, nil
+		}
+		result := make(map[int][]byte, len(values))
+		for i := range values {
+			newValue := newValues[i].GetData(nil)
+			if bytes.Equal(newValue, values[i].GetData(nil)) {
+				continue
+			}
+			encoded, err := coder.Encode(sqlparser.NewStrVal(nil), newValue)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = encoded
+		}
+		return result, nil
+	})
+}
+
+// handleCopyPacket replace rows in CopyData packet with processed rows. Rest of the buffered data processed as
+// the last row on CopyDone packet and sent in the separate CopyData packet before it
+func (proxy *PgProxy) handleCopyPacket(ctx context.Context, stream *copyStream, packet *PacketHandler, logger *log.Entry) error {
+	if packet.IsCopyData() {
+		rows, err := stream.Process(ctx, packet.GetCopyData(), false)
+		if err != nil {
+			return err
+		}
+		packet.ReplaceCopyData(rows)
+		return nil
+	}
+	rows, err := stream.Process(ctx, nil, true)
+	if err != nil || len(rows) == 0 {
+		return err
+	}
+	if err := packet.writeCopyData(rows); err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorNetworkWrite).
+			WithError(err).Errorln("Can't send the last rows of COPY statement")
+		return err
+	}
+	return nil
+}
+
 func (proxy *PgProxy) handleBindPacket(ctx context.Context, packet *PacketHandler, logger *log.Entry) (bool, error) {
 	bind := proxy.protocolState.PendingBind()
 	log := logger.WithField("portal", bind.PortalName()).WithField("statement", bind.StatementName())
@@ -388,7 +549,12 @@ func (proxy *PgProxy) handleBindPacket(ctx context.Context, packet *PacketHandle
 }
 
 func (proxy *PgProxy) sendClientAcraCensorError(logger *log.Entry) error {
-	errorMessage, err := NewPgError("AcraCensor blocked this query")
+	return proxy.sendClientError("AcraCensor blocked this query", logger)
+}
+
+// sendClientError sends ErrorResponse with message and ReadyForQuery packets to the client
+func (proxy *PgProxy) sendClientError(message string, logger *log.Entry) error {
+	errorMessage, err := NewPgError(message)
 	if err != nil {
 		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingPostgresqlCantGenerateErrorPacket).
 			WithError(err).Errorln("Can't create PostgreSQL error message")
@@ -566,6 +732,15 @@ func (proxy *PgProxy) ProxyDatabaseConnection(ctx context.Context, errCh chan<-
 
 		proxy.clientConnection.SetWriteDeadline(time.Now().Add(network.DefaultNetworkTimeout))
 
+		// Rest of query response after replaced data row shouldn't be sent to the client, client expects ReadyForQuery after error
+		if proxy.skipUntilReadyForQuery {
+			if !packetHandler.IsReadyForQuery() {
+				timer.ObserveDuration()
+				continue
+			}
+			proxy.skipUntilReadyForQuery = false
+		}
+
 		// Massage the packet. This should not normally fail. If it does, the client will not receive the packet.
 		err := proxy.handleDatabasePacket(packetCtx, packetHandler, logger)
 		if err != nil {
@@ -598,6 +773,30 @@ func (proxy *PgProxy) handleDatabasePacket(ctx context.Context, packet *PacketHa
 		// decrypt and process the data in it.
 		return proxy.handleQueryDataPacket(ctx, packet, logger)
 
+	case RowDescriptionResponsePacket:
+		// Description of columns with configured data types should match to values returned after decryption
+		return proxy.handleRowDescriptionPacket(packet, logger)
+
+	case CopyOutResponsePacket:
+		// Database starts to send rows of COPY ... TO STDOUT, remember their format to decrypt them.
+		proxy.copyOut = proxy.newCopyOutStream(packet, logger)
+		return nil
+
+	case CopyDataPacket, CopyDonePacket:
+		// Rows of COPY ... TO STDOUT have the same values as data rows of the query.
+		if proxy.copyOut == nil {
+			return nil
+		}
+		err := proxy.handleCopyPacket(ctx, proxy.copyOut, packet, logger)
+		if packet.IsCopyDone() {
+			proxy.copyOut = nil
+		}
+		if isColumnValueError(err) {
+			proxy.copyOut = nil
+			return proxy.replaceDataRowWithError(packet, err, logger)
+		}
+		return err
+
 	case ParseCompletePacket:
 		// Previously requested prepared statement has been confirmed by the database, register it.
 		preparedStatement := proxy.protocolState.PendingParse()
@@ -614,6 +813,64 @@ func (proxy *PgProxy) handleDatabasePacket(ctx context.Context, packet *PacketHa
 	}
 }
 
+// newCopyOutStream return copyStream which decrypts rows of COPY ... TO STDOUT statement of the pending query or nil
+// if it's another query or rows format is unsupported
+func (proxy *PgProxy) newCopyOutStream(packet *PacketHandler, logger *log.Entry) *copyStream {
+	query := proxy.protocolState.PendingQuery()
+	if query == nil {
+		return nil
+	}
+	statement, err := query.Statement()
+	if err != nil {
+		return nil
+	}
+	copyStatement, ok := statement.(*sqlparser.Copy)
+	if !ok || copyStatement.Direction != sqlparser.CopyToStdoutStr {
+		return nil
+	}
+	dataFormat, err := packet.GetCopyOutResponseFormat()
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingPostgresqlCantParseColumnsDescription).
+			WithError(err).Errorln("Can't parse CopyOutResponse packet")
+		return nil
+	}
+	format, err := newCopyFormat(copyStatement)
+	if err != nil || dataFormat != base.TextFormat {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
+			Warningln("Can't process data of COPY statement with unsupported format, data will be passed as is")
+		return nil
+	}
+	return newCopyStream(format, func(ctx context.Context, fields []copyField) (map[int][]byte, error) {
+		var result map[int][]byte
+		for i, field := range fields {
+			if field.value == nil {
+				continue
+			}
+			column, err := newTextColumnData(field.value)
+			if err != nil {
+				logger.WithError(err).WithField("column_index", i).Debugln("Can't decode value of COPY row, leave it as is")
+				continue
+			}
+			if err := proxy.decryptColumn(ctx, i, column, false); err != nil {
+				if !isColumnValueError(err) {
+					logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
+						WithError(err).Errorln("Error on column data processing")
+				}
+				return nil, err
+			}
+			newValue := column.data.Encoded()
+			if bytes.Equal(newValue, field.value) {
+				continue
+			}
+			if result == nil {
+				result = make(map[int][]byte, len(fields))
+			}
+			result[i] = newValue
+		}
+		return result, nil
+	})
+}
+
 func (proxy *PgProxy) handleQueryDataPacket(ctx context.Context, packet *PacketHandler, logger *log.Entry) error {
 	logger.Debugln("Matched data row packet")
 	// by default it's text format
@@ -654,19 +911,84 @@ func (proxy *PgProxy) handleQueryDataPacket(ctx context.Context, packet *PacketH
 			format = int(boundFormat)
 		}
 
-		newData, err := proxy.onColumnDecryption(ctx, i, column.GetData(), format == dataFormatBinary)
-		if err != nil {
+		if err := proxy.decryptColumn(ctx, i, column, format == dataFormatBinary); err != nil {
+			if isColumnValueError(err) {
+				return proxy.replaceDataRowWithError(packet, err, logger)
+			}
 			logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorGeneral).
 				WithError(err).Errorln("Error on column data processing")
 			return err
 		}
-		column.SetData(newData)
 	}
 	// After we're done processing the columns, update the actual packet data from them
 	packet.updateDataFromColumns()
 	return nil
 }
 
+// decryptColumn process value of the result column with subscribers and set new value to the column
+func (proxy *PgProxy) decryptColumn(ctx context.Context, i int, column *ColumnData, binaryFormat bool) error {
+	newData, err := proxy.onColumnDecryption(ctx, i, column.GetData(), binaryFormat)
+	if err != nil {
+		return err
+	}
+	// values of columns with data type already encoded in the final format
+	if proxy.getColumnDataType(i) != config.EncryptedDataTypeDefault {
+		column.SetRawData(newData)
+		return nil
+	}
+	column.SetData(newData)
+	return nil
+}
+
+// handleRowDescriptionPacket replace types of the columns with configured data type
+func (proxy *PgProxy) handleRowDescriptionPacket(packet *PacketHandler, logger *log.Entry) error {
+	if proxy.columnSettingProvider == nil {
+		return nil
+	}
+	rowDescription, err := packet.GetRowDescriptionData()
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingPostgresqlCantParseRowDescription).
+			WithError(err).Errorln("Can't parse RowDescription packet")
+		return err
+	}
+	changed := false
+	for i, field := range rowDescription.Fields {
+		description, ok := pgDataTypeDescriptions[proxy.getColumnDataType(i)]
+		if !ok {
+			continue
+		}
+		field.TypeOID = description.oid
+		field.TypeSize = description.size
+		field.TypeModifier = -1
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return packet.ReplaceRowDescription(rowDescription)
+}
+
+// isColumnValueError return true if the column value can't be returned to the client and the error should be returned
+// to the client instead of the rows
+func isColumnValueError(err error) bool {
+	return type_awareness.IsEncodingError(err) || access_policy.IsAccessDeniedError(err)
+}
+
+// replaceDataRowWithError replace DataRow packet with ErrorResponse and skip rest of the response until ReadyForQuery
+func (proxy *PgProxy) replaceDataRowWithError(packet *PacketHandler, encodingError error, logger *log.Entry) error {
+	logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingCantEncodeDataTypeValue).
+		WithError(encodingError).Errorln("Can't return column value, return error to the client")
+	errorPacket, err := NewPgError(encodingError.Error())
+	if err != nil {
+		logger.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorCodingPostgresqlCantGenerateErrorPacket).
+			WithError(err).Errorln("Can't create PostgreSQL error message")
+		return err
+	}
+	packet.ReplaceWithError(errorPacket)
+	proxy.skipUntilReadyForQuery = true
+	return nil
+}
+
 func (proxy *PgProxy) registerPreparedStatement(preparedStatement *ParsePacket, logger *log.Entry) error {
 	name := preparedStatement.Name()
 	queryText := preparedStatement.QueryString()
@@ -23,6 +23,7 @@ import (
 
 	"github.com/cossacklabs/acra/decryptor/base"
 	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/pseudonymization"
 	tokens "github.com/cossacklabs/acra/pseudonymization/common"
 	"github.com/cossacklabs/acra/sqlparser"
 )
@@ -260,6 +261,12 @@ func (p *pgBoundValue) SetData(newData []byte, setting config.ColumnEncryptionSe
 			output := make([]byte, 8)
 			binary.BigEndian.PutUint64(output[:], uint64(newVal))
 			p.data = output
+		case tokens.TokenType_UUID, tokens.TokenType_Date, tokens.TokenType_Timestamp:
+			output, err := pseudonymization.EncodePgSQLBinaryValue(setting.GetTokenType(), newData)
+			if err != nil {
+				return err
+			}
+			p.data = output
 		}
 	}
 	return nil
@@ -292,6 +299,11 @@ func (p *pgBoundValue) GetData(setting config.ColumnEncryptionSetting) []byte {
 				value := binary.BigEndian.Uint64(p.data)
 				strValue := strconv.FormatInt(int64(value), 10)
 				decodedData = []byte(strValue)
+			case tokens.TokenType_UUID, tokens.TokenType_Date, tokens.TokenType_Timestamp:
+				// invalid value returned as is and rejected by tokenizer
+				if value, err := pseudonymization.DecodePgSQLBinaryValue(setting.GetTokenType(), p.data); err == nil {
+					decodedData = value
+				}
 			}
 		}
 	}
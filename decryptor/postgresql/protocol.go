@@ -44,6 +44,11 @@ const (
 	BindStatementPacket
 	BindCompletePacket
 	DataPacket
+	RowDescriptionResponsePacket
+	CopyOutResponsePacket
+	CopyDataPacket
+	CopyDonePacket
+	CopyFailPacket
 	OtherPacket
 )
 
@@ -122,6 +127,20 @@ func (p *PgProtocolState) HandleClientPacket(packet *PacketHandler) error {
 		return nil
 	}
 
+	// Rows of COPY ... FROM STDIN statement and the end of data.
+	if packet.IsCopyData() {
+		p.lastPacketType = CopyDataPacket
+		return nil
+	}
+	if packet.IsCopyDone() {
+		p.lastPacketType = CopyDonePacket
+		return nil
+	}
+	if packet.IsCopyFail() {
+		p.lastPacketType = CopyFailPacket
+		return nil
+	}
+
 	// Execute packets initiate data retrieval from portals.
 	if packet.IsExecute() {
 		executePacket, err := packet.GetExecuteData()
@@ -150,11 +169,32 @@ func (p *PgProtocolState) HandleDatabasePacket(packet *PacketHandler) error {
 		return nil
 	}
 
+	if packet.IsRowDescription() {
+		p.lastPacketType = RowDescriptionResponsePacket
+		return nil
+	}
+
 	if packet.IsParseComplete() {
 		p.lastPacketType = ParseCompletePacket
 		return nil
 	}
 
+	// Database starts to send rows of COPY ... TO STDOUT statement.
+	if packet.IsCopyOutResponse() {
+		p.lastPacketType = CopyOutResponsePacket
+		return nil
+	}
+
+	if packet.IsCopyData() {
+		p.lastPacketType = CopyDataPacket
+		return nil
+	}
+
+	if packet.IsCopyDone() {
+		p.lastPacketType = CopyDonePacket
+		return nil
+	}
+
 	if packet.IsBindComplete() {
 		p.lastPacketType = BindCompletePacket
 		return nil
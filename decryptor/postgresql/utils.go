@@ -404,6 +404,82 @@ func NewExecutePacket(data []byte) (*ExecutePacket, error) {
 	return &ExecutePacket{portal, maxRows}, nil
 }
 
+// RowDescriptionField describes one column of the "RowDescription" packet
+type RowDescriptionField struct {
+	Name         string
+	TableOID     uint32
+	ColumnAttr   uint16
+	TypeOID      uint32
+	TypeSize     int16
+	TypeModifier int32
+	Format       uint16
+}
+
+// 4 bytes table OID + 2 bytes column attribute number + 4 bytes type OID + 2 bytes type size + 4 bytes type modifier + 2 bytes format
+const rowDescriptionFieldFixedSize = 18
+
+// RowDescriptionPacket represents "RowDescription" packet of the PostgreSQL protocol,
+// containing descriptions of the columns of the query result.
+// See https://www.postgresql.org/docs/current/protocol-message-formats.html
+type RowDescriptionPacket struct {
+	Fields []*RowDescriptionField
+}
+
+// NewRowDescriptionPacket parses RowDescription packet from data.
+func NewRowDescriptionPacket(data []byte) (*RowDescriptionPacket, error) {
+	if len(data) < 2 {
+		return nil, ErrPacketTruncated
+	}
+	fieldCount := int(binary.BigEndian.Uint16(data))
+	data = data[2:]
+	fields := make([]*RowDescriptionField, 0, fieldCount)
+	for i := 0; i < fieldCount; i++ {
+		name, remaining, err := readString(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(remaining) < rowDescriptionFieldFixedSize {
+			return nil, ErrPacketTruncated
+		}
+		fields = append(fields, &RowDescriptionField{
+			Name:         name,
+			TableOID:     binary.BigEndian.Uint32(remaining[0:4]),
+			ColumnAttr:   binary.BigEndian.Uint16(remaining[4:6]),
+			TypeOID:      binary.BigEndian.Uint32(remaining[6:10]),
+			TypeSize:     int16(binary.BigEndian.Uint16(remaining[10:12])),
+			TypeModifier: int32(binary.BigEndian.Uint32(remaining[12:16])),
+			Format:       binary.BigEndian.Uint16(remaining[16:18]),
+		})
+		data = remaining[rowDescriptionFieldFixedSize:]
+	}
+	return &RowDescriptionPacket{Fields: fields}, nil
+}
+
+// MarshalInto packet contents into the buffer, returns number of written bytes.
+func (p *RowDescriptionPacket) MarshalInto(buffer *bytes.Buffer) (int, error) {
+	if len(p.Fields) > math.MaxUint16 {
+		return 0, ErrArrayTooBig
+	}
+	total := 0
+	fixedBuf := make([]byte, rowDescriptionFieldFixedSize)
+	binary.BigEndian.PutUint16(fixedBuf[:2], uint16(len(p.Fields)))
+	n, _ := buffer.Write(fixedBuf[:2])
+	total += n
+	for _, field := range p.Fields {
+		n, _ = writeString(buffer, field.Name)
+		total += n
+		binary.BigEndian.PutUint32(fixedBuf[0:4], field.TableOID)
+		binary.BigEndian.PutUint16(fixedBuf[4:6], field.ColumnAttr)
+		binary.BigEndian.PutUint32(fixedBuf[6:10], field.TypeOID)
+		binary.BigEndian.PutUint16(fixedBuf[10:12], uint16(field.TypeSize))
+		binary.BigEndian.PutUint32(fixedBuf[12:16], uint32(field.TypeModifier))
+		binary.BigEndian.PutUint16(fixedBuf[16:18], field.Format)
+		n, _ = buffer.Write(fixedBuf)
+		total += n
+	}
+	return total, nil
+}
+
 func readString(data []byte) (string, []byte, error) {
 	// Read null-terminated string, don't include the terminator into value.
 	end := bytes.Index(data, terminator)
@@ -62,3 +62,36 @@ func TestParseCommand(t *testing.T) {
 		t.Fatal("parsed and marshaled data not equal")
 	}
 }
+
+func TestRowDescriptionPacket(t *testing.T) {
+	// RowDescription payload of "select id, data from test" without message type and length, took from wireshark
+	packetHex := "0002696400000040010001000000170004ffffffff0000646174610000004001000200000011ffffffffffff0000"
+	data, err := hex.DecodeString(packetHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packet, err := NewRowDescriptionPacket(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(packet.Fields) != 2 {
+		t.Fatalf("Expect 2 fields, took %d\n", len(packet.Fields))
+	}
+	if packet.Fields[0].Name != "id" || packet.Fields[0].TypeOID != pgTypeInt4OID || packet.Fields[0].TypeSize != 4 {
+		t.Fatalf("Incorrect first field: %+v\n", packet.Fields[0])
+	}
+	if packet.Fields[1].Name != "data" || packet.Fields[1].TypeOID != pgTypeByteaOID || packet.Fields[1].TypeSize != -1 {
+		t.Fatalf("Incorrect second field: %+v\n", packet.Fields[1])
+	}
+	output := &bytes.Buffer{}
+	n, err := packet.MarshalInto(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(data) || !bytes.Equal(output.Bytes(), data) {
+		t.Fatalf("Marshaled packet not equal to source: %x != %x\n", output.Bytes(), data)
+	}
+	if _, err := NewRowDescriptionPacket(data[:len(data)-1]); err != ErrPacketTruncated {
+		t.Fatalf("Expect %s, took %v\n", ErrPacketTruncated, err)
+	}
+}
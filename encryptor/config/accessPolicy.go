@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import "errors"
+
+// AccessAction defines what is returned to the client for the column value according to access policy
+type AccessAction string
+
+// Supported AccessAction values
+const (
+	// AccessActionDefault used for ClientIDs without rules for the column, value is decrypted if ClientID's keys allow
+	AccessActionDefault AccessAction = ""
+	// AccessActionClear returns decrypted or detokenized value
+	AccessActionClear AccessAction = "clear"
+	// AccessActionMasked returns masked value without decryption
+	AccessActionMasked AccessAction = "masked"
+	// AccessActionTokenized returns token without detokenization
+	AccessActionTokenized AccessAction = "tokenized"
+	// AccessActionDeny returns error instead of the value
+	AccessActionDeny AccessAction = "deny"
+)
+
+// Errors related to access policies
+var (
+	ErrUnsupportedAccessAction            = errors.New("access policy action must be clear, masked, tokenized or deny")
+	ErrAccessPolicyWithoutClientID        = errors.New("access policy should have client_id")
+	ErrAccessPolicyUnknownColumn          = errors.New("access policy rule refers to table or column without encryption settings")
+	ErrAccessPolicyDuplicateRule          = errors.New("access policy has several rules for the same client_id and column")
+	ErrMaskedAccessWithoutMasking         = errors.New("masked action may be used only for columns with masking")
+	ErrTokenizedAccessWithoutTokenization = errors.New("tokenized action may be used only for tokenized columns")
+)
+
+// accessPolicy maps ClientID to actions for columns. ClientIDs derived from TLS certificates are used as is
+type accessPolicy struct {
+	ClientID string        `yaml:"client_id"`
+	Rules    []*accessRule `yaml:"columns"`
+}
+
+// accessRule is an action for the column of the table, table should be equal to one in schemas
+type accessRule struct {
+	Table  string       `yaml:"table"`
+	Column string       `yaml:"column"`
+	Action AccessAction `yaml:"action"`
+}
+
+// ValidateAccessAction return error if value is unsupported AccessAction
+func ValidateAccessAction(value AccessAction) error {
+	switch value {
+	case AccessActionClear, AccessActionMasked, AccessActionTokenized, AccessActionDeny:
+		return nil
+	default:
+		return ErrUnsupportedAccessAction
+	}
+}
+
+// applyAccessPolicies validate policies and assign actions to column settings of schemas
+func applyAccessPolicies(schemas []*tableSchema, policies []*accessPolicy) error {
+	for _, policy := range policies {
+		if policy.ClientID == "" {
+			return ErrAccessPolicyWithoutClientID
+		}
+		for _, rule := range policy.Rules {
+			if err := ValidateAccessAction(rule.Action); err != nil {
+				return err
+			}
+			setting := findColumnSetting(schemas, rule.Table, rule.Column)
+			if setting == nil {
+				return ErrAccessPolicyUnknownColumn
+			}
+			if err := setting.setAccessAction(policy.ClientID, rule.Action); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func findColumnSetting(schemas []*tableSchema, table, column string) *BasicColumnEncryptionSetting {
+	for _, schema := range schemas {
+		if schema.TableName != table {
+			continue
+		}
+		for _, setting := range schema.EncryptionColumnSettings {
+			if setting.Name == column {
+				return setting
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// EncryptedDataType type of plaintext stored encrypted in the column, used to return decrypted data with expected type
+type EncryptedDataType string
+
+// Supported EncryptedDataType values
+const (
+	EncryptedDataTypeDefault   EncryptedDataType = ""
+	EncryptedDataTypeInt32     EncryptedDataType = "int32"
+	EncryptedDataTypeInt64     EncryptedDataType = "int64"
+	EncryptedDataTypeString    EncryptedDataType = "str"
+	EncryptedDataTypeBytes     EncryptedDataType = "bytes"
+	EncryptedDataTypeDate      EncryptedDataType = "date"
+	EncryptedDataTypeTimestamp EncryptedDataType = "timestamp"
+	EncryptedDataTypeNumeric   EncryptedDataType = "numeric"
+)
+
+// ResponseOnFail describes what should be returned to the client if data can't be decrypted
+type ResponseOnFail string
+
+// Supported ResponseOnFail values
+const (
+	ResponseOnFailEmpty        ResponseOnFail = ""
+	ResponseOnFailCiphertext   ResponseOnFail = "ciphertext"
+	ResponseOnFailDefaultValue ResponseOnFail = "default_value"
+	ResponseOnFailError        ResponseOnFail = "error"
+)
+
+// Text layouts of date/timestamp values expected in plaintext
+const (
+	DateLayout      = "2006-01-02"
+	TimestampLayout = "2006-01-02 15:04:05"
+)
+
+// Errors related to type-aware decryption settings
+var (
+	ErrUnsupportedEncryptedDataType   = errors.New("unsupported data_type")
+	ErrUnsupportedResponseOnFail      = errors.New("unsupported on_fail value")
+	ErrInvalidDataTypeValue           = errors.New("value doesn't match data_type")
+	ErrDefaultDataValueRequired       = errors.New("on_fail: default_value requires default_data_value")
+	ErrDefaultDataValueWithoutOnFail  = errors.New("default_data_value may be used only with on_fail: default_value")
+	ErrCiphertextResponseNotSupported = errors.New("on_fail: ciphertext supported only for str and bytes data types")
+	ErrDataTypeWithTokenization       = errors.New("data_type and on_fail can't be used with tokenization, use token_type instead")
+	ErrDataTypeWithMasking            = errors.New("masking supports only str and bytes data types")
+)
+
+var numericRegexp = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+// ValidateEncryptedDataType return error if value is unsupported EncryptedDataType
+func ValidateEncryptedDataType(value EncryptedDataType) error {
+	switch value {
+	case EncryptedDataTypeDefault, EncryptedDataTypeInt32, EncryptedDataTypeInt64, EncryptedDataTypeString, EncryptedDataTypeBytes,
+		EncryptedDataTypeDate, EncryptedDataTypeTimestamp, EncryptedDataTypeNumeric:
+		return nil
+	default:
+		return ErrUnsupportedEncryptedDataType
+	}
+}
+
+// ValidateResponseOnFail return error if value is unsupported ResponseOnFail
+func ValidateResponseOnFail(value ResponseOnFail) error {
+	switch value {
+	case ResponseOnFailEmpty, ResponseOnFailCiphertext, ResponseOnFailDefaultValue, ResponseOnFailError:
+		return nil
+	default:
+		return ErrUnsupportedResponseOnFail
+	}
+}
+
+// IsBinaryCompatible return true if any binary value may be returned for this type as is
+func (dataType EncryptedDataType) IsBinaryCompatible() bool {
+	return dataType == EncryptedDataTypeDefault || dataType == EncryptedDataTypeString || dataType == EncryptedDataTypeBytes
+}
+
+// ParseDate parse plaintext value of date column
+func ParseDate(value []byte) (time.Time, error) {
+	return time.Parse(DateLayout, string(value))
+}
+
+// ParseTimestamp parse plaintext value of timestamp column, fractional seconds are optional
+func ParseTimestamp(value []byte) (time.Time, error) {
+	parsed, err := time.Parse(TimestampLayout, string(value))
+	if err != nil {
+		// allow ISO 8601 separator
+		return time.Parse("2006-01-02T15:04:05", string(value))
+	}
+	return parsed, nil
+}
+
+// ValidateDataTypeValue return ErrInvalidDataTypeValue if value can't be represented as dataType
+func ValidateDataTypeValue(dataType EncryptedDataType, value []byte) error {
+	var err error
+	switch dataType {
+	case EncryptedDataTypeInt32:
+		_, err = strconv.ParseInt(string(value), 10, 32)
+	case EncryptedDataTypeInt64:
+		_, err = strconv.ParseInt(string(value), 10, 64)
+	case EncryptedDataTypeDate:
+		_, err = ParseDate(value)
+	case EncryptedDataTypeTimestamp:
+		_, err = ParseTimestamp(value)
+	case EncryptedDataTypeNumeric:
+		if !numericRegexp.Match(value) {
+			err = ErrInvalidDataTypeValue
+		}
+	}
+	if err != nil {
+		return ErrInvalidDataTypeValue
+	}
+	return nil
+}
@@ -18,6 +18,8 @@ package config
 
 import (
 	"errors"
+	"regexp"
+
 	maskingCommon "github.com/cossacklabs/acra/masking/common"
 	"github.com/cossacklabs/acra/pseudonymization/common"
 )
@@ -39,6 +41,10 @@ const (
 	SettingZoneIDFlag
 	SettingAcraBlockEncryptionFlag
 	SettingAcraStructEncryptionFlag
+	// SettingDataTypeFlag isn't part of validSettings and set after validation of other flags
+	SettingDataTypeFlag
+	// SettingAccessPolicyFlag isn't part of validSettings and set for columns with access policy rules
+	SettingAccessPolicyFlag
 )
 
 // validSettings store all valid combinations of encryption settings
@@ -102,6 +108,12 @@ var tokenTypeNames = map[string]common.TokenType{
 	"bytes": common.TokenType_Bytes,
 	"email": common.TokenType_Email,
 
+	"phone":       common.TokenType_Phone,
+	"uuid":        common.TokenType_UUID,
+	"date":        common.TokenType_Date,
+	"timestamp":   common.TokenType_Timestamp,
+	"card_number": common.TokenType_CardNumber,
+
 	"int32_string": common.TokenType_Int32Str,
 	"int64_string": common.TokenType_Int64Str,
 }
@@ -141,16 +153,36 @@ type BasicColumnEncryptionSetting struct {
 	Tokenized              bool   `yaml:"tokenized"`
 	ConsistentTokenization bool   `yaml:"consistent_tokenization"`
 	TokenType              string `yaml:"token_type"`
+	// Tokens generated with format-preserving encryption without storage
+	TokenizationMode           TokenizationMode                 `yaml:"tokenization_mode"`
+	FormatPreservingAlgorithm  common.FormatPreservingAlgorithm `yaml:"format_preserving_algorithm"`
+	FormatPreservingLuhn       bool                             `yaml:"format_preserving_luhn"`
+	FormatPreservingKeyVersion int                              `yaml:"format_preserving_key_version"`
+	// Retention of tokens in the token storage
+	TokenTTL       string `yaml:"token_ttl"`
+	TokenIdleTTL   string `yaml:"idle_ttl"`
+	tokenRetention common.TokenRetention
 
 	// Searchable encryption
-	Searchable bool `yaml:"searchable"`
+	Searchable       bool              `yaml:"searchable"`
+	SearchablePrefix *SearchablePrefix `yaml:"searchable_prefix"`
 	// Data masking
 	MaskingPattern           string                      `yaml:"masking"`
 	PartialPlaintextLenBytes int                         `yaml:"plaintext_length"`
 	PlaintextSide            maskingCommon.PlainTextSide `yaml:"plaintext_side"`
+	MaskingMode              maskingCommon.MaskingMode   `yaml:"masking_mode"`
+	MaskingRegex             string                      `yaml:"masking_regex"`
 	CryptoEnvelope           *CryptoEnvelopeType         `yaml:"crypto_envelope"`
 	ReEncryptToAcraBlock     *bool                       `yaml:"reencrypting_to_acrablocks"`
-	settingMask              SettingMask
+
+	// Type-aware decryption
+	DataType         EncryptedDataType `yaml:"data_type"`
+	ResponseOnFail   ResponseOnFail    `yaml:"on_fail"`
+	DefaultDataValue *string           `yaml:"default_data_value"`
+	settingMask      SettingMask
+	maskingRegex     *regexp.Regexp
+	// actions of access policies per ClientID
+	accessActions map[string]AccessAction
 }
 
 // Init validate and initialize SettingMask
@@ -198,14 +230,24 @@ func (s *BasicColumnEncryptionSetting) Init() error {
 		if s.ConsistentTokenization {
 			s.settingMask |= SettingConsistentTokenizationFlag
 		}
+		if err := s.initTokenizationMode(tokenType); err != nil {
+			return err
+		}
+		if err := s.initTokenRetention(); err != nil {
+			return err
+		}
 		// due to tokenization supports only AcraBlock and for backward compatibility, we reconfigure CryptoEnvelope always for AcraBlock
 		// to leave Defaults support
 		s.settingMask &= ^SettingAcraStructEncryptionFlag
 		s.settingMask |= SettingAcraBlockEncryptionFlag
+	} else if s.TokenizationMode != "" {
+		return ErrTokenizationModeWithoutTokenize
+	} else if s.TokenTTL != "" || s.TokenIdleTTL != "" {
+		return ErrTokenRetentionWithoutTokenize
 	}
 
-	if s.MaskingPattern != "" || s.PlaintextSide != "" {
-		if err := maskingCommon.ValidateMaskingParams(s.MaskingPattern, s.PartialPlaintextLenBytes, s.PlaintextSide); err != nil {
+	if s.MaskingPattern != "" || s.PlaintextSide != "" || s.MaskingMode != "" || s.MaskingRegex != "" {
+		if err := s.initMasking(); err != nil {
 			return err
 		}
 		s.settingMask |= SettingMaskingFlag | SettingMaskingPlaintextLengthFlag | SettingMaskingPlaintextSideFlag
@@ -213,10 +255,123 @@ func (s *BasicColumnEncryptionSetting) Init() error {
 	if s.Searchable {
 		s.settingMask |= SettingSearchFlag
 	}
+	if err := s.initSearchablePrefix(); err != nil {
+		return err
+	}
 	_, ok := validSettings[s.settingMask]
 	if !ok {
 		return ErrInvalidEncryptorConfig
 	}
+	if err := s.initDataType(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// setAccessAction validate action of access policy for the column and assign it to the ClientID
+func (s *BasicColumnEncryptionSetting) setAccessAction(clientID string, action AccessAction) error {
+	if _, ok := s.accessActions[clientID]; ok {
+		return ErrAccessPolicyDuplicateRule
+	}
+	if action == AccessActionMasked && s.settingMask&SettingMaskingFlag == 0 {
+		return ErrMaskedAccessWithoutMasking
+	}
+	if action == AccessActionTokenized && s.settingMask&SettingTokenizationFlag == 0 {
+		return ErrTokenizedAccessWithoutTokenization
+	}
+	if s.accessActions == nil {
+		s.accessActions = make(map[string]AccessAction)
+	}
+	s.accessActions[clientID] = action
+	s.settingMask |= SettingAccessPolicyFlag
+	return nil
+}
+
+// initMasking validate masking settings and compile masking_regex
+func (s *BasicColumnEncryptionSetting) initMasking() error {
+	s.maskingRegex = nil
+	if s.MaskingRegex != "" {
+		regex, err := regexp.Compile(s.MaskingRegex)
+		if err != nil {
+			return maskingCommon.ErrInvalidMaskingRegex
+		}
+		s.maskingRegex = regex
+	}
+	return maskingCommon.ValidateMaskingParams(s.MaskingPattern, s.GetMaskingOptions())
+}
+
+// initTokenizationMode validate settings of format-preserving tokenization
+func (s *BasicColumnEncryptionSetting) initTokenizationMode(tokenType common.TokenType) error {
+	if err := ValidateTokenizationMode(s.TokenizationMode); err != nil {
+		return err
+	}
+	if s.TokenizationMode != TokenizationModeFormatPreserving {
+		if s.FormatPreservingAlgorithm != common.FormatPreservingAlgorithmDefault || s.FormatPreservingLuhn || s.FormatPreservingKeyVersion != 0 {
+			return ErrFormatPreservingOptionsWithMode
+		}
+		return nil
+	}
+	return s.GetFormatPreservingOptions().Validate(tokenType)
+}
+
+// initTokenRetention validate and parse token_ttl and idle_ttl
+func (s *BasicColumnEncryptionSetting) initTokenRetention() error {
+	s.tokenRetention = common.TokenRetention{}
+	if s.TokenTTL == "" && s.TokenIdleTTL == "" {
+		return nil
+	}
+	// format-preserving tokens aren't saved in the storage so there is nothing to expire
+	if s.IsFormatPreservingTokenization() {
+		return ErrTokenRetentionWithFormatPreserving
+	}
+	var err error
+	if s.TokenTTL != "" {
+		if s.tokenRetention.TTL, err = common.ParseRetentionDuration(s.TokenTTL); err != nil {
+			return err
+		}
+	}
+	if s.TokenIdleTTL != "" {
+		if s.tokenRetention.IdleTTL, err = common.ParseRetentionDuration(s.TokenIdleTTL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initDataType validate type-aware decryption settings
+func (s *BasicColumnEncryptionSetting) initDataType() error {
+	if err := ValidateEncryptedDataType(s.DataType); err != nil {
+		return err
+	}
+	if err := ValidateResponseOnFail(s.ResponseOnFail); err != nil {
+		return err
+	}
+	if s.DataType == EncryptedDataTypeDefault && s.ResponseOnFail == ResponseOnFailEmpty && s.DefaultDataValue == nil {
+		return nil
+	}
+	if s.Tokenized {
+		return ErrDataTypeWithTokenization
+	}
+	if s.settingMask&SettingMaskingFlag == SettingMaskingFlag && !s.DataType.IsBinaryCompatible() {
+		return ErrDataTypeWithMasking
+	}
+	switch s.ResponseOnFail {
+	case ResponseOnFailCiphertext:
+		if !s.DataType.IsBinaryCompatible() {
+			return ErrCiphertextResponseNotSupported
+		}
+	case ResponseOnFailDefaultValue:
+		if s.DefaultDataValue == nil {
+			return ErrDefaultDataValueRequired
+		}
+		if err := ValidateDataTypeValue(s.DataType, []byte(*s.DefaultDataValue)); err != nil {
+			return err
+		}
+	}
+	if s.DefaultDataValue != nil && s.ResponseOnFail != ResponseOnFailDefaultValue {
+		return ErrDefaultDataValueWithoutOnFail
+	}
+	s.settingMask |= SettingDataTypeFlag
 	return nil
 }
 
@@ -283,11 +438,31 @@ func (s *BasicColumnEncryptionSetting) GetTokenType() common.TokenType {
 	return common.NormalizeTokenType(tokenType, defaultTokenType)
 }
 
+// IsFormatPreservingTokenization returns true if tokens should be generated with format-preserving encryption.
+func (s *BasicColumnEncryptionSetting) IsFormatPreservingTokenization() bool {
+	return s.TokenizationMode == TokenizationModeFormatPreserving
+}
+
+// GetFormatPreservingOptions returns options of format-preserving tokenization.
+func (s *BasicColumnEncryptionSetting) GetFormatPreservingOptions() common.FormatPreservingOptions {
+	return common.FormatPreservingOptions{Algorithm: s.FormatPreservingAlgorithm, LuhnValid: s.FormatPreservingLuhn, KeyVersion: s.FormatPreservingKeyVersion}
+}
+
+// GetTokenRetention returns retention of tokens saved in the token storage.
+func (s *BasicColumnEncryptionSetting) GetTokenRetention() common.TokenRetention {
+	return s.tokenRetention
+}
+
 // IsSearchable returns true if column should be searchable.
 func (s *BasicColumnEncryptionSetting) IsSearchable() bool {
 	return s.Searchable
 }
 
+// GetSearchablePrefix returns lengths of indexed value prefixes or nil if prefix search is disabled.
+func (s *BasicColumnEncryptionSetting) GetSearchablePrefix() *SearchablePrefix {
+	return s.SearchablePrefix
+}
+
 // GetMaskingPattern returns string which should be used to mask AcraStruct data.
 func (s *BasicColumnEncryptionSetting) GetMaskingPattern() string {
 	return s.MaskingPattern
@@ -298,11 +473,48 @@ func (s *BasicColumnEncryptionSetting) GetPartialPlaintextLen() int {
 	return s.PartialPlaintextLenBytes
 }
 
+// GetMaskingOptions returns which parts of the value should be masked.
+func (s *BasicColumnEncryptionSetting) GetMaskingOptions() maskingCommon.MaskingOptions {
+	return maskingCommon.MaskingOptions{
+		Mode:            s.MaskingMode,
+		PlaintextLength: s.PartialPlaintextLenBytes,
+		PlaintextSide:   s.PlaintextSide,
+		Regex:           s.maskingRegex,
+	}
+}
+
+// GetAccessAction returns action of access policy for the ClientID or AccessActionDefault if there is no rule for it.
+func (s *BasicColumnEncryptionSetting) GetAccessAction(clientID []byte) AccessAction {
+	return s.accessActions[string(clientID)]
+}
+
 // IsEndMasking returns true if the right part of the value should be masked.
 func (s *BasicColumnEncryptionSetting) IsEndMasking() bool {
 	return s.PlaintextSide == maskingCommon.PlainTextSideLeft
 }
 
+// GetEncryptedDataType returns type of data stored encrypted in the column
+func (s *BasicColumnEncryptionSetting) GetEncryptedDataType() EncryptedDataType {
+	return s.DataType
+}
+
+// GetResponseOnFail returns what should be returned if data can't be decrypted. Types that can't hold ciphertext
+// return error by default
+func (s *BasicColumnEncryptionSetting) GetResponseOnFail() ResponseOnFail {
+	if s.ResponseOnFail != ResponseOnFailEmpty {
+		return s.ResponseOnFail
+	}
+	if s.DataType.IsBinaryCompatible() {
+		return ResponseOnFailCiphertext
+	}
+	return ResponseOnFailError
+}
+
+// GetDefaultDataValue returns value used with ResponseOnFailDefaultValue or nil
+func (s *BasicColumnEncryptionSetting) GetDefaultDataValue() *string {
+	return s.DefaultDataValue
+}
+
 func (s *BasicColumnEncryptionSetting) applyDefaults(defaults defaultValues) {
 	if s.CryptoEnvelope == nil {
 		v := defaults.GetCryptoEnvelope()
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"sync/atomic"
+)
+
+// tableSchemaStoreHolder used to store values of different TableSchemaStore implementations in atomic.Value
+// which requires values of the same concrete type
+type tableSchemaStoreHolder struct {
+	store TableSchemaStore
+}
+
+// ReloadableTableSchemaStore TableSchemaStore which delegates calls to the current schema store that may be
+// atomically replaced with new one, for example after re-reading of encryptor config
+type ReloadableTableSchemaStore struct {
+	current atomic.Value
+}
+
+// NewReloadableTableSchemaStore return new ReloadableTableSchemaStore with store as current schema store
+func NewReloadableTableSchemaStore(store TableSchemaStore) *ReloadableTableSchemaStore {
+	reloadableStore := &ReloadableTableSchemaStore{}
+	reloadableStore.Store(store)
+	return reloadableStore
+}
+
+// Store replaces current schema store. Queries processed with previous store keep using it
+func (store *ReloadableTableSchemaStore) Store(schemaStore TableSchemaStore) {
+	store.current.Store(tableSchemaStoreHolder{schemaStore})
+}
+
+// Load return current schema store
+func (store *ReloadableTableSchemaStore) Load() TableSchemaStore {
+	return store.current.Load().(tableSchemaStoreHolder).store
+}
+
+// GetTableSchema return table schema from current schema store
+func (store *ReloadableTableSchemaStore) GetTableSchema(tableName string) TableSchema {
+	return store.Load().GetTableSchema(tableName)
+}
+
+// GetGlobalSettingsMask return settings mask of current schema store
+func (store *ReloadableTableSchemaStore) GetGlobalSettingsMask() SettingMask {
+	return store.Load().GetGlobalSettingsMask()
+}
+
+// IsStrictMode return strict mode of current schema store
+func (store *ReloadableTableSchemaStore) IsStrictMode() bool {
+	return store.Load().IsStrictMode()
+}
+
+// LoadTableSchemaStore return current schema store if store is ReloadableTableSchemaStore, otherwise store itself.
+// Used to process all parts of one query with the same schema store even if it was replaced in the middle of processing
+func LoadTableSchemaStore(store TableSchemaStore) TableSchemaStore {
+	if reloadableStore, ok := store.(*ReloadableTableSchemaStore); ok {
+		return reloadableStore.Load()
+	}
+	return store
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"testing"
+)
+
+func TestReloadableTableSchemaStore(t *testing.T) {
+	emptyStore, err := NewMapTableSchemaStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reloadableStore := NewReloadableTableSchemaStore(emptyStore)
+	if reloadableStore.GetTableSchema("test_table") != nil {
+		t.Fatal("Expect nil schema for empty store")
+	}
+	newStore, err := MapTableSchemaStoreFromConfig([]byte(`
+schemas:
+  - table: test_table
+    columns:
+      - data
+    encrypted:
+      - column: data
+        searchable: true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := LoadTableSchemaStore(reloadableStore)
+	reloadableStore.Store(newStore)
+	if reloadableStore.GetTableSchema("test_table") == nil {
+		t.Fatal("Expect schema from new store")
+	}
+	if reloadableStore.GetGlobalSettingsMask() != newStore.GetGlobalSettingsMask() {
+		t.Fatal("Expect settings mask of new store")
+	}
+	// snapshot taken before replacement should use previous store
+	if snapshot.GetTableSchema("test_table") != nil {
+		t.Fatal("Expect nil schema from snapshot of previous store")
+	}
+	if LoadTableSchemaStore(newStore) != newStore {
+		t.Fatal("Expect same store for non reloadable store")
+	}
+}
@@ -23,8 +23,12 @@ import (
 // TableSchemaStore fetches schema for encryptable tables in the database.
 type TableSchemaStore interface {
 	// GetTableSchema returns schema for given table if configured, or nil otherwise.
+	// Table name may be qualified with schema/database name as "schema.table"
 	GetTableSchema(tableName string) TableSchema
 	GetGlobalSettingsMask() SettingMask
+	// IsStrictMode returns true if queries that write data into encrypted columns in a way that can't be
+	// encrypted should be rejected instead of passing them to the database as is
+	IsStrictMode() bool
 }
 
 // defaultValues store default values for config
@@ -50,19 +54,22 @@ func (d defaultValues) ShouldReEncryptAcraStructToAcraBlock() bool {
 }
 
 type storeConfig struct {
-	Defaults *defaultValues
-	Schemas  []*tableSchema
+	Defaults       *defaultValues
+	StrictMode     bool `yaml:"strict_mode"`
+	Schemas        []*tableSchema
+	AccessPolicies []*accessPolicy `yaml:"access_policies"`
 }
 
-// MapTableSchemaStore store schemas per table name
+// MapTableSchemaStore store schemas per table name or pattern of table names
 type MapTableSchemaStore struct {
-	schemas    map[string]*tableSchema
+	schemas    *tableMatcher
 	globalMask SettingMask
+	strictMode bool
 }
 
 // NewMapTableSchemaStore return new MapTableSchemaStore
 func NewMapTableSchemaStore() (*MapTableSchemaStore, error) {
-	return &MapTableSchemaStore{schemas: make(map[string]*tableSchema)}, nil
+	return &MapTableSchemaStore{schemas: newTableMatcher()}, nil
 }
 
 // MapTableSchemaStoreFromConfig parse config and return MapTableSchemaStore with data from config
@@ -79,20 +86,28 @@ func MapTableSchemaStoreFromConfig(config []byte) (*MapTableSchemaStore, error)
 			return nil, err
 		}
 	}
-	var mask SettingMask
-	mapSchemas := make(map[string]*tableSchema, len(storeConfig.Schemas))
 	for _, schema := range storeConfig.Schemas {
 		for _, setting := range schema.EncryptionColumnSettings {
 			setting.applyDefaults(*storeConfig.Defaults)
 			if err := setting.Init(); err != nil {
 				return nil, err
 			}
-
+		}
+	}
+	if err := applyAccessPolicies(storeConfig.Schemas, storeConfig.AccessPolicies); err != nil {
+		return nil, err
+	}
+	var mask SettingMask
+	mapSchemas := newTableMatcher()
+	for _, schema := range storeConfig.Schemas {
+		for _, setting := range schema.EncryptionColumnSettings {
 			mask |= setting.settingMask
 		}
-		mapSchemas[schema.TableName] = schema
+		if err := mapSchemas.add(schema); err != nil {
+			return nil, err
+		}
 	}
-	return &MapTableSchemaStore{schemas: mapSchemas, globalMask: mask}, nil
+	return &MapTableSchemaStore{schemas: mapSchemas, globalMask: mask, strictMode: storeConfig.StrictMode}, nil
 }
 
 // GetGlobalSettingsMask return OR of all masks of column settings
@@ -100,12 +115,16 @@ func (store *MapTableSchemaStore) GetGlobalSettingsMask() SettingMask {
 	return store.globalMask
 }
 
-// GetTableSchema return table schema if exists otherwise nil
+// IsStrictMode return value of strict_mode option
+func (store *MapTableSchemaStore) IsStrictMode() bool {
+	return store.strictMode
+}
+
+// GetTableSchema return table schema if exists otherwise nil. See tableMatcher for precedence of table patterns
 func (store *MapTableSchemaStore) GetTableSchema(tableName string) TableSchema {
 	// Explicitly check for presence and return explicit "nil" value
 	// so that returned interface is "== nil".
-	schema, ok := store.schemas[tableName]
-	if ok {
+	if schema := store.schemas.match(tableName); schema != nil {
 		return schema
 	}
 	return nil
@@ -3,7 +3,9 @@ package config
 import (
 	"errors"
 	"github.com/cossacklabs/acra/masking/common"
+	tokenCommon "github.com/cossacklabs/acra/pseudonymization/common"
 	"testing"
+	"time"
 )
 
 func TestCryptoEnvelopeDefaultValuesWithDefinedValue(t *testing.T) {
@@ -284,3 +286,910 @@ schemas:
 		}
 	}
 }
+
+func TestMaskingModeSettings(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+      - data3
+      - data4
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        plaintext_length: 4
+        plaintext_side: "right"
+      - column: data2
+        masking: "****"
+        masking_mode: separators
+        plaintext_length: 4
+        plaintext_side: "right"
+      - column: data3
+        masking: "***"
+        masking_mode: email
+      - column: data4
+        masking: "*"
+        masking_mode: regex
+        masking_regex: "^(\\w)\\w*( \\w)"
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	testcases := []struct {
+		column string
+		data   string
+		masked string
+	}{
+		{"data1", "4111111111111234", "xxxx1234"},
+		{"data2", "4111-1111-1111-1234", "****-****-****-1234"},
+		{"data3", "john.doe@example.com", "***@example.com"},
+		{"data4", "John Doe", "J* D*"},
+	}
+	for i, tcase := range testcases {
+		setting := tableSchema.GetColumnEncryptionSettings(tcase.column)
+		masked := make([]byte, 0, len(tcase.data))
+		for _, segment := range setting.GetMaskingOptions().Split([]byte(tcase.data)) {
+			if segment.Masked {
+				masked = append(masked, setting.GetMaskingPattern()...)
+			} else {
+				masked = append(masked, segment.Data...)
+			}
+		}
+		if string(masked) != tcase.masked {
+			t.Fatalf("[%d] Expect %q, took %q\n", i, tcase.masked, masked)
+		}
+	}
+}
+
+func TestTypeAwareDecryptionSettings(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+      - data3
+      - data4
+      - data5
+    encrypted:
+      - column: data1
+        data_type: int64
+      - column: data2
+        data_type: str
+      - column: data3
+        data_type: date
+        on_fail: default_value
+        default_data_value: "2021-12-22"
+      - column: data4
+      - column: data5
+        data_type: numeric
+        on_fail: error
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schemaStore.GetGlobalSettingsMask()&SettingDataTypeFlag != SettingDataTypeFlag {
+		t.Fatal("Expect SettingDataTypeFlag in global mask")
+	}
+	type testcase struct {
+		column       string
+		dataType     EncryptedDataType
+		onFail       ResponseOnFail
+		defaultValue *string
+	}
+	defaultDate := "2021-12-22"
+	testcases := []testcase{
+		// types that can't hold ciphertext return error by default
+		{"data1", EncryptedDataTypeInt64, ResponseOnFailError, nil},
+		{"data2", EncryptedDataTypeString, ResponseOnFailCiphertext, nil},
+		{"data3", EncryptedDataTypeDate, ResponseOnFailDefaultValue, &defaultDate},
+		{"data4", EncryptedDataTypeDefault, ResponseOnFailCiphertext, nil},
+		{"data5", EncryptedDataTypeNumeric, ResponseOnFailError, nil},
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	for i, tcase := range testcases {
+		setting := tableSchema.GetColumnEncryptionSettings(tcase.column)
+		if setting.GetEncryptedDataType() != tcase.dataType {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.dataType, setting.GetEncryptedDataType())
+		}
+		if setting.GetResponseOnFail() != tcase.onFail {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.onFail, setting.GetResponseOnFail())
+		}
+		if (tcase.defaultValue == nil) != (setting.GetDefaultDataValue() == nil) {
+			t.Fatalf("[%d] Expect default value %v, took %v\n", i, tcase.defaultValue, setting.GetDefaultDataValue())
+		}
+		if tcase.defaultValue != nil && *tcase.defaultValue != *setting.GetDefaultDataValue() {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, *tcase.defaultValue, *setting.GetDefaultDataValue())
+		}
+	}
+}
+
+func TestInvalidTypeAwareDecryptionSettings(t *testing.T) {
+	type testcase struct {
+		config string
+		err    error
+	}
+	testcases := []testcase{
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        data_type: invalid
+`,
+			ErrUnsupportedEncryptedDataType},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        data_type: int32
+        on_fail: invalid
+`,
+			ErrUnsupportedResponseOnFail},
+		// ciphertext can't be returned as integer
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        data_type: int32
+        on_fail: ciphertext
+`,
+			ErrCiphertextResponseNotSupported},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        data_type: int32
+        on_fail: default_value
+`,
+			ErrDefaultDataValueRequired},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        data_type: int32
+        on_fail: default_value
+        default_data_value: "not a number"
+`,
+			ErrInvalidDataTypeValue},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        data_type: timestamp
+        on_fail: default_value
+        default_data_value: "2021-12-22"
+`,
+			ErrInvalidDataTypeValue},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        data_type: int64
+        default_data_value: "1"
+`,
+			ErrDefaultDataValueWithoutOnFail},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: int64
+        data_type: int64
+`,
+			ErrDataTypeWithTokenization},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        plaintext_length: 5
+        plaintext_side: "right"
+        data_type: int64
+`,
+			ErrDataTypeWithMasking},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        masking_mode: unknown
+`,
+			common.ErrInvalidMaskingMode},
+		// email masking keeps the whole domain
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        masking_mode: email
+        plaintext_length: 4
+        plaintext_side: "right"
+`,
+			common.ErrPlaintextWithMaskingMode},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        masking_mode: separators
+`,
+			common.ErrInvalidPlaintextSide},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        masking_mode: regex
+        masking_regex: "(\\d"
+`,
+			common.ErrInvalidMaskingRegex},
+		// regex should have groups to keep
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        masking_mode: regex
+        masking_regex: "\\d{4}$"
+`,
+			common.ErrInvalidMaskingRegex},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        masking_mode: email
+        masking_regex: "(\\d{4})$"
+`,
+			common.ErrMaskingRegexWithMode},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        masking_mode: email
+`,
+			common.ErrInvalidMaskingPattern},
+	}
+	for i, tcase := range testcases {
+		_, err := MapTableSchemaStoreFromConfig([]byte(tcase.config))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.err, err)
+		}
+	}
+}
+
+func TestValidateDataTypeValue(t *testing.T) {
+	type testcase struct {
+		dataType EncryptedDataType
+		value    string
+		valid    bool
+	}
+	testcases := []testcase{
+		{EncryptedDataTypeInt32, "-2147483648", true},
+		{EncryptedDataTypeInt32, "2147483648", false},
+		{EncryptedDataTypeInt64, "9223372036854775807", true},
+		{EncryptedDataTypeInt64, "1.5", false},
+		{EncryptedDataTypeDate, "2021-12-22", true},
+		{EncryptedDataTypeDate, "2021-13-22", false},
+		{EncryptedDataTypeTimestamp, "2021-12-22 10:11:12", true},
+		{EncryptedDataTypeTimestamp, "2021-12-22 10:11:12.123456", true},
+		{EncryptedDataTypeTimestamp, "2021-12-22T10:11:12", true},
+		{EncryptedDataTypeTimestamp, "2021-12-22", false},
+		{EncryptedDataTypeNumeric, "-123.45", true},
+		{EncryptedDataTypeNumeric, "123.", false},
+		{EncryptedDataTypeNumeric, "1e10", false},
+		{EncryptedDataTypeString, "any value", true},
+		{EncryptedDataTypeBytes, "\x00\x01", true},
+	}
+	for i, tcase := range testcases {
+		err := ValidateDataTypeValue(tcase.dataType, []byte(tcase.value))
+		if tcase.valid && err != nil {
+			t.Fatalf("[%d] Expect valid value, took %s\n", i, err)
+		}
+		if !tcase.valid && err != ErrInvalidDataTypeValue {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, ErrInvalidDataTypeValue, err)
+		}
+	}
+}
+
+func TestFormatPreservingTokenizationSettings(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+      - data3
+      - data4
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        tokenization_mode: format_preserving
+      - column: data2
+        tokenized: true
+        token_type: str
+        tokenization_mode: format_preserving
+        format_preserving_algorithm: ff3-1
+        format_preserving_luhn: true
+        format_preserving_key_version: 2
+      - column: data3
+        tokenized: true
+        token_type: email
+        tokenization_mode: storage
+      - column: data4
+        tokenized: true
+        token_type: bytes
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	testcases := []struct {
+		column           string
+		formatPreserving bool
+		options          tokenCommon.FormatPreservingOptions
+	}{
+		{"data1", true, tokenCommon.FormatPreservingOptions{}},
+		{"data2", true, tokenCommon.FormatPreservingOptions{Algorithm: tokenCommon.FormatPreservingAlgorithmFF31, LuhnValid: true, KeyVersion: 2}},
+		{"data3", false, tokenCommon.FormatPreservingOptions{}},
+		{"data4", false, tokenCommon.FormatPreservingOptions{}},
+	}
+	for i, tcase := range testcases {
+		setting := tableSchema.GetColumnEncryptionSettings(tcase.column)
+		if setting.IsFormatPreservingTokenization() != tcase.formatPreserving {
+			t.Fatalf("[%d] Expect format-preserving tokenization %t\n", i, tcase.formatPreserving)
+		}
+		if setting.GetFormatPreservingOptions() != tcase.options {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.options, setting.GetFormatPreservingOptions())
+		}
+	}
+}
+
+func TestInvalidFormatPreservingTokenizationSettings(t *testing.T) {
+	type testcase struct {
+		config string
+		err    error
+	}
+	testcases := []testcase{
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        tokenization_mode: invalid
+`,
+			ErrUnsupportedTokenizationMode},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenization_mode: format_preserving
+`,
+			ErrTokenizationModeWithoutTokenize},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        format_preserving_algorithm: ff1
+`,
+			ErrFormatPreservingOptionsWithMode},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        tokenization_mode: format_preserving
+        format_preserving_algorithm: ff3
+`,
+			tokenCommon.ErrUnsupportedFormatPreservingAlgorithm},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: int64
+        tokenization_mode: format_preserving
+`,
+			tokenCommon.ErrUnsupportedFormatPreservingTokenType},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: email
+        tokenization_mode: format_preserving
+        format_preserving_luhn: true
+`,
+			tokenCommon.ErrLuhnUnsupportedTokenType},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        format_preserving_key_version: 1
+`,
+			ErrFormatPreservingOptionsWithMode},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        tokenization_mode: format_preserving
+        format_preserving_key_version: -1
+`,
+			tokenCommon.ErrInvalidFormatPreservingKeyVersion},
+	}
+	for i, tcase := range testcases {
+		_, err := MapTableSchemaStoreFromConfig([]byte(tcase.config))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, tcase.err, err)
+		}
+	}
+}
+
+func TestTokenRetentionSettings(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+      - data3
+      - data4
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        token_ttl: 90d
+        idle_ttl: 30d
+      - column: data2
+        tokenized: true
+        token_type: email
+        consistent_tokenization: true
+        idle_ttl: 12h
+      - column: data3
+        tokenized: true
+        token_type: phone
+        token_ttl: 1h30m
+      - column: data4
+        tokenized: true
+        token_type: bytes
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	testcases := []struct {
+		column    string
+		retention tokenCommon.TokenRetention
+	}{
+		{"data1", tokenCommon.TokenRetention{TTL: 90 * 24 * time.Hour, IdleTTL: 30 * 24 * time.Hour}},
+		{"data2", tokenCommon.TokenRetention{IdleTTL: 12 * time.Hour}},
+		{"data3", tokenCommon.TokenRetention{TTL: 90 * time.Minute}},
+		{"data4", tokenCommon.TokenRetention{}},
+	}
+	for i, tcase := range testcases {
+		setting := tableSchema.GetColumnEncryptionSettings(tcase.column)
+		if setting.GetTokenRetention() != tcase.retention {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.retention, setting.GetTokenRetention())
+		}
+	}
+}
+
+func TestInvalidTokenRetentionSettings(t *testing.T) {
+	type testcase struct {
+		config string
+		err    error
+	}
+	testcases := []testcase{
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        token_ttl: 90d
+`,
+			ErrTokenRetentionWithoutTokenize},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        tokenization_mode: format_preserving
+        idle_ttl: 30d
+`,
+			ErrTokenRetentionWithFormatPreserving},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        token_ttl: 3 months
+`,
+			tokenCommon.ErrInvalidRetentionDuration},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        tokenized: true
+        token_type: str
+        idle_ttl: -1d
+`,
+			tokenCommon.ErrInvalidRetentionDuration},
+	}
+	for i, tcase := range testcases {
+		_, err := MapTableSchemaStoreFromConfig([]byte(tcase.config))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, tcase.err, err)
+		}
+	}
+}
+
+func TestSearchablePrefixSettings(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data1
+        searchable: true
+        searchable_prefix:
+          min: 3
+          max: 10
+      - column: data2
+        searchable: true
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	prefix := tableSchema.GetColumnEncryptionSettings("data1").GetSearchablePrefix()
+	if prefix == nil || prefix.Min != 3 || prefix.Max != 10 || prefix.Count() != 8 {
+		t.Fatalf("Incorrect searchable_prefix %v\n", prefix)
+	}
+	if !prefix.IsIndexed(3) || !prefix.IsIndexed(10) || prefix.IsIndexed(2) || prefix.IsIndexed(11) {
+		t.Fatal("Incorrect indexed lengths")
+	}
+	if len(prefix.Warnings()) != 1 {
+		t.Fatalf("Expect one warning, took %v\n", prefix.Warnings())
+	}
+	if weak := (&SearchablePrefix{Min: 1, Max: 5}); len(weak.Warnings()) != 2 {
+		t.Fatalf("Expect warning about short prefixes, took %v\n", weak.Warnings())
+	}
+	if tableSchema.GetColumnEncryptionSettings("data2").GetSearchablePrefix() != nil {
+		t.Fatal("Expect disabled prefix search")
+	}
+}
+
+func TestInvalidSearchablePrefixSettings(t *testing.T) {
+	type testcase struct {
+		config string
+		err    error
+	}
+	testcases := []testcase{
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        searchable_prefix:
+          min: 3
+          max: 10
+`,
+			ErrSearchablePrefixWithoutSearchable},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        searchable: true
+        searchable_prefix:
+          min: 0
+          max: 10
+`,
+			ErrInvalidSearchablePrefix},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        searchable: true
+        searchable_prefix:
+          min: 5
+          max: 4
+`,
+			ErrInvalidSearchablePrefix},
+		{`
+schemas:
+  - table: test_table
+    columns:
+      - data1
+    encrypted:
+      - column: data1
+        searchable: true
+        searchable_prefix:
+          min: 3
+          max: 65
+`,
+			ErrInvalidSearchablePrefix},
+	}
+	for i, tcase := range testcases {
+		_, err := MapTableSchemaStoreFromConfig([]byte(tcase.config))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, tcase.err, err)
+		}
+	}
+}
+
+// accessPolicySchemas used as schemas for access policies in tests
+const accessPolicySchemas = `
+schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+      - data3
+    encrypted:
+      - column: data1
+        masking: "xxxx"
+        plaintext_length: 4
+        plaintext_side: "right"
+      - column: data2
+        tokenized: true
+        token_type: str
+      - column: data3
+`
+
+func TestAccessPolicySettings(t *testing.T) {
+	testConfig := accessPolicySchemas + `
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: masked
+      - table: test_table
+        column: data2
+        action: tokenized
+      - table: test_table
+        column: data3
+        action: deny
+  - client_id: billing
+    columns:
+      - table: test_table
+        column: data1
+        action: clear
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schemaStore.GetGlobalSettingsMask()&SettingAccessPolicyFlag == 0 {
+		t.Fatal("Expect access policy flag in global settings mask")
+	}
+	tableSchema := schemaStore.GetTableSchema("test_table")
+	testcases := []struct {
+		column   string
+		clientID string
+		action   AccessAction
+	}{
+		{"data1", "support", AccessActionMasked},
+		{"data2", "support", AccessActionTokenized},
+		{"data3", "support", AccessActionDeny},
+		{"data1", "billing", AccessActionClear},
+		{"data2", "billing", AccessActionDefault},
+		{"data1", "unknown", AccessActionDefault},
+	}
+	for i, tcase := range testcases {
+		setting := tableSchema.GetColumnEncryptionSettings(tcase.column)
+		if action := setting.GetAccessAction([]byte(tcase.clientID)); action != tcase.action {
+			t.Fatalf("[%d] Expect %q action, took %q\n", i, tcase.action, action)
+		}
+	}
+
+	schemaStore, err = MapTableSchemaStoreFromConfig([]byte(accessPolicySchemas))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schemaStore.GetGlobalSettingsMask()&SettingAccessPolicyFlag != 0 {
+		t.Fatal("Expect no access policy flag without access policies")
+	}
+}
+
+func TestInvalidAccessPolicySettings(t *testing.T) {
+	type testcase struct {
+		policies string
+		err      error
+	}
+	testcases := []testcase{
+		{`
+access_policies:
+  - columns:
+      - table: test_table
+        column: data1
+        action: masked
+`,
+			ErrAccessPolicyWithoutClientID},
+		{`
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: hidden
+`,
+			ErrUnsupportedAccessAction},
+		{`
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+`,
+			ErrUnsupportedAccessAction},
+		{`
+access_policies:
+  - client_id: support
+    columns:
+      - table: another_table
+        column: data1
+        action: clear
+`,
+			ErrAccessPolicyUnknownColumn},
+		{`
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data4
+        action: clear
+`,
+			ErrAccessPolicyUnknownColumn},
+		{`
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: clear
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: deny
+`,
+			ErrAccessPolicyDuplicateRule},
+		{`
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data2
+        action: masked
+`,
+			ErrMaskedAccessWithoutMasking},
+		{`
+access_policies:
+  - client_id: support
+    columns:
+      - table: test_table
+        column: data1
+        action: tokenized
+`,
+			ErrTokenizedAccessWithoutTokenization},
+	}
+	for i, tcase := range testcases {
+		_, err := MapTableSchemaStoreFromConfig([]byte(accessPolicySchemas + tcase.policies))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, tcase.err, err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Limits of searchable_prefix lengths
+const (
+	// MaxSearchablePrefixLength limits size of prefix index stored with every value
+	MaxSearchablePrefixLength = 64
+	// weakSearchablePrefixLength is a length of prefixes which may be guessed by frequency analysis
+	weakSearchablePrefixLength = 2
+)
+
+// Errors related to searchable_prefix settings
+var (
+	ErrSearchablePrefixWithoutSearchable = errors.New("searchable_prefix may be used only with searchable: true")
+	ErrInvalidSearchablePrefix           = errors.New("searchable_prefix should have 1 <= min <= max <= 64")
+)
+
+// SearchablePrefix describes lengths of value prefixes (in bytes) indexed for LIKE 'prefix%' search
+type SearchablePrefix struct {
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
+}
+
+// Validate return error if lengths are out of allowed range
+func (p *SearchablePrefix) Validate() error {
+	if p.Min < 1 || p.Max < p.Min || p.Max > MaxSearchablePrefixLength {
+		return ErrInvalidSearchablePrefix
+	}
+	return nil
+}
+
+// Count returns number of indexed prefixes
+func (p *SearchablePrefix) Count() int {
+	return p.Max - p.Min + 1
+}
+
+// IsIndexed returns true if prefixes with such length are indexed
+func (p *SearchablePrefix) IsIndexed(length int) bool {
+	return length >= p.Min && length <= p.Max
+}
+
+// Warnings describe what is revealed to the database by the prefix index
+func (p *SearchablePrefix) Warnings() []string {
+	warnings := []string{
+		"searchable_prefix stores deterministic hashes of value prefixes: rows with the same prefixes may be linked by " +
+			"anyone with access to the database, and LIKE queries reveal which prefix length is searched",
+	}
+	if p.Min <= weakSearchablePrefixLength {
+		warnings = append(warnings, "searchable_prefix with min <= 2 indexes short prefixes which have few possible "+
+			"values and may be recovered by frequency analysis")
+	}
+	return warnings
+}
+
+// initSearchablePrefix validate searchable_prefix and log warnings about leakage
+func (s *BasicColumnEncryptionSetting) initSearchablePrefix() error {
+	if s.SearchablePrefix == nil {
+		return nil
+	}
+	if !s.Searchable {
+		return ErrSearchablePrefixWithoutSearchable
+	}
+	if err := s.SearchablePrefix.Validate(); err != nil {
+		return err
+	}
+	logger := logrus.WithFields(logrus.Fields{"column": s.Name, "min": s.SearchablePrefix.Min, "max": s.SearchablePrefix.Max})
+	for _, warning := range s.SearchablePrefix.Warnings() {
+		logger.Warningln(warning)
+	}
+	return nil
+}
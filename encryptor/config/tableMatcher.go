@@ -0,0 +1,164 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RegexTablePrefix marks table name in config as regular expression
+const RegexTablePrefix = "regex:"
+
+// tableNameSeparator separates schema (PostgreSQL) or database (MySQL) name from table name
+const tableNameSeparator = "."
+
+// Errors related to table names in config
+var (
+	ErrInvalidTablePattern = errors.New("invalid table pattern")
+	ErrEmptyTableName      = errors.New("empty table name")
+)
+
+// SplitQualifiedTableName splits table name into schema (database for MySQL) and table name. Schema is empty for
+// non-qualified names
+func SplitQualifiedTableName(name string) (string, string) {
+	if i := strings.Index(name, tableNameSeparator); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// QualifiedTableName joins schema (database for MySQL) and table name, returns table name as is if schema is empty
+func QualifiedTableName(schema, table string) string {
+	if schema == "" {
+		return table
+	}
+	return schema + tableNameSeparator + table
+}
+
+func isGlobPattern(value string) bool {
+	return strings.ContainsAny(value, "*?[")
+}
+
+// tablePattern matches table names with glob or regular expression pattern
+type tablePattern struct {
+	// schemaGlob is empty for patterns without schema and matches any schema
+	schemaGlob string
+	tableGlob  string
+	regex      *regexp.Regexp
+	schema     *tableSchema
+}
+
+func (pattern *tablePattern) match(schemaName, tableName string) bool {
+	if pattern.regex != nil {
+		return pattern.regex.MatchString(QualifiedTableName(schemaName, tableName))
+	}
+	if pattern.schemaGlob != "" {
+		// validated on config load
+		if matched, _ := path.Match(pattern.schemaGlob, schemaName); !matched {
+			return false
+		}
+	}
+	matched, _ := path.Match(pattern.tableGlob, tableName)
+	return matched
+}
+
+// tableMatcher finds table schema by table name that may be qualified with schema/database name. Precedence of matches:
+//  1. exact schema and table name (schema1.table1)
+//  2. glob patterns with schema (schema1.table_*, schema_*.table1) in config order
+//  3. exact table name without schema (table1), matches table in any schema
+//  4. glob patterns without schema (table_*) in config order
+//  5. regular expressions (regex:^schema1\.table_[0-9]+$) in config order, matched with table name qualified with
+//     schema if it's specified in the query
+type tableMatcher struct {
+	exact                map[string]*tableSchema
+	qualifiedPatterns    []*tablePattern
+	nonQualifiedPatterns []*tablePattern
+	regexPatterns        []*tablePattern
+}
+
+func newTableMatcher() *tableMatcher {
+	return &tableMatcher{exact: make(map[string]*tableSchema)}
+}
+
+// add registers schema according to the kind of table name. Latest schema overrides previous one with the same name
+func (matcher *tableMatcher) add(schema *tableSchema) error {
+	name := schema.TableName
+	if name == "" {
+		return ErrEmptyTableName
+	}
+	if strings.HasPrefix(name, RegexTablePrefix) {
+		regex, err := regexp.Compile(strings.TrimPrefix(name, RegexTablePrefix))
+		if err != nil {
+			return ErrInvalidTablePattern
+		}
+		matcher.regexPatterns = append(matcher.regexPatterns, &tablePattern{regex: regex, schema: schema})
+		return nil
+	}
+	schemaName, tableName := SplitQualifiedTableName(name)
+	if tableName == "" || (strings.Contains(name, tableNameSeparator) && schemaName == "") {
+		return ErrEmptyTableName
+	}
+	if !isGlobPattern(name) {
+		matcher.exact[name] = schema
+		return nil
+	}
+	// path.Match validates whole pattern only on matching
+	for _, glob := range []string{schemaName, tableName} {
+		if _, err := path.Match(glob, ""); err != nil {
+			return ErrInvalidTablePattern
+		}
+	}
+	pattern := &tablePattern{schemaGlob: schemaName, tableGlob: tableName, schema: schema}
+	if schemaName != "" {
+		matcher.qualifiedPatterns = append(matcher.qualifiedPatterns, pattern)
+	} else {
+		matcher.nonQualifiedPatterns = append(matcher.nonQualifiedPatterns, pattern)
+	}
+	return nil
+}
+
+// match return schema of the table with name qualified with schema/database name or nil
+func (matcher *tableMatcher) match(name string) *tableSchema {
+	if schema, ok := matcher.exact[name]; ok {
+		return schema
+	}
+	schemaName, tableName := SplitQualifiedTableName(name)
+	if schemaName != "" {
+		for _, pattern := range matcher.qualifiedPatterns {
+			if pattern.match(schemaName, tableName) {
+				return pattern.schema
+			}
+		}
+		if schema, ok := matcher.exact[tableName]; ok {
+			return schema
+		}
+	}
+	for _, pattern := range matcher.nonQualifiedPatterns {
+		if pattern.match(schemaName, tableName) {
+			return pattern.schema
+		}
+	}
+	for _, pattern := range matcher.regexPatterns {
+		if pattern.match(schemaName, tableName) {
+			return pattern.schema
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"testing"
+)
+
+func TestTableMatcherPrecedence(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: "regex:^(public\\.)?events_[0-9]{4}_[0-9]{2}$"
+    columns:
+      - regex
+  - table: events_*
+    columns:
+      - non_qualified_glob
+  - table: users
+    columns:
+      - non_qualified
+  - table: schema_*.users
+    columns:
+      - qualified_glob_schema
+  - table: schema1.user?
+    columns:
+      - qualified_glob_table
+  - table: schema1.users
+    columns:
+      - qualified
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type testcase struct {
+		table  string
+		column string
+	}
+	testcases := []testcase{
+		{"schema1.users", "qualified"},
+		{"schema1.userx", "qualified_glob_table"},
+		{"schema_2.users", "qualified_glob_schema"},
+		{"users", "non_qualified"},
+		{"public.users", "non_qualified"},
+		{"events_2026_01", "non_qualified_glob"},
+		{"public.events_2026_01", "non_qualified_glob"},
+		{"public.events", ""},
+		{"other", ""},
+	}
+	for i, tcase := range testcases {
+		schema := schemaStore.GetTableSchema(tcase.table)
+		if tcase.column == "" {
+			if schema != nil {
+				t.Fatalf("[%d] Expect nil schema for %s, took %s\n", i, tcase.table, schema.Name())
+			}
+			continue
+		}
+		if schema == nil {
+			t.Fatalf("[%d] Expect schema for %s, took nil\n", i, tcase.table)
+		}
+		if schema.Columns()[0] != tcase.column {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.column, schema.Columns()[0])
+		}
+	}
+}
+
+func TestTableMatcherRegex(t *testing.T) {
+	testConfig := `
+schemas:
+  - table: "regex:^(public\\.)?events_[0-9]{4}_[0-9]{2}$"
+    columns:
+      - data
+`
+	schemaStore, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range []string{"events_2026_01", "public.events_2026_01"} {
+		if schemaStore.GetTableSchema(table) == nil {
+			t.Fatalf("Expect schema for %s\n", table)
+		}
+	}
+	for _, table := range []string{"events_2026", "other.events_2026_01"} {
+		if schemaStore.GetTableSchema(table) != nil {
+			t.Fatalf("Expect nil schema for %s\n", table)
+		}
+	}
+}
+
+func TestInvalidTableNames(t *testing.T) {
+	type testcase struct {
+		table string
+		err   error
+	}
+	testcases := []testcase{
+		{`""`, ErrEmptyTableName},
+		{`"schema1."`, ErrEmptyTableName},
+		{`".table1"`, ErrEmptyTableName},
+		{`"table[1"`, ErrInvalidTablePattern},
+		{`"schema[.table1"`, ErrInvalidTablePattern},
+		{`"regex:table(1"`, ErrInvalidTablePattern},
+	}
+	for i, tcase := range testcases {
+		testConfig := `
+schemas:
+  - table: ` + tcase.table + `
+    columns:
+      - data
+`
+		_, err := MapTableSchemaStoreFromConfig([]byte(testConfig))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, tcase.err, err)
+		}
+	}
+}
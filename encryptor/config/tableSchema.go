@@ -17,6 +17,7 @@ limitations under the License.
 package config
 
 import (
+	maskingCommon "github.com/cossacklabs/acra/masking/common"
 	"github.com/cossacklabs/acra/pseudonymization/common"
 )
 
@@ -30,6 +31,8 @@ type Defaults interface {
 type TableSchema interface {
 	Name() string
 	Columns() []string
+	// EncryptedColumns returns names of the columns with encryption settings
+	EncryptedColumns() []string
 	NeedToEncrypt(columnName string) bool
 	// GetColumnEncryptionSettings fetches encryption settings for given column,
 	// or returns nil if the column should not be encrypted.
@@ -46,12 +49,22 @@ type ColumnEncryptionSetting interface {
 
 	// Searchable encryption
 	IsSearchable() bool
+	GetSearchablePrefix() *SearchablePrefix
 	// Data masking
 	GetMaskingPattern() string
 	GetPartialPlaintextLen() int
 	IsEndMasking() bool
+	GetMaskingOptions() maskingCommon.MaskingOptions
 	OnlyEncryption() bool
 
+	// Access policy
+	GetAccessAction(clientID []byte) AccessAction
+
+	// Type-aware decryption
+	GetEncryptedDataType() EncryptedDataType
+	GetResponseOnFail() ResponseOnFail
+	GetDefaultDataValue() *string
+
 	Defaults
 }
 
@@ -72,6 +85,15 @@ func (schema *tableSchema) Columns() []string {
 	return schema.TableColumns
 }
 
+// EncryptedColumns returns names of the columns with encryption settings in config order
+func (schema *tableSchema) EncryptedColumns() []string {
+	columns := make([]string, 0, len(schema.EncryptionColumnSettings))
+	for _, setting := range schema.EncryptionColumnSettings {
+		columns = append(columns, setting.Name)
+	}
+	return columns
+}
+
 // initMap create map of columns to encrypt from array
 func (schema *tableSchema) initMap() {
 	mapEncryptedColumns := make(map[string]*BasicColumnEncryptionSetting)
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import "errors"
+
+// TokenizationMode describes how tokens are generated and mapped back to source values
+type TokenizationMode string
+
+// Supported TokenizationMode values
+const (
+	// TokenizationModeStorage generates random tokens and keeps them in the token storage
+	TokenizationModeStorage TokenizationMode = "storage"
+	// TokenizationModeFormatPreserving generates tokens with format-preserving encryption without storage
+	TokenizationModeFormatPreserving TokenizationMode = "format_preserving"
+)
+
+// Errors related to tokenization mode settings
+var (
+	ErrUnsupportedTokenizationMode        = errors.New("unsupported tokenization_mode")
+	ErrTokenizationModeWithoutTokenize    = errors.New("tokenization_mode may be used only with tokenized: true")
+	ErrFormatPreservingOptionsWithMode    = errors.New("format_preserving_algorithm, format_preserving_luhn and format_preserving_key_version may be used only with tokenization_mode: format_preserving")
+	ErrTokenRetentionWithoutTokenize      = errors.New("token_ttl and idle_ttl may be used only with tokenized: true")
+	ErrTokenRetentionWithFormatPreserving = errors.New("token_ttl and idle_ttl can't be used with tokenization_mode: format_preserving")
+)
+
+// ValidateTokenizationMode return error if value is unsupported TokenizationMode
+func ValidateTokenizationMode(value TokenizationMode) error {
+	switch value {
+	case "", TokenizationModeStorage, TokenizationModeFormatPreserving:
+		return nil
+	default:
+		return ErrUnsupportedTokenizationMode
+	}
+}
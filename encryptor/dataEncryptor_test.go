@@ -21,6 +21,7 @@ import (
 	"errors"
 	"github.com/cossacklabs/acra/acrastruct"
 	"github.com/cossacklabs/acra/encryptor/config"
+	maskingCommon "github.com/cossacklabs/acra/masking/common"
 	"testing"
 
 	"github.com/cossacklabs/acra/pseudonymization/common"
@@ -95,6 +96,10 @@ func (s *emptyEncryptionSetting) IsSearchable() bool {
 	panic("implement me")
 }
 
+func (s *emptyEncryptionSetting) GetSearchablePrefix() *config.SearchablePrefix {
+	panic("implement me")
+}
+
 func (s *emptyEncryptionSetting) GetMaskingPattern() string {
 	panic("implement me")
 }
@@ -107,6 +112,14 @@ func (s *emptyEncryptionSetting) IsEndMasking() bool {
 	panic("implement me")
 }
 
+func (s *emptyEncryptionSetting) GetMaskingOptions() maskingCommon.MaskingOptions {
+	panic("implement me")
+}
+
+func (s *emptyEncryptionSetting) GetAccessAction(clientID []byte) config.AccessAction {
+	panic("implement me")
+}
+
 func (s *emptyEncryptionSetting) IsTokenized() bool {
 	panic("implement me")
 }
@@ -119,6 +132,30 @@ func (s *emptyEncryptionSetting) GetTokenType() common.TokenType {
 	panic("implement me")
 }
 
+func (s *emptyEncryptionSetting) IsFormatPreservingTokenization() bool {
+	panic("implement me")
+}
+
+func (s *emptyEncryptionSetting) GetFormatPreservingOptions() common.FormatPreservingOptions {
+	panic("implement me")
+}
+
+func (s *emptyEncryptionSetting) GetTokenRetention() common.TokenRetention {
+	panic("implement me")
+}
+
+func (*emptyEncryptionSetting) GetEncryptedDataType() config.EncryptedDataType {
+	return config.EncryptedDataTypeDefault
+}
+
+func (*emptyEncryptionSetting) GetResponseOnFail() config.ResponseOnFail {
+	return config.ResponseOnFailCiphertext
+}
+
+func (*emptyEncryptionSetting) GetDefaultDataValue() *string {
+	return nil
+}
+
 func (*emptyEncryptionSetting) ColumnName() string {
 	panic("implement me")
 }
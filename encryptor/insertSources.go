@@ -0,0 +1,331 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryptor
+
+import (
+	"errors"
+
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/logging"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrUnsupportedEncryptedWrite returned in strict mode when query writes data into encrypted column with expression
+// which value can't be encrypted by AcraServer
+var ErrUnsupportedEncryptedWrite = errors.New("can't encrypt data written into encrypted column")
+
+// IsUnsupportedEncryptedWriteError return true if err is ErrUnsupportedEncryptedWrite or wraps it
+func IsUnsupportedEncryptedWriteError(err error) bool {
+	return errors.Is(err, ErrUnsupportedEncryptedWrite)
+}
+
+// errUnsupportedSource used to stop processing of value which source can't be recognized
+var errUnsupportedSource = errors.New("unsupported source of column value")
+
+// maxCommonTableExprDepth limits resolving of values through references between common table expressions,
+// recursive CTEs refer to themselves
+const maxCommonTableExprDepth = 16
+
+// commonTableExprs stores common table expressions of WITH clause by name
+type commonTableExprs map[string]*sqlparser.CommonTableExpr
+
+func newCommonTableExprs(with *sqlparser.With) commonTableExprs {
+	ctes := commonTableExprs{}
+	if with == nil {
+		return ctes
+	}
+	for _, cte := range with.CTEs {
+		ctes[cte.Name.RawValue()] = cte
+	}
+	return ctes
+}
+
+// getWithClause return WITH clause of the statement or nil
+func getWithClause(statement sqlparser.Statement) *sqlparser.With {
+	switch statement := statement.(type) {
+	case *sqlparser.Select:
+		return statement.With
+	case *sqlparser.Union:
+		return statement.With
+	case *sqlparser.Insert:
+		return statement.With
+	case *sqlparser.Update:
+		return statement.With
+	case *sqlparser.Delete:
+		return statement.With
+	}
+	return nil
+}
+
+// sourceValueFunc called for literal or placeholder which value is written into the column of the table
+type sourceValueFunc func(value *sqlparser.SQLVal, schema config.TableSchema, columnName string) (bool, error)
+
+// isSupportedValue return true if value written into encrypted column as is may be encrypted
+func isSupportedValue(expr sqlparser.Expr) bool {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal, sqlparser.HexVal, sqlparser.PgEscapeString, sqlparser.IntVal, sqlparser.ValArg, sqlparser.PgPlaceholder:
+			return true
+		}
+	case *sqlparser.NullVal, *sqlparser.Default:
+		return true
+	}
+	return false
+}
+
+// selectBranches return all SELECT statements combined with UNION
+func selectBranches(statement sqlparser.SelectStatement) []*sqlparser.Select {
+	switch statement := statement.(type) {
+	case *sqlparser.Select:
+		return []*sqlparser.Select{statement}
+	case *sqlparser.ParenSelect:
+		return selectBranches(statement.Select)
+	case *sqlparser.Union:
+		return append(selectBranches(statement.Left), selectBranches(statement.Right)...)
+	}
+	return nil
+}
+
+// sourceTable is a table or common table expression referenced in FROM clause
+type sourceTable struct {
+	tableName string
+	cte       *sqlparser.CommonTableExpr
+}
+
+// findSourceTable return table referenced with qualifier in FROM clause or the only table if qualifier is empty
+func findSourceTable(from sqlparser.TableExprs, qualifier sqlparser.TableName, ctes commonTableExprs) (sourceTable, bool) {
+	var table *AliasedTableName
+	if qualifier.IsEmpty() {
+		// with several tables or subqueries we don't know which one has the column
+		if len(from) != 1 {
+			return sourceTable{}, false
+		}
+		aliasedExpr, ok := from[0].(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return sourceTable{}, false
+		}
+		tableName, ok := aliasedExpr.Expr.(sqlparser.TableName)
+		if !ok {
+			return sourceTable{}, false
+		}
+		table = &AliasedTableName{TableName: tableName, As: aliasedExpr.As}
+	} else {
+		for _, aliasedTable := range GetTablesWithAliases(from) {
+			name := aliasedTable.As
+			if name.IsEmpty() {
+				name = aliasedTable.TableName.Name
+			}
+			if name.RawValue() == qualifier.Name.RawValue() {
+				table = aliasedTable
+				break
+			}
+		}
+		if table == nil {
+			return sourceTable{}, false
+		}
+	}
+	if table.TableName.Qualifier.IsEmpty() {
+		if cte, ok := ctes[table.TableName.Name.RawValue()]; ok {
+			return sourceTable{cte: cte}, true
+		}
+	}
+	return sourceTable{tableName: GetQualifiedTableName(table.TableName)}, true
+}
+
+// getCommonTableExprColumns return names of the columns of CTE or nil if they are unknown
+func getCommonTableExprColumns(cte *sqlparser.CommonTableExpr) []string {
+	if len(cte.Columns) > 0 {
+		columns := make([]string, 0, len(cte.Columns))
+		for _, column := range cte.Columns {
+			columns = append(columns, column.String())
+		}
+		return columns
+	}
+	statement, ok := cte.Statement.(sqlparser.SelectStatement)
+	if !ok {
+		return nil
+	}
+	branches := selectBranches(statement)
+	if len(branches) == 0 {
+		return nil
+	}
+	// column names of UNION are taken from the first SELECT
+	columns := make([]string, 0, len(branches[0].SelectExprs))
+	for _, selectExpr := range branches[0].SelectExprs {
+		aliasedExpr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil
+		}
+		if !aliasedExpr.As.IsEmpty() {
+			columns = append(columns, aliasedExpr.As.String())
+			continue
+		}
+		if colName, ok := aliasedExpr.Expr.(*sqlparser.ColName); ok {
+			columns = append(columns, colName.Name.String())
+			continue
+		}
+		// name generated by database
+		columns = append(columns, "")
+	}
+	return columns
+}
+
+// walkInsertSelect calls valueFunc for each literal and placeholder which SELECT of INSERT ... SELECT query writes
+// into encrypted columns. Calls unsupportedFunc for encrypted columns which values come from expressions that can't be
+// encrypted and aren't encrypted data copied from another encrypted column
+func (encryptor *QueryDataEncryptor) walkInsertSelect(rows sqlparser.SelectStatement, schema config.TableSchema, columns []string, ctes commonTableExprs, valueFunc sourceValueFunc, unsupportedFunc func(columnName string) error) (bool, error) {
+	// values of common table expressions may be used several times, they should be processed only once and can't be
+	// written into different columns with different encryption settings
+	processedValues := make(map[*sqlparser.SQLVal]string)
+	processValue := func(value *sqlparser.SQLVal, schema config.TableSchema, columnName string) (bool, error) {
+		if processedColumn, ok := processedValues[value]; ok {
+			if processedColumn != columnName {
+				return false, errUnsupportedSource
+			}
+			return false, nil
+		}
+		processedValues[value] = columnName
+		return valueFunc(value, schema, columnName)
+	}
+	changed := false
+	for i, columnName := range columns {
+		if !schema.NeedToEncrypt(columnName) {
+			continue
+		}
+		columnChanged, err := encryptor.walkSelectColumn(rows, i, schema, columnName, ctes, processValue, 0)
+		changed = changed || columnChanged
+		if err == errUnsupportedSource {
+			err = unsupportedFunc(columnName)
+		}
+		if err != nil {
+			return changed, err
+		}
+	}
+	return changed, nil
+}
+
+// walkSelectColumn process values of result column with index of all SELECTs of statement which are written into
+// columnName of schema
+func (encryptor *QueryDataEncryptor) walkSelectColumn(statement sqlparser.SelectStatement, index int, schema config.TableSchema, columnName string, ctes commonTableExprs, valueFunc sourceValueFunc, depth int) (bool, error) {
+	if depth > maxCommonTableExprDepth {
+		return false, errUnsupportedSource
+	}
+	branches := selectBranches(statement)
+	if len(branches) == 0 {
+		return false, errUnsupportedSource
+	}
+	changed := false
+	for _, sel := range branches {
+		var exprChanged bool
+		var err error
+		// only SELECT * FROM table with known list of columns is supported
+		if star, ok := sel.SelectExprs[0].(*sqlparser.StarExpr); ok && len(sel.SelectExprs) == 1 {
+			exprChanged, err = encryptor.walkStarColumn(star, sel.From, index, schema, columnName, ctes, valueFunc, depth)
+		} else {
+			if index >= len(sel.SelectExprs) {
+				return changed, errUnsupportedSource
+			}
+			aliasedExpr, ok := sel.SelectExprs[index].(*sqlparser.AliasedExpr)
+			if !ok {
+				return changed, errUnsupportedSource
+			}
+			exprChanged, err = encryptor.walkSourceExpr(aliasedExpr.Expr, sel.From, schema, columnName, ctes, valueFunc, depth)
+		}
+		if err != nil {
+			return changed, err
+		}
+		changed = changed || exprChanged
+	}
+	return changed, nil
+}
+
+// walkStarColumn process column with index of table selected with SELECT *
+func (encryptor *QueryDataEncryptor) walkStarColumn(star *sqlparser.StarExpr, from sqlparser.TableExprs, index int, schema config.TableSchema, columnName string, ctes commonTableExprs, valueFunc sourceValueFunc, depth int) (bool, error) {
+	source, ok := findSourceTable(from, star.TableName, ctes)
+	if !ok {
+		return false, errUnsupportedSource
+	}
+	if source.cte != nil {
+		return encryptor.walkCommonTableExprColumn(source.cte, index, schema, columnName, ctes, valueFunc, depth)
+	}
+	sourceSchema := encryptor.schemaStore.GetTableSchema(source.tableName)
+	if sourceSchema == nil {
+		return false, errUnsupportedSource
+	}
+	sourceColumns := sourceSchema.Columns()
+	if index >= len(sourceColumns) || !sourceSchema.NeedToEncrypt(sourceColumns[index]) {
+		return false, errUnsupportedSource
+	}
+	return false, nil
+}
+
+// walkCommonTableExprColumn process column with index of common table expression
+func (encryptor *QueryDataEncryptor) walkCommonTableExprColumn(cte *sqlparser.CommonTableExpr, index int, schema config.TableSchema, columnName string, ctes commonTableExprs, valueFunc sourceValueFunc, depth int) (bool, error) {
+	// data-modifying statements return data through RETURNING clause
+	statement, ok := cte.Statement.(sqlparser.SelectStatement)
+	if !ok {
+		return false, errUnsupportedSource
+	}
+	return encryptor.walkSelectColumn(statement, index, schema, columnName, ctes, valueFunc, depth+1)
+}
+
+// walkSourceExpr process expression which value is written into columnName of schema
+func (encryptor *QueryDataEncryptor) walkSourceExpr(expr sqlparser.Expr, from sqlparser.TableExprs, schema config.TableSchema, columnName string, ctes commonTableExprs, valueFunc sourceValueFunc, depth int) (bool, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		if !isSupportedValue(expr) {
+			return false, errUnsupportedSource
+		}
+		return valueFunc(expr, schema, columnName)
+	case *sqlparser.NullVal:
+		return false, nil
+	case *sqlparser.ColName:
+		source, ok := findSourceTable(from, expr.Qualifier, ctes)
+		if !ok {
+			return false, errUnsupportedSource
+		}
+		if source.cte != nil {
+			for i, name := range getCommonTableExprColumns(source.cte) {
+				if name != "" && name == expr.Name.String() {
+					return encryptor.walkCommonTableExprColumn(source.cte, i, schema, columnName, ctes, valueFunc, depth)
+				}
+			}
+			return false, errUnsupportedSource
+		}
+		// data copied from another encrypted column is already encrypted
+		sourceSchema := encryptor.schemaStore.GetTableSchema(source.tableName)
+		if sourceSchema != nil && sourceSchema.NeedToEncrypt(expr.Name.String()) {
+			return false, nil
+		}
+	}
+	return false, errUnsupportedSource
+}
+
+// onUnsupportedWrite logs that data written into the encrypted column can't be encrypted and returns
+// ErrUnsupportedEncryptedWrite in strict mode
+func (encryptor *QueryDataEncryptor) onUnsupportedWrite(tableName, columnName string) error {
+	logger := logrus.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptorUnsupportedWrite).
+		WithFields(logrus.Fields{"table": tableName, "column": columnName})
+	if encryptor.schemaStore.IsStrictMode() {
+		logger.Errorln("Query writes data into encrypted column which can't be encrypted, reject query in strict mode")
+		return ErrUnsupportedEncryptedWrite
+	}
+	logger.Warningln("Query writes data into encrypted column which can't be encrypted, data will be stored as is")
+	return nil
+}
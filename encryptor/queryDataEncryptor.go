@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
@@ -39,8 +40,15 @@ type querySelectSetting struct {
 	columnAlias string
 }
 
+// ColumnEncryptionSettingProvider returns encryption settings of result columns of the last processed query
+type ColumnEncryptionSettingProvider interface {
+	GetColumnEncryptionSetting(index int) config.ColumnEncryptionSetting
+}
+
 // QueryDataEncryptor parse query and encrypt raw data according to TableSchemaStore
 type QueryDataEncryptor struct {
+	// schemaSource may be replaced with new schema on config reload, schemaStore used to process current query
+	schemaSource        config.TableSchemaStore
 	schemaStore         config.TableSchemaStore
 	encryptor           DataEncryptor
 	dataCoder           DBDataCoder
@@ -50,12 +58,12 @@ type QueryDataEncryptor struct {
 
 // NewMysqlQueryEncryptor create QueryDataEncryptor with MySQLDBDataCoder
 func NewMysqlQueryEncryptor(schema config.TableSchemaStore, parser *sqlparser.Parser, dataEncryptor DataEncryptor) (*QueryDataEncryptor, error) {
-	return &QueryDataEncryptor{schemaStore: schema, parser: parser, encryptor: dataEncryptor, dataCoder: &MysqlDBDataCoder{}}, nil
+	return &QueryDataEncryptor{schemaSource: schema, schemaStore: schema, parser: parser, encryptor: dataEncryptor, dataCoder: &MysqlDBDataCoder{}}, nil
 }
 
 // NewPostgresqlQueryEncryptor create QueryDataEncryptor with PostgresqlDBDataCoder
 func NewPostgresqlQueryEncryptor(schema config.TableSchemaStore, parser *sqlparser.Parser, dataEncryptor DataEncryptor) (*QueryDataEncryptor, error) {
-	return &QueryDataEncryptor{schemaStore: schema, parser: parser, encryptor: dataEncryptor, dataCoder: &PostgresqlDBDataCoder{}}, nil
+	return &QueryDataEncryptor{schemaSource: schema, schemaStore: schema, parser: parser, encryptor: dataEncryptor, dataCoder: &PostgresqlDBDataCoder{}}, nil
 }
 
 // ID returns name of this QueryObserver.
@@ -63,20 +71,28 @@ func (encryptor *QueryDataEncryptor) ID() string {
 	return "QueryDataEncryptor"
 }
 
-// encryptInsertQuery encrypt data in insert query in VALUES and ON DUPLICATE KEY UPDATE statements
+// encryptInsertQuery encrypt data in insert query in VALUES, SELECT and ON DUPLICATE KEY UPDATE statements
 func (encryptor *QueryDataEncryptor) encryptInsertQuery(ctx context.Context, insert *sqlparser.Insert) (bool, error) {
-	tableName := insert.Table.Name
-	schema := encryptor.schemaStore.GetTableSchema(tableName.String())
+	if encryptor.encryptor == nil {
+		tableName := GetQualifiedTableName(insert.Table)
+		if encryptor.schemaStore.GetTableSchema(tableName) == nil {
+			return false, nil
+		}
+		return false, encryptor.onReturning(insert.Returning, tableName)
+	}
+	return encryptor.encryptInsertData(ctx, insert, newCommonTableExprs(insert.With))
+}
+
+// encryptInsertData encrypt data of insert query which may be a part of WITH clause and use its common table expressions
+func (encryptor *QueryDataEncryptor) encryptInsertData(ctx context.Context, insert *sqlparser.Insert, ctes commonTableExprs) (bool, error) {
+	tableName := GetQualifiedTableName(insert.Table)
+	schema := encryptor.schemaStore.GetTableSchema(tableName)
 	if schema == nil {
 		// unsupported table, we have not schema and query hasn't columns description
 		logrus.Debugf("Hasn't schema for table %s", tableName)
 		return false, nil
 	}
 
-	if encryptor.encryptor == nil {
-		return false, encryptor.onReturning(insert.Returning, tableName.RawValue())
-	}
-
 	var columnsName []string
 	if len(insert.Columns) > 0 {
 		columnsName = make([]string, 0, len(insert.Columns))
@@ -96,6 +112,12 @@ func (encryptor *QueryDataEncryptor) encryptInsertQuery(ctx context.Context, ins
 				// collect values per column
 				for j, value := range valTuple {
 					columnName := columnsName[j]
+					if schema.NeedToEncrypt(columnName) && !isSupportedValue(value) {
+						if err := encryptor.onUnsupportedWrite(tableName, columnName); err != nil {
+							return changed, err
+						}
+						continue
+					}
 					if changedValue, err := encryptor.encryptExpression(ctx, value, schema, columnName); err != nil {
 						logrus.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptorCantEncryptExpression).WithError(err).Errorln("Can't encrypt expression")
 						return changed, err
@@ -104,11 +126,31 @@ func (encryptor *QueryDataEncryptor) encryptInsertQuery(ctx context.Context, ins
 					}
 				}
 			}
+		case sqlparser.SelectStatement:
+			selectChanged, err := encryptor.walkInsertSelect(rows, schema, columnsName, ctes,
+				func(value *sqlparser.SQLVal, schema config.TableSchema, columnName string) (bool, error) {
+					return encryptor.encryptExpression(ctx, value, schema, columnName)
+				},
+				func(columnName string) error {
+					return encryptor.onUnsupportedWrite(tableName, columnName)
+				})
+			if err != nil {
+				return changed, err
+			}
+			changed = changed || selectChanged
+		}
+	} else if insert.Rows != nil {
+		// without list of columns we can't match values with encrypted columns
+		for _, columnName := range schema.EncryptedColumns() {
+			if err := encryptor.onUnsupportedWrite(tableName, columnName); err != nil {
+				return changed, err
+			}
 		}
 	}
 
-	if len(insert.OnDup) > 0 {
-		onDupChanged, err := encryptor.encryptUpdateExpressions(ctx, sqlparser.UpdateExprs(insert.OnDup), insert.Table, AliasToTableMap{insert.Table.Name.String(): insert.Table.Name.String()})
+	if updateExprs := getInsertUpdateExprs(insert); len(updateExprs) > 0 {
+		// columns of PostgreSQL's EXCLUDED row aren't in the map and left as is
+		onDupChanged, err := encryptor.encryptUpdateExpressions(ctx, updateExprs, insert.Table, AliasToTableMap{insert.Table.Name.String(): tableName}, true)
 		if err != nil {
 			return changed, err
 		}
@@ -118,6 +160,18 @@ func (encryptor *QueryDataEncryptor) encryptInsertQuery(ctx context.Context, ins
 	return changed, nil
 }
 
+// getInsertUpdateExprs return update expressions of MySQL's ON DUPLICATE KEY UPDATE or PostgreSQL's
+// ON CONFLICT DO UPDATE clauses
+func getInsertUpdateExprs(insert *sqlparser.Insert) sqlparser.UpdateExprs {
+	if len(insert.OnDup) > 0 {
+		return sqlparser.UpdateExprs(insert.OnDup)
+	}
+	if insert.OnConflict != nil {
+		return insert.OnConflict.Exprs
+	}
+	return nil
+}
+
 // ErrUpdateLeaveDataUnchanged show that data wasn't changed in UpdateExpressionValue with updateFunc
 var ErrUpdateLeaveDataUnchanged = errors.New("updateFunc didn't change data")
 
@@ -211,29 +265,48 @@ func GetTablesWithAliases(tables sqlparser.TableExprs) []*AliasedTableName {
 // hasTablesToEncrypt check that exists schema for any table in tables
 func (encryptor *QueryDataEncryptor) hasTablesToEncrypt(tables []*AliasedTableName) bool {
 	for _, table := range tables {
-		if v := encryptor.schemaStore.GetTableSchema(table.TableName.Name.String()); v != nil {
+		if v := encryptor.schemaStore.GetTableSchema(GetQualifiedTableName(table.TableName)); v != nil {
 			return true
 		}
 	}
 	return false
 }
 
-// encryptUpdateExpressions try to encrypt all supported exprs. Use firstTable if column has not explicit table name because it's implicitly used in DBMSs
-func (encryptor *QueryDataEncryptor) encryptUpdateExpressions(ctx context.Context, exprs sqlparser.UpdateExprs, firstTable sqlparser.TableName, qualifierMap AliasToTableMap) (bool, error) {
+// isInsertedValueCopy return true if expr of ON CONFLICT DO UPDATE or ON DUPLICATE KEY UPDATE clause copies value of
+// the same column from the inserted row, which is already encrypted: EXCLUDED.column or VALUES(column)
+func isInsertedValueCopy(expr sqlparser.Expr, columnName string) bool {
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		return strings.EqualFold(expr.Qualifier.Name.String(), "excluded") && expr.Qualifier.Qualifier.IsEmpty() &&
+			expr.Name.String() == columnName
+	case *sqlparser.ValuesFuncExpr:
+		return expr.Name != nil && expr.Name.Name.String() == columnName
+	}
+	return false
+}
+
+// encryptUpdateExpressions try to encrypt all supported exprs. Use firstTable if column has not explicit table name because it's implicitly used in DBMSs.
+// Expressions of insert's ON CONFLICT DO UPDATE or ON DUPLICATE KEY UPDATE clauses may copy values of the inserted row
+func (encryptor *QueryDataEncryptor) encryptUpdateExpressions(ctx context.Context, exprs sqlparser.UpdateExprs, firstTable sqlparser.TableName, qualifierMap AliasToTableMap, insert bool) (bool, error) {
 	var schema config.TableSchema
 	changed := false
 	for _, expr := range exprs {
 		// recognize table name of column
-		if expr.Name.Qualifier.IsEmpty() {
-			schema = encryptor.schemaStore.GetTableSchema(firstTable.Name.String())
-		} else {
-			tableName := qualifierMap[expr.Name.Qualifier.Name.String()]
-			schema = encryptor.schemaStore.GetTableSchema(tableName)
+		tableName := GetQualifiedTableName(firstTable)
+		if !expr.Name.Qualifier.IsEmpty() {
+			tableName = qualifierMap[expr.Name.Qualifier.Name.String()]
 		}
+		schema = encryptor.schemaStore.GetTableSchema(tableName)
 		if schema == nil {
 			continue
 		}
 		columnName := expr.Name.Name.String()
+		if schema.NeedToEncrypt(columnName) && !isSupportedValue(expr.Expr) && !(insert && isInsertedValueCopy(expr.Expr, columnName)) {
+			if err := encryptor.onUnsupportedWrite(tableName, columnName); err != nil {
+				return changed, err
+			}
+			continue
+		}
 		if changedExpr, err := encryptor.encryptExpression(ctx, expr.Expr, schema, columnName); err != nil {
 			logrus.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptorCantEncryptExpression).WithError(err).Errorln("Can't update expression with encrypted sql value")
 			return changed, err
@@ -251,10 +324,11 @@ type AliasToTableMap map[string]string
 func NewAliasToTableMapFromTables(tables []*AliasedTableName) AliasToTableMap {
 	qualifierMap := AliasToTableMap{}
 	for _, table := range tables {
+		// columns may be qualified with table name without schema
 		if table.As.IsEmpty() {
-			qualifierMap[table.TableName.Name.String()] = table.TableName.Name.String()
+			qualifierMap[table.TableName.Name.String()] = GetQualifiedTableName(table.TableName)
 		} else {
-			qualifierMap[table.As.String()] = table.TableName.Name.String()
+			qualifierMap[table.As.String()] = GetQualifiedTableName(table.TableName)
 		}
 	}
 	return qualifierMap
@@ -271,7 +345,7 @@ func (encryptor *QueryDataEncryptor) encryptUpdateQuery(ctx context.Context, upd
 	}
 	qualifierMap := NewAliasToTableMapFromTables(tables)
 	firstTable := tables[0].TableName
-	return encryptor.encryptUpdateExpressions(ctx, update.Exprs, firstTable, qualifierMap)
+	return encryptor.encryptUpdateExpressions(ctx, update.Exprs, firstTable, qualifierMap, false)
 }
 
 // OnColumn return new encryption setting context if info exist, otherwise column data and passed context will be returned
@@ -290,6 +364,18 @@ func (encryptor *QueryDataEncryptor) OnColumn(ctx context.Context, data []byte)
 	return ctx, data, nil
 }
 
+// GetColumnEncryptionSetting return encryption setting of the result column with index from the last processed
+// SELECT/RETURNING query or nil
+func (encryptor *QueryDataEncryptor) GetColumnEncryptionSetting(index int) config.ColumnEncryptionSetting {
+	if index < 0 || index >= len(encryptor.querySelectSettings) {
+		return nil
+	}
+	if selectSetting := encryptor.querySelectSettings[index]; selectSetting != nil {
+		return selectSetting.setting
+	}
+	return nil
+}
+
 const allColumnsName = "*"
 
 func (encryptor *QueryDataEncryptor) onSelect(statement *sqlparser.Select) (bool, error) {
@@ -382,8 +468,137 @@ func (encryptor *QueryDataEncryptor) onReturning(returning sqlparser.Returning,
 	return nil
 }
 
+// getRowColumns return names of the columns transferred by COPY or LOAD DATA statement in the same order as values
+// in rows. Columns from the table schema are used if the statement doesn't specify them
+func getRowColumns(statementColumns sqlparser.Columns, schema config.TableSchema) []string {
+	if len(statementColumns) == 0 {
+		return schema.Columns()
+	}
+	columns := make([]string, 0, len(statementColumns))
+	for _, column := range statementColumns {
+		columns = append(columns, column.String())
+	}
+	return columns
+}
+
+// onCopy remember encryption settings of the columns returned by COPY ... TO STDOUT and check that data of
+// COPY ... FROM STDIN can be encrypted. Rows of COPY ... FROM STDIN are encrypted by OnBind
+func (encryptor *QueryDataEncryptor) onCopy(statement *sqlparser.Copy) (bool, error) {
+	if statement.Query != nil {
+		if selectStatement, ok := statement.Query.(*sqlparser.Select); ok {
+			return encryptor.onSelect(selectStatement)
+		}
+		return false, nil
+	}
+	tableName := GetQualifiedTableName(statement.Table)
+	schema := encryptor.schemaStore.GetTableSchema(tableName)
+	if schema == nil {
+		if statement.Direction == sqlparser.CopyToStdoutStr {
+			encryptor.querySelectSettings = nil
+		}
+		return false, nil
+	}
+	columns := getRowColumns(statement.Columns, schema)
+	if statement.Direction == sqlparser.CopyToStdoutStr {
+		querySelectSettings := make([]*querySelectSetting, 0, len(columns))
+		for _, name := range columns {
+			if columnSetting := schema.GetColumnEncryptionSettings(name); columnSetting != nil {
+				querySelectSettings = append(querySelectSettings, &querySelectSetting{
+					setting:    columnSetting,
+					tableName:  tableName,
+					columnName: name,
+				})
+				continue
+			}
+			querySelectSettings = append(querySelectSettings, nil)
+		}
+		encryptor.querySelectSettings = querySelectSettings
+		return false, nil
+	}
+	if encryptor.encryptor == nil {
+		return false, nil
+	}
+	// rows in binary format or without known order of columns can't be encrypted
+	if format, _ := statement.OptionString(sqlparser.CopyFormatOption); len(columns) == 0 || strings.EqualFold(format, "binary") {
+		for _, columnName := range schema.EncryptedColumns() {
+			if err := encryptor.onUnsupportedWrite(tableName, columnName); err != nil {
+				return false, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// ErrUnsupportedLoadData returned when LOAD DATA statement writes data into encrypted columns with options which don't
+// allow to encrypt rows. Such statements are rejected regardless of strict mode to not store plaintext data
+var ErrUnsupportedLoadData = fmt.Errorf("%w: unsupported options of LOAD DATA statement", ErrUnsupportedEncryptedWrite)
+
+// ErrRowValuesCountMismatch returned when row of COPY ... FROM STDIN or LOAD DATA LOCAL INFILE statement has another
+// count of values than the count of columns of the statement
+var ErrRowValuesCountMismatch = errors.New("count of values in the row doesn't match count of columns")
+
+// onLoadData check that rows of LOAD DATA statement can be encrypted and reject the statement otherwise. Rows of
+// LOAD DATA LOCAL INFILE are sent by the client after the query and encrypted by OnBind
+func (encryptor *QueryDataEncryptor) onLoadData(statement *sqlparser.LoadData) error {
+	if encryptor.encryptor == nil {
+		return nil
+	}
+	tableName := GetQualifiedTableName(statement.Table)
+	schema := encryptor.schemaStore.GetTableSchema(tableName)
+	if schema == nil || len(schema.EncryptedColumns()) == 0 {
+		return nil
+	}
+	reason := getUnsupportedLoadDataReason(statement, schema)
+	if reason == "" {
+		return nil
+	}
+	logrus.WithField(logging.FieldKeyEventCode, logging.EventCodeErrorEncryptorUnsupportedWrite).
+		WithFields(logrus.Fields{"table": tableName, "reason": reason}).
+		Errorln("LOAD DATA statement writes data into encrypted columns which can't be encrypted, reject query")
+	return ErrUnsupportedLoadData
+}
+
+// getUnsupportedLoadDataReason return description of the LOAD DATA option which doesn't allow to encrypt rows or empty
+// string if rows can be encrypted
+func getUnsupportedLoadDataReason(statement *sqlparser.LoadData, schema config.TableSchema) string {
+	if !statement.Local {
+		return "file is read by the database without LOCAL"
+	}
+	if len(getRowColumns(statement.Columns, schema)) == 0 {
+		return "unknown order of columns"
+	}
+	for _, expr := range statement.SetExprs {
+		if schema.NeedToEncrypt(expr.Name.Name.String()) {
+			return "encrypted column is assigned with SET clause"
+		}
+	}
+	if statement.FieldsTerminatedBy != nil && len(statement.FieldsTerminatedBy.Val) == 0 {
+		return "fixed-width rows with empty FIELDS TERMINATED BY"
+	}
+	if statement.LinesTerminatedBy != nil && len(statement.LinesTerminatedBy.Val) == 0 {
+		return "empty LINES TERMINATED BY"
+	}
+	if statement.LinesStartingBy != nil && len(statement.LinesStartingBy.Val) > 0 {
+		return "LINES STARTING BY"
+	}
+	if statement.EnclosedBy != nil && len(statement.EnclosedBy.Val) > 1 {
+		return "ENCLOSED BY with several characters"
+	}
+	if statement.EscapedBy != nil && len(statement.EscapedBy.Val) > 1 {
+		return "ESCAPED BY with several characters"
+	}
+	// binary data can't be written without escaping or enclosing
+	escaped := statement.EscapedBy == nil || len(statement.EscapedBy.Val) > 0
+	enclosed := statement.EnclosedBy != nil && len(statement.EnclosedBy.Val) > 0
+	if !escaped && !enclosed {
+		return "empty ESCAPED BY without ENCLOSED BY"
+	}
+	return ""
+}
+
 // OnQuery raw data in query according to TableSchemaStore
 func (encryptor *QueryDataEncryptor) OnQuery(ctx context.Context, query base.OnQueryObject) (base.OnQueryObject, bool, error) {
+	encryptor.schemaStore = config.LoadTableSchemaStore(encryptor.schemaSource)
 	statement, err := query.Statement()
 	if err != nil {
 		return query, false, err
@@ -398,16 +613,48 @@ func (encryptor *QueryDataEncryptor) OnQuery(ctx context.Context, query base.OnQ
 		if encryptor.encryptor != nil {
 			changed, err = encryptor.encryptUpdateQuery(ctx, statement)
 		}
+	case *sqlparser.Copy:
+		changed, err = encryptor.onCopy(statement)
+	case *sqlparser.LoadData:
+		err = encryptor.onLoadData(statement)
 	}
 	if err != nil {
 		return query, false, err
 	}
+	if with := getWithClause(statement); with != nil && encryptor.encryptor != nil {
+		withChanged, err := encryptor.encryptWithClause(ctx, with)
+		if err != nil {
+			return query, false, err
+		}
+		changed = changed || withChanged
+	}
 	if changed {
 		return base.NewOnQueryObjectFromStatement(statement, encryptor.parser), true, nil
 	}
 	return query, false, nil
 }
 
+// encryptWithClause encrypt data of PostgreSQL's data-modifying statements in WITH clause
+func (encryptor *QueryDataEncryptor) encryptWithClause(ctx context.Context, with *sqlparser.With) (bool, error) {
+	ctes := newCommonTableExprs(with)
+	changed := false
+	for _, cte := range with.CTEs {
+		var cteChanged bool
+		var err error
+		switch statement := cte.Statement.(type) {
+		case *sqlparser.Insert:
+			cteChanged, err = encryptor.encryptInsertData(ctx, statement, ctes)
+		case *sqlparser.Update:
+			cteChanged, err = encryptor.encryptUpdateQuery(ctx, statement)
+		}
+		if err != nil {
+			return changed, err
+		}
+		changed = changed || cteChanged
+	}
+	return changed, nil
+}
+
 // ErrInvalidPlaceholder is returned when Acra cannot parse SQL placeholder expression.
 var ErrInvalidPlaceholder = errors.New("invalid placeholder value")
 
@@ -419,27 +666,65 @@ func (encryptor *QueryDataEncryptor) OnBind(ctx context.Context, statement sqlpa
 	if encryptor.encryptor == nil {
 		return values, false, nil
 	}
+	encryptor.schemaStore = config.LoadTableSchemaStore(encryptor.schemaSource)
 	newValues := values
 	changed := false
 	var err error
 	switch statement := statement.(type) {
 	case *sqlparser.Insert:
-		newValues, changed, err = encryptor.encryptInsertValues(ctx, statement, values)
+		newValues, changed, err = encryptor.encryptInsertValues(ctx, statement, values, newCommonTableExprs(statement.With))
 	case *sqlparser.Update:
 		newValues, changed, err = encryptor.encryptUpdateValues(ctx, statement, values)
+	case *sqlparser.Copy:
+		if statement.Direction == sqlparser.CopyFromStdinStr {
+			newValues, changed, err = encryptor.encryptRowValues(ctx, statement.Table, statement.Columns, values)
+		}
+	case *sqlparser.LoadData:
+		if statement.Local {
+			newValues, changed, err = encryptor.encryptRowValues(ctx, statement.Table, statement.Columns, values)
+		}
 	}
 	if err != nil {
 		return values, false, err
 	}
+	if with := getWithClause(statement); with != nil {
+		var withChanged bool
+		newValues, withChanged, err = encryptor.encryptWithClauseValues(ctx, with, newValues)
+		if err != nil {
+			return values, false, err
+		}
+		changed = changed || withChanged
+	}
 	return newValues, changed, nil
 }
 
-func (encryptor *QueryDataEncryptor) encryptInsertValues(ctx context.Context, insert *sqlparser.Insert, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+// encryptWithClauseValues encrypt values of placeholders used in PostgreSQL's data-modifying statements in WITH clause
+func (encryptor *QueryDataEncryptor) encryptWithClauseValues(ctx context.Context, with *sqlparser.With, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+	ctes := newCommonTableExprs(with)
+	changed := false
+	for _, cte := range with.CTEs {
+		var cteChanged bool
+		var err error
+		switch statement := cte.Statement.(type) {
+		case *sqlparser.Insert:
+			values, cteChanged, err = encryptor.encryptInsertValues(ctx, statement, values, ctes)
+		case *sqlparser.Update:
+			values, cteChanged, err = encryptor.encryptUpdateValues(ctx, statement, values)
+		}
+		if err != nil {
+			return values, false, err
+		}
+		changed = changed || cteChanged
+	}
+	return values, changed, nil
+}
+
+func (encryptor *QueryDataEncryptor) encryptInsertValues(ctx context.Context, insert *sqlparser.Insert, values []base.BoundValue, ctes commonTableExprs) ([]base.BoundValue, bool, error) {
 	logrus.Debugln("QueryDataEncryptor.encryptInsertValues")
-	tableName := insert.Table.Name
+	tableName := GetQualifiedTableName(insert.Table)
 	// Look for the schema of the table where the INSERT happens.
 	// If we don't have a schema then we don't know what to encrypt, so do nothing.
-	schema := encryptor.schemaStore.GetTableSchema(tableName.String())
+	schema := encryptor.schemaStore.GetTableSchema(tableName)
 	if schema == nil {
 		logrus.WithField("table", tableName).Debugln("No encryption schema")
 		return values, false, nil
@@ -485,14 +770,40 @@ func (encryptor *QueryDataEncryptor) encryptInsertValues(ctx context.Context, in
 				}
 			}
 		}
+	case sqlparser.SelectStatement:
+		// Placeholders may be used in INSERT ... SELECT queries and common table expressions used by them:
+		//
+		//     WITH data(value) AS (SELECT $1) INSERT INTO table(column1) SELECT value FROM data;
+		//
+		// Unsupported expressions are reported on query preparation.
+		_, err := encryptor.walkInsertSelect(rows, schema, columns, ctes,
+			func(value *sqlparser.SQLVal, schema config.TableSchema, columnName string) (bool, error) {
+				return false, encryptor.updatePlaceholderMap(values, placeholders, value, columnName)
+			},
+			func(columnName string) error {
+				return nil
+			})
+		if err != nil {
+			return values, false, err
+		}
 	}
 
-	// TODO(ilammy, 2020-10-13): handle ON DUPLICATE KEY UPDATE clauses
-	// These clauses are handled for textual queries. It would be nice to encrypt
-	// any prepared statement parameters that are used there as well.
-	// See "encryptInsertQuery" for reference.
-	if len(insert.OnDup) > 0 {
-		logrus.Warning("ON DUPLICATE KEY UPDATE is not supported in prepared statements")
+	// Placeholders may be used in ON DUPLICATE KEY UPDATE/ON CONFLICT DO UPDATE clauses as well:
+	//
+	//     INSERT INTO table(column1) VALUES ($1) ON CONFLICT (column1) DO UPDATE SET column2 = $2;
+	//
+	// Only columns of the inserted table are updated there.
+	for _, expr := range getInsertUpdateExprs(insert) {
+		if !expr.Name.Qualifier.IsEmpty() && expr.Name.Qualifier.Name.String() != insert.Table.Name.String() {
+			continue
+		}
+		switch value := expr.Expr.(type) {
+		case *sqlparser.SQLVal:
+			err := encryptor.updatePlaceholderMap(values, placeholders, value, expr.Name.Name.String())
+			if err != nil {
+				return values, false, err
+			}
+		}
 	}
 
 	// Now that we know the placeholder mapping,
@@ -510,7 +821,7 @@ func (encryptor *QueryDataEncryptor) encryptUpdateValues(ctx context.Context, up
 	// and we need to take all of that into account. But we're interested only in the first table.
 	// If the updated table does not have a schema entry, there is nothing to encrypt here.
 	tables := GetTablesWithAliases(update.TableExprs)
-	tableName := tables[0].TableName.Name.String()
+	tableName := GetQualifiedTableName(tables[0].TableName)
 	schema := encryptor.schemaStore.GetTableSchema(tableName)
 	if schema == nil {
 		logrus.WithField("table", tableName).Debugln("No encryption schema")
@@ -544,6 +855,33 @@ func (encryptor *QueryDataEncryptor) encryptUpdateValues(ctx context.Context, up
 	return encryptor.encryptValuesWithPlaceholders(ctx, values, placeholders, schema)
 }
 
+// encryptRowValues encrypts values of the one row of COPY ... FROM STDIN or LOAD DATA LOCAL INFILE statement.
+// Values are passed in the order of the statement's columns, NULL values have nil data and left as is
+func (encryptor *QueryDataEncryptor) encryptRowValues(ctx context.Context, table sqlparser.TableName, statementColumns sqlparser.Columns, values []base.BoundValue) ([]base.BoundValue, bool, error) {
+	tableName := GetQualifiedTableName(table)
+	schema := encryptor.schemaStore.GetTableSchema(tableName)
+	if schema == nil {
+		logrus.WithField("table", tableName).Debugln("No encryption schema")
+		return values, false, nil
+	}
+	columns := getRowColumns(statementColumns, schema)
+	// MySQL loads such rows of LOAD DATA LOCAL INFILE with a warning, so values can't be matched to columns and the row
+	// can't be passed as is
+	if len(columns) != len(values) {
+		logrus.WithFields(logrus.Fields{"table": tableName, "columns": len(columns), "values": len(values)}).
+			Warningln("Count of values in the row doesn't match count of columns")
+		return values, false, ErrRowValuesCountMismatch
+	}
+	placeholders := make(map[int]string, len(values))
+	for i, value := range values {
+		if value.GetData(nil) == nil {
+			continue
+		}
+		placeholders[i] = columns[i]
+	}
+	return encryptor.encryptValuesWithPlaceholders(ctx, values, placeholders, schema)
+}
+
 // updatePlaceholderMap matches the placeholder of a value to its column and records this into the mapping.
 func (encryptor *QueryDataEncryptor) updatePlaceholderMap(values []base.BoundValue, placeholders map[int]string, placeholder *sqlparser.SQLVal, columnName string) error {
 	updateMapByPlaceholderPart := func(part string) error {
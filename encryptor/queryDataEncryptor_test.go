@@ -398,6 +398,68 @@ schemas:
 			DataCoder:         &PostgresqlDBDataCoder{},
 			dialect:           postgresql.NewPostgreSQLDialect(),
 		},
+		// 29. insert with ON CONFLICT DO UPDATE for postgresql
+		{
+			Query:             `INSERT INTO "TableWithoutColumnSchema" ("zone_id", "other_column") VALUES ('%s', '%s') ON CONFLICT ("zone_id") DO UPDATE SET "other_column"='%s', "specified_client_id"='%s', "default_client_id"=excluded."default_client_id"`,
+			QueryData:         []interface{}{simpleStringData, simpleStringData, simpleStringData, simpleStringData},
+			ExpectedQueryData: []interface{}{encryptedValue, simpleStringData, simpleStringData, encryptedValue},
+			Normalized:        true,
+			Changed:           true,
+			ExpectedIDS:       [][]byte{zoneID, specifiedClientID},
+			DataCoder:         &PostgresqlDBDataCoder{},
+			dialect:           postgresql.NewPostgreSQLDialect(),
+		},
+		// 30. insert with ON CONFLICT DO NOTHING for postgresql
+		{
+			Query:             `INSERT INTO "TableWithoutColumnSchema" ("zone_id", "other_column") VALUES ('%s', '%s') ON CONFLICT DO NOTHING`,
+			QueryData:         []interface{}{simpleStringData, simpleStringData},
+			ExpectedQueryData: []interface{}{encryptedValue, simpleStringData},
+			Normalized:        true,
+			Changed:           true,
+			ExpectedIDS:       [][]byte{zoneID},
+			DataCoder:         &PostgresqlDBDataCoder{},
+			dialect:           postgresql.NewPostgreSQLDialect(),
+		},
+		// 31. insert with select of literals
+		{
+			Query:             `INSERT INTO TableWithoutColumnSchema (zone_id, other_column) SELECT X'%s', X'%s' UNION ALL SELECT X'%s', X'%s'`,
+			QueryData:         []interface{}{dataHexValue, dataHexValue, dataHexValue, dataHexValue},
+			ExpectedQueryData: []interface{}{hexEncryptedValue, dataHexValue, hexEncryptedValue, dataHexValue},
+			Normalized:        true,
+			Changed:           true,
+			ExpectedIDS:       [][]byte{zoneID, zoneID},
+		},
+		// 32. insert with select from common table expression for postgresql
+		{
+			Query:             `WITH "data"("value", "other") AS (SELECT '%s', '%s'), "data2" AS (SELECT "other" AS "value2" FROM "data") INSERT INTO "TableWithoutColumnSchema" ("default_client_id", "specified_client_id", "other_column") SELECT d.value, data2.value2, 1 FROM "data" AS d, "data2"`,
+			QueryData:         []interface{}{simpleStringData, simpleStringData},
+			ExpectedQueryData: []interface{}{encryptedValue, encryptedValue},
+			Normalized:        true,
+			Changed:           true,
+			ExpectedIDS:       [][]byte{defaultClientID, specifiedClientID},
+			DataCoder:         &PostgresqlDBDataCoder{},
+			dialect:           postgresql.NewPostgreSQLDialect(),
+		},
+		// 33. insert with select of data from encrypted columns
+		{
+			Query:             `INSERT INTO TableWithoutColumnSchema (zone_id, other_column) SELECT t.zone_id, X'%s' FROM TableWithColumnSchema AS t`,
+			QueryData:         []interface{}{dataHexValue},
+			ExpectedQueryData: []interface{}{dataHexValue},
+			Normalized:        false,
+			Changed:           false,
+			ExpectedIDS:       [][]byte{},
+		},
+		// 34. data-modifying statement in WITH clause for postgresql
+		{
+			Query:             `WITH "inserted" AS (INSERT INTO "TableWithoutColumnSchema" ("zone_id", "other_column") VALUES ('%s', '%s') RETURNING "zone_id") SELECT * FROM "inserted"`,
+			QueryData:         []interface{}{simpleStringData, simpleStringData},
+			ExpectedQueryData: []interface{}{encryptedValue, simpleStringData},
+			Normalized:        true,
+			Changed:           true,
+			ExpectedIDS:       [][]byte{zoneID},
+			DataCoder:         &PostgresqlDBDataCoder{},
+			dialect:           postgresql.NewPostgreSQLDialect(),
+		},
 	}
 	encryptor := &testEncryptor{value: encryptedValue}
 	parser := sqlparser.New(sqlparser.ModeStrict)
@@ -450,6 +512,327 @@ schemas:
 	sqlparser.SetDefaultDialect(mysql.NewMySQLDialect())
 }
 
+type testBoundValue struct {
+	data []byte
+}
+
+func (v *testBoundValue) Format() base.BoundValueFormat {
+	return base.TextFormat
+}
+
+func (v *testBoundValue) Copy() base.BoundValue {
+	return &testBoundValue{data: append([]byte{}, v.data...)}
+}
+
+func (v *testBoundValue) SetData(newData []byte, setting config.ColumnEncryptionSetting) error {
+	v.data = newData
+	return nil
+}
+
+func (v *testBoundValue) GetData(setting config.ColumnEncryptionSetting) []byte {
+	return v.data
+}
+
+func (v *testBoundValue) Encode() ([]byte, error) {
+	return v.data, nil
+}
+
+func (v *testBoundValue) GetType() byte {
+	return 0
+}
+
+func TestOnBindInsertPlaceholders(t *testing.T) {
+	clientIDStr := "specified_client_id"
+	specifiedClientID := []byte(clientIDStr)
+	defaultClientID := []byte("default_client_id")
+	configStr := fmt.Sprintf(`
+schemas:
+  - table: TableWithoutColumnSchema
+    encrypted: 
+      - column: "default_client_id"
+      - column: specified_client_id
+        client_id: %s
+`, clientIDStr)
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(configStr))
+	if err != nil {
+		t.Fatalf("Can't parse config: %s", err.Error())
+	}
+	plaintext := []byte("plaintext")
+	encryptedValue := []byte("encrypted")
+	testcases := []struct {
+		query       string
+		dialect     dialect.Dialect
+		values      int
+		expected    [][]byte
+		expectedIDs [][]byte
+		err         error
+	}{
+		// placeholders in VALUES and ON DUPLICATE KEY UPDATE
+		{
+			query:       "INSERT INTO TableWithoutColumnSchema (other_column, default_client_id) VALUES (?, ?) ON DUPLICATE KEY UPDATE specified_client_id=?, other_column=?",
+			dialect:     mysql.NewMySQLDialect(),
+			values:      4,
+			expected:    [][]byte{plaintext, encryptedValue, encryptedValue, plaintext},
+			expectedIDs: [][]byte{defaultClientID, specifiedClientID},
+		},
+		// placeholders in VALUES and ON CONFLICT DO UPDATE, excluded row is ignored
+		{
+			query:       `INSERT INTO TableWithoutColumnSchema (other_column) VALUES ($1) ON CONFLICT (other_column) DO UPDATE SET specified_client_id=$2, default_client_id=excluded.default_client_id`,
+			dialect:     postgresql.NewPostgreSQLDialect(),
+			values:      2,
+			expected:    [][]byte{plaintext, encryptedValue},
+			expectedIDs: [][]byte{specifiedClientID},
+		},
+		// the same placeholder used for update of the inserted column
+		{
+			query:       `INSERT INTO TableWithoutColumnSchema (default_client_id) VALUES ($1) ON CONFLICT (other_column) DO UPDATE SET default_client_id=$1`,
+			dialect:     postgresql.NewPostgreSQLDialect(),
+			values:      1,
+			expected:    [][]byte{encryptedValue},
+			expectedIDs: [][]byte{defaultClientID},
+		},
+		// the same placeholder used for different columns
+		{
+			query:   `INSERT INTO TableWithoutColumnSchema (default_client_id) VALUES ($1) ON CONFLICT (other_column) DO UPDATE SET specified_client_id=$1`,
+			dialect: postgresql.NewPostgreSQLDialect(),
+			values:  1,
+			err:     ErrInconsistentPlaceholder,
+		},
+		// placeholders in INSERT ... SELECT
+		{
+			query:       "INSERT INTO TableWithoutColumnSchema (other_column, default_client_id) SELECT ?, ? UNION SELECT other_column, ? FROM t",
+			dialect:     mysql.NewMySQLDialect(),
+			values:      3,
+			expected:    [][]byte{plaintext, encryptedValue, encryptedValue},
+			expectedIDs: [][]byte{defaultClientID, defaultClientID},
+		},
+		// placeholders in common table expression used by INSERT ... SELECT
+		{
+			query:       `WITH data(value) AS (SELECT $2) INSERT INTO TableWithoutColumnSchema (other_column, specified_client_id) SELECT $1, value FROM data`,
+			dialect:     postgresql.NewPostgreSQLDialect(),
+			values:      2,
+			expected:    [][]byte{plaintext, encryptedValue},
+			expectedIDs: [][]byte{specifiedClientID},
+		},
+		// placeholders in data-modifying statement of WITH clause
+		{
+			query:       `WITH inserted AS (INSERT INTO TableWithoutColumnSchema (other_column, default_client_id) VALUES ($1, $2) RETURNING id) UPDATE TableWithoutColumnSchema SET specified_client_id=$3 WHERE id IN (SELECT id FROM inserted)`,
+			dialect:     postgresql.NewPostgreSQLDialect(),
+			values:      3,
+			expected:    [][]byte{plaintext, encryptedValue, encryptedValue},
+			expectedIDs: [][]byte{specifiedClientID, defaultClientID},
+		},
+		// row of COPY FROM STDIN passed as bound values
+		{
+			query:       `COPY TableWithoutColumnSchema (specified_client_id, other_column, default_client_id) FROM STDIN WITH (FORMAT csv)`,
+			dialect:     postgresql.NewPostgreSQLDialect(),
+			values:      3,
+			expected:    [][]byte{encryptedValue, plaintext, encryptedValue},
+			expectedIDs: [][]byte{specifiedClientID, defaultClientID},
+		},
+		// row of LOAD DATA LOCAL INFILE passed as bound values
+		{
+			query:       "LOAD DATA LOCAL INFILE 'data.csv' INTO TABLE TableWithoutColumnSchema FIELDS TERMINATED BY ',' (other_column, default_client_id, specified_client_id)",
+			dialect:     mysql.NewMySQLDialect(),
+			values:      3,
+			expected:    [][]byte{plaintext, encryptedValue, encryptedValue},
+			expectedIDs: [][]byte{defaultClientID, specifiedClientID},
+		},
+		// rows with another count of values can't be matched with columns
+		{
+			query:   "LOAD DATA LOCAL INFILE 'data.csv' INTO TABLE TableWithoutColumnSchema FIELDS TERMINATED BY ',' (other_column, default_client_id, specified_client_id)",
+			dialect: mysql.NewMySQLDialect(),
+			values:  2,
+			err:     ErrRowValuesCountMismatch,
+		},
+		{
+			query:   `COPY TableWithoutColumnSchema (specified_client_id, other_column, default_client_id) FROM STDIN WITH (FORMAT csv)`,
+			dialect: postgresql.NewPostgreSQLDialect(),
+			values:  4,
+			err:     ErrRowValuesCountMismatch,
+		},
+	}
+	encryptor := &testEncryptor{value: encryptedValue}
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	queryEncryptor, err := NewPostgresqlQueryEncryptor(schemaStore, parser, encryptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := base.SetAccessContextToContext(context.Background(), base.NewAccessContext(base.WithClientID(defaultClientID)))
+	for i, tcase := range testcases {
+		encryptor.reset()
+		statement, err := sqlparser.ParseWithDialect(tcase.dialect, tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] Can't parse query: %s", i, err)
+		}
+		values := make([]base.BoundValue, tcase.values)
+		for j := range values {
+			values[j] = &testBoundValue{data: plaintext}
+		}
+		newValues, changed, err := queryEncryptor.OnBind(ctx, statement, values)
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %v error, took %v\n", i, tcase.err, err)
+		}
+		if tcase.err != nil {
+			continue
+		}
+		if !changed {
+			t.Fatalf("[%d] Expect changed values\n", i)
+		}
+		for j, value := range newValues {
+			if !bytes.Equal(value.GetData(nil), tcase.expected[j]) {
+				t.Fatalf("[%d] Expect %s value, took %s\n", i, tcase.expected[j], value.GetData(nil))
+			}
+		}
+		if len(encryptor.fetchedIDs) != len(tcase.expectedIDs) {
+			t.Fatalf("[%d] Expect %d fetched ids, took %d\n", i, len(tcase.expectedIDs), len(encryptor.fetchedIDs))
+		}
+	}
+}
+
+func TestUnsupportedEncryptedWrite(t *testing.T) {
+	configTemplate := `
+strict_mode: %t
+schemas:
+  - table: TableWithColumnSchema
+    columns: ["other_column", "default_client_id"]
+    encrypted:
+      - column: "default_client_id"
+
+  - table: TableWithoutColumnSchema
+    encrypted:
+      - column: "default_client_id"
+      - column: "specified_client_id"
+        client_id: specified_client_id
+`
+	queries := []string{
+		// function result can't be encrypted
+		"INSERT INTO TableWithoutColumnSchema (other_column, default_client_id) VALUES (1, lower('data'))",
+		// values can't be matched with columns
+		"INSERT INTO TableWithoutColumnSchema VALUES (1, 'data')",
+		// plaintext column of another table
+		"INSERT INTO TableWithoutColumnSchema (default_client_id) SELECT other_column FROM TableWithColumnSchema",
+		// column from one of several tables
+		"INSERT INTO TableWithoutColumnSchema (default_client_id) SELECT default_client_id FROM TableWithColumnSchema, t2",
+		// SELECT * from table without list of columns
+		"INSERT INTO TableWithoutColumnSchema (default_client_id) SELECT * FROM t2",
+		// plaintext column of table selected with SELECT *
+		"INSERT INTO TableWithoutColumnSchema (default_client_id) SELECT * FROM TableWithColumnSchema",
+		// expression in common table expression
+		"WITH data(value) AS (SELECT concat('a', 'b')) INSERT INTO TableWithoutColumnSchema (default_client_id) SELECT value FROM data",
+		// recursive common table expression
+		"WITH RECURSIVE data(value) AS (SELECT value FROM data) INSERT INTO TableWithoutColumnSchema (default_client_id) SELECT value FROM data",
+		// the same value written into different encrypted columns
+		"WITH data(value) AS (SELECT 'data') INSERT INTO TableWithoutColumnSchema (default_client_id, specified_client_id) SELECT value, value FROM data",
+		// data-modifying statement in WITH clause
+		"WITH data AS (INSERT INTO TableWithoutColumnSchema (default_client_id) VALUES (lower('data')) RETURNING id) SELECT * FROM data",
+		// COPY in binary format
+		"COPY TableWithColumnSchema (other_column, default_client_id) FROM STDIN WITH (FORMAT binary)",
+		// COPY rows can't be matched with columns
+		"COPY TableWithoutColumnSchema FROM STDIN",
+		// function result assigned to encrypted column
+		"UPDATE TableWithoutColumnSchema SET default_client_id = lower('data')",
+		"INSERT INTO TableWithoutColumnSchema (id, default_client_id) VALUES (1, 'data') ON CONFLICT (id) DO UPDATE SET default_client_id = lower('data')",
+		"INSERT INTO TableWithoutColumnSchema (id, default_client_id) VALUES (1, 'data') ON DUPLICATE KEY UPDATE default_client_id = lower('data')",
+		// value of another column of the inserted row
+		"INSERT INTO TableWithoutColumnSchema (id, default_client_id) VALUES (1, 'data') ON CONFLICT (id) DO UPDATE SET default_client_id = EXCLUDED.specified_client_id",
+		"INSERT INTO TableWithoutColumnSchema (id, default_client_id) VALUES (1, 'data') ON DUPLICATE KEY UPDATE default_client_id = VALUES(id)",
+		// EXCLUDED is plain table alias outside of insert statement
+		"UPDATE TableWithoutColumnSchema AS excluded SET default_client_id = excluded.default_client_id",
+	}
+	// queries which write only encrypted data and allowed in strict mode
+	supportedQueries := []string{
+		"UPDATE TableWithoutColumnSchema SET default_client_id = 'data', other_column = lower('data')",
+		"INSERT INTO TableWithoutColumnSchema (id, default_client_id) VALUES (1, 'data') ON CONFLICT (id) DO UPDATE SET default_client_id = EXCLUDED.default_client_id",
+		"INSERT INTO TableWithoutColumnSchema (id, default_client_id) VALUES (1, 'data') ON CONFLICT (id) DO UPDATE SET default_client_id = 'data'",
+		"INSERT INTO TableWithoutColumnSchema (id, default_client_id) VALUES (1, 'data') ON DUPLICATE KEY UPDATE default_client_id = VALUES(default_client_id)",
+	}
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	for _, strictMode := range []bool{true, false} {
+		schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(fmt.Sprintf(configTemplate, strictMode)))
+		if err != nil {
+			t.Fatalf("Can't parse config: %s", err.Error())
+		}
+		if schemaStore.IsStrictMode() != strictMode {
+			t.Fatalf("Expect %t strict mode, took %t\n", strictMode, schemaStore.IsStrictMode())
+		}
+		queryEncryptor, err := NewMysqlQueryEncryptor(schemaStore, parser, &testEncryptor{value: []byte("encrypted")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, query := range queries {
+			_, _, err := queryEncryptor.OnQuery(context.Background(), base.NewOnQueryObjectFromQuery(query, parser))
+			if strictMode && err != ErrUnsupportedEncryptedWrite {
+				t.Fatalf("[%d] Expect %s error, took %v\n", i, ErrUnsupportedEncryptedWrite, err)
+			}
+			// query passed to the database as is without strict mode
+			if !strictMode && err != nil {
+				t.Fatalf("[%d] Unexpected error %s\n", i, err)
+			}
+		}
+		for i, query := range supportedQueries {
+			_, _, err := queryEncryptor.OnQuery(context.Background(), base.NewOnQueryObjectFromQuery(query, parser))
+			if err != nil {
+				t.Fatalf("[%d] Unexpected error %s\n", i, err)
+			}
+		}
+	}
+}
+
+func TestUnsupportedLoadData(t *testing.T) {
+	configStr := `
+schemas:
+  - table: TableWithColumnSchema
+    columns: ["other_column", "default_client_id"]
+    encrypted:
+      - column: "default_client_id"
+
+  - table: TableWithoutColumnSchema
+    encrypted:
+      - column: "default_client_id"
+`
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(configStr))
+	if err != nil {
+		t.Fatalf("Can't parse config: %s", err.Error())
+	}
+	parser := sqlparser.New(sqlparser.ModeStrict)
+	queryEncryptor, err := NewMysqlQueryEncryptor(schemaStore, parser, &testEncryptor{value: []byte("encrypted")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		query string
+		err   error
+	}{
+		// rows read by proxy and can be encrypted
+		{"LOAD DATA LOCAL INFILE 'a' INTO TABLE TableWithColumnSchema (other_column, default_client_id)", nil},
+		{"LOAD DATA LOCAL INFILE 'a' INTO TABLE TableWithColumnSchema FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' LINES TERMINATED BY '\\r\\n' IGNORE 1 LINES (other_column, default_client_id)", nil},
+		// table without encrypted columns
+		{"LOAD DATA INFILE 'a' INTO TABLE t", nil},
+		// file read by the database
+		{"LOAD DATA INFILE 'a' INTO TABLE TableWithColumnSchema (other_column, default_client_id)", ErrUnsupportedLoadData},
+		// columns of rows taken from table schema
+		{"LOAD DATA LOCAL INFILE 'a' INTO TABLE TableWithColumnSchema", nil},
+		// rows can't be matched with columns
+		{"LOAD DATA LOCAL INFILE 'a' INTO TABLE TableWithoutColumnSchema", ErrUnsupportedLoadData},
+		// encrypted column assigned with SET
+		{"LOAD DATA LOCAL INFILE 'a' INTO TABLE TableWithColumnSchema (other_column, @var) SET default_client_id=lower(@var)", ErrUnsupportedLoadData},
+		// fixed-width rows
+		{"LOAD DATA LOCAL INFILE 'a' INTO TABLE TableWithColumnSchema FIELDS TERMINATED BY '' (other_column, default_client_id)", ErrUnsupportedLoadData},
+		// prefix of lines
+		{"LOAD DATA LOCAL INFILE 'a' INTO TABLE TableWithColumnSchema LINES STARTING BY 'row' (other_column, default_client_id)", ErrUnsupportedLoadData},
+	}
+	for i, tcase := range testcases {
+		_, _, err := queryEncryptor.OnQuery(context.Background(), base.NewOnQueryObjectFromQuery(tcase.query, parser))
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %v error, took %v\n", i, tcase.err, err)
+		}
+		if err != nil && !IsUnsupportedEncryptedWriteError(err) {
+			t.Fatalf("[%d] Expect error caused by unsupported encrypted write, took %v\n", i, err)
+		}
+	}
+}
+
 func TestOnReturning(t *testing.T) {
 	zoneIDStr := string(zone.GenerateZoneID())
 	clientIDStr := "specified_client_id"
@@ -685,6 +1068,98 @@ func TestEncryptionSettingCollection(t *testing.T) {
 				nil,
 			},
 		},
+		// schema qualified table has precedence over table without schema
+		{config: `schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data1
+  - table: schema1.test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data2`,
+			query: `select data1, data2 from schema1.test_table`,
+			settings: []*querySelectSetting{
+				nil,
+				{setting: &config.BasicColumnEncryptionSetting{Name: "data2"}, tableName: "schema1.test_table", columnName: "data2", columnAlias: "schema1.test_table"},
+			},
+		},
+		// table without schema in config matches table in any schema
+		{config: `schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data1
+  - table: schema1.test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data2`,
+			query: `select t1.data1, t1.data2 from schema2.test_table as t1`,
+			settings: []*querySelectSetting{
+				{setting: &config.BasicColumnEncryptionSetting{Name: "data1"}, tableName: "schema2.test_table", columnName: "data1", columnAlias: "t1"},
+				nil,
+			},
+		},
+		// table pattern
+		{config: `schemas:
+  - table: events_*
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data1`,
+			query: `select * from events_2026_01`,
+			settings: []*querySelectSetting{
+				{setting: &config.BasicColumnEncryptionSetting{Name: "data1"}, tableName: "events_2026_01", columnName: "data1", columnAlias: ""},
+				nil,
+			},
+		},
+		// COPY TO STDOUT with columns from table schema
+		{config: `schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data2`,
+			query: `copy test_table to stdout`,
+			settings: []*querySelectSetting{
+				nil,
+				{setting: &config.BasicColumnEncryptionSetting{Name: "data2"}, tableName: "test_table", columnName: "data2", columnAlias: ""},
+			},
+		},
+		// COPY TO STDOUT with specified columns
+		{config: `schemas:
+  - table: test_table
+    encrypted:
+      - column: data2`,
+			query: `copy test_table (data2, data1) to stdout with (format csv)`,
+			settings: []*querySelectSetting{
+				{setting: &config.BasicColumnEncryptionSetting{Name: "data2"}, tableName: "test_table", columnName: "data2", columnAlias: ""},
+				nil,
+			},
+		},
+		// COPY of SELECT query
+		{config: `schemas:
+  - table: test_table
+    columns:
+      - data1
+      - data2
+    encrypted:
+      - column: data1`,
+			query: `copy (select data1 from test_table) to stdout`,
+			settings: []*querySelectSetting{
+				{setting: &config.BasicColumnEncryptionSetting{Name: "data1"}, tableName: "test_table", columnName: "data1", columnAlias: "test_table"},
+			},
+		},
 	}
 	parser := sqlparser.New(sqlparser.ModeDefault)
 	encryptor, err := NewPostgresqlQueryEncryptor(nil, parser, nil)
@@ -702,11 +1177,14 @@ func TestEncryptionSettingCollection(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		selectExpr, ok := statement.(*sqlparser.Select)
-		if !ok {
-			t.Fatalf("[%d] Test query should be SELECT query, took %s\n", i, tcase.query)
+		switch statement := statement.(type) {
+		case *sqlparser.Select:
+			_, err = encryptor.onSelect(statement)
+		case *sqlparser.Copy:
+			_, err = encryptor.onCopy(statement)
+		default:
+			t.Fatalf("[%d] Test query should be SELECT or COPY query, took %s\n", i, tcase.query)
 		}
-		_, err = encryptor.onSelect(selectExpr)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -18,6 +18,8 @@ package encryptor
 
 import (
 	"errors"
+
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/sqlparser"
 )
 
@@ -32,6 +34,12 @@ type columnInfo struct {
 
 var errEmptyTableExprs = errors.New("empty table exprs")
 
+// GetQualifiedTableName return table name qualified with schema/database name if it's specified in the query.
+// Used to find table schema in config.TableSchemaStore
+func GetQualifiedTableName(table sqlparser.TableName) string {
+	return config.QualifiedTableName(table.Qualifier.RawValue(), table.Name.RawValue())
+}
+
 // parseJoinTablesInfo recursively read and save sql join structure info, aliases map is used to save association between tables and its aliases,
 // tables slice is used to collect certain order of tables (saved in reverse order of declaration).
 // JoinTableExpr structure represent a recursive tree where RightExpr and LeftExpr are corresponded leaf node
@@ -59,8 +67,8 @@ func parseJoinTablesInfo(joinExp *sqlparser.JoinTableExpr, tables *[]string, ali
 			alias = tableName.Name.RawValue()
 		}
 
-		*tables = append(*tables, tableName.Name.RawValue())
-		aliases[alias] = tableName.Name.RawValue()
+		*tables = append(*tables, GetQualifiedTableName(tableName))
+		aliases[alias] = GetQualifiedTableName(tableName)
 		return true
 	}
 
@@ -96,7 +104,7 @@ func getRightJoinTableInfo(joinExp *sqlparser.JoinTableExpr) (string, string, bo
 		alias = tableName.Name.RawValue()
 	}
 
-	return tableName.Name.RawValue(), alias, true
+	return GetQualifiedTableName(tableName), alias, true
 }
 
 // getJoinFirstTableWithoutAlias recursively process JoinTableExpr tree until it reaches the first table in JOIN declarations
@@ -158,7 +166,7 @@ func getNonAliasedName(aliased *sqlparser.AliasedTableExpr) (string, bool) {
 	if !ok {
 		return "", false
 	}
-	return tableName.Name.RawValue(), true
+	return GetQualifiedTableName(tableName), true
 }
 
 func getTableNameWithoutAliases(expr sqlparser.TableExpr) (string, error) {
@@ -170,7 +178,7 @@ func getTableNameWithoutAliases(expr sqlparser.TableExpr) (string, error) {
 	if !ok {
 		return "", errNotFoundtable
 	}
-	return tableName.Name.RawValue(), nil
+	return GetQualifiedTableName(tableName), nil
 }
 
 func findTableName(alias, columnName string, expr sqlparser.SQLNode) (columnInfo, error) {
@@ -186,9 +194,9 @@ func findTableName(alias, columnName string, expr sqlparser.SQLNode) (columnInfo
 		}
 		return columnInfo{}, errNotFoundtable
 	case sqlparser.TableName:
-		// table1, should be equal to end alias value
-		if alias == val.Name.RawValue() {
-			return columnInfo{Name: columnName, Table: alias}, nil
+		// table1 or schema1.table1, should be equal to end alias value
+		if qualifiedName := GetQualifiedTableName(val); alias == val.Name.RawValue() || alias == qualifiedName {
+			return columnInfo{Name: columnName, Table: qualifiedName}, nil
 		}
 		return columnInfo{}, errNotFoundtable
 	case *sqlparser.AliasedTableExpr:
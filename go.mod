@@ -4,7 +4,9 @@ go 1.14
 
 require (
 	contrib.go.opencensus.io/exporter/jaeger v0.2.1
+	github.com/DATA-DOG/go-sqlmock v1.5.0
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
+	github.com/alicebob/miniredis/v2 v2.30.0
 	github.com/cossacklabs/themis/gothemis v0.13.1
 	github.com/gin-gonic/gin v1.7.2
 	github.com/go-redis/redis/v7 v7.0.1
@@ -71,9 +71,15 @@ func (e *SearchableDataEncryptor) EncryptWithClientID(clientID, data []byte, set
 				logrus.WithError(err).WithField("decryptor", e.decryptor).Debugln("Not decrypted")
 				return nil, err
 			}
-			hash = GenerateHMAC(key, data)
+			hash, err = generateSearchableHash(key, data, setting)
+			if err != nil {
+				return nil, err
+			}
 		} else {
-			hash = GenerateHMAC(key, data)
+			hash, err = generateSearchableHash(key, data, setting)
+			if err != nil {
+				return nil, err
+			}
 			encryptedData, err = e.dataEncryptor.EncryptWithClientID(clientID, data, setting)
 			if err != nil {
 				return nil, err
@@ -84,3 +90,16 @@ func (e *SearchableDataEncryptor) EncryptWithClientID(clientID, data []byte, set
 	}
 	return data, nil
 }
+
+// generateSearchableHash return HMAC of data followed by prefix index if searchable_prefix configured for the column
+func generateSearchableHash(key, data []byte, setting config.ColumnEncryptionSetting) ([]byte, error) {
+	prefix := setting.GetSearchablePrefix()
+	if prefix == nil {
+		return GenerateHMAC(key, data), nil
+	}
+	index, err := GeneratePrefixIndex(key, data, prefix.Min, prefix.Max)
+	if err != nil {
+		return nil, err
+	}
+	return append(GenerateHMAC(key, data), index...), nil
+}
@@ -120,6 +120,11 @@ func (key SimpleHmacKeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	return key, nil
 }
 
+// GetHMACSecretKeys return itself as the only key on any passed id
+func (key SimpleHmacKeyStore) GetHMACSecretKeys(id []byte) ([][]byte, error) {
+	return [][]byte{key}, nil
+}
+
 // DecryptRotatedSearchableAcraStruct decrypt acrastruct with hash and verify that hash correct
 // Note: function expects that AcraStruct was encrypted with key related to this context and hmacKey passed according to this context
 // context should be ClientID or ZoneID
@@ -0,0 +1,213 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decryptor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+)
+
+// ErrInvalidArray returned if value can't be parsed as one-dimensional PostgreSQL array
+var ErrInvalidArray = errors.New("invalid or unsupported array value")
+
+const (
+	pgArraySpaces     = " \t\n\r\v\f"
+	pgArrayNullLength = -1
+)
+
+// pgBinaryArray is a PostgreSQL array in binary format, nil elements are NULLs
+type pgBinaryArray struct {
+	// header contains flags, type oid and dimensions and kept as is except size of the dimension
+	header   []byte
+	elements [][]byte
+}
+
+// parsePgBinaryArray parses array in the format of array_send:
+// int32 ndim, int32 hasnull, uint32 elemtype, ndim * (int32 size, int32 lbound), elements as int32 length with data
+func parsePgBinaryArray(data []byte) (*pgBinaryArray, error) {
+	if len(data) < 12 {
+		return nil, ErrInvalidArray
+	}
+	ndim := int32(binary.BigEndian.Uint32(data[:4]))
+	if ndim == 0 {
+		return &pgBinaryArray{header: data[:12]}, nil
+	}
+	if ndim != 1 {
+		return nil, ErrInvalidArray
+	}
+	headerLength := 12 + 8
+	if len(data) < headerLength {
+		return nil, ErrInvalidArray
+	}
+	size := int32(binary.BigEndian.Uint32(data[12:16]))
+	if size < 0 {
+		return nil, ErrInvalidArray
+	}
+	array := &pgBinaryArray{header: data[:headerLength], elements: make([][]byte, 0, size)}
+	data = data[headerLength:]
+	for i := int32(0); i < size; i++ {
+		if len(data) < 4 {
+			return nil, ErrInvalidArray
+		}
+		length := int32(binary.BigEndian.Uint32(data[:4]))
+		data = data[4:]
+		if length == pgArrayNullLength {
+			array.elements = append(array.elements, nil)
+			continue
+		}
+		if length < 0 || int(length) > len(data) {
+			return nil, ErrInvalidArray
+		}
+		array.elements = append(array.elements, data[:length])
+		data = data[length:]
+	}
+	if len(data) != 0 {
+		return nil, ErrInvalidArray
+	}
+	return array, nil
+}
+
+// Encode returns array in binary format
+func (array *pgBinaryArray) Encode() []byte {
+	output := make([]byte, 0, len(array.header))
+	output = append(output, array.header...)
+	if len(output) >= 16 {
+		// elements may be added, so dimension size is updated
+		binary.BigEndian.PutUint32(output[12:16], uint32(len(array.elements)))
+	}
+	lengthBuf := make([]byte, 4)
+	for _, element := range array.elements {
+		length := int32(len(element))
+		if element == nil {
+			length = pgArrayNullLength
+		}
+		binary.BigEndian.PutUint32(lengthBuf, uint32(length))
+		output = append(output, lengthBuf...)
+		output = append(output, element...)
+	}
+	return output
+}
+
+// parsePgTextArray parses one-dimensional array in the text format like {value,"quoted value",NULL},
+// NULL elements returned as nil
+func parsePgTextArray(data []byte) ([][]byte, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) < 2 || data[0] != '{' || data[len(data)-1] != '}' {
+		return nil, ErrInvalidArray
+	}
+	data = data[1 : len(data)-1]
+	if len(bytes.TrimSpace(data)) == 0 {
+		return [][]byte{}, nil
+	}
+	var elements [][]byte
+	for i := 0; ; {
+		i = skipPgArraySpaces(data, i)
+		if i == len(data) {
+			return nil, ErrInvalidArray
+		}
+		element := make([]byte, 0)
+		quoted := false
+		switch data[i] {
+		case '"':
+			quoted = true
+			i++
+			for ; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+					if i == len(data) {
+						return nil, ErrInvalidArray
+					}
+				}
+				element = append(element, data[i])
+			}
+			if i == len(data) {
+				return nil, ErrInvalidArray
+			}
+			i++
+			i = skipPgArraySpaces(data, i)
+		case '{':
+			// multidimensional arrays are not supported
+			return nil, ErrInvalidArray
+		default:
+			for ; i < len(data) && data[i] != ','; i++ {
+				switch data[i] {
+				case '"', '{', '}':
+					return nil, ErrInvalidArray
+				case '\\':
+					i++
+					if i == len(data) {
+						return nil, ErrInvalidArray
+					}
+				}
+				element = append(element, data[i])
+			}
+			element = bytes.TrimRight(element, pgArraySpaces)
+			if len(element) == 0 {
+				return nil, ErrInvalidArray
+			}
+		}
+		if !quoted && bytes.EqualFold(element, []byte("NULL")) {
+			element = nil
+		}
+		elements = append(elements, element)
+		if i == len(data) {
+			return elements, nil
+		}
+		if data[i] != ',' {
+			return nil, ErrInvalidArray
+		}
+		i++
+	}
+}
+
+func skipPgArraySpaces(data []byte, i int) int {
+	for i < len(data) && bytes.IndexByte([]byte(pgArraySpaces), data[i]) != -1 {
+		i++
+	}
+	return i
+}
+
+// encodePgTextArray returns array in text format with elements encoded as bytea in hex format
+func encodePgTextArray(elements [][]byte) []byte {
+	output := bytes.NewBuffer(make([]byte, 0, len(elements)*8+2))
+	output.WriteByte('{')
+	for i, element := range elements {
+		if i > 0 {
+			output.WriteByte(',')
+		}
+		if element == nil {
+			output.WriteString("NULL")
+			continue
+		}
+		// backslash of \x prefix should be escaped inside quoted element
+		output.WriteString(`"\`)
+		output.Write(encodePgHexBytea(element))
+		output.WriteByte('"')
+	}
+	output.WriteByte('}')
+	return output.Bytes()
+}
+
+// encodePgHexBytea returns data as bytea in hex format
+func encodePgHexBytea(data []byte) []byte {
+	output := make([]byte, 2+hex.EncodedLen(len(data)))
+	copy(output, `\x`)
+	hex.Encode(output[2:], data)
+	return output
+}
@@ -38,17 +38,123 @@ func isSupportedSQLVal(val *sqlparser.SQLVal) bool {
 	return false
 }
 
-// getEqualComparisonExprs return only <ColName> = <VALUE> or <ColName> != <VALUE> or <ColName> <=> <VALUE> expressions
-func getEqualComparisonExprs(stmt sqlparser.SQLNode) ([]*sqlparser.ComparisonExpr, error) {
+func isPlaceholder(val *sqlparser.SQLVal) bool {
+	return val.Type == sqlparser.PgPlaceholder || val.Type == sqlparser.ValArg
+}
+
+// getArrayComparisonValue return value of PostgreSQL's ANY(<VALUE>)/SOME(<VALUE>)/ALL(<VALUE>) array comparison
+// and lowered name of the function
+func getArrayComparisonValue(expr sqlparser.Expr) (*sqlparser.SQLVal, string, bool) {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	if !ok || !funcExpr.Qualifier.IsEmpty() || len(funcExpr.Exprs) != 1 {
+		return nil, "", false
+	}
+	name := funcExpr.Name.Lowered()
+	switch name {
+	case "any", "some", "all":
+	default:
+		return nil, "", false
+	}
+	aliasedExpr, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, "", false
+	}
+	sqlVal, ok := aliasedExpr.Expr.(*sqlparser.SQLVal)
+	if !ok || !isSupportedSQLVal(sqlVal) {
+		return nil, "", false
+	}
+	return sqlVal, name, true
+}
+
+// isSupportedComparisonValue return true if right side of comparison may be replaced with HMAC
+func isSupportedComparisonValue(comparisonExpr *sqlparser.ComparisonExpr) bool {
+	switch comparisonExpr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.NullSafeEqualStr:
+		if sqlVal, ok := comparisonExpr.Right.(*sqlparser.SQLVal); ok {
+			return isSupportedSQLVal(sqlVal)
+		}
+		if comparisonExpr.Operator == sqlparser.NullSafeEqualStr {
+			return false
+		}
+		sqlVal, name, ok := getArrayComparisonValue(comparisonExpr.Right)
+		if !ok {
+			return false
+		}
+		if isPlaceholder(sqlVal) {
+			return true
+		}
+		// array literals are replaced with IN/NOT IN lists which are equal only to these comparisons
+		if comparisonExpr.Operator == sqlparser.EqualStr {
+			return name != "all"
+		}
+		return name == "all"
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		sqlVal, ok := comparisonExpr.Right.(*sqlparser.SQLVal)
+		return ok && comparisonExpr.Escape == nil && isSupportedSQLVal(sqlVal) && sqlVal.Type != sqlparser.HexVal
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := comparisonExpr.Right.(sqlparser.ValTuple)
+		if !ok || len(tuple) == 0 {
+			return false
+		}
+		for _, expr := range tuple {
+			if sqlVal, ok := expr.(*sqlparser.SQLVal); !ok || !isSupportedSQLVal(sqlVal) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// isLikeComparison return true for LIKE and NOT LIKE comparisons
+func isLikeComparison(comparisonExpr *sqlparser.ComparisonExpr) bool {
+	return comparisonExpr.Operator == sqlparser.LikeStr || comparisonExpr.Operator == sqlparser.NotLikeStr
+}
+
+// getSearchableComparisonExprs return only <ColName> = <VALUE> or <ColName> != <VALUE> or <ColName> <=> <VALUE>,
+// <ColName> [NOT] IN (<VALUE>, ...), <ColName> = ANY(<VALUE>) or <ColName> != ALL(<VALUE>) expressions and other
+// PostgreSQL's array comparisons with placeholders like <ColName> = ALL($1), <ColName> [NOT] LIKE <VALUE>
+func getSearchableComparisonExprs(stmt sqlparser.SQLNode) ([]*sqlparser.ComparisonExpr, error) {
 	var exprs []*sqlparser.ComparisonExpr
 	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
-		if comparisonExpr, ok := node.(*sqlparser.ComparisonExpr); ok {
-			if sqlVal, ok := comparisonExpr.Right.(*sqlparser.SQLVal); ok && isSupportedSQLVal(sqlVal) {
-				if comparisonExpr.Operator == sqlparser.EqualStr || comparisonExpr.Operator == sqlparser.NotEqualStr || comparisonExpr.Operator == sqlparser.NullSafeEqualStr {
-					if _, ok := comparisonExpr.Left.(*sqlparser.ColName); ok {
-						exprs = append(exprs, comparisonExpr)
-					}
-				}
+		if comparisonExpr, ok := node.(*sqlparser.ComparisonExpr); ok && isSupportedComparisonValue(comparisonExpr) {
+			if _, ok := comparisonExpr.Left.(*sqlparser.ColName); ok {
+				exprs = append(exprs, comparisonExpr)
+			}
+		}
+		return true, nil
+	}, stmt)
+	return exprs, err
+}
+
+// isColumnComparison return true for <ColName> = <ColName>, <ColName> != <ColName> or <ColName> <=> <ColName>
+func isColumnComparison(comparisonExpr *sqlparser.ComparisonExpr) bool {
+	switch comparisonExpr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.NullSafeEqualStr:
+	default:
+		return false
+	}
+	_, leftOk := comparisonExpr.Left.(*sqlparser.ColName)
+	_, rightOk := comparisonExpr.Right.(*sqlparser.ColName)
+	return leftOk && rightOk
+}
+
+// getColumnComparisonExprs return comparisons of two columns from WHERE clauses and JOIN ... ON conditions
+func getColumnComparisonExprs(stmt sqlparser.SQLNode) ([]*sqlparser.ComparisonExpr, error) {
+	var exprs []*sqlparser.ComparisonExpr
+	collectComparisons := func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if comparisonExpr, ok := node.(*sqlparser.ComparisonExpr); ok && isColumnComparison(comparisonExpr) {
+			exprs = append(exprs, comparisonExpr)
+		}
+		return true, nil
+	}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch clause := node.(type) {
+		case *sqlparser.Where:
+			return false, sqlparser.Walk(collectComparisons, clause)
+		case sqlparser.JoinCondition:
+			if clause.On != nil {
+				return false, sqlparser.Walk(collectComparisons, clause.On)
 			}
 		}
 		return true, nil
@@ -17,6 +17,7 @@ limitations under the License.
 package decryptor
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/cossacklabs/acra/utils"
@@ -40,21 +41,25 @@ type HashDecryptStore interface {
 
 // HashQuery calculate hmac for data inside AcraStruct and change WHERE conditions to support searchable encryption
 type HashQuery struct {
-	keystore    HashDecryptStore
-	coder       queryEncryptor.DBDataCoder
-	schemaStore config.TableSchemaStore
-	decryptor   base.ExtendedDataProcessor
-	parser      *sqlparser.Parser
+	keystore HashDecryptStore
+	coder    queryEncryptor.DBDataCoder
+	// schemaSource may be replaced with new schema on config reload, schemaStore used to process current query
+	schemaSource config.TableSchemaStore
+	schemaStore  config.TableSchemaStore
+	decryptor    base.ExtendedDataProcessor
+	parser       *sqlparser.Parser
+	// preparedKeyCounts maps text of queries with placeholders to count of HMAC keys used to rewrite them
+	preparedKeyCounts map[string]int
 }
 
 // NewPostgresqlHashQuery return HashQuery with coder for postgresql
 func NewPostgresqlHashQuery(keystore HashDecryptStore, schemaStore config.TableSchemaStore, decryptor base.ExtendedDataProcessor) *HashQuery {
-	return &HashQuery{keystore: keystore, coder: &queryEncryptor.PostgresqlDBDataCoder{}, schemaStore: schemaStore, decryptor: decryptor}
+	return &HashQuery{keystore: keystore, coder: &queryEncryptor.PostgresqlDBDataCoder{}, schemaSource: schemaStore, schemaStore: schemaStore, decryptor: decryptor}
 }
 
 // NewMysqlHashQuery return HashQuery with coder for mysql
 func NewMysqlHashQuery(keystore HashDecryptStore, schemaStore config.TableSchemaStore, decryptor base.ExtendedDataProcessor) *HashQuery {
-	return &HashQuery{keystore: keystore, coder: &queryEncryptor.MysqlDBDataCoder{}, schemaStore: schemaStore, decryptor: decryptor}
+	return &HashQuery{keystore: keystore, coder: &queryEncryptor.MysqlDBDataCoder{}, schemaSource: schemaStore, schemaStore: schemaStore, decryptor: decryptor}
 }
 
 // ID returns name of this QueryObserver.
@@ -62,7 +67,13 @@ func (encryptor *HashQuery) ID() string {
 	return "HashQuery"
 }
 
-func (encryptor *HashQuery) filterSearchableComparisons(statement sqlparser.Statement) []*sqlparser.ComparisonExpr {
+// searchableComparison is a comparison with searchable column and its encryption setting
+type searchableComparison struct {
+	expr    *sqlparser.ComparisonExpr
+	setting config.ColumnEncryptionSetting
+}
+
+func (encryptor *HashQuery) filterSearchableComparisons(statement sqlparser.Statement) []searchableComparison {
 	// We are interested only in SELECT statements which access at least one encryptable table.
 	// If that's not the case, we have nothing to do here.
 	defaultTable, aliasedTables := encryptor.filterInterestingTables(statement)
@@ -71,7 +82,7 @@ func (encryptor *HashQuery) filterSearchableComparisons(statement sqlparser.Stat
 		return nil
 	}
 	// Now take a closer look at WHERE clauses of the statement. We need only expressions
-	// which are simple equality comparisons, like "WHERE column = value".
+	// which compare column with values, like "WHERE column = value" or "WHERE column IN (value1, value2)".
 	exprs := encryptor.filterComparisonExprs(statement)
 	if len(exprs) == 0 {
 		logrus.Debugln("No eligible comparisons in search query")
@@ -79,12 +90,12 @@ func (encryptor *HashQuery) filterSearchableComparisons(statement sqlparser.Stat
 	}
 	// And among those expressions, not all may refer to columns with searchable encryption
 	// enabled for them. Leave only those expressions which are searchable.
-	exprs = encryptor.filterSerchableComparisons(exprs, defaultTable, aliasedTables)
-	if len(exprs) == 0 {
+	comparisons := encryptor.filterSerchableComparisons(exprs, defaultTable, aliasedTables)
+	if len(comparisons) == 0 {
 		logrus.Debugln("No searchable comparisons in search query")
 		return nil
 	}
-	return exprs
+	return comparisons
 }
 
 func (encryptor *HashQuery) filterInterestingTables(statement sqlparser.Statement) (*queryEncryptor.AliasedTableName, queryEncryptor.AliasToTableMap) {
@@ -101,7 +112,7 @@ func (encryptor *HashQuery) filterInterestingTables(statement sqlparser.Statemen
 	// And even then, we can work only with tables that we have an encryption schema for.
 	var encryptableTables []*queryEncryptor.AliasedTableName
 	for _, table := range tables {
-		if v := encryptor.schemaStore.GetTableSchema(table.TableName.Name.String()); v != nil {
+		if v := encryptor.schemaStore.GetTableSchema(queryEncryptor.GetQualifiedTableName(table.TableName)); v != nil {
 			encryptableTables = append(encryptableTables, table)
 		}
 	}
@@ -121,7 +132,7 @@ func (encryptor *HashQuery) filterComparisonExprs(statement sqlparser.Statement)
 	// ...and find all eligible comparison expressions in them.
 	var exprs []*sqlparser.ComparisonExpr
 	for _, whereExpr := range whereExprs {
-		comparisonExprs, err := getEqualComparisonExprs(whereExpr)
+		comparisonExprs, err := getSearchableComparisonExprs(whereExpr)
 		if err != nil {
 			logrus.WithError(err).Debugln("Failed to extract comparison expressions")
 			return nil
@@ -131,19 +142,50 @@ func (encryptor *HashQuery) filterComparisonExprs(statement sqlparser.Statement)
 	return exprs
 }
 
-func (encryptor *HashQuery) filterSerchableComparisons(exprs []*sqlparser.ComparisonExpr, defaultTable *queryEncryptor.AliasedTableName, aliasedTables queryEncryptor.AliasToTableMap) []*sqlparser.ComparisonExpr {
+func (encryptor *HashQuery) filterSerchableComparisons(exprs []*sqlparser.ComparisonExpr, defaultTable *queryEncryptor.AliasedTableName, aliasedTables queryEncryptor.AliasToTableMap) []searchableComparison {
+	filtered := make([]searchableComparison, 0, len(exprs))
+	for _, expr := range exprs {
+		// Leave out comparisons of columns which are not searchable after alias resolution.
+		encryptionSetting := encryptor.getSearchableSettingOfColumn(expr.Left.(*sqlparser.ColName), defaultTable, aliasedTables)
+		if encryptionSetting == nil {
+			continue
+		}
+		// LIKE may be searched only with prefix index.
+		if isLikeComparison(expr) && encryptionSetting.GetSearchablePrefix() == nil {
+			continue
+		}
+		filtered = append(filtered, searchableComparison{expr: expr, setting: encryptionSetting})
+	}
+	return filtered
+}
+
+// filterSearchableColumnComparisons return comparisons of two searchable columns which HMACs may be compared
+func (encryptor *HashQuery) filterSearchableColumnComparisons(statement sqlparser.Statement) []*sqlparser.ComparisonExpr {
+	defaultTable, aliasedTables := encryptor.filterInterestingTables(statement)
+	if len(aliasedTables) == 0 {
+		return nil
+	}
+	exprs, err := getColumnComparisonExprs(statement)
+	if err != nil {
+		logrus.WithError(err).Debugln("Failed to extract column comparisons")
+		return nil
+	}
 	filtered := make([]*sqlparser.ComparisonExpr, 0, len(exprs))
 	for _, expr := range exprs {
-		// Leave out comparisons of columns which do not have a schema after alias resolution.
-		column := expr.Left.(*sqlparser.ColName)
-		schema := encryptor.getTableSchemaOfColumn(column, defaultTable, aliasedTables)
-		if schema == nil {
+		leftColumn, rightColumn := expr.Left.(*sqlparser.ColName), expr.Right.(*sqlparser.ColName)
+		leftSetting := encryptor.getSearchableSettingOfColumn(leftColumn, defaultTable, aliasedTables)
+		rightSetting := encryptor.getSearchableSettingOfColumn(rightColumn, defaultTable, aliasedTables)
+		if leftSetting == nil && rightSetting == nil {
+			continue
+		}
+		logger := logrus.WithFields(logrus.Fields{"left": sqlparser.String(leftColumn), "right": sqlparser.String(rightColumn)})
+		if leftSetting == nil || rightSetting == nil {
+			logger.Warningln("Searchable column compared with not searchable column, leave comparison as is")
 			continue
 		}
-		// Also leave out those columns which are not searchable.
-		columnName := column.Name.String()
-		encryptionSetting := schema.GetColumnEncryptionSettings(columnName)
-		if encryptionSetting == nil || !encryptionSetting.IsSearchable() {
+		// HMACs are equal only if values were hashed with the same key
+		if !bytes.Equal(leftSetting.ClientID(), rightSetting.ClientID()) || !bytes.Equal(leftSetting.ZoneID(), rightSetting.ZoneID()) {
+			logger.Warningln("Searchable columns use different HMAC keys and can't be compared, leave comparison as is")
 			continue
 		}
 		filtered = append(filtered, expr)
@@ -151,9 +193,31 @@ func (encryptor *HashQuery) filterSerchableComparisons(exprs []*sqlparser.Compar
 	return filtered
 }
 
+// getSearchableSettingOfColumn return encryption setting of the column or nil if the column isn't searchable
+func (encryptor *HashQuery) getSearchableSettingOfColumn(column *sqlparser.ColName, defaultTable *queryEncryptor.AliasedTableName, aliasedTables queryEncryptor.AliasToTableMap) config.ColumnEncryptionSetting {
+	schema := encryptor.getTableSchemaOfColumn(column, defaultTable, aliasedTables)
+	if schema == nil {
+		return nil
+	}
+	encryptionSetting := schema.GetColumnEncryptionSettings(column.Name.String())
+	if encryptionSetting == nil || !encryptionSetting.IsSearchable() {
+		return nil
+	}
+	return encryptionSetting
+}
+
+// hmacSubstr return substring of column with HMAC of the value
+func hmacSubstr(column *sqlparser.ColName) *sqlparser.SubstrExpr {
+	return &sqlparser.SubstrExpr{
+		Name: column,
+		From: sqlparser.NewIntVal([]byte{'1'}),
+		To:   sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", hmac.GetDefaultHashSize()))),
+	}
+}
+
 func (encryptor *HashQuery) getTableSchemaOfColumn(column *sqlparser.ColName, defaultTable *queryEncryptor.AliasedTableName, aliasedTables queryEncryptor.AliasToTableMap) config.TableSchema {
-	if column.Qualifier.Qualifier.IsEmpty() {
-		return encryptor.schemaStore.GetTableSchema(defaultTable.TableName.Name.String())
+	if column.Qualifier.IsEmpty() {
+		return encryptor.schemaStore.GetTableSchema(queryEncryptor.GetQualifiedTableName(defaultTable.TableName))
 	}
 	tableName := aliasedTables[column.Qualifier.Name.String()]
 	return encryptor.schemaStore.GetTableSchema(tableName)
@@ -161,17 +225,34 @@ func (encryptor *HashQuery) getTableSchemaOfColumn(column *sqlparser.ColName, de
 
 // OnQuery processes query text before database sees it.
 //
-// Searchable encryption rewrites WHERE clauses with equality comparisons like this:
+// Searchable encryption rewrites WHERE clauses with comparisons like this:
 //
-//     WHERE column = 'value'   ===>   WHERE substring(column, 1, <HMAC_size>) = <HMAC('value')>
+//     WHERE column = 'value'               ===>   WHERE substring(column, 1, <HMAC_size>) = <HMAC('value')>
+//     WHERE column != 'value'              ===>   WHERE substring(column, 1, <HMAC_size>) != <HMAC('value')>
+//     WHERE column IN ('value1', 'value2') ===>   WHERE substring(column, 1, <HMAC_size>) IN (<HMAC('value1')>, <HMAC('value2')>)
+//
+// PostgreSQL array literals are replaced with lists of HMACs:
+//
+//     WHERE column = ANY('{value1,value2}')  ===>   WHERE substring(column, 1, <HMAC_size>) IN (<HMAC('value1')>, <HMAC('value2')>)
+//     WHERE column != ALL('{value1,value2}') ===>   WHERE substring(column, 1, <HMAC_size>) NOT IN (<HMAC('value1')>, <HMAC('value2')>)
 //
 // If the query is a parameterized prepared query then OnQuery() rewriting yields this:
 //
 //     WHERE column = $1        ===>   WHERE substring(column, 1, <HMAC_size>) = $1
+//     WHERE column = ANY($1)   ===>   WHERE substring(column, 1, <HMAC_size>) = ANY($1)
 //
 // and actual "value" is passed via parameters later. See OnBind() for details.
+//
+// Comparisons of two searchable columns which use the same HMAC key, for example in JOIN conditions, compare HMACs:
+//
+//     ON t1.column = t2.column   ===>   ON substring(t1.column, 1, <HMAC_size>) = substring(t2.column, 1, <HMAC_size>)
+//
+// If the client has rotated HMAC keys, values are compared with HMACs calculated with every key, see rotation.go.
+// Comparisons of two searchable columns match only rows which were hashed with the same key, so they are logged with
+// warning until rotated keys are destroyed.
 func (encryptor *HashQuery) OnQuery(ctx context.Context, query base.OnQueryObject) (base.OnQueryObject, bool, error) {
 	logrus.Debugln("HashQuery.OnQuery")
+	encryptor.schemaStore = config.LoadTableSchemaStore(encryptor.schemaSource)
 	stmt, err := query.Statement()
 	if err != nil {
 		logrus.WithError(err).Debugln("Can't parse SQL statement")
@@ -180,68 +261,214 @@ func (encryptor *HashQuery) OnQuery(ctx context.Context, query base.OnQueryObjec
 	// Extract the subexpressions that we are interested in for searchable encryption.
 	// The list might be empty for non-SELECT queries or for non-eligible SELECTs.
 	// In that case we don't have any more work to do here.
-	exprs := encryptor.filterSearchableComparisons(stmt)
-	if len(exprs) == 0 {
+	comparisons := encryptor.filterSearchableComparisons(stmt)
+	columnComparisons := encryptor.filterSearchableColumnComparisons(stmt)
+	if len(comparisons) == 0 && len(columnComparisons) == 0 {
 		return query, false, nil
 	}
+	keyCount := 1
+	if len(comparisons) != 0 {
+		keyCount, err = encryptor.getHMACKeyCount(ctx)
+		if err != nil {
+			return query, false, err
+		}
+		if hasPlaceholders(comparisons) {
+			encryptor.setPreparedKeyCount(stmt, keyCount)
+		}
+	}
+	if len(columnComparisons) != 0 {
+		encryptor.warnColumnComparisonsWithRotatedKeys(ctx, columnComparisons)
+	}
 	// Now that we have condition expressions, perform rewriting in them.
-	hashSize := []byte(fmt.Sprintf("%d", hmac.GetDefaultHashSize()))
-	for _, expr := range exprs {
-		// column = 'value' ===> substring(column, 1, <HMAC_size>) = 'value'
-		expr.Left = &sqlparser.SubstrExpr{
-			Name: expr.Left.(*sqlparser.ColName),
-			From: sqlparser.NewIntVal([]byte{'1'}),
-			To:   sqlparser.NewIntVal(hashSize),
+	changed := false
+	for _, expr := range columnComparisons {
+		// t1.column = t2.column ===> substring(t1.column, 1, <HMAC_size>) = substring(t2.column, 1, <HMAC_size>)
+		expr.Left = hmacSubstr(expr.Left.(*sqlparser.ColName))
+		expr.Right = hmacSubstr(expr.Right.(*sqlparser.ColName))
+		changed = true
+	}
+	for _, comparison := range comparisons {
+		expr := comparison.expr
+		if isLikeComparison(expr) {
+			// column LIKE 'value%' ===> substring(column, <prefix_offset>, <prefix_hash_size>) = <prefix_hash('value')>
+			likeChanged, err := encryptor.rewriteLikeComparison(ctx, expr, comparison.setting.GetSearchablePrefix(), keyCount)
+			if err != nil {
+				logrus.WithError(err).Debugln("Failed to update LIKE expression")
+				return query, false, err
+			}
+			changed = changed || likeChanged
+			continue
 		}
+		// column = 'value' ===> substring(column, 1, <HMAC_size>) = 'value'
+		expr.Left = hmacSubstr(expr.Left.(*sqlparser.ColName))
 
 		// substring(column, 1, <HMAC_size>) = 'value' ===> substring(column, 1, <HMAC_size>) = <HMAC('value')>
 		// substring(column, 1, <HMAC_size>) = $1      ===> no changes
-		err := queryEncryptor.UpdateExpressionValue(ctx, expr.Right, encryptor.coder, encryptor.calculateHmac)
+		if keyCount > 1 {
+			err = encryptor.updateRotatedComparisonValue(ctx, expr, keyCount)
+		} else {
+			err = encryptor.updateComparisonValue(ctx, expr)
+		}
 		if err != nil {
 			logrus.WithError(err).Debugln("Failed to update expression")
 			return query, false, err
 		}
+		changed = true
+	}
+	if !changed {
+		return query, false, nil
 	}
 	logrus.Debugln("HashQuery.OnQuery changed query")
 	return base.NewOnQueryObjectFromStatement(stmt, encryptor.parser), true, nil
 }
 
+// updateComparisonValue replaces values on the right side of comparison with their HMACs
+func (encryptor *HashQuery) updateComparisonValue(ctx context.Context, expr *sqlparser.ComparisonExpr) error {
+	switch value := expr.Right.(type) {
+	case *sqlparser.SQLVal:
+		return queryEncryptor.UpdateExpressionValue(ctx, value, encryptor.coder, encryptor.calculateHmac)
+	case sqlparser.ValTuple:
+		for _, item := range value {
+			if err := queryEncryptor.UpdateExpressionValue(ctx, item, encryptor.coder, encryptor.calculateHmac); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *sqlparser.FuncExpr:
+		arrayValue, _, ok := getArrayComparisonValue(value)
+		if !ok || isPlaceholder(arrayValue) {
+			return nil
+		}
+		// Array literal should be decoded and every element replaced with HMAC. We can't print bytea array literal
+		// safely, so convert comparison into equal IN/NOT IN list of values.
+		elements, err := parsePgTextArray(arrayValue.Val)
+		if err != nil {
+			return err
+		}
+		// "= ANY('{}')" and "!= ALL('{}')" don't have equal IN/NOT IN expressions, leave as is
+		if len(elements) == 0 {
+			return nil
+		}
+		tuple := make(sqlparser.ValTuple, 0, len(elements))
+		for _, element := range elements {
+			if element == nil {
+				tuple = append(tuple, &sqlparser.NullVal{})
+				continue
+			}
+			item := sqlparser.NewStrVal(element)
+			if err := queryEncryptor.UpdateExpressionValue(ctx, item, encryptor.coder, encryptor.calculateHmac); err != nil {
+				return err
+			}
+			tuple = append(tuple, item)
+		}
+		if expr.Operator == sqlparser.EqualStr {
+			expr.Operator = sqlparser.InStr
+		} else {
+			expr.Operator = sqlparser.NotInStr
+		}
+		expr.Right = tuple
+	}
+	return nil
+}
+
 // OnBind processes bound values for prepared statements.
 //
-// Searchable encryption rewrites WHERE clauses with equality comparisons like this:
+// Searchable encryption rewrites WHERE clauses with comparisons like this:
 //
 //     WHERE column = 'value'   ===>   WHERE substring(column, 1, <HMAC_size>) = <HMAC('value')>
 //
 // If the query is a parameterized prepared query then OnQuery() rewriting yields this:
 //
-//     WHERE column = $1        ===>   WHERE substring(column, 1, <HMAC_size>) = $1
+//     WHERE column = $1             ===>   WHERE substring(column, 1, <HMAC_size>) = $1
+//     WHERE column IN ($1, $2)      ===>   WHERE substring(column, 1, <HMAC_size>) IN ($1, $2)
+//     WHERE column = ANY($1)        ===>   WHERE substring(column, 1, <HMAC_size>) = ANY($1)
 //
 // and actual "value" is passed via parameters, visible here in OnBind().
 // If that's the case, HMAC computation should be performed for relevant values.
+// Array parameters of ANY/SOME/ALL comparisons get HMAC computed for every element.
+// If the client has rotated HMAC keys, values are replaced with concatenation of HMACs calculated with as many keys as
+// the statement was prepared with and arrays get HMACs of every element calculated with every key.
 func (encryptor *HashQuery) OnBind(ctx context.Context, statement sqlparser.Statement, values []base.BoundValue) ([]base.BoundValue, bool, error) {
 	logrus.Debugln("HashQuery.OnBind")
+	encryptor.schemaStore = config.LoadTableSchemaStore(encryptor.schemaSource)
 	// Extract the subexpressions that we are interested in for searchable encryption.
 	// The list might be empty for non-SELECT queries or for non-eligible SELECTs.
 	// In that case we don't have any more work to do here.
-	exprs := encryptor.filterSearchableComparisons(statement)
-	if len(exprs) == 0 {
+	comparisons := encryptor.filterSearchableComparisons(statement)
+	if len(comparisons) == 0 {
 		return values, false, nil
 	}
 	// Now that we have expressions, analyze them to look for involved placeholders
 	// and map them onto values that we need to update.
 	placeholders := make([]int, 0, len(values))
-	for _, expr := range exprs {
-		switch value := expr.Right.(type) {
+	arrayPlaceholders := make([]int, 0, len(values))
+	currentKeyArrayPlaceholders := make([]int, 0, len(values))
+	likePlaceholders := make(map[int]*config.SearchablePrefix)
+	var err error
+	for _, comparison := range comparisons {
+		switch value := comparison.expr.Right.(type) {
 		case *sqlparser.SQLVal:
-			var err error
+			if isLikeComparison(comparison.expr) {
+				var indexes []int
+				indexes, err = encryptor.updatePlaceholderList(nil, values, value)
+				for _, index := range indexes {
+					likePlaceholders[index] = comparison.setting.GetSearchablePrefix()
+				}
+				break
+			}
 			placeholders, err = encryptor.updatePlaceholderList(placeholders, values, value)
-			if err != nil {
-				return values, false, err
+		case sqlparser.ValTuple:
+			for _, item := range value {
+				if sqlVal, ok := item.(*sqlparser.SQLVal); ok {
+					placeholders, err = encryptor.updatePlaceholderList(placeholders, values, sqlVal)
+					if err != nil {
+						break
+					}
+				}
+			}
+		case *sqlparser.FuncExpr:
+			sqlVal, name, ok := getArrayComparisonValue(value)
+			if !ok {
+				break
+			}
+			// "= ANY" and "!= ALL" match any of HMACs calculated with rotated keys, other comparisons can't be
+			// satisfied by several HMACs of the same value and compare only HMACs calculated with current key
+			if (comparison.expr.Operator == sqlparser.EqualStr) != (name == "all") {
+				arrayPlaceholders, err = encryptor.updatePlaceholderList(arrayPlaceholders, values, sqlVal)
+			} else {
+				currentKeyArrayPlaceholders, err = encryptor.updatePlaceholderList(currentKeyArrayPlaceholders, values, sqlVal)
 			}
 		}
+		if err != nil {
+			return values, false, err
+		}
+	}
+	keyCount := 1
+	if len(placeholders) != 0 || len(likePlaceholders) != 0 {
+		keyCount, err = encryptor.getPreparedKeyCount(ctx, statement)
+		if err != nil {
+			return values, false, err
+		}
 	}
 	// Finally, once we know which values to replace with HMACs, do this replacement.
-	return encryptor.replaceValuesWithHMACs(ctx, values, placeholders)
+	newValues, changed, err := encryptor.replaceValuesWithHMACs(ctx, values, placeholders, keyCount)
+	if err != nil {
+		return values, false, err
+	}
+	newValues, arraysChanged, err := encryptor.replaceArraysWithHMACs(ctx, newValues, arrayPlaceholders, encryptor.calculateHmacs)
+	if err != nil {
+		return values, false, err
+	}
+	newValues, currentKeyArraysChanged, err := encryptor.replaceArraysWithHMACs(ctx, newValues, currentKeyArrayPlaceholders, encryptor.calculateCurrentHmac)
+	if err != nil {
+		return values, false, err
+	}
+	arraysChanged = arraysChanged || currentKeyArraysChanged
+	newValues, patternsChanged, err := encryptor.replaceLikePatternsWithHashes(ctx, newValues, likePlaceholders, keyCount)
+	if err != nil {
+		return values, false, err
+	}
+	return newValues, changed || arraysChanged || patternsChanged, nil
 }
 
 func (encryptor *HashQuery) updatePlaceholderList(placeholders []int, values []base.BoundValue, placeholder *sqlparser.SQLVal) ([]int, error) {
@@ -259,6 +486,12 @@ func (encryptor *HashQuery) updatePlaceholderList(placeholders []int, values []b
 				Warning("Invalid placeholder index")
 			return nil, queryEncryptor.ErrInvalidPlaceholder
 		}
+		// The same placeholder may be used several times but should be replaced only once.
+		for _, i := range placeholders {
+			if i == index {
+				return placeholders, nil
+			}
+		}
 		placeholders = append(placeholders, index)
 		return placeholders, nil
 	}
@@ -274,7 +507,7 @@ func (encryptor *HashQuery) updatePlaceholderList(placeholders []int, values []b
 	return placeholders, nil
 }
 
-func (encryptor *HashQuery) replaceValuesWithHMACs(ctx context.Context, values []base.BoundValue, placeholders []int) ([]base.BoundValue, bool, error) {
+func (encryptor *HashQuery) replaceValuesWithHMACs(ctx context.Context, values []base.BoundValue, placeholders []int, keyCount int) ([]base.BoundValue, bool, error) {
 	// If there are no interesting placholder positions then we don't have to process anything.
 	if len(placeholders) == 0 {
 		return values, false, nil
@@ -291,17 +524,21 @@ func (encryptor *HashQuery) replaceValuesWithHMACs(ctx context.Context, values [
 		case base.BinaryFormat:
 			// If we can't decrypt the data and compute its HMAC, searchable encryption failed to apply.
 			// Since we have already modified the query, it's likely to fail, but we can't do much about it.
-			hmacHash, err := encryptor.calculateHmac(ctx, data)
+			hmacHashes, err := encryptor.calculateHmacs(ctx, data)
 			if err != nil {
 				logrus.WithError(err).WithField("index", valueIndex).Debug("Failed to encrypt column")
 				return values, false, err
 			}
 			// it is ok to ignore the error if not column setting provided
-			_ = newValues[valueIndex].SetData(hmacHash, nil)
+			_ = newValues[valueIndex].SetData(bytes.Join(fitHashes(hmacHashes, keyCount), nil), nil)
 
-		// TODO(ilammy, 2020-10-14): implement support for base.BindText
-		// We should parse and decode the data, convert that into HMAC instead,
-		// and then either force binary format or reencode the data back into text.
+		case base.TextFormat:
+			hmacHashes, err := encryptor.calculateTextHmacs(ctx, data)
+			if err != nil {
+				logrus.WithError(err).WithField("index", valueIndex).Debug("Failed to encrypt column")
+				return values, false, err
+			}
+			_ = newValues[valueIndex].SetData(encodePgHexBytea(bytes.Join(fitHashes(hmacHashes, keyCount), nil)), nil)
 
 		default:
 			logrus.WithFields(logrus.Fields{"format": format, "index": valueIndex}).
@@ -311,6 +548,63 @@ func (encryptor *HashQuery) replaceValuesWithHMACs(ctx context.Context, values [
 	return newValues, true, nil
 }
 
+// replaceArraysWithHMACs replaces every element of bound arrays with hashes returned by calculate
+func (encryptor *HashQuery) replaceArraysWithHMACs(ctx context.Context, values []base.BoundValue, placeholders []int, calculate func(context.Context, []byte) ([][]byte, error)) ([]base.BoundValue, bool, error) {
+	if len(placeholders) == 0 {
+		return values, false, nil
+	}
+	newValues := make([]base.BoundValue, len(values))
+	copy(newValues, values)
+
+	for _, valueIndex := range placeholders {
+		format := values[valueIndex].Format()
+		logger := logrus.WithFields(logrus.Fields{"format": format, "index": valueIndex})
+
+		data := values[valueIndex].GetData(nil)
+		switch format {
+		case base.BinaryFormat:
+			array, err := parsePgBinaryArray(data)
+			if err != nil {
+				logger.WithError(err).Debug("Failed to parse array parameter")
+				return values, false, err
+			}
+			array.elements, err = encryptor.hashArrayElements(ctx, array.elements, calculate)
+			if err != nil {
+				logger.WithError(err).Debug("Failed to encrypt array element")
+				return values, false, err
+			}
+			_ = newValues[valueIndex].SetData(array.Encode(), nil)
+
+		case base.TextFormat:
+			elements, err := parsePgTextArray(data)
+			if err != nil {
+				logger.WithError(err).Debug("Failed to parse array parameter")
+				return values, false, err
+			}
+			for i, element := range elements {
+				if element == nil {
+					continue
+				}
+				elements[i], err = encryptor.coder.Decode(sqlparser.NewStrVal(element))
+				if err != nil {
+					logger.WithError(err).Debug("Failed to decode array element")
+					return values, false, err
+				}
+			}
+			elements, err = encryptor.hashArrayElements(ctx, elements, calculate)
+			if err != nil {
+				logger.WithError(err).Debug("Failed to encrypt array element")
+				return values, false, err
+			}
+			_ = newValues[valueIndex].SetData(encodePgTextArray(elements), nil)
+
+		default:
+			logger.Warning("Parameter format not supported, skipping")
+		}
+	}
+	return newValues, true, nil
+}
+
 func (encryptor *HashQuery) calculateHmac(ctx context.Context, data []byte) ([]byte, error) {
 	accessContext := base.AccessContextFromContext(ctx)
 	if !encryptor.decryptor.MatchDataSignature(data) {
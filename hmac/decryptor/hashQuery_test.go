@@ -0,0 +1,606 @@
+package decryptor
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/hmac"
+	"github.com/cossacklabs/acra/keystore/mocks"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/cossacklabs/acra/sqlparser/dialect"
+	"github.com/cossacklabs/acra/sqlparser/dialect/mysql"
+	"github.com/cossacklabs/acra/sqlparser/dialect/postgresql"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+)
+
+type testDataProcessor struct{}
+
+func (testDataProcessor) Process(data []byte, context *base.DataProcessorContext) ([]byte, error) {
+	return data, nil
+}
+
+func (testDataProcessor) MatchDataSignature([]byte) bool {
+	return false
+}
+
+type testBoundValue struct {
+	data   []byte
+	format base.BoundValueFormat
+}
+
+func (v *testBoundValue) Format() base.BoundValueFormat { return v.format }
+func (v *testBoundValue) Copy() base.BoundValue {
+	return &testBoundValue{data: append([]byte{}, v.data...), format: v.format}
+}
+func (v *testBoundValue) SetData(newData []byte, setting config.ColumnEncryptionSetting) error {
+	v.data = newData
+	return nil
+}
+func (v *testBoundValue) GetData(setting config.ColumnEncryptionSetting) []byte { return v.data }
+func (v *testBoundValue) Encode() ([]byte, error)                               { return v.data, nil }
+func (v *testBoundValue) GetType() byte                                         { return 0 }
+
+var testHMACKey = []byte("hmac key")
+var testRotatedHMACKey = []byte("rotated hmac key")
+
+func newTestHashQuery(t *testing.T, newHashQuery func(HashDecryptStore, config.TableSchemaStore, base.ExtendedDataProcessor) *HashQuery) *HashQuery {
+	return newTestHashQueryWithKeys(t, newHashQuery, testHMACKey)
+}
+
+// newTestHashQueryWithKeys returns HashQuery with current HMAC key followed by rotated keys
+func newTestHashQueryWithKeys(t *testing.T, newHashQuery func(HashDecryptStore, config.TableSchemaStore, base.ExtendedDataProcessor) *HashQuery, keys ...[]byte) *HashQuery {
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(`
+schemas:
+  - table: users
+    columns:
+      - id
+      - email
+      - name
+      - login
+    encrypted:
+      - column: email
+        searchable: true
+      - column: name
+      - column: login
+        searchable: true
+        searchable_prefix:
+          min: 3
+          max: 5
+  - table: orders
+    columns:
+      - id
+      - email
+      - phone
+      - note
+    encrypted:
+      - column: email
+        searchable: true
+      - column: phone
+        searchable: true
+        client_id: other_client
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keystore := &mocks.ServerKeyStore{}
+	// keys are zeroized after use, so return new copy every time
+	keystore.On("GetHMACSecretKey", mock.Anything).Return(func([]byte) []byte {
+		return append([]byte{}, keys[0]...)
+	}, nil)
+	keystore.On("GetHMACSecretKeys", mock.Anything).Return(func([]byte) [][]byte {
+		copies := make([][]byte, 0, len(keys))
+		for _, key := range keys {
+			copies = append(copies, append([]byte{}, key...))
+		}
+		return copies
+	}, nil)
+	return newHashQuery(keystore, schemaStore, testDataProcessor{})
+}
+
+func testHMAC(data string) []byte {
+	return hmac.GenerateHMAC(append([]byte{}, testHMACKey...), []byte(data))
+}
+
+func testRotatedHMAC(data string) []byte {
+	return hmac.GenerateHMAC(append([]byte{}, testRotatedHMACKey...), []byte(data))
+}
+
+func testPgHMAC(data string) string {
+	return `'\x` + hex.EncodeToString(testHMAC(data)) + `'`
+}
+
+func testPgRotatedHMAC(data string) string {
+	return `'\x` + hex.EncodeToString(testRotatedHMAC(data)) + `'`
+}
+
+func testPgPrefixHash(prefix string) string {
+	return `'\x` + hex.EncodeToString(hmac.GeneratePrefixHash(testHMACKey, []byte(prefix))) + `'`
+}
+
+func testPrefixSubstr(length int) string {
+	return fmt.Sprintf("substr(login, %d, %d)", hmac.GetPrefixHashOffset(3, length)+1, hmac.PrefixHashSize)
+}
+
+func testPrefixSubstrs() string {
+	return strings.Join([]string{testPrefixSubstr(3), testPrefixSubstr(4), testPrefixSubstr(5)}, ", ")
+}
+
+func testMysqlHMAC(data string) string {
+	return sqlparser.String(sqlparser.NewStrVal(testHMAC(data)))
+}
+
+func TestHashQueryOnQuery(t *testing.T) {
+	pgSubstr := fmt.Sprintf("substr(email, 1, %d)", hmac.GetDefaultHashSize())
+	testcases := []struct {
+		dialect  dialect.Dialect
+		query    string
+		expected string
+	}{
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = 'a'`,
+			fmt.Sprintf(`select id from users where %s = %s`, pgSubstr, testPgHMAC("a"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email <> 'a'`,
+			fmt.Sprintf(`select id from users where %s != %s`, pgSubstr, testPgHMAC("a"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email in ('a', 'b')`,
+			fmt.Sprintf(`select id from users where %s in (%s, %s)`, pgSubstr, testPgHMAC("a"), testPgHMAC("b"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email not in ('a', 'b')`,
+			fmt.Sprintf(`select id from users where %s not in (%s, %s)`, pgSubstr, testPgHMAC("a"), testPgHMAC("b"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = any('{a,"b c",NULL}')`,
+			fmt.Sprintf(`select id from users where %s in (%s, %s, null)`, pgSubstr, testPgHMAC("a"), testPgHMAC("b c"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email != all('{a,b}')`,
+			fmt.Sprintf(`select id from users where %s not in (%s, %s)`, pgSubstr, testPgHMAC("a"), testPgHMAC("b"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = any($1) and email != all($2)`,
+			fmt.Sprintf(`select id from users where %s = any($1) and %s != all($2)`, pgSubstr, pgSubstr)},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email in ($1, $2)`,
+			fmt.Sprintf(`select id from users where %s in ($1, $2)`, pgSubstr)},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login like 'joh%'`,
+			fmt.Sprintf(`select id from users where %s = %s`, testPrefixSubstr(3), testPgPrefixHash("joh"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login not like 'j\\_hn%%'`,
+			fmt.Sprintf(`select id from users where %s != %s`, testPrefixSubstr(4), testPgPrefixHash("j_hn"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login like 'john'`,
+			fmt.Sprintf(`select id from users where substr(login, 1, %d) = %s`, hmac.GetDefaultHashSize(), testPgHMAC("john"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login like $1`,
+			fmt.Sprintf(`select id from users where $1 in (%s, %s, %s)`, testPrefixSubstr(3), testPrefixSubstr(4), testPrefixSubstr(5))},
+		{mysql.NewMySQLDialect(), `select id from users where login not like ?`,
+			fmt.Sprintf(`select id from users where ? not in (%s, %s, %s)`, testPrefixSubstr(3), testPrefixSubstr(4), testPrefixSubstr(5))},
+		{mysql.NewMySQLDialect(), `select id from users where email in ('a', 'b')`,
+			fmt.Sprintf(`select id from users where substr(email, 1, %d) in (%s, %s)`, hmac.GetDefaultHashSize(), testMysqlHMAC("a"), testMysqlHMAC("b"))},
+		{mysql.NewMySQLDialect(), `select id from users where email != ?`,
+			fmt.Sprintf(`select id from users where substr(email, 1, %d) != ?`, hmac.GetDefaultHashSize())},
+		{postgresql.NewPostgreSQLDialect(), `select u.id from users as u join orders as o on u.email = o.email`,
+			fmt.Sprintf(`select u.id from users as u join orders as o on substr(u.email, 1, %d) = substr(o.email, 1, %d)`, hmac.GetDefaultHashSize(), hmac.GetDefaultHashSize())},
+		{postgresql.NewPostgreSQLDialect(), `select users.id from users, orders where users.login != orders.email and users.email = 'a'`,
+			fmt.Sprintf(`select users.id from users, orders where substr(users.login, 1, %d) != substr(orders.email, 1, %d) and substr(users.email, 1, %d) = %s`,
+				hmac.GetDefaultHashSize(), hmac.GetDefaultHashSize(), hmac.GetDefaultHashSize(), testPgHMAC("a"))},
+		{mysql.NewMySQLDialect(), `select u.id from users as u left join orders as o on o.email <=> u.email and o.id = u.id`,
+			fmt.Sprintf(`select u.id from users as u left join orders as o on substr(o.email, 1, %d) <=> substr(u.email, 1, %d) and o.id = u.id`, hmac.GetDefaultHashSize(), hmac.GetDefaultHashSize())},
+	}
+	for i, tcase := range testcases {
+		newHashQuery := NewPostgresqlHashQuery
+		if _, ok := tcase.dialect.(*mysql.MySQLDialect); ok {
+			newHashQuery = NewMysqlHashQuery
+		}
+		hashQuery := newTestHashQuery(t, newHashQuery)
+		statement, err := sqlparser.ParseWithDialect(tcase.dialect, tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		query, changed, err := hashQuery.OnQuery(context.Background(), base.NewOnQueryObjectFromStatement(statement, nil))
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if !changed {
+			t.Fatalf("[%d] Expect changed query", i)
+		}
+		if query.Query() != tcase.expected {
+			t.Fatalf("[%d] Incorrect query.\nTook: %s\nExpected: %s", i, query.Query(), tcase.expected)
+		}
+	}
+}
+
+func TestHashQueryOnQueryRotatedKeys(t *testing.T) {
+	size := hmac.GetDefaultHashSize()
+	pgSubstr := fmt.Sprintf("substr(email, 1, %d)", size)
+	pgPlaceholder := fmt.Sprintf("substr($1::bytea, 1, %d), substr($1::bytea, %d, %d)", size, size+1, size)
+	mysqlHMACs := func(data string) string {
+		return sqlparser.String(sqlparser.NewStrVal(append(testHMAC(data), testRotatedHMAC(data)...)))
+	}
+	testcases := []struct {
+		dialect  dialect.Dialect
+		query    string
+		expected string
+	}{
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = 'a'`,
+			fmt.Sprintf(`select id from users where %s in (%s, %s)`, pgSubstr, testPgHMAC("a"), testPgRotatedHMAC("a"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email <> 'a'`,
+			fmt.Sprintf(`select id from users where %s not in (%s, %s)`, pgSubstr, testPgHMAC("a"), testPgRotatedHMAC("a"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email in ('a', 'b')`,
+			fmt.Sprintf(`select id from users where %s in (%s, %s, %s, %s)`, pgSubstr, testPgHMAC("a"), testPgRotatedHMAC("a"), testPgHMAC("b"), testPgRotatedHMAC("b"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = any('{a,NULL}')`,
+			fmt.Sprintf(`select id from users where %s in (%s, %s, null)`, pgSubstr, testPgHMAC("a"), testPgRotatedHMAC("a"))},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = $1`,
+			fmt.Sprintf(`select id from users where %s in (%s)`, pgSubstr, pgPlaceholder)},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email not in ($1)`,
+			fmt.Sprintf(`select id from users where %s not in (%s)`, pgSubstr, pgPlaceholder)},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = any($1)`,
+			fmt.Sprintf(`select id from users where %s = any($1)`, pgSubstr)},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login like 'joh%'`,
+			fmt.Sprintf(`select id from users where %s in (%s, '\x%s')`, testPrefixSubstr(3), testPgPrefixHash("joh"),
+				hex.EncodeToString(hmac.GeneratePrefixHash(testRotatedHMACKey, []byte("joh"))))},
+		{mysql.NewMySQLDialect(), `select id from users where email in ('a', 'b')`,
+			fmt.Sprintf(`select id from users where substr(email, 1, %d) in (%s, %s, %s, %s)`, size,
+				testMysqlHMAC("a"), sqlparser.String(sqlparser.NewStrVal(testRotatedHMAC("a"))),
+				testMysqlHMAC("b"), sqlparser.String(sqlparser.NewStrVal(testRotatedHMAC("b"))))},
+		{mysql.NewMySQLDialect(), `select id from users where email = ?`,
+			fmt.Sprintf(`select id from users where locate(substr(email, 1, %d), ?) in (1, %d)`, size, size+1)},
+		{mysql.NewMySQLDialect(), `select id from users where email not in (?, 'a')`,
+			fmt.Sprintf(`select id from users where locate(substr(email, 1, %d), concat(?, %s)) not in (1, %d, %d, %d)`,
+				size, mysqlHMACs("a"), size+1, 2*size+1, 3*size+1)},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login like $1`,
+			fmt.Sprintf(`select id from users where (substr($1::bytea, 1, %d) in (%s) or substr($1::bytea, %d, %d) in (%s)) = true`,
+				hmac.PrefixHashSize, testPrefixSubstrs(), hmac.PrefixHashSize+1, hmac.PrefixHashSize, testPrefixSubstrs())},
+		{mysql.NewMySQLDialect(), `select id from users where login not like ?`,
+			fmt.Sprintf(`select id from users where (select substr(acra_bound.acra_hashes, 1, %d) in (%s) or substr(acra_bound.acra_hashes, %d, %d) in (%s) from (select ? as acra_hashes from dual) as acra_bound) = false`,
+				hmac.PrefixHashSize, testPrefixSubstrs(), hmac.PrefixHashSize+1, hmac.PrefixHashSize, testPrefixSubstrs())},
+	}
+	for i, tcase := range testcases {
+		newHashQuery := NewPostgresqlHashQuery
+		if _, ok := tcase.dialect.(*mysql.MySQLDialect); ok {
+			newHashQuery = NewMysqlHashQuery
+		}
+		hashQuery := newTestHashQueryWithKeys(t, newHashQuery, testHMACKey, testRotatedHMACKey)
+		statement, err := sqlparser.ParseWithDialect(tcase.dialect, tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		query, changed, err := hashQuery.OnQuery(context.Background(), base.NewOnQueryObjectFromStatement(statement, nil))
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if !changed {
+			t.Fatalf("[%d] Expect changed query", i)
+		}
+		if query.Query() != tcase.expected {
+			t.Fatalf("[%d] Incorrect query.\nTook: %s\nExpected: %s", i, query.Query(), tcase.expected)
+		}
+	}
+}
+
+func TestHashQueryOnQueryColumnComparisonsWithRotatedKeys(t *testing.T) {
+	size := hmac.GetDefaultHashSize()
+	query := `select u.id from users as u join orders as o on u.email = o.email`
+	expected := fmt.Sprintf(`select u.id from users as u join orders as o on substr(u.email, 1, %d) = substr(o.email, 1, %d)`, size, size)
+	output := &bytes.Buffer{}
+	logrus.SetOutput(output)
+	defer logrus.SetOutput(os.Stderr)
+	testcases := []struct {
+		keys   [][]byte
+		warned bool
+	}{
+		{[][]byte{testHMACKey}, false},
+		{[][]byte{testHMACKey, testRotatedHMACKey}, true},
+	}
+	for i, tcase := range testcases {
+		output.Reset()
+		hashQuery := newTestHashQueryWithKeys(t, NewPostgresqlHashQuery, tcase.keys...)
+		statement, err := sqlparser.ParseWithDialect(postgresql.NewPostgreSQLDialect(), query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		newQuery, changed, err := hashQuery.OnQuery(context.Background(), base.NewOnQueryObjectFromStatement(statement, nil))
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		// HMACs are compared as is because rows hashed with different keys can't be matched by the query
+		if !changed || newQuery.Query() != expected {
+			t.Fatalf("[%d] Incorrect query.\nTook: %s\nExpected: %s", i, newQuery.Query(), expected)
+		}
+		if warned := strings.Contains(output.String(), "rotated HMAC keys exist"); warned != tcase.warned {
+			t.Fatalf("[%d] Expect warning %t, took %t", i, tcase.warned, warned)
+		}
+	}
+}
+
+func TestHashQueryOnQueryUnsupportedComparisons(t *testing.T) {
+	queries := []string{
+		// not searchable columns
+		`select id from users where name in ('a', 'b')`,
+		`select id from users where id = any('{1,2}')`,
+		// array literals of these comparisons can't be replaced with IN lists
+		`select id from users where email = all('{a,b}')`,
+		`select id from users where email != any('{a,b}')`,
+		// subqueries and non-literal values
+		`select id from users where email in (select email from users)`,
+		`select id from users where email in (lower('a'))`,
+		// LIKE without prefix index and patterns which can't be searched with it
+		`select id from users where email like 'joh%'`,
+		`select id from users where login like 'jo%'`,
+		`select id from users where login like 'johnny%'`,
+		`select id from users where login like '%ohn%'`,
+		`select id from users where login like 'j_hn%'`,
+		`select id from users where login like 'joh%' escape '!'`,
+		// columns with different HMAC keys, not searchable columns and comparisons which can't be done with HMACs
+		`select u.id from users as u join orders as o on u.email = o.phone`,
+		`select u.id from users as u join orders as o on u.email = o.note`,
+		`select u.id from users as u join orders as o on u.name = o.note`,
+		`select u.id from users as u join orders as o on u.email < o.email`,
+		`select u.id from users as u join orders as o using (email)`,
+	}
+	hashQuery := newTestHashQuery(t, NewPostgresqlHashQuery)
+	for i, query := range queries {
+		statement, err := sqlparser.ParseWithDialect(postgresql.NewPostgreSQLDialect(), query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		_, changed, err := hashQuery.OnQuery(context.Background(), base.NewOnQueryObjectFromStatement(statement, nil))
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if changed {
+			t.Fatalf("[%d] Expect unchanged query", i)
+		}
+	}
+}
+
+func testPgBinaryArray(elements ...[]byte) []byte {
+	output := make([]byte, 20)
+	hasNull := 0
+	for _, element := range elements {
+		if element == nil {
+			hasNull = 1
+		}
+	}
+	binary.BigEndian.PutUint32(output[0:], 1)
+	binary.BigEndian.PutUint32(output[4:], uint32(hasNull))
+	// bytea oid
+	binary.BigEndian.PutUint32(output[8:], 17)
+	binary.BigEndian.PutUint32(output[12:], uint32(len(elements)))
+	binary.BigEndian.PutUint32(output[16:], 1)
+	return (&pgBinaryArray{header: output, elements: elements}).Encode()
+}
+
+func TestHashQueryOnBind(t *testing.T) {
+	type testcase struct {
+		dialect  dialect.Dialect
+		query    string
+		values   []base.BoundValue
+		expected [][]byte
+	}
+	testcases := []testcase{
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email in ($1, $2) and id = $3`,
+			[]base.BoundValue{
+				&testBoundValue{[]byte("a"), base.BinaryFormat},
+				&testBoundValue{[]byte(`\x62`), base.TextFormat},
+				&testBoundValue{[]byte("1"), base.TextFormat}},
+			[][]byte{testHMAC("a"), encodePgHexBytea(testHMAC("b")), []byte("1")}},
+		// the same placeholder used several times should be replaced once
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email <> $1 or email not in ($1)`,
+			[]base.BoundValue{&testBoundValue{[]byte("a"), base.BinaryFormat}},
+			[][]byte{testHMAC("a")}},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = any($1)`,
+			[]base.BoundValue{&testBoundValue{testPgBinaryArray([]byte("a"), nil, []byte("b")), base.BinaryFormat}},
+			[][]byte{testPgBinaryArray(testHMAC("a"), nil, testHMAC("b"))}},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email != all($1)`,
+			[]base.BoundValue{&testBoundValue{[]byte(`{a,"\\x62",NULL}`), base.TextFormat}},
+			[][]byte{encodePgTextArray([][]byte{testHMAC("a"), testHMAC("b"), nil})}},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login like $1 or login not like $2`,
+			[]base.BoundValue{
+				&testBoundValue{[]byte("joh%"), base.BinaryFormat},
+				&testBoundValue{[]byte("john%"), base.TextFormat}},
+			[][]byte{hmac.GeneratePrefixHash(testHMACKey, []byte("joh")), encodePgHexBytea(hmac.GeneratePrefixHash(testHMACKey, []byte("john")))}},
+		{mysql.NewMySQLDialect(), `select id from users where email in (?, ?)`,
+			[]base.BoundValue{
+				&testBoundValue{[]byte("a"), base.BinaryFormat},
+				&testBoundValue{[]byte("b"), base.BinaryFormat}},
+			[][]byte{testHMAC("a"), testHMAC("b")}},
+	}
+	for i, tcase := range testcases {
+		newHashQuery := NewPostgresqlHashQuery
+		if _, ok := tcase.dialect.(*mysql.MySQLDialect); ok {
+			newHashQuery = NewMysqlHashQuery
+		}
+		hashQuery := newTestHashQuery(t, newHashQuery)
+		statement, err := sqlparser.ParseWithDialect(tcase.dialect, tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		values, changed, err := hashQuery.OnBind(context.Background(), statement, tcase.values)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if !changed {
+			t.Fatalf("[%d] Expect changed values", i)
+		}
+		for j, value := range values {
+			if !bytes.Equal(value.GetData(nil), tcase.expected[j]) {
+				t.Fatalf("[%d] Incorrect value %d, took %q, expected %q", i, j, value.GetData(nil), tcase.expected[j])
+			}
+		}
+	}
+}
+
+func TestHashQueryOnBindRotatedKeys(t *testing.T) {
+	hmacs := func(data string) []byte {
+		return append(testHMAC(data), testRotatedHMAC(data)...)
+	}
+	testcases := []struct {
+		dialect  dialect.Dialect
+		query    string
+		values   []base.BoundValue
+		expected [][]byte
+	}{
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = $1 or email in ($2)`,
+			[]base.BoundValue{
+				&testBoundValue{[]byte("a"), base.BinaryFormat},
+				&testBoundValue{[]byte(`\x62`), base.TextFormat}},
+			[][]byte{hmacs("a"), encodePgHexBytea(hmacs("b"))}},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = any($1)`,
+			[]base.BoundValue{&testBoundValue{testPgBinaryArray([]byte("a"), nil), base.BinaryFormat}},
+			[][]byte{testPgBinaryArray(testHMAC("a"), testRotatedHMAC("a"), nil)}},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email != all($1)`,
+			[]base.BoundValue{&testBoundValue{[]byte(`{a}`), base.TextFormat}},
+			[][]byte{encodePgTextArray([][]byte{testHMAC("a"), testRotatedHMAC("a")})}},
+		// these comparisons can't be satisfied by several HMACs of one value and use only current key
+		{postgresql.NewPostgreSQLDialect(), `select id from users where email = all($1) or email != any($2)`,
+			[]base.BoundValue{
+				&testBoundValue{testPgBinaryArray([]byte("a")), base.BinaryFormat},
+				&testBoundValue{testPgBinaryArray([]byte("b")), base.BinaryFormat}},
+			[][]byte{testPgBinaryArray(testHMAC("a")), testPgBinaryArray(testHMAC("b"))}},
+		{postgresql.NewPostgreSQLDialect(), `select id from users where login like $1`,
+			[]base.BoundValue{&testBoundValue{[]byte("joh%"), base.BinaryFormat}},
+			[][]byte{append(hmac.GeneratePrefixHash(testHMACKey, []byte("joh")), hmac.GeneratePrefixHash(testRotatedHMACKey, []byte("joh"))...)}},
+		{mysql.NewMySQLDialect(), `select id from users where email in (?, ?)`,
+			[]base.BoundValue{
+				&testBoundValue{[]byte("a"), base.BinaryFormat},
+				&testBoundValue{[]byte("b"), base.BinaryFormat}},
+			[][]byte{hmacs("a"), hmacs("b")}},
+	}
+	for i, tcase := range testcases {
+		newHashQuery := NewPostgresqlHashQuery
+		if _, ok := tcase.dialect.(*mysql.MySQLDialect); ok {
+			newHashQuery = NewMysqlHashQuery
+		}
+		hashQuery := newTestHashQueryWithKeys(t, newHashQuery, testHMACKey, testRotatedHMACKey)
+		statement, err := sqlparser.ParseWithDialect(tcase.dialect, tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		values, changed, err := hashQuery.OnBind(context.Background(), statement, tcase.values)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if !changed {
+			t.Fatalf("[%d] Expect changed values", i)
+		}
+		for j, value := range values {
+			if !bytes.Equal(value.GetData(nil), tcase.expected[j]) {
+				t.Fatalf("[%d] Incorrect value %d, took %q, expected %q", i, j, value.GetData(nil), tcase.expected[j])
+			}
+		}
+	}
+}
+
+func TestHashQueryOnBindKeysRotatedAfterPrepare(t *testing.T) {
+	thirdHMACKey := []byte("third hmac key")
+	testcases := []struct {
+		query    string
+		prepared [][]byte
+		bound    [][]byte
+		expected [][]byte
+	}{
+		// statement prepared with one key expects one HMAC
+		{`select id from users where email = $1`, [][]byte{testHMACKey}, [][]byte{testRotatedHMACKey, testHMACKey},
+			[][]byte{testRotatedHMAC("a")}},
+		{`select id from users where login like $1`, [][]byte{testHMACKey}, [][]byte{testRotatedHMACKey, testHMACKey},
+			[][]byte{hmac.GeneratePrefixHash(testRotatedHMACKey, []byte("joh"))}},
+		// HMAC of the oldest key is dropped after one more rotation
+		{`select id from users where email = $1`, [][]byte{testHMACKey, testRotatedHMACKey}, [][]byte{thirdHMACKey, testHMACKey, testRotatedHMACKey},
+			[][]byte{hmac.GenerateHMAC(append([]byte{}, thirdHMACKey...), []byte("a")), testHMAC("a")}},
+		// HMAC of the current key is repeated after rotated keys were destroyed
+		{`select id from users where email in ($1)`, [][]byte{testHMACKey, testRotatedHMACKey}, [][]byte{testHMACKey},
+			[][]byte{testHMAC("a"), testHMAC("a")}},
+	}
+	for i, tcase := range testcases {
+		hashQuery := newTestHashQueryWithKeys(t, NewPostgresqlHashQuery, tcase.prepared...)
+		statement, err := sqlparser.ParseWithDialect(postgresql.NewPostgreSQLDialect(), tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if _, _, err := hashQuery.OnQuery(context.Background(), base.NewOnQueryObjectFromStatement(statement, nil)); err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		hashQuery.keystore = newTestHashQueryWithKeys(t, NewPostgresqlHashQuery, tcase.bound...).keystore
+		// prepared statement is parsed again from the query text
+		statement, err = sqlparser.ParseWithDialect(postgresql.NewPostgreSQLDialect(), tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		value := "a"
+		if strings.Contains(tcase.query, "like") {
+			value = "joh%"
+		}
+		values, _, err := hashQuery.OnBind(context.Background(), statement, []base.BoundValue{&testBoundValue{[]byte(value), base.BinaryFormat}})
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if expected := bytes.Join(tcase.expected, nil); !bytes.Equal(values[0].GetData(nil), expected) {
+			t.Fatalf("[%d] Incorrect value, took %q, expected %q", i, values[0].GetData(nil), expected)
+		}
+	}
+}
+
+func TestHashQueryOnBindUnsupportedLikePattern(t *testing.T) {
+	hashQuery := newTestHashQuery(t, NewPostgresqlHashQuery)
+	statement, err := sqlparser.ParseWithDialect(postgresql.NewPostgreSQLDialect(), `select id from users where login like $1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, pattern := range []string{"jo%", "johnny%", "john", "%ohn%", "j_hn%"} {
+		values := []base.BoundValue{&testBoundValue{[]byte(pattern), base.BinaryFormat}}
+		if _, _, err := hashQuery.OnBind(context.Background(), statement, values); err != ErrUnsupportedLikePattern {
+			t.Fatalf("[%d] Expect ErrUnsupportedLikePattern, took %v", i, err)
+		}
+	}
+}
+
+func TestParsePgTextArray(t *testing.T) {
+	testcases := []struct {
+		array    string
+		expected [][]byte
+	}{
+		{`{}`, [][]byte{}},
+		{` { a , b } `, [][]byte{[]byte("a"), []byte("b")}},
+		{`{"a,b","c\"d",e\,f}`, [][]byte{[]byte("a,b"), []byte(`c"d`), []byte("e,f")}},
+		{`{NULL,"NULL",null}`, [][]byte{nil, []byte("NULL"), nil}},
+		{`{"\\x61"}`, [][]byte{[]byte(`\x61`)}},
+	}
+	for i, tcase := range testcases {
+		elements, err := parsePgTextArray([]byte(tcase.array))
+		if err != nil {
+			t.Fatalf("[%d] %s", i, err)
+		}
+		if len(elements) != len(tcase.expected) {
+			t.Fatalf("[%d] Incorrect elements count, took %q", i, elements)
+		}
+		for j, element := range elements {
+			if !bytes.Equal(element, tcase.expected[j]) || (element == nil) != (tcase.expected[j] == nil) {
+				t.Fatalf("[%d] Incorrect element %d, took %q, expected %q", i, j, element, tcase.expected[j])
+			}
+		}
+	}
+
+	invalidArrays := []string{``, `{`, `a,b`, `{a,}`, `{,a}`, `{"a}`, `{"a"b}`, `{{a},{b}}`, `{a"b}`}
+	for i, array := range invalidArrays {
+		if _, err := parsePgTextArray([]byte(array)); err != ErrInvalidArray {
+			t.Fatalf("[%d] Expect ErrInvalidArray, took %v", i, err)
+		}
+	}
+}
+
+func TestParsePgBinaryArray(t *testing.T) {
+	data := testPgBinaryArray([]byte("a"), nil, []byte{})
+	array, err := parsePgBinaryArray(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(array.elements) != 3 || !bytes.Equal(array.elements[0], []byte("a")) || array.elements[1] != nil ||
+		array.elements[2] == nil || len(array.elements[2]) != 0 {
+		t.Fatalf("Incorrect elements %q", array.elements)
+	}
+	if !bytes.Equal(array.Encode(), data) {
+		t.Fatal("Encoded array not equal to source")
+	}
+	for i, invalid := range [][]byte{data[:10], data[:len(data)-1], append(data, 0)} {
+		if _, err := parsePgBinaryArray(invalid); err != ErrInvalidArray {
+			t.Fatalf("[%d] Expect ErrInvalidArray, took %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decryptor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/hmac"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrUnsupportedLikePattern returned for LIKE patterns which can't be searched with prefix index
+var ErrUnsupportedLikePattern = errors.New("LIKE pattern can't be searched with searchable_prefix index")
+
+// likePattern is a parsed LIKE pattern with unescaped prefix
+type likePattern struct {
+	prefix []byte
+	// true if prefix is followed by % wildcard, otherwise pattern matches only equal value
+	hasWildcard bool
+}
+
+// parseLikePattern parses 'prefix%' or 'value' patterns with default \ escape character. Patterns with _ wildcard
+// or % in the middle return ErrUnsupportedLikePattern
+func parseLikePattern(pattern []byte) (likePattern, error) {
+	prefix := make([]byte, 0, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+			if i == len(pattern) {
+				return likePattern{}, ErrUnsupportedLikePattern
+			}
+			prefix = append(prefix, pattern[i])
+		case '_':
+			return likePattern{}, ErrUnsupportedLikePattern
+		case '%':
+			for ; i < len(pattern); i++ {
+				if pattern[i] != '%' {
+					return likePattern{}, ErrUnsupportedLikePattern
+				}
+			}
+			return likePattern{prefix: prefix, hasWildcard: true}, nil
+		default:
+			prefix = append(prefix, pattern[i])
+		}
+	}
+	return likePattern{prefix: prefix}, nil
+}
+
+// prefixHashSubstr returns substring of column with hash of the prefix with specified length
+func prefixHashSubstr(column *sqlparser.ColName, prefix *config.SearchablePrefix, length int) *sqlparser.SubstrExpr {
+	return &sqlparser.SubstrExpr{
+		Name: column,
+		From: sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", hmac.GetPrefixHashOffset(prefix.Min, length)+1))),
+		To:   sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", hmac.PrefixHashSize))),
+	}
+}
+
+// rewriteLikeComparison rewrites LIKE comparisons of column with searchable_prefix:
+//
+//     WHERE column LIKE 'value%'   ===>   WHERE substring(column, <prefix_offset>, <prefix_hash_size>) = <prefix_hash('value')>
+//     WHERE column LIKE 'value'    ===>   WHERE substring(column, 1, <HMAC_size>) = <HMAC('value')>
+//     WHERE column LIKE $1         ===>   WHERE $1 IN (substring(column, <prefix_offset_min>, <prefix_hash_size>), ...)
+//
+// and NOT LIKE to != and NOT IN. Patterns which can't be searched with prefix index are left as is. Literal patterns
+// are compared with IN/NOT IN lists of hashes calculated with current and rotated HMAC keys, bound patterns are
+// replaced with concatenation of such hashes, see rotation.go.
+func (encryptor *HashQuery) rewriteLikeComparison(ctx context.Context, expr *sqlparser.ComparisonExpr, prefix *config.SearchablePrefix, keyCount int) (bool, error) {
+	column := expr.Left.(*sqlparser.ColName)
+	value := expr.Right.(*sqlparser.SQLVal)
+	if isPlaceholder(value) {
+		// length of the pattern is unknown until bind, so compare bound hash with hashes of all indexed lengths
+		tuple := make(sqlparser.ValTuple, 0, prefix.Count())
+		for length := prefix.Min; length <= prefix.Max; length++ {
+			tuple = append(tuple, prefixHashSubstr(column, prefix, length))
+		}
+		if keyCount > 1 {
+			encryptor.updateRotatedLikeComparison(expr, value, tuple, keyCount)
+			return true, nil
+		}
+		expr.Left = value
+		expr.Right = tuple
+		if expr.Operator == sqlparser.LikeStr {
+			expr.Operator = sqlparser.InStr
+		} else {
+			expr.Operator = sqlparser.NotInStr
+		}
+		return true, nil
+	}
+
+	data, err := encryptor.coder.Decode(value)
+	if err != nil {
+		return false, err
+	}
+	pattern, err := parseLikePattern(data)
+	if err == nil && pattern.hasWildcard && !prefix.IsIndexed(len(pattern.prefix)) {
+		err = ErrUnsupportedLikePattern
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"column": column.Name.String(), "min": prefix.Min, "max": prefix.Max}).
+			WithError(err).Warningln("Can't search LIKE pattern with prefix index, leave it as is")
+		return false, nil
+	}
+	// hashes calculated with rotated HMAC keys are compared with IN/NOT IN
+	hashes, err := encryptor.hashValues(ctx, value, func(ctx context.Context, _ []byte) ([][]byte, error) {
+		if pattern.hasWildcard {
+			return encryptor.calculatePrefixHashes(ctx, pattern.prefix)
+		}
+		return encryptor.calculateHmacs(ctx, pattern.prefix)
+	})
+	if err != nil {
+		return false, err
+	}
+	if pattern.hasWildcard {
+		expr.Left = prefixHashSubstr(column, prefix, len(pattern.prefix))
+	} else {
+		expr.Left = hmacSubstr(column)
+	}
+	if expr.Operator == sqlparser.LikeStr {
+		expr.Operator = sqlparser.EqualStr
+	} else {
+		expr.Operator = sqlparser.NotEqualStr
+	}
+	replaceWithHashList(expr, hashes)
+	return true, nil
+}
+
+// replaceLikePatternsWithHashes replaces bound LIKE 'prefix%' patterns with concatenation of prefix hashes calculated
+// with keyCount HMAC keys
+func (encryptor *HashQuery) replaceLikePatternsWithHashes(ctx context.Context, values []base.BoundValue, placeholders map[int]*config.SearchablePrefix, keyCount int) ([]base.BoundValue, bool, error) {
+	if len(placeholders) == 0 {
+		return values, false, nil
+	}
+	newValues := make([]base.BoundValue, len(values))
+	copy(newValues, values)
+
+	for valueIndex, prefix := range placeholders {
+		format := values[valueIndex].Format()
+		logger := logrus.WithFields(logrus.Fields{"format": format, "index": valueIndex})
+
+		data := values[valueIndex].GetData(nil)
+		if format == base.TextFormat {
+			decoded, err := encryptor.coder.Decode(sqlparser.NewStrVal(data))
+			if err != nil {
+				return values, false, err
+			}
+			data = decoded
+		}
+		pattern, err := parseLikePattern(data)
+		if err == nil && (!pattern.hasWildcard || !prefix.IsIndexed(len(pattern.prefix))) {
+			err = ErrUnsupportedLikePattern
+		}
+		if err != nil {
+			logger.WithError(err).Warningln("Can't search LIKE pattern with prefix index")
+			return values, false, err
+		}
+		hashes, err := encryptor.calculatePrefixHashes(ctx, pattern.prefix)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to calculate prefix hash")
+			return values, false, err
+		}
+		hash := bytes.Join(fitHashes(hashes, keyCount), nil)
+		switch format {
+		case base.BinaryFormat:
+			_ = newValues[valueIndex].SetData(hash, nil)
+		case base.TextFormat:
+			_ = newValues[valueIndex].SetData(encodePgHexBytea(hash), nil)
+		default:
+			logger.Warning("Parameter format not supported, skipping")
+		}
+	}
+	return newValues, true, nil
+}
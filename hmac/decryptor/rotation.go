@@ -0,0 +1,446 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decryptor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cossacklabs/acra/decryptor/base"
+	queryEncryptor "github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/hmac"
+	"github.com/cossacklabs/acra/sqlparser"
+	"github.com/cossacklabs/acra/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// After rotation of HMAC key stored rows keep hashes calculated with rotated keys until they are re-hashed, so
+// searchable queries should match HMACs calculated with every key of the client. Literal values are replaced with
+// lists of HMACs. Placeholders are bound to concatenation of HMACs which is split by the query:
+//
+//     PostgreSQL: substr(column, 1, <HMAC_size>) IN (substr($1::bytea, 1, <HMAC_size>), substr($1::bytea, <HMAC_size>+1, <HMAC_size>))
+//     MySQL:      locate(substr(column, 1, <HMAC_size>), ?) IN (1, <HMAC_size>+1)
+//
+// because MySQL placeholders can't be used twice. Bound LIKE patterns are replaced with concatenation of prefix hashes:
+//
+//     PostgreSQL: (substr($1::bytea, 1, <prefix_hash_size>) IN (<column_prefix_hashes>) OR substr($1::bytea, <prefix_hash_size>+1, <prefix_hash_size>) IN (<column_prefix_hashes>)) = true
+//     MySQL:      (SELECT substr(acra_bound.acra_hashes, 1, <prefix_hash_size>) IN (<column_prefix_hashes>) OR ... FROM (SELECT ? AS acra_hashes) AS acra_bound) = true
+//
+// Count of keys is fixed when the query is rewritten, so values bound to prepared statements get as many HMACs as
+// the statement expects even if keys were rotated after it was prepared.
+
+// Names of derived table and its column used to refer to MySQL placeholder several times
+const (
+	boundHashesTable  = "acra_bound"
+	boundHashesColumn = "acra_hashes"
+)
+
+// preparedKeyCountsLimit limits count of remembered queries of the connection, all of them are forgotten when the limit
+// is reached and values of their statements get HMACs of all current keys
+const preparedKeyCountsLimit = 1024
+
+// hasPlaceholders returns true if any comparison has placeholders which will be bound later
+func hasPlaceholders(comparisons []searchableComparison) bool {
+	for _, comparison := range comparisons {
+		switch value := comparison.expr.Right.(type) {
+		case *sqlparser.SQLVal:
+			if isPlaceholder(value) {
+				return true
+			}
+		case sqlparser.ValTuple:
+			for _, item := range value {
+				if sqlVal, ok := item.(*sqlparser.SQLVal); ok && isPlaceholder(sqlVal) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// setPreparedKeyCount remembers count of HMAC keys used to rewrite the query which is about to be prepared
+func (encryptor *HashQuery) setPreparedKeyCount(statement sqlparser.Statement, keyCount int) {
+	if encryptor.preparedKeyCounts == nil || len(encryptor.preparedKeyCounts) >= preparedKeyCountsLimit {
+		encryptor.preparedKeyCounts = make(map[string]int)
+	}
+	encryptor.preparedKeyCounts[sqlparser.String(statement)] = keyCount
+}
+
+// getPreparedKeyCount returns count of HMAC keys used to rewrite the prepared statement or count of current keys if
+// the statement wasn't rewritten by this connection
+func (encryptor *HashQuery) getPreparedKeyCount(ctx context.Context, statement sqlparser.Statement) (int, error) {
+	if keyCount, ok := encryptor.preparedKeyCounts[sqlparser.String(statement)]; ok {
+		return keyCount, nil
+	}
+	return encryptor.getHMACKeyCount(ctx)
+}
+
+// fitHashes returns keyCount hashes. Hashes of the oldest keys are dropped if keys were rotated after the statement was
+// prepared, the hash of the current key is repeated if rotated keys were destroyed
+func fitHashes(hashes [][]byte, keyCount int) [][]byte {
+	if len(hashes) >= keyCount {
+		return hashes[:keyCount]
+	}
+	for len(hashes) < keyCount {
+		hashes = append(hashes, hashes[0])
+	}
+	return hashes
+}
+
+// getHMACKeyCount returns number of current and rotated HMAC keys of the client
+func (encryptor *HashQuery) getHMACKeyCount(ctx context.Context) (int, error) {
+	accessContext := base.AccessContextFromContext(ctx)
+	keys, err := encryptor.keystore.GetHMACSecretKeys(accessContext.GetClientID())
+	if err != nil {
+		logrus.WithError(err).Debugln("Can't load keys for hmac")
+		return 0, err
+	}
+	utils.ZeroizeSymmetricKeys(keys)
+	if len(keys) == 0 {
+		return 0, hmac.ErrHMACNotMatch
+	}
+	return len(keys), nil
+}
+
+// warnColumnComparisonsWithRotatedKeys logs comparisons of two searchable columns if the client has rotated HMAC keys.
+// Rows hashed with different keys can't be matched by such comparisons until they are re-hashed with current key by
+// acra-rotate and rotated keys are destroyed
+func (encryptor *HashQuery) warnColumnComparisonsWithRotatedKeys(ctx context.Context, comparisons []*sqlparser.ComparisonExpr) {
+	keyCount, err := encryptor.getHMACKeyCount(ctx)
+	if err != nil || keyCount < 2 {
+		return
+	}
+	for _, expr := range comparisons {
+		logrus.WithFields(logrus.Fields{"left": sqlparser.String(expr.Left), "right": sqlparser.String(expr.Right), "key_count": keyCount}).
+			Warningln("Searchable columns are compared while rotated HMAC keys exist, rows hashed with different keys " +
+				"don't match until they are re-hashed with acra-rotate --rehash_hmac_enable")
+	}
+}
+
+// calculateHmacs returns HMACs of data calculated with current and rotated keys, HMAC with current key goes first
+func (encryptor *HashQuery) calculateHmacs(ctx context.Context, data []byte) ([][]byte, error) {
+	accessContext := base.AccessContextFromContext(ctx)
+	if encryptor.decryptor.MatchDataSignature(data) {
+		processorContext := base.DataProcessorContext{Context: base.SetAccessContextToContext(ctx, accessContext), Keystore: encryptor.keystore}
+		decrypted, err := encryptor.decryptor.Process(data, &processorContext)
+		if err != nil {
+			logrus.WithError(err).Debugln("Can't decrypt data for HMAC calculation")
+			return nil, err
+		}
+		data = decrypted
+	}
+	keys, err := encryptor.keystore.GetHMACSecretKeys(accessContext.GetClientID())
+	if err != nil {
+		logrus.WithError(err).Debugln("Can't load keys for hmac")
+		return nil, err
+	}
+	macs := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		// key is zeroized by GenerateHMAC
+		macs = append(macs, hmac.GenerateHMAC(key, data))
+	}
+	return macs, nil
+}
+
+// calculateTextHmacs decodes value passed in the text format and returns HMACs of the decoded data
+func (encryptor *HashQuery) calculateTextHmacs(ctx context.Context, data []byte) ([][]byte, error) {
+	decoded, err := encryptor.coder.Decode(sqlparser.NewStrVal(data))
+	if err != nil {
+		return nil, err
+	}
+	return encryptor.calculateHmacs(ctx, decoded)
+}
+
+// calculatePrefixHashes returns hashes of the prefix calculated with current and rotated keys
+func (encryptor *HashQuery) calculatePrefixHashes(ctx context.Context, prefix []byte) ([][]byte, error) {
+	accessContext := base.AccessContextFromContext(ctx)
+	keys, err := encryptor.keystore.GetHMACSecretKeys(accessContext.GetClientID())
+	if err != nil {
+		logrus.WithError(err).Debugln("Can't load keys for hmac")
+		return nil, err
+	}
+	defer utils.ZeroizeSymmetricKeys(keys)
+	hashes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		hashes = append(hashes, hmac.GeneratePrefixHash(key, prefix))
+	}
+	return hashes, nil
+}
+
+// hashValues returns copies of the literal with hashes of its value calculated with every HMAC key
+func (encryptor *HashQuery) hashValues(ctx context.Context, value *sqlparser.SQLVal, calculate func(context.Context, []byte) ([][]byte, error)) ([]*sqlparser.SQLVal, error) {
+	data, err := encryptor.coder.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := calculate(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]*sqlparser.SQLVal, 0, len(hashes))
+	for _, hash := range hashes {
+		item, err := encryptor.encodeHash(value, hash)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, item)
+	}
+	return values, nil
+}
+
+// encodeHash returns copy of the literal with hash as a value
+func (encryptor *HashQuery) encodeHash(value *sqlparser.SQLVal, hash []byte) (*sqlparser.SQLVal, error) {
+	item := &sqlparser.SQLVal{Type: value.Type, Val: value.Val}
+	coded, err := encryptor.coder.Encode(item, hash)
+	if err != nil {
+		return nil, err
+	}
+	item.Val = coded
+	return item, nil
+}
+
+// replaceWithHashList replaces right side of the comparison with list of hashes matched by IN/NOT IN operators
+func replaceWithHashList(expr *sqlparser.ComparisonExpr, hashes []*sqlparser.SQLVal) {
+	if len(hashes) == 1 {
+		expr.Right = hashes[0]
+		return
+	}
+	tuple := make(sqlparser.ValTuple, 0, len(hashes))
+	for _, hash := range hashes {
+		tuple = append(tuple, hash)
+	}
+	expr.Right = tuple
+	expr.Operator = getListOperator(expr.Operator)
+}
+
+// getListOperator returns IN/NOT IN operator matching the comparison with any value from the list
+func getListOperator(operator string) string {
+	switch operator {
+	case sqlparser.NotEqualStr, sqlparser.NotInStr, sqlparser.NotLikeStr:
+		return sqlparser.NotInStr
+	}
+	return sqlparser.InStr
+}
+
+func (encryptor *HashQuery) isMySQL() bool {
+	_, ok := encryptor.coder.(*queryEncryptor.MysqlDBDataCoder)
+	return ok
+}
+
+// updateRotatedComparisonValue replaces values on the right side of comparison with HMACs calculated with current
+// and rotated keys
+func (encryptor *HashQuery) updateRotatedComparisonValue(ctx context.Context, expr *sqlparser.ComparisonExpr, keyCount int) error {
+	var items []sqlparser.Expr
+	switch value := expr.Right.(type) {
+	case *sqlparser.SQLVal:
+		items = []sqlparser.Expr{value}
+	case sqlparser.ValTuple:
+		items = value
+	case *sqlparser.FuncExpr:
+		arrayValue, _, ok := getArrayComparisonValue(value)
+		// array placeholders get HMACs of all keys as additional elements on bind
+		if !ok || isPlaceholder(arrayValue) {
+			return nil
+		}
+		elements, err := parsePgTextArray(arrayValue.Val)
+		if err != nil {
+			return err
+		}
+		if len(elements) == 0 {
+			return nil
+		}
+		for _, element := range elements {
+			if element == nil {
+				items = append(items, &sqlparser.NullVal{})
+				continue
+			}
+			items = append(items, sqlparser.NewStrVal(element))
+		}
+	default:
+		return nil
+	}
+	hasPlaceholders := false
+	for _, item := range items {
+		if sqlVal, ok := item.(*sqlparser.SQLVal); ok && isPlaceholder(sqlVal) {
+			hasPlaceholders = true
+		}
+	}
+	if hasPlaceholders && encryptor.isMySQL() {
+		return encryptor.updateRotatedMysqlComparison(ctx, expr, items, keyCount)
+	}
+	hashSize := hmac.GetDefaultHashSize()
+	tuple := make(sqlparser.ValTuple, 0, len(items)*keyCount)
+	for _, item := range items {
+		sqlVal, ok := item.(*sqlparser.SQLVal)
+		if !ok {
+			tuple = append(tuple, item)
+			continue
+		}
+		if isPlaceholder(sqlVal) {
+			// placeholder is bound to concatenation of HMACs, compare with every part of it
+			placeholder := &sqlparser.SQLVal{Type: sqlVal.Type, Val: sqlVal.Val, CastType: []byte("::bytea")}
+			for i := 0; i < keyCount; i++ {
+				tuple = append(tuple, &sqlparser.FuncExpr{
+					Name: sqlparser.NewColIdent("substr"),
+					Exprs: sqlparser.SelectExprs{
+						&sqlparser.AliasedExpr{Expr: placeholder},
+						&sqlparser.AliasedExpr{Expr: sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", i*hashSize+1)))},
+						&sqlparser.AliasedExpr{Expr: sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", hashSize)))},
+					},
+				})
+			}
+			continue
+		}
+		hashes, err := encryptor.hashValues(ctx, sqlVal, encryptor.calculateHmacs)
+		if err != nil {
+			return err
+		}
+		for _, hash := range hashes {
+			tuple = append(tuple, hash)
+		}
+	}
+	expr.Right = tuple
+	expr.Operator = getListOperator(expr.Operator)
+	return nil
+}
+
+// updateRotatedMysqlComparison rewrites comparison into search of column HMAC in concatenation of HMACs
+// calculated with all keys
+func (encryptor *HashQuery) updateRotatedMysqlComparison(ctx context.Context, expr *sqlparser.ComparisonExpr, items []sqlparser.Expr, keyCount int) error {
+	args := make(sqlparser.SelectExprs, 0, len(items))
+	for _, item := range items {
+		sqlVal, ok := item.(*sqlparser.SQLVal)
+		if !ok {
+			continue
+		}
+		if isPlaceholder(sqlVal) {
+			args = append(args, &sqlparser.AliasedExpr{Expr: sqlVal})
+			continue
+		}
+		data, err := encryptor.coder.Decode(sqlVal)
+		if err != nil {
+			return err
+		}
+		hashes, err := encryptor.calculateHmacs(ctx, data)
+		if err != nil {
+			return err
+		}
+		if len(hashes) != keyCount {
+			return hmac.ErrHMACNotMatch
+		}
+		value, err := encryptor.encodeHash(sqlVal, bytes.Join(hashes, nil))
+		if err != nil {
+			return err
+		}
+		args = append(args, &sqlparser.AliasedExpr{Expr: value})
+	}
+	var haystack sqlparser.Expr = &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("concat"), Exprs: args}
+	if len(args) == 1 {
+		haystack = args[0].(*sqlparser.AliasedExpr).Expr
+	}
+	hashSize := hmac.GetDefaultHashSize()
+	positions := make(sqlparser.ValTuple, 0, len(args)*keyCount)
+	for i := 0; i < len(args)*keyCount; i++ {
+		positions = append(positions, sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", i*hashSize+1))))
+	}
+	expr.Left = &sqlparser.FuncExpr{
+		Name:  sqlparser.NewColIdent("locate"),
+		Exprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: expr.Left}, &sqlparser.AliasedExpr{Expr: haystack}},
+	}
+	expr.Right = positions
+	expr.Operator = getListOperator(expr.Operator)
+	return nil
+}
+
+// updateRotatedLikeComparison rewrites comparison of bound LIKE pattern into match of any part of concatenated prefix
+// hashes with prefix hashes of the column
+func (encryptor *HashQuery) updateRotatedLikeComparison(expr *sqlparser.ComparisonExpr, placeholder *sqlparser.SQLVal, columnHashes sqlparser.ValTuple, keyCount int) {
+	var hashes sqlparser.Expr = &sqlparser.SQLVal{Type: placeholder.Type, Val: placeholder.Val, CastType: []byte("::bytea")}
+	if encryptor.isMySQL() {
+		hashes = &sqlparser.ColName{
+			Name:      sqlparser.NewColIdent(boundHashesColumn),
+			Qualifier: sqlparser.TableName{Name: sqlparser.NewTableIdent(boundHashesTable)},
+		}
+	}
+	var match sqlparser.Expr
+	for i := 0; i < keyCount; i++ {
+		part := &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InStr,
+			Left: &sqlparser.FuncExpr{
+				Name: sqlparser.NewColIdent("substr"),
+				Exprs: sqlparser.SelectExprs{
+					&sqlparser.AliasedExpr{Expr: hashes},
+					&sqlparser.AliasedExpr{Expr: sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", i*hmac.PrefixHashSize+1)))},
+					&sqlparser.AliasedExpr{Expr: sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", hmac.PrefixHashSize)))},
+				},
+			},
+			Right: columnHashes,
+		}
+		if match == nil {
+			match = part
+			continue
+		}
+		match = &sqlparser.OrExpr{Left: match, Right: part}
+	}
+	if encryptor.isMySQL() {
+		bound := &sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{
+				&sqlparser.AliasedExpr{Expr: placeholder, As: sqlparser.NewColIdent(boundHashesColumn)},
+			},
+			From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("dual")}}},
+		}
+		match = &sqlparser.Subquery{Select: &sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: match}},
+			From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+				Expr: &sqlparser.Subquery{Select: bound},
+				As:   sqlparser.NewTableIdent(boundHashesTable),
+			}},
+		}}
+	} else {
+		match = &sqlparser.ParenExpr{Expr: match}
+	}
+	expr.Left = match
+	expr.Right = sqlparser.BoolVal(expr.Operator == sqlparser.LikeStr)
+	expr.Operator = sqlparser.EqualStr
+}
+
+// calculateCurrentHmac returns HMAC of data calculated only with current key
+func (encryptor *HashQuery) calculateCurrentHmac(ctx context.Context, data []byte) ([][]byte, error) {
+	mac, err := encryptor.calculateHmac(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{mac}, nil
+}
+
+// hashArrayElements replaces every non-NULL element of the array with all hashes returned by calculate
+func (encryptor *HashQuery) hashArrayElements(ctx context.Context, elements [][]byte, calculate func(context.Context, []byte) ([][]byte, error)) ([][]byte, error) {
+	hashed := make([][]byte, 0, len(elements))
+	for _, element := range elements {
+		if element == nil {
+			hashed = append(hashed, nil)
+			continue
+		}
+		hashes, err := calculate(ctx, element)
+		if err != nil {
+			return nil, err
+		}
+		hashed = append(hashed, hashes...)
+	}
+	return hashed, nil
+}
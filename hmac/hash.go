@@ -73,18 +73,27 @@ func (d *HashData) Marshal() []byte { return d.data }
 // Length of hash data
 func (d *HashData) Length() int { return len(d.data) }
 
-// IsEqual if hmac equal to calculated hmac for data
+// IsEqual if hmac equal to calculated hmac for data with current or rotated key
 func (d *HashData) IsEqual(data []byte, keyID []byte, store keystore.HmacKeyStore) bool {
-	key, err := store.GetHMACSecretKey(keyID)
+	keys, err := store.GetHMACSecretKeys(keyID)
 	if err != nil {
 		return false
 	}
-	h := hmac.New(d.info, key)
-	h.Write(data)
-	mac := h.Sum(nil)
-	h.Reset()
-	utils.ZeroizeSymmetricKey(key)
-	return hmac.Equal(d.data[1:], mac)
+	defer utils.ZeroizeSymmetricKeys(keys)
+	for _, key := range keys {
+		h := hmac.New(d.info, key)
+		h.Write(data)
+		mac := h.Sum(nil)
+		h.Reset()
+		// hash may be followed by prefix index
+		if len(d.data) < len(mac)+1 {
+			return false
+		}
+		if hmac.Equal(d.data[1:len(mac)+1], mac) {
+			return true
+		}
+	}
+	return false
 }
 
 // ExtractHash return Hash if matched otherwise nil
@@ -103,7 +112,9 @@ func ExtractHash(data []byte) Hash {
 		return nil
 	}
 	logrus.Debugln("Return without hash")
-	return &HashData{info: hashFunc, data: data[:size+1]}
+	// prefix index is a part of hash signature and skipped with it
+	indexLength := getPrefixIndexLength(data[size+1:])
+	return &HashData{info: hashFunc, data: data[:size+1+indexLength]}
 }
 
 // ExtractHashAndData return hash and data with extracted hash if matched. Otherwise both are nil
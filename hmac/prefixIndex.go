@@ -0,0 +1,119 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hmac
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/cossacklabs/acra/utils"
+)
+
+// Prefix index is stored right after HMAC of the value as
+// Tag[1] + MinLength[1] + MaxLength[1] + (MaxLength - MinLength + 1) * PrefixHash[PrefixHashSize]
+// where PrefixHash of every length is truncated HMAC of the value prefix with this length or random bytes
+// if the value is shorter. Tag differs from the first byte of AcraStruct/AcraBlock so index is detected without
+// encryptor config and skipped together with HMAC.
+const (
+	prefixIndexTag        = 0xfe
+	prefixIndexHeaderSize = 3
+	// PrefixHashSize is size of truncated HMAC of the value prefix
+	PrefixHashSize = 8
+)
+
+// prefixKeyContext separates keys of prefix hashes from HMAC keys of the whole values, so prefix hashes can't be
+// compared with HMACs of values which are equal to the prefix
+var prefixKeyContext = []byte("acra searchable prefix")
+
+func derivePrefixKey(key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(prefixKeyContext)
+	return h.Sum(nil)
+}
+
+func generatePrefixHash(prefixKey, prefix []byte) []byte {
+	h := hmac.New(sha256.New, prefixKey)
+	h.Write(prefix)
+	return h.Sum(nil)[:PrefixHashSize]
+}
+
+// GeneratePrefixHash return truncated HMAC of the prefix used for LIKE 'prefix%' search. Key isn't zeroized
+func GeneratePrefixHash(key, prefix []byte) []byte {
+	prefixKey := derivePrefixKey(key)
+	defer utils.ZeroizeSymmetricKey(prefixKey)
+	return generatePrefixHash(prefixKey, prefix)
+}
+
+// GeneratePrefixIndex return index of value prefixes with lengths from min to max. Key isn't zeroized
+func GeneratePrefixIndex(key, data []byte, min, max int) ([]byte, error) {
+	prefixKey := derivePrefixKey(key)
+	defer utils.ZeroizeSymmetricKey(prefixKey)
+	index := make([]byte, prefixIndexHeaderSize, prefixIndexHeaderSize+(max-min+1)*PrefixHashSize)
+	index[0] = prefixIndexTag
+	index[1] = byte(min)
+	index[2] = byte(max)
+	for length := min; length <= max; length++ {
+		if length <= len(data) {
+			index = append(index, generatePrefixHash(prefixKey, data[:length])...)
+			continue
+		}
+		// random hash doesn't match any prefix and doesn't reveal that the value is shorter
+		randomHash := make([]byte, PrefixHashSize)
+		if _, err := rand.Read(randomHash); err != nil {
+			return nil, err
+		}
+		index = append(index, randomHash...)
+	}
+	return index, nil
+}
+
+// GetPrefixHashOffset return offset of the prefix hash with specified length from the start of searchable data
+func GetPrefixHashOffset(min, length int) int {
+	return GetDefaultHashSize() + prefixIndexHeaderSize + (length-min)*PrefixHashSize
+}
+
+// getPrefixIndexLength return length of prefix index at the start of data or 0 if there is no index
+func getPrefixIndexLength(data []byte) int {
+	if len(data) < prefixIndexHeaderSize || data[0] != prefixIndexTag {
+		return 0
+	}
+	min, max := int(data[1]), int(data[2])
+	if min == 0 || max < min {
+		return 0
+	}
+	length := prefixIndexHeaderSize + (max-min+1)*PrefixHashSize
+	if len(data) < length {
+		return 0
+	}
+	return length
+}
+
+// RegenerateSearchableHash return HMAC of data calculated with the key followed by prefix index with the same lengths
+// as in the previous hash. It is used to re-hash stored data with new key after HMAC key rotation. Key is zeroized
+func RegenerateSearchableHash(key, data, previousHash []byte) ([]byte, error) {
+	size := GetDefaultHashSize()
+	if len(previousHash) <= size || getPrefixIndexLength(previousHash[size:]) == 0 {
+		return GenerateHMAC(key, data), nil
+	}
+	index, err := GeneratePrefixIndex(key, data, int(previousHash[size+1]), int(previousHash[size+2]))
+	if err != nil {
+		utils.ZeroizeSymmetricKey(key)
+		return nil, err
+	}
+	return append(GenerateHMAC(key, data), index...), nil
+}
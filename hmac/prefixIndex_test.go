@@ -0,0 +1,94 @@
+package hmac
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrefixIndex(t *testing.T) {
+	key := []byte(`key`)
+	data := []byte(`john@example.com`)
+	min, max := 3, 20
+	index, err := GeneratePrefixIndex(key, data, min, max)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index) != prefixIndexHeaderSize+(max-min+1)*PrefixHashSize {
+		t.Fatal("Invalid prefix index size")
+	}
+	hash := GenerateHMAC(append([]byte{}, key...), data)
+	container := []byte(`acrastruct`)
+	searchableData := append(append(append([]byte{}, hash...), index...), container...)
+
+	for length := min; length <= len(data); length++ {
+		offset := GetPrefixHashOffset(min, length)
+		prefixHash := searchableData[offset : offset+PrefixHashSize]
+		if !bytes.Equal(prefixHash, GeneratePrefixHash(key, data[:length])) {
+			t.Fatalf("Prefix hash with length %d not equal to expected", length)
+		}
+	}
+	// prefixes longer than value have random hashes
+	secondIndex, err := GeneratePrefixIndex(key, data, min, max)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offset := GetPrefixHashOffset(min, max) - GetDefaultHashSize()
+	if bytes.Equal(index[offset:], secondIndex[offset:]) {
+		t.Fatal("Expect random hashes of prefixes longer than value")
+	}
+	// prefix hashes differ from HMAC of the value equal to the prefix
+	if bytes.Contains(GenerateHMAC(append([]byte{}, key...), data[:min]), GeneratePrefixHash(key, data[:min])) {
+		t.Fatal("Prefix hash shouldn't be a part of HMAC")
+	}
+
+	// index is extracted as part of the hash
+	extractedHash, extractedData := ExtractHashAndData(searchableData)
+	if extractedHash == nil {
+		t.Fatal("Hash not found")
+	}
+	if !bytes.Equal(extractedData, container) {
+		t.Fatal("Incorrect data after hash with prefix index")
+	}
+	if !extractedHash.IsEqual(data, nil, SimpleHmacKeyStore(append([]byte{}, key...))) {
+		t.Fatal("Hash with prefix index not equal to data")
+	}
+	// data after hash without prefix index tag isn't changed
+	extractedHash, extractedData = ExtractHashAndData(append(append([]byte{}, hash...), container...))
+	if extractedHash == nil || extractedHash.Length() != GetDefaultHashSize() || !bytes.Equal(extractedData, container) {
+		t.Fatal("Incorrect hash without prefix index")
+	}
+}
+
+func TestRegenerateSearchableHash(t *testing.T) {
+	oldKey, newKey := []byte(`old key`), []byte(`new key`)
+	data := []byte(`john@example.com`)
+	index, err := GeneratePrefixIndex(oldKey, data, 3, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldHash := append(GenerateHMAC(append([]byte{}, oldKey...), data), index...)
+
+	newHash, err := RegenerateSearchableHash(append([]byte{}, newKey...), data, oldHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(newHash) != len(oldHash) {
+		t.Fatal("Prefix index should have the same lengths")
+	}
+	extractedHash := ExtractHash(newHash)
+	if extractedHash == nil || !extractedHash.IsEqual(data, nil, SimpleHmacKeyStore(append([]byte{}, newKey...))) {
+		t.Fatal("Hash should be calculated with new key")
+	}
+	offset := GetPrefixHashOffset(3, 4)
+	if !bytes.Equal(newHash[offset:offset+PrefixHashSize], GeneratePrefixHash(newKey, data[:4])) {
+		t.Fatal("Prefix hash should be calculated with new key")
+	}
+	// hash without prefix index stays without it
+	newHash, err = RegenerateSearchableHash(append([]byte{}, newKey...), data, oldHash[:GetDefaultHashSize()])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(newHash, GenerateHMAC(append([]byte{}, newKey...), data)) {
+		t.Fatal("Incorrect hash without prefix index")
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
 
 	"github.com/cossacklabs/acra/cmd"
@@ -850,6 +851,87 @@ func (store *KeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	return decryptedKey, nil
 }
 
+// GetHMACSecretKeys return current key for hmac calculation followed by rotated keys according to id
+func (store *KeyStore) GetHMACSecretKeys(id []byte) ([][]byte, error) {
+	filename := getHmacKeyFilename(id)
+	// current key is cached together with rotated keys and filenames of key history, so all of them are loaded from
+	// fs at once and stay consistent when keys are rotated by another process
+	encryptedKeys, ok := store.getCachedHMACKeyHistory(filename)
+	if !ok {
+		filenames, err := store.GetHistoricalPrivateKeyFilenames(filename)
+		if err != nil {
+			return nil, err
+		}
+		encryptedKeys = make([][]byte, 0, len(filenames))
+		for _, name := range filenames {
+			encryptedKey, err := store.ReadKeyFile(store.GetPrivateKeyFilePath(name))
+			if err != nil {
+				return nil, err
+			}
+			encryptedKeys = append(encryptedKeys, encryptedKey)
+		}
+		for i, name := range filenames {
+			store.Add(name, encryptedKeys[i])
+		}
+		store.Add(filename+hmacKeyHistoryCacheSuffix, []byte(strings.Join(filenames, "\n")))
+		log.Debugf("Load key history from fs: %s", filename)
+	}
+	keys := make([][]byte, 0, len(encryptedKeys))
+	for _, encryptedKey := range encryptedKeys {
+		key, err := store.encryptor.Decrypt(encryptedKey, id)
+		if err != nil {
+			utils.ZeroizeSymmetricKeys(keys)
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// hmacKeyHistoryCacheSuffix appended to the filename of current HMAC key to cache filenames of current and rotated keys
+const hmacKeyHistoryCacheSuffix = ".history"
+
+// getCachedHMACKeyHistory return encrypted current and rotated HMAC keys if all of them are cached
+func (store *KeyStore) getCachedHMACKeyHistory(filename string) ([][]byte, bool) {
+	history, ok := store.Get(filename + hmacKeyHistoryCacheSuffix)
+	// empty history is used to reset cached one
+	if !ok || len(history) == 0 {
+		return nil, false
+	}
+	filenames := strings.Split(string(history), "\n")
+	encryptedKeys := make([][]byte, 0, len(filenames))
+	for _, name := range filenames {
+		encryptedKey, ok := store.Get(name)
+		if !ok {
+			return nil, false
+		}
+		encryptedKeys = append(encryptedKeys, encryptedKey)
+	}
+	return encryptedKeys, true
+}
+
+// resetCachedHMACKeyHistory forces next GetHMACSecretKeys to load key history from fs
+func (store *KeyStore) resetCachedHMACKeyHistory(id []byte) {
+	store.Add(getHmacKeyFilename(id)+hmacKeyHistoryCacheSuffix, nil)
+}
+
+// DestroyRotatedHmacKeys removes rotated keys for hmac calculation and keeps current one
+func (store *KeyStore) DestroyRotatedHmacKeys(id []byte) error {
+	filenames, err := store.GetHistoricalPrivateKeyFilenames(getHmacKeyFilename(id))
+	if err != nil {
+		return err
+	}
+	store.resetCachedHMACKeyHistory(id)
+	// the first one is current key
+	for _, filename := range filenames[1:] {
+		err = store.fs.Remove(store.GetPrivateKeyFilePath(filename))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // GenerateHmacKey key for hmac calculation in in folder for private keys
 func (store *KeyStore) GenerateHmacKey(id []byte) error {
 	log.Debugln("Generate HMAC")
@@ -870,6 +952,7 @@ func (store *KeyStore) GenerateHmacKey(id []byte) error {
 	}
 
 	store.Add(getHmacKeyFilename(id), encryptedKey)
+	store.resetCachedHMACKeyHistory(id)
 
 	return nil
 }
@@ -37,6 +37,7 @@ func FilesystemKeyStoreTests(storage Storage, t *testing.T) {
 	testFilesystemKeyStoreWithCache(storage, t)
 	testFilesystemKeyStoreRotateZoneKey(storage, t)
 	testHistoricalKeyAccess(storage, t)
+	testRotatedHmacKeys(storage, t)
 }
 
 func testGenerateKeyPair(store *KeyStore, t *testing.T) {
@@ -719,3 +720,119 @@ func testHistoricalKeyAccess(storage Storage, t *testing.T) {
 		}
 	}
 }
+
+func testRotatedHmacKeys(storage Storage, t *testing.T) {
+	keyDirectory, err := storage.TempDir("test_filesystem_store", keyDirMode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer storage.RemoveAll(keyDirectory)
+
+	encryptor, err := keystore.NewSCellKeyEncryptor([]byte("some key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyStore, err := NewCustomFilesystemKeyStore().
+		KeyDirectory(keyDirectory).
+		Encryptor(encryptor).
+		Storage(storage).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientID := []byte("client")
+	if err := keyStore.GenerateHmacKey(clientID); err != nil {
+		t.Fatal(err)
+	}
+	key1, err := keyStore.GetHMACSecretKey(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := keyStore.GenerateHmacKey(clientID); err != nil {
+		t.Fatal(err)
+	}
+	key2, err := keyStore.GetHMACSecretKey(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(key1, key2) {
+		t.Fatal("rotated hmac key should not stay the same")
+	}
+	allKeys, err := keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// From newest to oldest
+	if len(allKeys) != 2 || !bytes.Equal(allKeys[0], key2) || !bytes.Equal(allKeys[1], key1) {
+		t.Fatalf("incorrect hmac keys: %v", len(allKeys))
+	}
+
+	if err := keyStore.DestroyRotatedHmacKeys(clientID); err != nil {
+		t.Fatal(err)
+	}
+	allKeys, err = keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allKeys) != 1 || !bytes.Equal(allKeys[0], key2) {
+		t.Fatalf("expected only current hmac key, took %v keys", len(allKeys))
+	}
+
+	// keys rotated by another process are read from fs after reset of the cache, until then current and rotated keys
+	// are returned from the cache consistently
+	otherKeyStore, err := NewCustomFilesystemKeyStore().
+		KeyDirectory(keyDirectory).
+		Encryptor(encryptor).
+		Storage(storage).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := otherKeyStore.GenerateHmacKey(clientID); err != nil {
+		t.Fatal(err)
+	}
+	key3, err := otherKeyStore.GetHMACSecretKey(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	allKeys, err = keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allKeys) != 1 || !bytes.Equal(allKeys[0], key2) {
+		t.Fatalf("expected cached hmac keys before reset, took %v keys", len(allKeys))
+	}
+	keyStore.Reset()
+	allKeys, err = keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allKeys) != 2 || !bytes.Equal(allKeys[0], key3) || !bytes.Equal(allKeys[1], key2) {
+		t.Fatalf("incorrect hmac keys after rotation by another keystore: %v", len(allKeys))
+	}
+	currentKey, err := keyStore.GetHMACSecretKey(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(currentKey, key3) {
+		t.Fatal("cached hmac key should be updated with rotated one")
+	}
+
+	// rotated keys are cached and aren't read from fs again
+	for _, filename := range []string{getHmacKeyFilename(clientID), getHmacKeyFilename(clientID) + hmacKeyHistoryCacheSuffix} {
+		if _, ok := keyStore.Get(filename); !ok {
+			t.Fatalf("expected cached %s", filename)
+		}
+	}
+	if err := otherKeyStore.DestroyRotatedHmacKeys(clientID); err != nil {
+		t.Fatal(err)
+	}
+	allKeys, err = keyStore.GetHMACSecretKeys(clientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(allKeys) != 2 || !bytes.Equal(allKeys[0], key3) || !bytes.Equal(allKeys[1], key2) {
+		t.Fatalf("expected cached rotated hmac keys: %v", len(allKeys))
+	}
+}
@@ -87,17 +87,27 @@ var ErrKeysNotFound = errors.New("keys not found")
 // TokenKeystore method related with key management used by tokenization components
 type TokenKeystore interface {
 	GetEncryptionTokenSymmetricKey(id []byte, ownerType KeyOwnerType) ([]byte, error)
+	// GetDecryptionTokenSymmetricKeys return all token keys of ClientID/ZoneID from the newest to the oldest
 	GetDecryptionTokenSymmetricKeys(id []byte, ownerType KeyOwnerType) ([][]byte, error)
 }
 
+// TokenKeyGenerator is able to generate keys for TokenKeystore.
+type TokenKeyGenerator interface {
+	GenerateTokenSymmetricKey(id []byte, ownerType KeyOwnerType) error
+}
+
 // HmacKeyStore interface to fetch keys for hma calculation
 type HmacKeyStore interface {
 	GetHMACSecretKey(id []byte) ([]byte, error)
+	// GetHMACSecretKeys return current key followed by rotated keys which may still be used by stored hashes
+	GetHMACSecretKeys(id []byte) ([][]byte, error)
 }
 
 // HmacKeyGenerator is able to generate keys for HmacKeyStore.
 type HmacKeyGenerator interface {
 	GenerateHmacKey(id []byte) error
+	// DestroyRotatedHmacKeys destroys all keys except current one when no stored hashes use them
+	DestroyRotatedHmacKeys(id []byte) error
 }
 
 // SymmetricEncryptionKeyStore interface describe access methods to encryption symmetric keys
@@ -292,6 +302,7 @@ type DecryptionKeyStore interface {
 	PrivateKeyStore
 	PoisonKeyStore
 	HmacKeyStore
+	TokenKeystore
 }
 
 // StorageKeyGenerator is able to generate keys for Acra CE and Acra EE.
@@ -308,6 +319,7 @@ type KeyMaking interface {
 	AuditLogKeyGenerator
 	HmacKeyGenerator
 	SymmetricEncryptionKeyStoreGenerator
+	TokenKeyGenerator
 }
 
 // PoisonKeyStore provides access to poison record key pairs.
@@ -14,6 +14,20 @@ type KeyStore struct {
 	mock.Mock
 }
 
+// DestroyRotatedHmacKeys provides a mock function with given fields: id
+func (_m *KeyStore) DestroyRotatedHmacKeys(id []byte) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GenerateClientIDSymmetricKey provides a mock function with given fields: id
 func (_m *KeyStore) GenerateClientIDSymmetricKey(id []byte) error {
 	ret := _m.Called(id)
@@ -176,6 +190,52 @@ func (_m *KeyStore) GetClientIDSymmetricKeys(id []byte) ([][]byte, error) {
 	return r0, r1
 }
 
+// GetDecryptionTokenSymmetricKeys provides a mock function with given fields: id, ownerType
+func (_m *KeyStore) GetDecryptionTokenSymmetricKeys(id []byte, ownerType acrakeystore.KeyOwnerType) ([][]byte, error) {
+	ret := _m.Called(id, ownerType)
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func([]byte, acrakeystore.KeyOwnerType) [][]byte); ok {
+		r0 = rf(id, ownerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, acrakeystore.KeyOwnerType) error); ok {
+		r1 = rf(id, ownerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEncryptionTokenSymmetricKey provides a mock function with given fields: id, ownerType
+func (_m *KeyStore) GetEncryptionTokenSymmetricKey(id []byte, ownerType acrakeystore.KeyOwnerType) ([]byte, error) {
+	ret := _m.Called(id, ownerType)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte, acrakeystore.KeyOwnerType) []byte); ok {
+		r0 = rf(id, ownerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, acrakeystore.KeyOwnerType) error); ok {
+		r1 = rf(id, ownerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHMACSecretKey provides a mock function with given fields: id
 func (_m *KeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	ret := _m.Called(id)
@@ -199,6 +259,29 @@ func (_m *KeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	return r0, r1
 }
 
+// GetHMACSecretKeys provides a mock function with given fields: id
+func (_m *KeyStore) GetHMACSecretKeys(id []byte) ([][]byte, error) {
+	ret := _m.Called(id)
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func([]byte) [][]byte); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogSecretKey provides a mock function with given fields:
 func (_m *KeyStore) GetLogSecretKey() ([]byte, error) {
 	ret := _m.Called()
@@ -148,6 +148,52 @@ func (_m *ServerKeyStore) GetClientIDSymmetricKeys(id []byte) ([][]byte, error)
 	return r0, r1
 }
 
+// GetDecryptionTokenSymmetricKeys provides a mock function with given fields: id, ownerType
+func (_m *ServerKeyStore) GetDecryptionTokenSymmetricKeys(id []byte, ownerType keystore.KeyOwnerType) ([][]byte, error) {
+	ret := _m.Called(id, ownerType)
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func([]byte, keystore.KeyOwnerType) [][]byte); ok {
+		r0 = rf(id, ownerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, keystore.KeyOwnerType) error); ok {
+		r1 = rf(id, ownerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEncryptionTokenSymmetricKey provides a mock function with given fields: id, ownerType
+func (_m *ServerKeyStore) GetEncryptionTokenSymmetricKey(id []byte, ownerType keystore.KeyOwnerType) ([]byte, error) {
+	ret := _m.Called(id, ownerType)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte, keystore.KeyOwnerType) []byte); ok {
+		r0 = rf(id, ownerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, keystore.KeyOwnerType) error); ok {
+		r1 = rf(id, ownerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHMACSecretKey provides a mock function with given fields: id
 func (_m *ServerKeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	ret := _m.Called(id)
@@ -171,6 +217,29 @@ func (_m *ServerKeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	return r0, r1
 }
 
+// GetHMACSecretKeys provides a mock function with given fields: id
+func (_m *ServerKeyStore) GetHMACSecretKeys(id []byte) ([][]byte, error) {
+	ret := _m.Called(id)
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func([]byte) [][]byte); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogSecretKey provides a mock function with given fields:
 func (_m *ServerKeyStore) GetLogSecretKey() ([]byte, error) {
 	ret := _m.Called()
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	keystore "github.com/cossacklabs/acra/keystore"
 	keys "github.com/cossacklabs/themis/gothemis/keys"
 
 	mock "github.com/stretchr/testify/mock"
@@ -59,6 +60,52 @@ func (_m *TranslationKeyStore) GetClientIDSymmetricKeys(id []byte) ([][]byte, er
 	return r0, r1
 }
 
+// GetDecryptionTokenSymmetricKeys provides a mock function with given fields: id, ownerType
+func (_m *TranslationKeyStore) GetDecryptionTokenSymmetricKeys(id []byte, ownerType keystore.KeyOwnerType) ([][]byte, error) {
+	ret := _m.Called(id, ownerType)
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func([]byte, keystore.KeyOwnerType) [][]byte); ok {
+		r0 = rf(id, ownerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, keystore.KeyOwnerType) error); ok {
+		r1 = rf(id, ownerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEncryptionTokenSymmetricKey provides a mock function with given fields: id, ownerType
+func (_m *TranslationKeyStore) GetEncryptionTokenSymmetricKey(id []byte, ownerType keystore.KeyOwnerType) ([]byte, error) {
+	ret := _m.Called(id, ownerType)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte, keystore.KeyOwnerType) []byte); ok {
+		r0 = rf(id, ownerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, keystore.KeyOwnerType) error); ok {
+		r1 = rf(id, ownerType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetHMACSecretKey provides a mock function with given fields: id
 func (_m *TranslationKeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	ret := _m.Called(id)
@@ -82,6 +129,29 @@ func (_m *TranslationKeyStore) GetHMACSecretKey(id []byte) ([]byte, error) {
 	return r0, r1
 }
 
+// GetHMACSecretKeys provides a mock function with given fields: id
+func (_m *TranslationKeyStore) GetHMACSecretKeys(id []byte) ([][]byte, error) {
+	ret := _m.Called(id)
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func([]byte) [][]byte); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogSecretKey provides a mock function with given fields:
 func (_m *TranslationKeyStore) GetLogSecretKey() ([]byte, error) {
 	ret := _m.Called()
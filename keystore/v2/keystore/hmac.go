@@ -18,6 +18,8 @@ package keystore
 
 import (
 	"path/filepath"
+
+	"github.com/cossacklabs/acra/keystore/v2/keystore/api"
 )
 
 //
@@ -40,6 +42,48 @@ func (s *ServerKeyStore) GetHMACSecretKey(clientID []byte) ([]byte, error) {
 	return symmetricKey, nil
 }
 
+// GetHMACSecretKeys retrieves current symmetric key for token HMAC followed by rotated keys for given client.
+func (s *ServerKeyStore) GetHMACSecretKeys(clientID []byte) ([][]byte, error) {
+	log := s.log.WithField("clientID", clientID)
+	ring, err := s.OpenKeyRing(s.clientHMACKeyPath(clientID))
+	if err != nil {
+		log.WithError(err).Debug("Failed to open HMAC key ring for client")
+		return nil, err
+	}
+	current, err := ring.CurrentKey()
+	if err != nil {
+		log.WithError(err).Debug("Failed to get current HMAC key for client")
+		return nil, err
+	}
+	seqnums, err := ring.AllKeys()
+	if err != nil {
+		log.WithError(err).Debug("Failed to get HMAC keys for client")
+		return nil, err
+	}
+	// current key goes first, then rotated keys from newest to oldest
+	seqnums = append([]int{current}, seqnums...)
+	symmetricKeys := make([][]byte, 0, len(seqnums))
+	for i, seqnum := range seqnums {
+		if i > 0 && seqnum == current {
+			continue
+		}
+		state, err := ring.State(seqnum)
+		if err != nil {
+			return nil, err
+		}
+		if state == api.KeyDestroyed {
+			continue
+		}
+		symmetricKey, err := ring.SymmetricKey(seqnum, api.ThemisSymmetricKeyFormat)
+		if err != nil {
+			log.WithError(err).Debug("Failed to get HMAC key for client")
+			return nil, err
+		}
+		symmetricKeys = append(symmetricKeys, symmetricKey)
+	}
+	return symmetricKeys, nil
+}
+
 //
 // HmacKeyGenerator interface
 //
@@ -68,6 +112,50 @@ func (s *ServerKeyStore) GenerateHmacKey(clientID []byte) error {
 	return nil
 }
 
+// DestroyRotatedHmacKeys destroys all symmetric keys for token HMAC for given client except the current one.
+func (s *ServerKeyStore) DestroyRotatedHmacKeys(clientID []byte) error {
+	log := s.log.WithField("clientID", clientID)
+	ring, err := s.OpenKeyRingRW(s.clientHMACKeyPath(clientID))
+	if err != nil {
+		log.WithError(err).Debug("Failed to open HMAC key ring for client")
+		return err
+	}
+	current, err := ring.CurrentKey()
+	if err != nil {
+		log.WithError(err).Debug("Failed to get current HMAC key for client")
+		return err
+	}
+	seqnums, err := ring.AllKeys()
+	if err != nil {
+		log.WithError(err).Debug("Failed to get HMAC keys for client")
+		return err
+	}
+	for _, seqnum := range seqnums {
+		if seqnum == current {
+			continue
+		}
+		state, err := ring.State(seqnum)
+		if err != nil {
+			return err
+		}
+		if state == api.KeyDestroyed {
+			continue
+		}
+		// active keys should be deactivated before destruction
+		if !api.KeyStateTransitionValid(state, api.KeyDestroyed) {
+			if err = ring.SetState(seqnum, api.KeyDeactivated); err != nil {
+				log.WithError(err).Debug("Failed to deactivate rotated HMAC key for client")
+				return err
+			}
+		}
+		if err = ring.DestroyKey(seqnum); err != nil {
+			log.WithError(err).Debug("Failed to destroy rotated HMAC key for client")
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *ServerKeyStore) importHmacKey(clientID []byte, hmacKey []byte) error {
 	log := s.log.WithField("clientID", clientID)
 	ring, err := s.OpenKeyRingRW(s.clientHMACKeyPath(clientID))
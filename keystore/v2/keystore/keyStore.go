@@ -48,6 +48,8 @@ const (
 	PurposeStorageClientSym    = "client storage symmetric key"
 	PurposeStorageZoneSym      = "zone storage symmetric key"
 	PurposeSearchHMAC          = "encrypted search HMAC key"
+	PurposeTokenClientSym      = "client token symmetric key"
+	PurposeTokenZoneSym        = "zone token symmetric key"
 )
 
 // ServerKeyStore provides full access to Acra Keystore.
@@ -186,6 +188,20 @@ func (s *ServerKeyStore) DescribeKeyRing(path string) (*keystore.KeyDescription,
 				ZoneID:  []byte(components[1]),
 			}, nil
 		}
+		if components[0] == clientPrefix && components[2] == tokenSymmetricSuffix {
+			return &keystore.KeyDescription{
+				ID:       path,
+				Purpose:  PurposeTokenClientSym,
+				ClientID: []byte(components[1]),
+			}, nil
+		}
+		if components[0] == zonePrefix && components[2] == tokenSymmetricSuffix {
+			return &keystore.KeyDescription{
+				ID:      path,
+				Purpose: PurposeTokenZoneSym,
+				ZoneID:  []byte(components[1]),
+			}, nil
+		}
 	}
 	if len(components) == 4 {
 		if components[0] == clientPrefix && components[2] == transportSuffix && components[3] == serverSuffix {
@@ -287,3 +287,39 @@ func TestTranslatorKeyStore(t *testing.T) {
 		t.Errorf("Invalid public key for %s", testClientB)
 	}
 }
+
+func TestTokenSymmetricKeys(t *testing.T) {
+	keyStore := NewServerKeyStore(testKeyDirectory(t))
+
+	for _, ownerType := range []keystore.KeyOwnerType{keystore.KeyOwnerTypeClient, keystore.KeyOwnerTypeZone} {
+		if _, err := keyStore.GetDecryptionTokenSymmetricKeys(testClientA, ownerType); err == nil {
+			t.Fatalf("Expected error for missing token key of %s", testClientA)
+		}
+		for i := 0; i < 2; i++ {
+			if err := keyStore.GenerateTokenSymmetricKey(testClientA, ownerType); err != nil {
+				t.Fatalf("Failed to generate token key for %s: %v", testClientA, err)
+			}
+		}
+		currentKey, err := keyStore.GetEncryptionTokenSymmetricKey(testClientA, ownerType)
+		if err != nil {
+			t.Fatalf("Failed to get current token key for %s: %v", testClientA, err)
+		}
+		allKeys, err := keyStore.GetDecryptionTokenSymmetricKeys(testClientA, ownerType)
+		if err != nil {
+			t.Fatalf("Failed to get token keys for %s: %v", testClientA, err)
+		}
+		if len(allKeys) != 2 {
+			t.Fatalf("Expected 2 token keys, got %d", len(allKeys))
+		}
+		if subtle.ConstantTimeCompare(currentKey, allKeys[0]) != 1 {
+			t.Errorf("Current token key must be the first one")
+		}
+		if subtle.ConstantTimeCompare(allKeys[0], allKeys[1]) == 1 {
+			t.Errorf("Rotated token key must differ from the previous one")
+		}
+	}
+
+	if err := keyStore.GenerateTokenSymmetricKey(testClientA, keystore.KeyOwnerType(-1)); err != ErrUnknownKeyOwnerType {
+		t.Errorf("Expected ErrUnknownKeyOwnerType, got %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package keystore
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/cossacklabs/acra/keystore"
+)
+
+// ErrUnknownKeyOwnerType is returned for KeyOwnerType other than client or zone
+var ErrUnknownKeyOwnerType = errors.New("unknown key owner type")
+
+//
+// TokenKeystore interface
+//
+
+// GetEncryptionTokenSymmetricKey retrieves current token symmetric key of given client or zone.
+func (s *ServerKeyStore) GetEncryptionTokenSymmetricKey(id []byte, ownerType keystore.KeyOwnerType) ([]byte, error) {
+	log := s.log.WithField("id", id).WithField("ownerType", ownerType)
+	path, err := s.tokenSymmetricKeyPath(id, ownerType)
+	if err != nil {
+		return nil, err
+	}
+	ring, err := s.OpenKeyRing(path)
+	if err != nil {
+		log.WithError(err).Debug("Failed to open token symmetric key ring")
+		return nil, err
+	}
+	symmetricKey, err := s.currentSymmetricKey(ring)
+	if err != nil {
+		log.WithError(err).Debug("Failed to get current token symmetric key")
+		return nil, err
+	}
+	return symmetricKey, nil
+}
+
+// GetDecryptionTokenSymmetricKeys retrieves all token symmetric keys of given client or zone.
+// The keys are returned from newest to oldest.
+func (s *ServerKeyStore) GetDecryptionTokenSymmetricKeys(id []byte, ownerType keystore.KeyOwnerType) ([][]byte, error) {
+	log := s.log.WithField("id", id).WithField("ownerType", ownerType)
+	path, err := s.tokenSymmetricKeyPath(id, ownerType)
+	if err != nil {
+		return nil, err
+	}
+	ring, err := s.OpenKeyRing(path)
+	if err != nil {
+		log.WithError(err).Debug("Failed to open token symmetric key ring")
+		return nil, err
+	}
+	symmetricKeys, err := s.allSymmetricKeys(ring)
+	if err != nil {
+		log.WithError(err).Debug("Failed to get token symmetric keys")
+		return nil, err
+	}
+	return symmetricKeys, nil
+}
+
+//
+// TokenKeyGenerator interface
+//
+
+const (
+	tokenSymmetricSuffix = "token-sym"
+)
+
+func (s *ServerKeyStore) tokenSymmetricKeyPath(id []byte, ownerType keystore.KeyOwnerType) (string, error) {
+	switch ownerType {
+	case keystore.KeyOwnerTypeClient:
+		return filepath.Join(clientPrefix, string(id), tokenSymmetricSuffix), nil
+	case keystore.KeyOwnerTypeZone:
+		return filepath.Join(zonePrefix, string(id), tokenSymmetricSuffix), nil
+	default:
+		return "", ErrUnknownKeyOwnerType
+	}
+}
+
+// GenerateTokenSymmetricKey generates new token symmetric key of given client or zone.
+func (s *ServerKeyStore) GenerateTokenSymmetricKey(id []byte, ownerType keystore.KeyOwnerType) error {
+	log := s.log.WithField("id", id).WithField("ownerType", ownerType)
+	path, err := s.tokenSymmetricKeyPath(id, ownerType)
+	if err != nil {
+		return err
+	}
+	ring, err := s.OpenKeyRingRW(path)
+	if err != nil {
+		log.WithError(err).Debug("Failed to open token symmetric key ring")
+		return err
+	}
+	_, err = s.newCurrentSymmetricKey(ring)
+	if err != nil {
+		log.WithError(err).Debug("Failed to generate token symmetric key")
+		return err
+	}
+	return nil
+}
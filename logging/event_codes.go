@@ -62,13 +62,15 @@ const (
 	EventCodeErrorCantCloseConnectionDB = 541
 
 	// acracensor
-	EventCodeErrorCensorQueryIsNotAllowed   = 560
-	EventCodeErrorCensorSetupError          = 561
-	EventCodeErrorCensorBackgroundError     = 562
-	EventCodeErrorCensorQueryParseError     = 563
-	EventCodeErrorCensorIOError             = 564
-	EventCodeErrorCensorQuerySerializeError = 565
-	EventCodeErrorCensorWriterMemoryError   = 566
+	EventCodeErrorCensorQueryIsNotAllowed    = 560
+	EventCodeErrorCensorSetupError           = 561
+	EventCodeErrorCensorBackgroundError      = 562
+	EventCodeErrorCensorQueryParseError      = 563
+	EventCodeErrorCensorIOError              = 564
+	EventCodeErrorCensorQuerySerializeError  = 565
+	EventCodeErrorCensorWriterMemoryError    = 566
+	EventCodeErrorCensorRateLimitExceeded    = 567
+	EventCodeErrorCensorMonitoredQueryDenied = 568
 
 	// response connector
 	EventCodeErrorResponseConnectorCantWriteToDB      = 570
@@ -131,6 +133,8 @@ const (
 	EventCodeErrorDataEncryptorInitialization    = 902
 	EventCodeErrorEncryptorCantEncryptExpression = 903
 	EventCodeErrorCantEncryptData                = 904
+	EventCodeErrorEncryptorConfigReload          = 905
+	EventCodeErrorEncryptorUnsupportedWrite      = 906
 
 	// metrics
 	EventCodeErrorPrometheusHTTPHandler       = 1000
@@ -151,9 +155,20 @@ const (
 	EventCodeErrorCodingPostgresqlCantParseColumnsDescription  = 1207
 	EventCodeErrorCodingPostgresqlOctalEscape                  = 1208
 	EventCodeErrorCodingCantDecodeSQLValue                     = 1209
+	EventCodeErrorCodingCantEncodeDataTypeValue                = 1210
+	EventCodeErrorCodingDataTypeValueNotDecrypted              = 1211
+	EventCodeErrorCodingPostgresqlCantParseRowDescription      = 1212
 
 	// network additional
 	EventCodeErrorNetworkWrite      = 1300
 	EventCodeErrorNetworkFlush      = 1301
 	EventCodeErrorNetworkTLSGeneral = 1302
+
+	// tokenization
+	EventCodeErrorTokenExpired      = 1400
+	EventCodeErrorTokenStorageSweep = 1401
+
+	// access policy
+	EventCodeAccessPolicyDecision    = 1500
+	EventCodeErrorAccessPolicyDenied = 1501
 )
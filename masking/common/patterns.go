@@ -16,7 +16,13 @@
 
 package common
 
-import "errors"
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+)
 
 // PlainTextSide defines which side of data is left untouched (in plain), and which is masked with a pattern.
 type PlainTextSide string
@@ -27,23 +33,170 @@ const (
 	PlainTextSideRight PlainTextSide = "right"
 )
 
+// MaskingMode defines which parts of data are left untouched (in plain), and which are masked with a pattern.
+type MaskingMode string
+
+// Allowable values for MaskingMode
+const (
+	// MaskingModeDefault is the same as MaskingModePlaintextSide
+	MaskingModeDefault MaskingMode = ""
+	// MaskingModePlaintextSide leaves plaintext length bytes on plaintext side
+	MaskingModePlaintextSide MaskingMode = "plaintext_side"
+	// MaskingModeEmail leaves the domain part of email with "@"
+	MaskingModeEmail MaskingMode = "email"
+	// MaskingModeSeparators leaves all characters except letters and digits and plaintext length letters and digits
+	// on plaintext side, so "4111-1111-1111-1234" is masked as "xxxx-xxxx-xxxx-1234"
+	MaskingModeSeparators MaskingMode = "separators"
+	// MaskingModeRegex leaves capturing groups of the first match of the regular expression
+	MaskingModeRegex MaskingMode = "regex"
+)
+
 // Validaton errors
 var (
-	ErrInvalidPlaintextLength = errors.New("plaintext length cannot be negative")
-	ErrInvalidPlaintextSide   = errors.New("plaintext side must be left of right")
-	ErrInvalidMaskingPattern  = errors.New("masking pattern can't be empty")
+	ErrInvalidPlaintextLength   = errors.New("plaintext length cannot be negative")
+	ErrInvalidPlaintextSide     = errors.New("plaintext side must be left of right")
+	ErrInvalidMaskingPattern    = errors.New("masking pattern can't be empty")
+	ErrInvalidMaskingMode       = errors.New("masking mode must be plaintext_side, email, separators or regex")
+	ErrPlaintextWithMaskingMode = errors.New("plaintext length and side may be used only with plaintext_side and separators masking modes")
+	ErrInvalidMaskingRegex      = errors.New("masking regex must be valid regular expression with at least one capturing group")
+	ErrMaskingRegexWithMode     = errors.New("masking regex may be used only with regex masking mode")
 )
 
+// MaskingOptions describes which parts of data are masked
+type MaskingOptions struct {
+	Mode            MaskingMode
+	PlaintextLength int
+	PlaintextSide   PlainTextSide
+	// Regex is used only with MaskingModeRegex
+	Regex *regexp.Regexp
+}
+
+// Segment is a part of data which is either left untouched or masked
+type Segment struct {
+	Data   []byte
+	Masked bool
+}
+
 // ValidateMaskingParams checks and returns an error if masking parameters are incorrect.
-func ValidateMaskingParams(pattern string, plaintextLength int, plaintextSide PlainTextSide) error {
+func ValidateMaskingParams(pattern string, options MaskingOptions) error {
 	if len(pattern) == 0 {
 		return ErrInvalidMaskingPattern
 	}
-	if plaintextLength < 0 {
-		return ErrInvalidPlaintextLength
+	switch options.Mode {
+	case MaskingModeDefault, MaskingModePlaintextSide, MaskingModeSeparators:
+		if options.PlaintextLength < 0 {
+			return ErrInvalidPlaintextLength
+		}
+		if options.PlaintextSide != PlainTextSideRight && options.PlaintextSide != PlainTextSideLeft {
+			return ErrInvalidPlaintextSide
+		}
+	case MaskingModeEmail, MaskingModeRegex:
+		if options.PlaintextLength != 0 || options.PlaintextSide != "" {
+			return ErrPlaintextWithMaskingMode
+		}
+	default:
+		return ErrInvalidMaskingMode
 	}
-	if plaintextSide != PlainTextSideRight && plaintextSide != PlainTextSideLeft {
-		return ErrInvalidPlaintextSide
+	if options.Mode == MaskingModeRegex {
+		if options.Regex == nil || options.Regex.NumSubexp() == 0 {
+			return ErrInvalidMaskingRegex
+		}
+	} else if options.Regex != nil {
+		return ErrMaskingRegexWithMode
 	}
 	return nil
 }
+
+// Split data into segments which are left untouched and masked according to options. Adjacent bytes which are
+// masked or not are joined into one segment. If options leave nothing to mask, the whole data is masked.
+func (options MaskingOptions) Split(data []byte) []Segment {
+	visible := make([]bool, len(data))
+	switch options.Mode {
+	case MaskingModeEmail:
+		options.splitEmail(data, visible)
+	case MaskingModeSeparators:
+		options.splitSeparators(data, visible)
+	case MaskingModeRegex:
+		options.splitRegex(data, visible)
+	default:
+		options.splitPlaintextSide(data, visible)
+	}
+
+	segments := make([]Segment, 0, 3)
+	hasMasked := false
+	for i := 0; i < len(data); {
+		j := i + 1
+		for j < len(data) && visible[j] == visible[i] {
+			j++
+		}
+		segments = append(segments, Segment{Data: data[i:j], Masked: !visible[i]})
+		hasMasked = hasMasked || !visible[i]
+		i = j
+	}
+	if !hasMasked {
+		// two variants are possible in such case:
+		// to encrypt all data or to left all data in plaintext.
+		// Seems encrypt data is better
+		return []Segment{{Data: data, Masked: true}}
+	}
+	return segments
+}
+
+func (options MaskingOptions) splitPlaintextSide(data []byte, visible []bool) {
+	if options.PlaintextLength >= len(data) {
+		return
+	}
+	if options.PlaintextSide == PlainTextSideLeft {
+		markVisible(visible, 0, options.PlaintextLength)
+	} else {
+		markVisible(visible, len(data)-options.PlaintextLength, len(data))
+	}
+}
+
+func (options MaskingOptions) splitEmail(data []byte, visible []bool) {
+	at := bytes.LastIndexByte(data, '@')
+	if at > 0 {
+		markVisible(visible, at, len(data))
+	}
+}
+
+func (options MaskingOptions) splitSeparators(data []byte, visible []bool) {
+	// start and end offsets of letters and digits, other characters are separators
+	var characters [][2]int
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r != utf8.RuneError && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			markVisible(visible, i, i+size)
+		} else {
+			characters = append(characters, [2]int{i, i + size})
+		}
+		i += size
+	}
+	if options.PlaintextLength >= len(characters) {
+		return
+	}
+	if options.PlaintextSide == PlainTextSideLeft {
+		characters = characters[:options.PlaintextLength]
+	} else {
+		characters = characters[len(characters)-options.PlaintextLength:]
+	}
+	for _, character := range characters {
+		markVisible(visible, character[0], character[1])
+	}
+}
+
+func (options MaskingOptions) splitRegex(data []byte, visible []bool) {
+	match := options.Regex.FindSubmatchIndex(data)
+	// skip the whole match, only groups are left untouched
+	for i := 2; i+1 < len(match); i += 2 {
+		if match[i] >= 0 {
+			markVisible(visible, match[i], match[i+1])
+		}
+	}
+}
+
+func markVisible(visible []bool, start, end int) {
+	for i := start; i < end; i++ {
+		visible[i] = true
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2026, Cossack Labs Limited
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// render segments with masked ones replaced by "*"
+func renderSegments(segments []Segment) string {
+	result := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if segment.Masked {
+			result = append(result, "*")
+		} else {
+			result = append(result, string(segment.Data))
+		}
+	}
+	return strings.Join(result, "")
+}
+
+func TestMaskingOptionsSplit(t *testing.T) {
+	testcases := []struct {
+		options MaskingOptions
+		data    string
+		masked  string
+	}{
+		{MaskingOptions{PlaintextLength: 4, PlaintextSide: PlainTextSideRight}, "4111111111111234", "*1234"},
+		{MaskingOptions{Mode: MaskingModePlaintextSide, PlaintextLength: 4, PlaintextSide: PlainTextSideLeft}, "4111111111111234", "4111*"},
+		{MaskingOptions{PlaintextLength: 0, PlaintextSide: PlainTextSideLeft}, "secret", "*"},
+		// nothing is left in plaintext if plaintext length isn't shorter than data
+		{MaskingOptions{PlaintextLength: 6, PlaintextSide: PlainTextSideLeft}, "secret", "*"},
+		{MaskingOptions{PlaintextLength: 6, PlaintextSide: PlainTextSideLeft}, "", "*"},
+
+		{MaskingOptions{Mode: MaskingModeEmail}, "john.doe@example.com", "*@example.com"},
+		{MaskingOptions{Mode: MaskingModeEmail}, "a@b@example.com", "*@example.com"},
+		{MaskingOptions{Mode: MaskingModeEmail}, "@example.com", "*"},
+		{MaskingOptions{Mode: MaskingModeEmail}, "not an email", "*"},
+
+		{MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 4, PlaintextSide: PlainTextSideRight}, "4111-1111-1111-1234", "*-*-*-1234"},
+		{MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 6, PlaintextSide: PlainTextSideRight}, "4111-1111-1111-1234", "*-*-*11-1234"},
+		{MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 2, PlaintextSide: PlainTextSideLeft}, "+1 (555) 010-99", "+1 (5*) *-*"},
+		{MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 1, PlaintextSide: PlainTextSideRight}, "Ünïcode-ß", "*-ß"},
+		// only separators are left if plaintext length isn't shorter than count of letters and digits
+		{MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 4, PlaintextSide: PlainTextSideRight}, "12-34", "*-*"},
+		{MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 0, PlaintextSide: PlainTextSideRight}, "---", "*"},
+
+		{MaskingOptions{Mode: MaskingModeRegex, Regex: regexp.MustCompile(`^(\w)\w*( \w)`)}, "John Doe", "J* D*"},
+		{MaskingOptions{Mode: MaskingModeRegex, Regex: regexp.MustCompile(`(\d{3})-\d+-(\d{2})(x)?`)}, "ID 123-4567-89", "*123*89"},
+		{MaskingOptions{Mode: MaskingModeRegex, Regex: regexp.MustCompile(`(\d+)`)}, "no digits", "*"},
+		{MaskingOptions{Mode: MaskingModeRegex, Regex: regexp.MustCompile(`(.*)`)}, "everything", "*"},
+	}
+	for i, tcase := range testcases {
+		segments := tcase.options.Split([]byte(tcase.data))
+		if masked := renderSegments(segments); masked != tcase.masked {
+			t.Fatalf("[%d] Expect %q, took %q\n", i, tcase.masked, masked)
+		}
+		joined := make([]byte, 0, len(tcase.data))
+		for _, segment := range segments {
+			joined = append(joined, segment.Data...)
+		}
+		if string(joined) != tcase.data {
+			t.Fatalf("[%d] Segments %q don't form source data %q\n", i, joined, tcase.data)
+		}
+	}
+}
+
+func TestValidateMaskingParams(t *testing.T) {
+	testcases := []struct {
+		pattern string
+		options MaskingOptions
+		err     error
+	}{
+		{"xxxx", MaskingOptions{PlaintextLength: 4, PlaintextSide: PlainTextSideRight}, nil},
+		{"xxxx", MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 4, PlaintextSide: PlainTextSideLeft}, nil},
+		{"xxxx", MaskingOptions{Mode: MaskingModeEmail}, nil},
+		{"xxxx", MaskingOptions{Mode: MaskingModeRegex, Regex: regexp.MustCompile(`(\d{4})$`)}, nil},
+		{"", MaskingOptions{Mode: MaskingModeEmail}, ErrInvalidMaskingPattern},
+		{"xxxx", MaskingOptions{PlaintextLength: -1, PlaintextSide: PlainTextSideRight}, ErrInvalidPlaintextLength},
+		{"xxxx", MaskingOptions{Mode: MaskingModeSeparators, PlaintextLength: 4}, ErrInvalidPlaintextSide},
+		{"xxxx", MaskingOptions{Mode: "unknown"}, ErrInvalidMaskingMode},
+		{"xxxx", MaskingOptions{Mode: MaskingModeEmail, PlaintextSide: PlainTextSideRight}, ErrPlaintextWithMaskingMode},
+		{"xxxx", MaskingOptions{Mode: MaskingModeRegex, PlaintextLength: 4, Regex: regexp.MustCompile(`(\d{4})$`)}, ErrPlaintextWithMaskingMode},
+		{"xxxx", MaskingOptions{Mode: MaskingModeRegex}, ErrInvalidMaskingRegex},
+		{"xxxx", MaskingOptions{Mode: MaskingModeRegex, Regex: regexp.MustCompile(`\d{4}$`)}, ErrInvalidMaskingRegex},
+		{"xxxx", MaskingOptions{Mode: MaskingModeEmail, Regex: regexp.MustCompile(`(\d{4})$`)}, ErrMaskingRegexWithMode},
+	}
+	for i, tcase := range testcases {
+		if err := ValidateMaskingParams(tcase.pattern, tcase.options); err != tcase.err {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.err, err)
+		}
+	}
+}
@@ -55,28 +55,20 @@ func (e *DataEncryptor) encryptByFunction(context, data []byte, settingCE config
 		return nil, errors.New("can't cast column encryption settings")
 	}
 	if setting.GetMaskingPattern() != "" {
-		partialPlaintextLen := setting.GetPartialPlaintextLen()
-		if partialPlaintextLen >= len(data) {
-			// two variants are possible in such case:
-			// to encrypt all data or to left all data in plaintext.
-			// Seems encrypt data is better
-			return encryptionFunc(context, data, setting)
-		}
-		var result []byte
-		if setting.IsEndMasking() {
-			partialPlaintext := data[0:partialPlaintextLen]
-			acrastruct, err := encryptionFunc(context, data[partialPlaintextLen:], setting)
-			if err != nil {
-				return nil, err
+		// each masked segment is encrypted separately, so it is replaced with the masking pattern in place when
+		// can't be decrypted and untouched segments like separators or email domain are kept around it
+		segments := setting.GetMaskingOptions().Split(data)
+		result := make([]byte, 0, len(data))
+		for _, segment := range segments {
+			if !segment.Masked {
+				result = append(result, segment.Data...)
+				continue
 			}
-			result = append(partialPlaintext, acrastruct...)
-		} else {
-			partialPlaintext := data[len(data)-partialPlaintextLen:]
-			acrastruct, err := encryptionFunc(context, data[0:len(data)-partialPlaintextLen], setting)
+			encrypted, err := encryptionFunc(context, segment.Data, setting)
 			if err != nil {
 				return nil, err
 			}
-			result = append(acrastruct, partialPlaintext...)
+			result = append(result, encrypted...)
 		}
 		return result, nil
 	}
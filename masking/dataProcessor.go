@@ -3,7 +3,9 @@ package masking
 import (
 	"bytes"
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/decryptor/base/access_policy"
 	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/logging"
 )
 
@@ -15,13 +17,19 @@ func NewProcessor(decryptor base.ExtendedDataProcessor) (*Processor, error) {
 	return &Processor{decryptor: decryptor}, nil
 }
 
-// Process implement DataProcessor with AcraStruct decryption
+// Process implement DataProcessor with AcraStruct decryption. Each masked segment of the value is a separate
+// container, so every segment which can't be decrypted or should be masked by access policy is replaced with the
+// masking pattern
 func (processor *Processor) Process(data []byte, context *base.DataProcessorContext) ([]byte, error) {
 	logger := logging.GetLoggerFromContext(context.Context).WithField("processor", "masking")
 	logger.Debugln("Processing masking")
 	setting, ok := encryptor.EncryptionSettingFromContext(context.Context)
 	if ok && setting.GetMaskingPattern() != "" {
 		logger.Debugln("Has pattern")
+		if access_policy.ActionFromContext(context.Context, setting) == config.AccessActionMasked {
+			logger.Debugln("Mask data according to access policy")
+			return []byte(setting.GetMaskingPattern()), nil
+		}
 		newData, err := processor.decryptor.Process(data, context)
 		if err != nil || bytes.Equal(newData, data) {
 			logger.Debugln("Mask data")
@@ -308,3 +308,17 @@ func GetClientIDFromTLSConn(conn *tls.Conn, extractor TLSClientIDExtractor) ([]b
 	certificate := connectionInfo.VerifiedChains[0][0]
 	return getClientIDFromCertificate(certificate, extractor)
 }
+
+// GetTLSIdentity returns subject of the verified client certificate of TLS connection or empty string for connections
+// without TLS or certificate
+func GetTLSIdentity(conn net.Conn) string {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return ""
+	}
+	connectionInfo := tlsConn.ConnectionState()
+	if len(connectionInfo.VerifiedChains) == 0 || len(connectionInfo.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return connectionInfo.VerifiedChains[0][0].Subject.String()
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pseudonymization
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+// ErrInvalidBinaryValue returned for binary values with unexpected size
+var ErrInvalidBinaryValue = errors.New("invalid size of binary value")
+
+// timestampTextLayout is a text format of timestamp decoded from binary format
+const timestampTextLayout = "2006-01-02 15:04:05.999999"
+
+// pgEpoch used as zero point for date and timestamp values in PostgreSQL binary format
+var pgEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+const secondsPerDay = 24 * 60 * 60
+
+// IsPgSQLBinaryTokenType return true if values of tokenType have PostgreSQL binary format different from text format
+func IsPgSQLBinaryTokenType(tokenType common.TokenType) bool {
+	switch tokenType {
+	case common.TokenType_UUID, common.TokenType_Date, common.TokenType_Timestamp:
+		return true
+	}
+	return false
+}
+
+// DecodePgSQLBinaryValue converts uuid, date and timestamp values from PostgreSQL binary format to text format
+// expected by tokenizer. Values of other token types returned as is
+func DecodePgSQLBinaryValue(tokenType common.TokenType, data []byte) ([]byte, error) {
+	switch tokenType {
+	case common.TokenType_UUID:
+		if len(data) != 16 {
+			return nil, ErrInvalidBinaryValue
+		}
+		output := make([]byte, 0, 36)
+		for i, part := range [][]byte{data[0:4], data[4:6], data[6:8], data[8:10], data[10:]} {
+			if i > 0 {
+				output = append(output, '-')
+			}
+			output = append(output, hex.EncodeToString(part)...)
+		}
+		return output, nil
+	case common.TokenType_Date:
+		// days since 2000-01-01
+		if len(data) != 4 {
+			return nil, ErrInvalidBinaryValue
+		}
+		days := int32(binary.BigEndian.Uint32(data))
+		return []byte(pgEpoch.AddDate(0, 0, int(days)).Format(DateLayout)), nil
+	case common.TokenType_Timestamp:
+		// microseconds since 2000-01-01 00:00:00 UTC for timestamp and timestamptz
+		if len(data) != 8 {
+			return nil, ErrInvalidBinaryValue
+		}
+		microseconds := int64(binary.BigEndian.Uint64(data))
+		timestamp := time.Unix(pgEpoch.Unix()+microseconds/1000000, (microseconds%1000000)*1000).UTC()
+		return []byte(timestamp.Format(timestampTextLayout)), nil
+	}
+	return data, nil
+}
+
+// EncodePgSQLBinaryValue converts uuid, date and timestamp values from text format to PostgreSQL binary format. Values
+// of other token types returned as is
+func EncodePgSQLBinaryValue(tokenType common.TokenType, data []byte) ([]byte, error) {
+	switch tokenType {
+	case common.TokenType_UUID:
+		value, err := normalizeUUID(common.UUID(data))
+		if err != nil {
+			return nil, err
+		}
+		return hex.DecodeString(strings.Replace(string(value), "-", "", -1))
+	case common.TokenType_Date:
+		date, err := time.Parse(DateLayout, string(data))
+		if err != nil {
+			return nil, err
+		}
+		output := make([]byte, 4)
+		binary.BigEndian.PutUint32(output, uint32(int32((date.Unix()-pgEpoch.Unix())/secondsPerDay)))
+		return output, nil
+	case common.TokenType_Timestamp:
+		timestamp, _, err := parseTimestamp(data)
+		if err != nil {
+			return nil, err
+		}
+		microseconds := (timestamp.Unix()-pgEpoch.Unix())*1000000 + int64(timestamp.Nanosecond()/1000)
+		output := make([]byte, 8)
+		binary.BigEndian.PutUint64(output, uint64(microseconds))
+		return output, nil
+	}
+	return data, nil
+}
+
+// IsMySQLBinaryTokenType return true if values of tokenType have MySQL binary protocol format different from text format
+func IsMySQLBinaryTokenType(tokenType common.TokenType) bool {
+	return tokenType == common.TokenType_Date || tokenType == common.TokenType_Timestamp
+}
+
+// IsMySQLBinaryDateTime return true if data has size of DATE/DATETIME/TIMESTAMP value in MySQL binary protocol. Text
+// values of these types are longer
+func IsMySQLBinaryDateTime(data []byte) bool {
+	switch len(data) {
+	case 4, 7, 11:
+		return true
+	}
+	return false
+}
+
+// DecodeMySQLBinaryValue converts date and timestamp values from MySQL binary protocol format to text format expected
+// by tokenizer. Values of other token types returned as is
+// https://dev.mysql.com/doc/internals/en/binary-protocol-value.html
+func DecodeMySQLBinaryValue(tokenType common.TokenType, data []byte) ([]byte, error) {
+	if !IsMySQLBinaryTokenType(tokenType) {
+		return data, nil
+	}
+	if !IsMySQLBinaryDateTime(data) {
+		return nil, ErrInvalidBinaryValue
+	}
+	// year[2] + month[1] + day[1] + optional hour[1] + minute[1] + second[1] + optional microseconds[4]
+	var hour, minute, second, microseconds int
+	if len(data) >= 7 {
+		hour, minute, second = int(data[4]), int(data[5]), int(data[6])
+	}
+	if len(data) == 11 {
+		microseconds = int(binary.LittleEndian.Uint32(data[7:]))
+	}
+	timestamp := time.Date(int(binary.LittleEndian.Uint16(data)), time.Month(data[2]), int(data[3]), hour, minute, second, microseconds*1000, time.UTC)
+	if tokenType == common.TokenType_Date {
+		return []byte(timestamp.Format(DateLayout)), nil
+	}
+	return []byte(timestamp.Format(timestampTextLayout)), nil
+}
+
+// EncodeMySQLBinaryValue converts date and timestamp values from text format to MySQL binary protocol format. Values
+// of other token types returned as is
+func EncodeMySQLBinaryValue(tokenType common.TokenType, data []byte) ([]byte, error) {
+	var timestamp time.Time
+	var err error
+	switch tokenType {
+	case common.TokenType_Date:
+		timestamp, err = time.Parse(DateLayout, string(data))
+	case common.TokenType_Timestamp:
+		timestamp, _, err = parseTimestamp(data)
+	default:
+		return data, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	output := make([]byte, 4, 11)
+	binary.LittleEndian.PutUint16(output, uint16(timestamp.Year()))
+	output[2] = byte(timestamp.Month())
+	output[3] = byte(timestamp.Day())
+	if tokenType == common.TokenType_Date {
+		return output, nil
+	}
+	output = append(output, byte(timestamp.Hour()), byte(timestamp.Minute()), byte(timestamp.Second()))
+	if microseconds := timestamp.Nanosecond() / 1000; microseconds > 0 {
+		output = output[:11]
+		binary.LittleEndian.PutUint32(output[7:], uint32(microseconds))
+	}
+	return output, nil
+}
@@ -27,6 +27,9 @@ type TokenSetting interface {
 	IsTokenized() bool
 	IsConsistentTokenization() bool
 	GetTokenType() TokenType
+	IsFormatPreservingTokenization() bool
+	GetFormatPreservingOptions() FormatPreservingOptions
+	GetTokenRetention() TokenRetention
 }
 
 // ErrTokenNotFound error used when token wasn't found in storage
@@ -38,6 +41,12 @@ var ErrTokenExists = errors.New("token already exists")
 // ErrTokenDisabled is returned when a token was found, but is explicitly disabled
 var ErrTokenDisabled = errors.New("disabled token accessed")
 
+// ErrTokenExpired is returned when a token was found, but outlived its retention
+var ErrTokenExpired = errors.New("expired token accessed")
+
+// ErrTokenDataChanged is returned when data of a token entry was changed concurrently
+var ErrTokenDataChanged = errors.New("token data changed concurrently")
+
 // Encryptor interface used as abstraction for token encryption
 type Encryptor interface {
 	Encrypt(data, context TokenContext) ([]byte, error)
@@ -47,6 +56,15 @@ type Encryptor interface {
 // Email type used to separate string type from Email for tokens
 type Email string
 
+// Phone type used to separate string type from phone numbers in E.164 format for tokens
+type Phone string
+
+// UUID type used to separate string type from UUID in canonical text format for tokens
+type UUID string
+
+// CardNumber type used to separate string type from payment card numbers for tokens
+type CardNumber string
+
 // TokenStorage interface abstracts storage implementation
 type TokenStorage interface {
 	Save(id []byte, context TokenContext, data []byte) error
@@ -64,6 +82,27 @@ type TokenStorage interface {
 	SetAccessTimeGranularity(granularity time.Duration) error
 }
 
+// TokenEntry is a token entry as it is kept by TokenStorage, used to copy tokens between storages.
+type TokenEntry struct {
+	// ContextHash is the digest of token context returned by AggregateTokenContextToBytes
+	ContextHash []byte
+	ID          []byte
+	Data        []byte
+	Metadata    TokenMetadata
+}
+
+// TokenEntryStorage is implemented by token storages which allow to export and import entries as is.
+type TokenEntryStorage interface {
+	// Iterate over token entries in the storage with the same guarantees as VisitMetadata.
+	// Callback may modify the storage. Return a non-nil error to stop iteration and return this error.
+	VisitEntries(cb func(entry TokenEntry) error) error
+	// SaveEntry saves the entry with its metadata or return ErrTokenExists if the entry with the same id and context exists.
+	SaveEntry(entry TokenEntry) error
+	// ReplaceEntryData replaces data of the entry keeping its current metadata if stored data is still equal to entry.Data.
+	// Return ErrTokenNotFound if the entry doesn't exist and ErrTokenDataChanged if its data differs.
+	ReplaceEntryData(entry TokenEntry, data []byte) error
+}
+
 // DefaultAccessTimeGranularity is the default difference in time required for the access time to be updated.
 const DefaultAccessTimeGranularity = 24 * time.Hour
 
@@ -102,6 +141,9 @@ type Pseudoanonymizer interface {
 type TokenContext struct {
 	ClientID []byte
 	ZoneID   []byte
+	// Retention of tokens saved in the context. It isn't a part of the context identity and ignored for token lookup,
+	// but consistent tokenization issues separate tokens for each retention
+	Retention TokenRetention
 }
 
 // AggregateTokenContextToBytes used as function to return one byte array as value which is digest for context
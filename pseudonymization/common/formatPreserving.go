@@ -0,0 +1,84 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import "errors"
+
+// FormatPreservingAlgorithm is a mode of format-preserving encryption used to generate tokens without storage
+type FormatPreservingAlgorithm string
+
+// Supported FormatPreservingAlgorithm values
+const (
+	FormatPreservingAlgorithmDefault FormatPreservingAlgorithm = ""
+	FormatPreservingAlgorithmFF1     FormatPreservingAlgorithm = "ff1"
+	FormatPreservingAlgorithmFF31    FormatPreservingAlgorithm = "ff3-1"
+)
+
+// Errors related to format-preserving tokenization
+var (
+	ErrUnsupportedFormatPreservingAlgorithm = errors.New("unsupported format-preserving encryption algorithm")
+	ErrUnsupportedFormatPreservingTokenType = errors.New("format-preserving tokenization supports only str, email and bytes token types")
+	ErrLuhnUnsupportedTokenType             = errors.New("luhn-valid tokens supported only for str token type")
+	ErrInvalidFormatPreservingKeyVersion    = errors.New("format-preserving key version can't be negative")
+)
+
+// FormatPreservingOptions describes how tokens are generated with format-preserving encryption
+type FormatPreservingOptions struct {
+	Algorithm FormatPreservingAlgorithm
+	// LuhnValid keeps the last digit of the value as valid Luhn check digit of the token
+	LuhnValid bool
+	// KeyVersion is the number of token key of ClientID/ZoneID used to generate tokens, counted from the first
+	// generated key. Tokens don't change when new token keys are generated until the version is changed
+	KeyVersion int
+}
+
+// GetAlgorithm return algorithm with FF1 as default value
+func (options FormatPreservingOptions) GetAlgorithm() FormatPreservingAlgorithm {
+	if options.Algorithm == FormatPreservingAlgorithmDefault {
+		return FormatPreservingAlgorithmFF1
+	}
+	return options.Algorithm
+}
+
+// ValidateFormatPreservingAlgorithm return error if value is unsupported FormatPreservingAlgorithm
+func ValidateFormatPreservingAlgorithm(value FormatPreservingAlgorithm) error {
+	switch value {
+	case FormatPreservingAlgorithmDefault, FormatPreservingAlgorithmFF1, FormatPreservingAlgorithmFF31:
+		return nil
+	default:
+		return ErrUnsupportedFormatPreservingAlgorithm
+	}
+}
+
+// Validate return error if options can't be used to tokenize values of the tokenType
+func (options FormatPreservingOptions) Validate(tokenType TokenType) error {
+	if err := ValidateFormatPreservingAlgorithm(options.Algorithm); err != nil {
+		return err
+	}
+	if options.KeyVersion < 0 {
+		return ErrInvalidFormatPreservingKeyVersion
+	}
+	switch tokenType {
+	case TokenType_String, TokenType_Email, TokenType_Bytes:
+	default:
+		return ErrUnsupportedFormatPreservingTokenType
+	}
+	if options.LuhnValid && tokenType != TokenType_String {
+		return ErrLuhnUnsupportedTokenType
+	}
+	return nil
+}
@@ -24,9 +24,10 @@ import (
 
 // TokenMetadata is additional bookeeping information kept by TokenStorage along with the token value.
 type TokenMetadata struct {
-	Created  time.Time
-	Accessed time.Time
-	Disabled bool
+	Created   time.Time
+	Accessed  time.Time
+	Disabled  bool
+	Retention TokenRetention
 }
 
 // NewTokenMetadata creates metadata for a newly created token entry,
@@ -35,14 +36,33 @@ func NewTokenMetadata() TokenMetadata {
 	return TokenMetadata{Created: now, Accessed: now, Disabled: false}
 }
 
+// NewTokenMetadataWithRetention creates metadata for a newly created token entry which expires according to retention.
+func NewTokenMetadataWithRetention(retention TokenRetention) TokenMetadata {
+	metadata := NewTokenMetadata()
+	metadata.Retention = retention
+	return metadata
+}
+
 // AccessedBefore checks that the token has been accessed before the specified time instance with given granularity.
 func (t *TokenMetadata) AccessedBefore(instant time.Time, granularity time.Duration) bool {
 	return t.Accessed.Before(instant.Add(-granularity))
 }
 
+// Expired checks that the token outlived its TTL or hasn't been accessed during its idle TTL at the specified time instance.
+func (t TokenMetadata) Expired(instant time.Time) bool {
+	if t.Retention.TTL > 0 && !instant.Before(t.Created.Add(t.Retention.TTL)) {
+		return true
+	}
+	if t.Retention.IdleTTL > 0 && !instant.Before(t.Accessed.Add(t.Retention.IdleTTL)) {
+		return true
+	}
+	return false
+}
+
 // Equal returns true if this metadata is equal to the other one.
 func (t TokenMetadata) Equal(other TokenMetadata) bool {
-	return t.Created.Equal(other.Created) && t.Accessed.Equal(other.Accessed) && t.Disabled == other.Disabled
+	return t.Created.Equal(other.Created) && t.Accessed.Equal(other.Accessed) && t.Disabled == other.Disabled &&
+		t.Retention == other.Retention
 }
 
 // EmbedMetadata composes data with additional metadata into a single byte slice.
@@ -52,6 +72,8 @@ func EmbedMetadata(data []byte, metadata TokenMetadata) []byte {
 		Created:  metadata.Created.Unix(),
 		Accessed: metadata.Accessed.Unix(),
 		Disabled: metadata.Disabled,
+		Ttl:      int64(metadata.Retention.TTL / time.Second),
+		IdleTtl:  int64(metadata.Retention.IdleTTL / time.Second),
 	}
 	bytes, _ := proto.Marshal(&value)
 	return bytes
@@ -68,6 +90,10 @@ func ExtractMetadata(data []byte) ([]byte, TokenMetadata, error) {
 		Created:  time.Unix(value.Created, 0),
 		Accessed: time.Unix(value.Accessed, 0),
 		Disabled: value.Disabled,
+		Retention: TokenRetention{
+			TTL:     time.Duration(value.Ttl) * time.Second,
+			IdleTTL: time.Duration(value.IdleTtl) * time.Second,
+		},
 	}
 	return value.Data, metadata, nil
 }
@@ -0,0 +1,63 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TokenRetention describes how long tokens are kept in the storage. Zero values mean that tokens don't expire
+type TokenRetention struct {
+	// TTL is the lifetime of the token since its creation
+	TTL time.Duration
+	// IdleTTL is the lifetime of the token since its last access
+	IdleTTL time.Duration
+}
+
+// IsEmpty return true if tokens never expire
+func (r TokenRetention) IsEmpty() bool {
+	return r.TTL == 0 && r.IdleTTL == 0
+}
+
+// ErrInvalidRetentionDuration returned when retention duration can't be parsed or isn't positive
+var ErrInvalidRetentionDuration = errors.New("invalid retention duration, expected positive duration like 90d, 12h or 30m")
+
+// ParseRetentionDuration parses duration of token retention. Along with units supported by time.ParseDuration it
+// accepts days as "d" suffix (90d). Retention is stored with seconds precision
+func ParseRetentionDuration(value string) (time.Duration, error) {
+	var duration time.Duration
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.ParseUint(strings.TrimSuffix(value, "d"), 10, 32)
+		if err != nil {
+			return 0, ErrInvalidRetentionDuration
+		}
+		duration = time.Duration(days) * 24 * time.Hour
+	} else {
+		var err error
+		duration, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, ErrInvalidRetentionDuration
+		}
+	}
+	if duration < time.Second {
+		return 0, ErrInvalidRetentionDuration
+	}
+	return duration, nil
+}
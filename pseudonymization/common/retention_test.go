@@ -0,0 +1,85 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRetentionDuration(t *testing.T) {
+	testcases := []struct {
+		value    string
+		duration time.Duration
+		err      error
+	}{
+		{"90d", 90 * 24 * time.Hour, nil},
+		{"12h", 12 * time.Hour, nil},
+		{"1h30m", 90 * time.Minute, nil},
+		{"1s", time.Second, nil},
+		{"", 0, ErrInvalidRetentionDuration},
+		{"d", 0, ErrInvalidRetentionDuration},
+		{"-1d", 0, ErrInvalidRetentionDuration},
+		{"-1h", 0, ErrInvalidRetentionDuration},
+		{"0s", 0, ErrInvalidRetentionDuration},
+		{"100ms", 0, ErrInvalidRetentionDuration},
+		{"1w", 0, ErrInvalidRetentionDuration},
+	}
+	for i, tcase := range testcases {
+		duration, err := ParseRetentionDuration(tcase.value)
+		if err != tcase.err {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.err, err)
+		}
+		if duration != tcase.duration {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.duration, duration)
+		}
+	}
+}
+
+func TestTokenMetadataExpired(t *testing.T) {
+	created := time.Date(2021, 12, 22, 0, 0, 0, 0, time.UTC)
+	accessed := created.Add(10 * 24 * time.Hour)
+	testcases := []struct {
+		retention TokenRetention
+		instant   time.Time
+		expired   bool
+	}{
+		{TokenRetention{}, created.Add(100 * 365 * 24 * time.Hour), false},
+		{TokenRetention{TTL: 30 * 24 * time.Hour}, created.Add(30*24*time.Hour - time.Second), false},
+		{TokenRetention{TTL: 30 * 24 * time.Hour}, created.Add(30 * 24 * time.Hour), true},
+		{TokenRetention{IdleTTL: 5 * 24 * time.Hour}, accessed.Add(4 * 24 * time.Hour), false},
+		{TokenRetention{IdleTTL: 5 * 24 * time.Hour}, accessed.Add(5 * 24 * time.Hour), true},
+		// whichever comes first
+		{TokenRetention{TTL: 30 * 24 * time.Hour, IdleTTL: 5 * 24 * time.Hour}, accessed.Add(6 * 24 * time.Hour), true},
+		{TokenRetention{TTL: 12 * 24 * time.Hour, IdleTTL: 5 * 24 * time.Hour}, accessed.Add(3 * 24 * time.Hour), true},
+		{TokenRetention{TTL: 30 * 24 * time.Hour, IdleTTL: 5 * 24 * time.Hour}, accessed.Add(3 * 24 * time.Hour), false},
+	}
+	for i, tcase := range testcases {
+		metadata := TokenMetadata{Created: created, Accessed: accessed, Retention: tcase.retention}
+		if metadata.Expired(tcase.instant) != tcase.expired {
+			t.Fatalf("[%d] Expect expired == %v\n", i, tcase.expired)
+		}
+		// retention is kept after serialization
+		_, extracted, err := ExtractMetadata(EmbedMetadata([]byte(`data`), metadata))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !extracted.Equal(metadata) {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, metadata, extracted)
+		}
+	}
+}
@@ -28,6 +28,12 @@ var supportedTokenTypes = map[TokenType]bool{
 	TokenType_String: true,
 	TokenType_Bytes:  true,
 	TokenType_Email:  true,
+
+	TokenType_Phone:      true,
+	TokenType_UUID:       true,
+	TokenType_Date:       true,
+	TokenType_Timestamp:  true,
+	TokenType_CardNumber: true,
 }
 
 // Validation errors
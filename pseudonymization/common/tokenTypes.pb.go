@@ -1,18 +1,16 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.6.1
 // source: tokenTypes.proto
 
 package common
 
 import (
-	reflect "reflect"
-	sync "sync"
-
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -22,45 +20,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 // TokenType defines tokenization type.
 type TokenType int32
 
 const (
-	TokenType_Unknown  TokenType = 0
-	TokenType_Int32    TokenType = 1
-	TokenType_Int64    TokenType = 2
-	TokenType_String   TokenType = 3
-	TokenType_Bytes    TokenType = 4
-	TokenType_Email    TokenType = 5
-	TokenType_Int32Str TokenType = 6
-	TokenType_Int64Str TokenType = 7
+	TokenType_Unknown    TokenType = 0
+	TokenType_Int32      TokenType = 1
+	TokenType_Int64      TokenType = 2
+	TokenType_String     TokenType = 3
+	TokenType_Bytes      TokenType = 4
+	TokenType_Email      TokenType = 5
+	TokenType_Int32Str   TokenType = 6
+	TokenType_Int64Str   TokenType = 7
+	TokenType_Phone      TokenType = 8
+	TokenType_UUID       TokenType = 9
+	TokenType_Date       TokenType = 10
+	TokenType_Timestamp  TokenType = 11
+	TokenType_CardNumber TokenType = 12
 )
 
 // Enum value maps for TokenType.
 var (
 	TokenType_name = map[int32]string{
-		0: "Unknown",
-		1: "Int32",
-		2: "Int64",
-		3: "String",
-		4: "Bytes",
-		5: "Email",
-		6: "Int32Str",
-		7: "Int64Str",
+		0:  "Unknown",
+		1:  "Int32",
+		2:  "Int64",
+		3:  "String",
+		4:  "Bytes",
+		5:  "Email",
+		6:  "Int32Str",
+		7:  "Int64Str",
+		8:  "Phone",
+		9:  "UUID",
+		10: "Date",
+		11: "Timestamp",
+		12: "CardNumber",
 	}
 	TokenType_value = map[string]int32{
-		"Unknown":  0,
-		"Int32":    1,
-		"Int64":    2,
-		"String":   3,
-		"Bytes":    4,
-		"Email":    5,
-		"Int32Str": 6,
-		"Int64Str": 7,
+		"Unknown":    0,
+		"Int32":      1,
+		"Int64":      2,
+		"String":     3,
+		"Bytes":      4,
+		"Email":      5,
+		"Int32Str":   6,
+		"Int64Str":   7,
+		"Phone":      8,
+		"UUID":       9,
+		"Date":       10,
+		"Timestamp":  11,
+		"CardNumber": 12,
 	}
 )
 
@@ -156,18 +165,22 @@ var file_tokenTypes_proto_rawDesc = []byte{
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25,
 	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x6c, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x10,
-	0x03, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x33, 0x32,
-	0x53, 0x74, 0x72, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x53, 0x74,
-	0x72, 0x10, 0x07, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x73, 0x61, 0x63, 0x6b, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x63,
-	0x72, 0x61, 0x2d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x70, 0x73,
-	0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0xaa, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x10, 0x04, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x53, 0x74, 0x72, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x53,
+	0x74, 0x72, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x10, 0x08, 0x12,
+	0x08, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x65, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x10, 0x0c, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x73, 0x61, 0x63, 0x6b, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x61, 0x63, 0x72,
+	0x61, 0x2d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x70, 0x73, 0x65,
+	0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8,7 +8,9 @@ import (
 	"strconv"
 
 	"github.com/cossacklabs/acra/decryptor/base"
+	"github.com/cossacklabs/acra/decryptor/base/access_policy"
 	"github.com/cossacklabs/acra/encryptor"
+	"github.com/cossacklabs/acra/encryptor/config"
 	"github.com/cossacklabs/acra/pseudonymization/common"
 )
 
@@ -32,8 +34,16 @@ func (p *TokenProcessor) OnColumn(ctx context.Context, data []byte) (context.Con
 	accessContext := base.AccessContextFromContext(ctx)
 	columnSetting, ok := encryptor.EncryptionSettingFromContext(ctx)
 	if ok && columnSetting.IsTokenized() {
+		if access_policy.ActionFromContext(ctx, columnSetting) == config.AccessActionTokenized {
+			// access policy allows to see only tokens
+			return ctx, data, nil
+		}
 		tokenContext := common.TokenContext{ClientID: accessContext.GetClientID(), ZoneID: accessContext.GetZoneID()}
 		data, err := p.tokenizer.Detokenize(data, tokenContext, columnSetting)
+		if err == common.ErrTokenExpired {
+			logging.GetLoggerFromContext(ctx).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorTokenExpired).
+				WithField("column", columnSetting.ColumnName()).Warningln("Refused to detokenize expired token")
+		}
 		return ctx, data, err
 	}
 	return ctx, data, nil
@@ -79,12 +89,15 @@ func (p *PgSQLDataEncoderProcessor) OnColumn(ctx context.Context, data []byte) (
 	if !(ok && columnSetting.IsTokenized()) {
 		return ctx, data, nil
 	}
-	// process only int tokenization
-	switch columnSetting.GetTokenType() {
+	// process only int tokenization and types with different binary format
+	tokenType := columnSetting.GetTokenType()
+	switch tokenType {
 	case common.TokenType_Int64, common.TokenType_Int32:
 		break
 	default:
-		return ctx, data, nil
+		if !IsPgSQLBinaryTokenType(tokenType) {
+			return ctx, data, nil
+		}
 	}
 	logger := logging.GetLoggerFromContext(ctx)
 	newData := data
@@ -98,6 +111,21 @@ func (p *PgSQLDataEncoderProcessor) OnColumn(ctx context.Context, data []byte) (
 	if !columnInfo.IsBinaryFormat() {
 		return ctx, data, nil
 	}
+	if IsPgSQLBinaryTokenType(tokenType) {
+		var err error
+		switch p.mode {
+		case DataEncoderModeEncode:
+			newData, err = EncodePgSQLBinaryValue(tokenType, data)
+		case DataEncoderModeDecode:
+			newData, err = DecodePgSQLBinaryValue(tokenType, data)
+		default:
+			err = ErrInvalidDataEncoderMode
+		}
+		if err != nil {
+			return ctx, data, err
+		}
+		return ctx, newData, nil
+	}
 	if p.mode == DataEncoderModeEncode {
 		// convert back from text to binary
 		value, err := strconv.ParseInt(string(data), 10, 64)
@@ -142,3 +170,57 @@ func (p *PgSQLDataEncoderProcessor) OnColumn(ctx context.Context, data []byte) (
 	return ctx, newData, nil
 
 }
+
+// mysqlBinaryDateTimeKey used to mark values decoded from MySQL binary protocol format to encode them back
+type mysqlBinaryDateTimeKey struct{}
+
+// MySQLDataEncoderProcessor implements processor and encode/decode date and timestamp values of MySQL binary protocol
+// to text format which acceptable by Tokenizer
+type MySQLDataEncoderProcessor struct {
+	mode DataEncoderMode
+}
+
+// NewMySQLDataEncoderProcessor return new data encoder/decoder from/to binary format for tokenization
+func NewMySQLDataEncoderProcessor(mode DataEncoderMode) (*MySQLDataEncoderProcessor, error) {
+	switch mode {
+	case DataEncoderModeDecode, DataEncoderModeEncode:
+		return &MySQLDataEncoderProcessor{mode}, nil
+	}
+	return nil, ErrInvalidDataEncoderMode
+}
+
+// ID return name of processor
+func (p *MySQLDataEncoderProcessor) ID() string {
+	return "MySQLDataEncoderProcessor"
+}
+
+// OnColumn decode binary date/timestamp value to text and back. Should be before and after tokenizer processor
+func (p *MySQLDataEncoderProcessor) OnColumn(ctx context.Context, data []byte) (context.Context, []byte, error) {
+	columnSetting, ok := encryptor.EncryptionSettingFromContext(ctx)
+	if !(ok && columnSetting.IsTokenized() && IsMySQLBinaryTokenType(columnSetting.GetTokenType())) {
+		return ctx, data, nil
+	}
+	switch p.mode {
+	case DataEncoderModeDecode:
+		columnInfo, ok := base.ColumnInfoFromContext(ctx)
+		// text values of string columns are passed as binary too so decode only values of binary DATE/DATETIME/TIMESTAMP size
+		if !ok || !columnInfo.IsBinaryFormat() || !IsMySQLBinaryDateTime(data) {
+			return ctx, data, nil
+		}
+		newData, err := DecodeMySQLBinaryValue(columnSetting.GetTokenType(), data)
+		if err != nil {
+			return ctx, data, err
+		}
+		return context.WithValue(ctx, mysqlBinaryDateTimeKey{}, true), newData, nil
+	case DataEncoderModeEncode:
+		if decoded, ok := ctx.Value(mysqlBinaryDateTimeKey{}).(bool); !ok || !decoded {
+			return ctx, data, nil
+		}
+		newData, err := EncodeMySQLBinaryValue(columnSetting.GetTokenType(), data)
+		if err != nil {
+			return ctx, data, err
+		}
+		return context.WithValue(ctx, mysqlBinaryDateTimeKey{}, false), newData, nil
+	}
+	return ctx, data, ErrInvalidDataEncoderMode
+}
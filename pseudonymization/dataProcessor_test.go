@@ -6,6 +6,7 @@ import (
 	"github.com/cossacklabs/acra/decryptor/base"
 	"github.com/cossacklabs/acra/encryptor"
 	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/pseudonymization/common"
 	"strconv"
 	"testing"
 )
@@ -236,3 +237,107 @@ func TestFailedEncodingInvalidTextValue(t *testing.T) {
 		t.Fatal("Result data should be the same")
 	}
 }
+
+func TestPgSQLEncodingDecodingBinaryTokenTypes(t *testing.T) {
+	type testcase struct {
+		tokenType   string
+		binValue    []byte
+		stringValue []byte
+	}
+	testcases := []testcase{
+		{"uuid", []byte{0xc8, 0xd6, 0xbb, 0x3a, 0x1b, 0x3c, 0x4b, 0x4e, 0x9f, 0x6e, 0x1c, 0x2d, 0x3e, 0x4f, 0x5a, 0x6b}, []byte("c8d6bb3a-1b3c-4b4e-9f6e-1c2d3e4f5a6b")},
+		// 2000-01-01 is zero point
+		{"date", []byte{0, 0, 0, 0}, []byte("2000-01-01")},
+		{"date", []byte{0, 0, 0x1f, 0x5a}, []byte("2021-12-22")},
+		{"date", []byte{0xff, 0xff, 0xff, 0xff}, []byte("1999-12-31")},
+		{"timestamp", []byte{0, 0, 0, 0, 0, 0, 0, 0}, []byte("2000-01-01 00:00:00")},
+		{"timestamp", []byte{0, 0x02, 0x76, 0xb8, 0x1c, 0x2b, 0xf2, 0x08}, []byte("2021-12-22 10:11:12.1234")},
+		{"timestamp", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, []byte("1999-12-31 23:59:59.999999")},
+	}
+	encoder, err := NewPgSQLDataEncoderProcessor(DataEncoderModeEncode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder, err := NewPgSQLDataEncoderProcessor(DataEncoderModeDecode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tcase := range testcases {
+		accessContext := &base.AccessContext{}
+		accessContext.SetColumnInfo(base.NewColumnInfo(0, "", true, len(tcase.binValue)))
+		ctx := base.SetAccessContextToContext(context.Background(), accessContext)
+		testSetting := config.BasicColumnEncryptionSetting{Tokenized: true, TokenType: tcase.tokenType}
+		ctx = encryptor.NewContextWithEncryptionSetting(ctx, &testSetting)
+		ctx, strData, err := decoder.OnColumn(ctx, tcase.binValue)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if !bytes.Equal(tcase.stringValue, strData) {
+			t.Fatalf("[%d] Expect '%s', took '%s'\n", i, tcase.stringValue, strData)
+		}
+		_, binData, err := encoder.OnColumn(ctx, strData)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if !bytes.Equal(binData, tcase.binValue) {
+			t.Fatalf("[%d] Expect '%v', took '%v'\n", i, tcase.binValue, binData)
+		}
+	}
+	// binary values of text columns and values with unexpected size
+	if _, err := DecodePgSQLBinaryValue(common.TokenType_Date, []byte("2021-12-22")); err != ErrInvalidBinaryValue {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidBinaryValue, err)
+	}
+}
+
+func TestMySQLEncodingDecodingProcessor(t *testing.T) {
+	type testcase struct {
+		tokenType   string
+		binValue    []byte
+		stringValue []byte
+		// binary value after encoding if differs from source
+		encodedValue []byte
+	}
+	testcases := []testcase{
+		{"date", []byte{0xe5, 0x07, 12, 22}, []byte("2021-12-22"), nil},
+		{"timestamp", []byte{0xe5, 0x07, 12, 22, 10, 11, 12}, []byte("2021-12-22 10:11:12"), nil},
+		{"timestamp", []byte{0xe5, 0x07, 12, 22, 10, 11, 12, 0x40, 0xe2, 0x01, 0x00}, []byte("2021-12-22 10:11:12.123456"), nil},
+		// MySQL omits time part of midnight timestamps
+		{"timestamp", []byte{0xe5, 0x07, 12, 22}, []byte("2021-12-22 00:00:00"), []byte{0xe5, 0x07, 12, 22, 0, 0, 0}},
+		// text values passed as is
+		{"date", []byte("2021-12-22"), []byte("2021-12-22"), nil},
+		{"str", []byte{0xe5, 0x07, 12, 22}, []byte{0xe5, 0x07, 12, 22}, nil},
+	}
+	encoder, err := NewMySQLDataEncoderProcessor(DataEncoderModeEncode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder, err := NewMySQLDataEncoderProcessor(DataEncoderModeDecode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tcase := range testcases {
+		accessContext := &base.AccessContext{}
+		accessContext.SetColumnInfo(base.NewColumnInfo(0, "", true, len(tcase.binValue)))
+		ctx := base.SetAccessContextToContext(context.Background(), accessContext)
+		testSetting := config.BasicColumnEncryptionSetting{Tokenized: true, TokenType: tcase.tokenType}
+		ctx = encryptor.NewContextWithEncryptionSetting(ctx, &testSetting)
+		ctx, strData, err := decoder.OnColumn(ctx, tcase.binValue)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if !bytes.Equal(tcase.stringValue, strData) {
+			t.Fatalf("[%d] Expect '%s', took '%s'\n", i, tcase.stringValue, strData)
+		}
+		_, binData, err := encoder.OnColumn(ctx, strData)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		expected := tcase.binValue
+		if tcase.encodedValue != nil {
+			expected = tcase.encodedValue
+		}
+		if !bytes.Equal(binData, expected) {
+			t.Fatalf("[%d] Expect '%v', took '%v'\n", i, expected, binData)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package pseudonymization
 
 import (
 	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/keystore"
 	"strconv"
 
 	"github.com/cossacklabs/acra/pseudonymization/common"
@@ -27,19 +28,34 @@ import (
 // DataTokenizer tokenizes and detokenizes data buffers.
 type DataTokenizer struct {
 	tokenizer common.Pseudoanonymizer
+	keystore  keystore.TokenKeystore
 }
 
-// NewDataTokenizer makes a new data buffer tokenizer based on provided pseudoanonymizer.
-func NewDataTokenizer(tokenizer common.Pseudoanonymizer) (*DataTokenizer, error) {
-	return &DataTokenizer{tokenizer}, nil
+// NewDataTokenizer makes a new data buffer tokenizer based on provided pseudoanonymizer. Keystore is used for columns
+// with format-preserving tokenization.
+func NewDataTokenizer(tokenizer common.Pseudoanonymizer, keystore keystore.TokenKeystore) (*DataTokenizer, error) {
+	return &DataTokenizer{tokenizer: tokenizer, keystore: keystore}, nil
+}
+
+// getTokenizer return pseudoanonymizer which should be used for the column
+func (t *DataTokenizer) getTokenizer(setting config.ColumnEncryptionSetting) (common.Pseudoanonymizer, error) {
+	if setting.IsFormatPreservingTokenization() {
+		return NewFormatPreservingPseudoanonymizer(t.keystore, setting.GetFormatPreservingOptions())
+	}
+	return t.tokenizer, nil
 }
 
 // Tokenize the data in given context with provided settings.
 func (t *DataTokenizer) Tokenize(data []byte, context common.TokenContext, setting config.ColumnEncryptionSetting) ([]byte, error) {
-	anonymize := t.tokenizer.Anonymize
+	tokenizer, err := t.getTokenizer(setting)
+	if err != nil {
+		return nil, err
+	}
+	anonymize := tokenizer.Anonymize
 	if setting.IsConsistentTokenization() {
-		anonymize = t.tokenizer.AnonymizeConsistently
+		anonymize = tokenizer.AnonymizeConsistently
 	}
+	context.Retention = setting.GetTokenRetention()
 
 	logrus.WithFields(logrus.Fields{"column": setting.ColumnName(), "client_id": string(context.ClientID), "zone_id": string(context.ZoneID)}).Debugln("Tokenize with DataTokenizer")
 	tokenType := setting.GetTokenType()
@@ -87,6 +103,9 @@ func (t *DataTokenizer) Tokenize(data []byte, context common.TokenContext, setti
 		}
 		return []byte(newVal.(common.Email)), nil
 
+	case common.TokenType_Phone, common.TokenType_UUID, common.TokenType_Date, common.TokenType_Timestamp, common.TokenType_CardNumber:
+		return processTextValue(data, context, tokenType, anonymize)
+
 	default:
 		logrus.WithField("type", tokenType).Debugln("Unknown token type")
 		return nil, ErrDataTypeMismatch
@@ -96,6 +115,10 @@ func (t *DataTokenizer) Tokenize(data []byte, context common.TokenContext, setti
 // Detokenize the data in given context with provided settings.
 func (t *DataTokenizer) Detokenize(data []byte, context common.TokenContext, setting config.ColumnEncryptionSetting) ([]byte, error) {
 	logrus.WithFields(logrus.Fields{"column": setting.ColumnName(), "client_id": string(context.ClientID), "zone_id": string(context.ZoneID)}).Debugln("Detokenize with DataTokenizer")
+	tokenizer, err := t.getTokenizer(setting)
+	if err != nil {
+		return nil, err
+	}
 	tokenType := setting.GetTokenType()
 	switch tokenType {
 	case common.TokenType_Int32:
@@ -103,7 +126,7 @@ func (t *DataTokenizer) Detokenize(data []byte, context common.TokenContext, set
 		if err != nil {
 			return nil, err
 		}
-		newVal, err := t.tokenizer.Deanonymize(int32(i), context, common.TokenType_Int32)
+		newVal, err := tokenizer.Deanonymize(int32(i), context, common.TokenType_Int32)
 		if err != nil {
 			return nil, err
 		}
@@ -114,33 +137,36 @@ func (t *DataTokenizer) Detokenize(data []byte, context common.TokenContext, set
 		if err != nil {
 			return nil, err
 		}
-		newVal, err := t.tokenizer.Deanonymize(i, context, common.TokenType_Int64)
+		newVal, err := tokenizer.Deanonymize(i, context, common.TokenType_Int64)
 		if err != nil {
 			return nil, err
 		}
 		return []byte(strconv.FormatInt(newVal.(int64), 10)), nil
 
 	case common.TokenType_String:
-		newVal, err := t.tokenizer.Deanonymize(string(data), context, common.TokenType_String)
+		newVal, err := tokenizer.Deanonymize(string(data), context, common.TokenType_String)
 		if err != nil {
 			return nil, err
 		}
 		return []byte(newVal.(string)), nil
 
 	case common.TokenType_Bytes:
-		newVal, err := t.tokenizer.Deanonymize(data, context, common.TokenType_Bytes)
+		newVal, err := tokenizer.Deanonymize(data, context, common.TokenType_Bytes)
 		if err != nil {
 			return nil, err
 		}
 		return newVal.([]byte), nil
 
 	case common.TokenType_Email:
-		newVal, err := t.tokenizer.Deanonymize(common.Email(data), context, common.TokenType_Email)
+		newVal, err := tokenizer.Deanonymize(common.Email(data), context, common.TokenType_Email)
 		if err != nil {
 			return nil, err
 		}
 		return []byte(newVal.(common.Email)), nil
 
+	case common.TokenType_Phone, common.TokenType_UUID, common.TokenType_Date, common.TokenType_Timestamp, common.TokenType_CardNumber:
+		return processTextValue(data, context, tokenType, tokenizer.Deanonymize)
+
 	default:
 		logrus.WithField("type", tokenType).Debugln("Unknown token type")
 		return nil, ErrDataTypeMismatch
@@ -0,0 +1,493 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pseudonymization
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/cossacklabs/acra/keystore"
+	"github.com/cossacklabs/acra/pseudonymization/common"
+	"github.com/cossacklabs/acra/pseudonymization/fpe"
+	"github.com/cossacklabs/acra/utils"
+)
+
+// ErrInvalidLuhnChecksum returned when value tokenized with luhn-valid tokens has invalid check digit
+var ErrInvalidLuhnChecksum = errors.New("value has invalid luhn check digit")
+
+// ErrFormatPreservingKeyVersionNotFound returned when ClientID/ZoneID has less token keys than required by key version
+var ErrFormatPreservingKeyVersionNotFound = errors.New("token key of format-preserving key version not found")
+
+// formatPreservingKeyLabel used to derive the key of format-preserving encryption from token key of ClientID/ZoneID
+var formatPreservingKeyLabel = []byte(`acra format-preserving tokenization`)
+
+// mixedRadixTweakName used in tweak of values with character classes encrypted together
+const mixedRadixTweakName = "mixed"
+
+// cycleWalkingMinBits is the minimal length of binary numeral strings with domain not less than 1000000 required by
+// FF1/FF3-1
+const cycleWalkingMinBits = 20
+
+// prefixCipherMaxDomain is the largest domain of values encrypted with prefix cipher instead of cycle walking. Both take
+// up to a few thousands of AES operations for domains of this size
+const prefixCipherMaxDomain = 1 << 12
+
+// characterClass is a set of characters of the value which are encrypted together and replaced with characters from
+// the same set
+type characterClass struct {
+	name     string
+	alphabet string
+	index    [256]int
+}
+
+func newCharacterClass(name, alphabet string) *characterClass {
+	class := &characterClass{name: name, alphabet: alphabet}
+	for i := range class.index {
+		class.index[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		class.index[alphabet[i]] = i
+	}
+	return class
+}
+
+// Characters of strings tokenized separately to keep digits as digits and letters as letters. Other characters like
+// separators and punctuation are left as is. All bytes of binary values are tokenized together
+var (
+	digitsClass  = newCharacterClass("digits", "0123456789")
+	lettersClass = newCharacterClass("letters", "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	bytesClass   = newCharacterClass("bytes", allBytes())
+)
+
+// allBytes return string of all byte values used as alphabet of binary values
+func allBytes() string {
+	alphabet := make([]byte, 256)
+	for i := range alphabet {
+		alphabet[i] = byte(i)
+	}
+	return string(alphabet)
+}
+
+// formatPreservingPseudoanonymizer generates tokens with format-preserving encryption. Tokens are deterministic for the
+// same ClientID/ZoneID and key version and decrypted back without any storage
+type formatPreservingPseudoanonymizer struct {
+	keystore keystore.TokenKeystore
+	options  common.FormatPreservingOptions
+}
+
+// NewFormatPreservingPseudoanonymizer return Pseudoanonymizer which generates tokens with FF1 or FF3-1 encryption with
+// keys derived from token keys of ClientID/ZoneID
+func NewFormatPreservingPseudoanonymizer(keystore keystore.TokenKeystore, options common.FormatPreservingOptions) (common.Pseudoanonymizer, error) {
+	if err := common.ValidateFormatPreservingAlgorithm(options.Algorithm); err != nil {
+		return nil, err
+	}
+	return &formatPreservingPseudoanonymizer{keystore: keystore, options: options}, nil
+}
+
+// getKey return AES key derived from the token key of the context with configured key version. Tokens aren't stored
+// anywhere and can't be re-encrypted, so versions are counted from the first token key and the key doesn't change when
+// new token keys are generated. Tokens stay reversible while the token key of their version is kept in the keystore
+func (p *formatPreservingPseudoanonymizer) getKey(context common.TokenContext) ([]byte, error) {
+	id, ownerType := context.ClientID, keystore.KeyOwnerType(keystore.KeyOwnerTypeClient)
+	if len(context.ZoneID) != 0 {
+		id, ownerType = context.ZoneID, keystore.KeyOwnerTypeZone
+	}
+	keys, err := p.keystore.GetDecryptionTokenSymmetricKeys(id, ownerType)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, key := range keys {
+			utils.ZeroizeSymmetricKey(key)
+		}
+	}()
+	if len(keys) == 0 {
+		return nil, keystore.ErrKeysNotFound
+	}
+	// keys are ordered from the newest to the oldest one
+	index := len(keys) - 1 - p.options.KeyVersion
+	if index < 0 {
+		return nil, ErrFormatPreservingKeyVersionNotFound
+	}
+	mac := hmac.New(sha256.New, keys[index])
+	mac.Write(formatPreservingKeyLabel)
+	mac.Write([]byte(p.options.GetAlgorithm()))
+	return mac.Sum(nil), nil
+}
+
+// newCipher return cipher of configured algorithm
+func (p *formatPreservingPseudoanonymizer) newCipher(key []byte, radix int) (fpe.Cipher, error) {
+	switch p.options.GetAlgorithm() {
+	case common.FormatPreservingAlgorithmFF1:
+		return fpe.NewFF1(key, radix)
+	case common.FormatPreservingAlgorithmFF31:
+		return fpe.NewFF31(key, radix)
+	default:
+		return nil, common.ErrUnsupportedFormatPreservingAlgorithm
+	}
+}
+
+// getTweak return tweak which separates tokens of different key versions, token types and character classes
+func (p *formatPreservingPseudoanonymizer) getTweak(dataType common.TokenType, className string) []byte {
+	h := sha256.New()
+	version := make([]byte, 4)
+	binary.BigEndian.PutUint32(version, uint32(p.options.KeyVersion))
+	h.Write(version)
+	h.Write([]byte(strconv.Itoa(int(dataType))))
+	h.Write([]byte(className))
+	tweak := h.Sum(nil)
+	if p.options.GetAlgorithm() == common.FormatPreservingAlgorithmFF31 {
+		return tweak[:fpe.FF31TweakSize]
+	}
+	return tweak
+}
+
+// numeralString is a numeral string of characters of one class in the value
+type numeralString struct {
+	class     *characterClass
+	positions []int
+	numerals  []uint16
+	// luhn is true if the last numeral is a luhn check digit which isn't encrypted and is recalculated instead
+	luhn bool
+}
+
+// body return numerals which should be encrypted
+func (s *numeralString) body() []uint16 {
+	if s.luhn {
+		return s.numerals[:len(s.numerals)-1]
+	}
+	return s.numerals
+}
+
+// setBody replaces numerals with encrypted or decrypted ones and recalculates the luhn check digit
+func (s *numeralString) setBody(body []uint16) {
+	if s.luhn {
+		body = append(body, luhnCheckDigit(body))
+	}
+	s.numerals = body
+}
+
+// process encrypts or decrypts value preserving its format
+func (p *formatPreservingPseudoanonymizer) process(data []byte, context common.TokenContext, dataType common.TokenType, decrypt bool) ([]byte, error) {
+	if err := p.options.Validate(dataType); err != nil {
+		return nil, err
+	}
+	classes := []*characterClass{digitsClass, lettersClass}
+	if dataType == common.TokenType_Bytes {
+		classes = []*characterClass{bytesClass}
+	}
+	numeralStrings := make([]*numeralString, 0, len(classes))
+	for _, class := range classes {
+		numerals := &numeralString{class: class, luhn: class == digitsClass && p.options.LuhnValid}
+		for i, c := range data {
+			if index := class.index[c]; index >= 0 {
+				numerals.positions = append(numerals.positions, i)
+				numerals.numerals = append(numerals.numerals, uint16(index))
+			}
+		}
+		if len(numerals.numerals) == 0 {
+			continue
+		}
+		if numerals.luhn && !decrypt && !isLuhnValid(numerals.numerals) {
+			return nil, ErrInvalidLuhnChecksum
+		}
+		numeralStrings = append(numeralStrings, numerals)
+	}
+
+	key, err := p.getKey(context)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.ZeroizeSymmetricKey(key)
+
+	err = p.processNumeralStrings(key, numeralStrings, dataType, decrypt)
+	if err == fpe.ErrInvalidLength {
+		// some character class is too short to be encrypted separately. Count of characters of each class is the same
+		// in the value and its token, so decryption falls back in the same way
+		err = p.processMixedRadix(key, numeralStrings, p.getTweak(dataType, mixedRadixTweakName), decrypt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	output := make([]byte, len(data))
+	copy(output, data)
+	for _, numerals := range numeralStrings {
+		for i, position := range numerals.positions {
+			output[position] = numerals.class.alphabet[numerals.numerals[i]]
+		}
+	}
+	return output, nil
+}
+
+// processNumeralStrings encrypts or decrypts numeral strings of each character class separately. Numeral strings are
+// changed only if all of them are processed successfully
+func (p *formatPreservingPseudoanonymizer) processNumeralStrings(key []byte, numeralStrings []*numeralString, dataType common.TokenType, decrypt bool) error {
+	bodies := make([][]uint16, len(numeralStrings))
+	for i, numerals := range numeralStrings {
+		body, err := p.processNumerals(key, len(numerals.class.alphabet), numerals.body(), p.getTweak(dataType, numerals.class.name), decrypt)
+		if err != nil {
+			return err
+		}
+		bodies[i] = body
+	}
+	for i, numerals := range numeralStrings {
+		numerals.setBody(bodies[i])
+	}
+	return nil
+}
+
+// processNumerals encrypts or decrypts numeral string
+func (p *formatPreservingPseudoanonymizer) processNumerals(key []byte, radix int, numerals []uint16, tweak []byte, decrypt bool) ([]uint16, error) {
+	cipher, err := p.newCipher(key, radix)
+	if err != nil {
+		return nil, err
+	}
+	if decrypt {
+		return cipher.Decrypt(numerals, tweak)
+	}
+	return cipher.Encrypt(numerals, tweak)
+}
+
+// processMixedRadix encrypts or decrypts numerals of all character classes together as one integer in mixed radix, so
+// short values are encrypted while digits stay digits and letters stay letters
+func (p *formatPreservingPseudoanonymizer) processMixedRadix(key []byte, numeralStrings []*numeralString, tweak []byte, decrypt bool) error {
+	domain := big.NewInt(1)
+	value := new(big.Int)
+	radix := new(big.Int)
+	numeral := new(big.Int)
+	for _, numerals := range numeralStrings {
+		radix.SetInt64(int64(len(numerals.class.alphabet)))
+		for _, n := range numerals.body() {
+			value.Mul(value, radix)
+			value.Add(value, numeral.SetUint64(uint64(n)))
+			domain.Mul(domain, radix)
+		}
+	}
+	if domain.IsInt64() && domain.Int64() == 1 {
+		return nil
+	}
+	var err error
+	if domain.IsInt64() && domain.Int64() <= prefixCipherMaxDomain {
+		value, err = processPrefixCipher(key, value.Int64(), domain.Int64(), tweak, decrypt)
+	} else {
+		value, err = p.processCycleWalking(key, value, domain, tweak, decrypt)
+	}
+	if err != nil {
+		return err
+	}
+	bodies := make([][]uint16, len(numeralStrings))
+	for i := len(numeralStrings) - 1; i >= 0; i-- {
+		radix.SetInt64(int64(len(numeralStrings[i].class.alphabet)))
+		body := make([]uint16, len(numeralStrings[i].body()))
+		for j := len(body) - 1; j >= 0; j-- {
+			value.DivMod(value, radix, numeral)
+			body[j] = uint16(numeral.Uint64())
+		}
+		bodies[i] = body
+	}
+	for i, numerals := range numeralStrings {
+		numerals.setBody(bodies[i])
+	}
+	return nil
+}
+
+// processCycleWalking encrypts or decrypts value from range [0, domain) with binary FF1/FF3-1 of at least
+// cycleWalkingMinBits bits repeatedly until the result gets into the same range
+func (p *formatPreservingPseudoanonymizer) processCycleWalking(key []byte, value, domain *big.Int, tweak []byte, decrypt bool) (*big.Int, error) {
+	bits := new(big.Int).Sub(domain, big.NewInt(1)).BitLen()
+	if bits < cycleWalkingMinBits {
+		bits = cycleWalkingMinBits
+	}
+	cipher, err := p.newCipher(key, 2)
+	if err != nil {
+		return nil, err
+	}
+	result := new(big.Int).Set(value)
+	numerals := make([]uint16, bits)
+	for {
+		for i := range numerals {
+			numerals[i] = uint16(result.Bit(bits - 1 - i))
+		}
+		if decrypt {
+			numerals, err = cipher.Decrypt(numerals, tweak)
+		} else {
+			numerals, err = cipher.Encrypt(numerals, tweak)
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.SetInt64(0)
+		for i, numeral := range numerals {
+			result.SetBit(result, bits-1-i, uint(numeral))
+		}
+		if result.Cmp(domain) < 0 {
+			return result, nil
+		}
+	}
+}
+
+// processPrefixCipher encrypts or decrypts value from small range [0, domain) with permutation defined by the order of
+// AES encrypted values of the range
+func processPrefixCipher(key []byte, value, domain int64, tweak []byte, decrypt bool) (*big.Int, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	input := make([]byte, aes.BlockSize)
+	copy(input[:8], tweak)
+	encrypted := make([][]byte, domain)
+	order := make([]int64, domain)
+	for i := range order {
+		order[i] = int64(i)
+		binary.BigEndian.PutUint64(input[8:], uint64(i))
+		encrypted[i] = make([]byte, aes.BlockSize)
+		block.Encrypt(encrypted[i], input)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return bytes.Compare(encrypted[order[i]], encrypted[order[j]]) < 0
+	})
+	if decrypt {
+		return big.NewInt(order[value]), nil
+	}
+	for i, x := range order {
+		if x == value {
+			return big.NewInt(int64(i)), nil
+		}
+	}
+	return nil, fpe.ErrInvalidNumeral
+}
+
+// luhnCheckDigit return check digit which should be appended to digits to get a valid luhn number
+func luhnCheckDigit(digits []uint16) uint16 {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i])
+		// digits are doubled starting from the rightmost one because check digit will be appended after it
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return uint16((10 - sum%10) % 10)
+}
+
+// isLuhnValid return true if the last digit is valid luhn check digit
+func isLuhnValid(digits []uint16) bool {
+	if len(digits) < 2 {
+		return false
+	}
+	return luhnCheckDigit(digits[:len(digits)-1]) == digits[len(digits)-1]
+}
+
+// Anonymize return token generated with format-preserving encryption
+func (p *formatPreservingPseudoanonymizer) Anonymize(data interface{}, context common.TokenContext, dataType common.TokenType) (interface{}, error) {
+	return p.processValue(data, context, dataType, false)
+}
+
+// AnonymizeConsistently return the same token as Anonymize because format-preserving encryption is deterministic
+func (p *formatPreservingPseudoanonymizer) AnonymizeConsistently(data interface{}, context common.TokenContext, dataType common.TokenType) (interface{}, error) {
+	return p.processValue(data, context, dataType, false)
+}
+
+// Deanonymize return source value decrypted from token
+func (p *formatPreservingPseudoanonymizer) Deanonymize(data interface{}, context common.TokenContext, dataType common.TokenType) (interface{}, error) {
+	return p.processValue(data, context, dataType, true)
+}
+
+// processValue encrypts or decrypts value of supported types
+func (p *formatPreservingPseudoanonymizer) processValue(data interface{}, context common.TokenContext, dataType common.TokenType, decrypt bool) (interface{}, error) {
+	switch dataType {
+	case common.TokenType_String:
+		v, ok := data.(string)
+		if !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		output, err := p.process([]byte(v), context, dataType, decrypt)
+		if err != nil {
+			return nil, err
+		}
+		return string(output), nil
+	case common.TokenType_Email:
+		v, ok := data.(common.Email)
+		if !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		output, err := p.process([]byte(v), context, dataType, decrypt)
+		if err != nil {
+			return nil, err
+		}
+		return common.Email(output), nil
+	case common.TokenType_Bytes:
+		v, ok := data.([]byte)
+		if !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		return p.process(v, context, dataType, decrypt)
+	case common.TokenType_Int32, common.TokenType_Int64, common.TokenType_Phone, common.TokenType_UUID,
+		common.TokenType_Date, common.TokenType_Timestamp, common.TokenType_CardNumber:
+		return nil, common.ErrUnsupportedFormatPreservingTokenType
+	default:
+		return nil, common.ErrUnknownTokenType
+	}
+}
+
+// AnonymizeInt32 isn't supported by format-preserving tokenization
+func (p *formatPreservingPseudoanonymizer) AnonymizeInt32(value int32, context common.TokenContext) (int32, error) {
+	return 0, common.ErrUnsupportedFormatPreservingTokenType
+}
+
+// AnonymizeInt64 isn't supported by format-preserving tokenization
+func (p *formatPreservingPseudoanonymizer) AnonymizeInt64(value int64, context common.TokenContext) (int64, error) {
+	return 0, common.ErrUnsupportedFormatPreservingTokenType
+}
+
+// AnonymizeBytes return token for []byte value
+func (p *formatPreservingPseudoanonymizer) AnonymizeBytes(value []byte, context common.TokenContext) ([]byte, error) {
+	newVal, err := p.Anonymize(value, context, common.TokenType_Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return newVal.([]byte), nil
+}
+
+// AnonymizeStr return token for string value
+func (p *formatPreservingPseudoanonymizer) AnonymizeStr(value string, context common.TokenContext) (string, error) {
+	newVal, err := p.Anonymize(value, context, common.TokenType_String)
+	if err != nil {
+		return "", err
+	}
+	return newVal.(string), nil
+}
+
+// AnonymizeEmail return token for Email value
+func (p *formatPreservingPseudoanonymizer) AnonymizeEmail(email common.Email, context common.TokenContext) (common.Email, error) {
+	newVal, err := p.Anonymize(email, context, common.TokenType_Email)
+	if err != nil {
+		return "", err
+	}
+	return newVal.(common.Email), nil
+}
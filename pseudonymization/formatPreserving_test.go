@@ -0,0 +1,288 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pseudonymization
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/cossacklabs/acra/keystore"
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+// testTokenKeyStore return token keys derived from ClientID/ZoneID. New slices returned on each call because they are
+// zeroized after use
+type testTokenKeyStore struct{}
+
+func (testTokenKeyStore) GetEncryptionTokenSymmetricKey(id []byte, ownerType keystore.KeyOwnerType) ([]byte, error) {
+	key := sha256.Sum256(append([]byte(strconv.Itoa(int(ownerType))), id...))
+	return key[:], nil
+}
+
+func (store testTokenKeyStore) GetDecryptionTokenSymmetricKeys(id []byte, ownerType keystore.KeyOwnerType) ([][]byte, error) {
+	key, err := store.GetEncryptionTokenSymmetricKey(id, ownerType)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{key}, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func TestFormatPreservingPseudoanonymizer(t *testing.T) {
+	clientContext := common.TokenContext{ClientID: []byte(`client`)}
+	testcases := []struct {
+		Value   interface{}
+		Type    common.TokenType
+		Options common.FormatPreservingOptions
+	}{
+		{Value: "4111-1111-1111-1111", Type: common.TokenType_String, Options: common.FormatPreservingOptions{}},
+		{Value: "4111-1111-1111-1111", Type: common.TokenType_String, Options: common.FormatPreservingOptions{Algorithm: common.FormatPreservingAlgorithmFF31}},
+		{Value: "4111 1111 1111 1111", Type: common.TokenType_String, Options: common.FormatPreservingOptions{LuhnValid: true}},
+		{Value: "4111111111111111", Type: common.TokenType_String, Options: common.FormatPreservingOptions{Algorithm: common.FormatPreservingAlgorithmFF31, LuhnValid: true}},
+		{Value: "John Smith, +380 (44) 123-45-67", Type: common.TokenType_String, Options: common.FormatPreservingOptions{}},
+		{Value: common.Email("john.smith@example.com"), Type: common.TokenType_Email, Options: common.FormatPreservingOptions{}},
+		{Value: common.Email("john.smith@example.com"), Type: common.TokenType_Email, Options: common.FormatPreservingOptions{Algorithm: common.FormatPreservingAlgorithmFF31}},
+		{Value: []byte("some binary data"), Type: common.TokenType_Bytes, Options: common.FormatPreservingOptions{}},
+		{Value: []byte("some binary data"), Type: common.TokenType_Bytes, Options: common.FormatPreservingOptions{Algorithm: common.FormatPreservingAlgorithmFF31}},
+		// values with character classes too short for FF1/FF3-1
+		{Value: "Bob", Type: common.TokenType_String, Options: common.FormatPreservingOptions{}},
+		{Value: "Jo", Type: common.TokenType_String, Options: common.FormatPreservingOptions{}},
+		{Value: "Jo", Type: common.TokenType_String, Options: common.FormatPreservingOptions{Algorithm: common.FormatPreservingAlgorithmFF31}},
+		{Value: "Smith 42", Type: common.TokenType_String, Options: common.FormatPreservingOptions{}},
+		{Value: "abcd-12", Type: common.TokenType_String, Options: common.FormatPreservingOptions{Algorithm: common.FormatPreservingAlgorithmFF31}},
+		{Value: "No. 7", Type: common.TokenType_String, Options: common.FormatPreservingOptions{}},
+		{Value: "No. 18", Type: common.TokenType_String, Options: common.FormatPreservingOptions{LuhnValid: true}},
+		{Value: common.Email("jo@ex.io"), Type: common.TokenType_Email, Options: common.FormatPreservingOptions{}},
+		{Value: []byte("ab"), Type: common.TokenType_Bytes, Options: common.FormatPreservingOptions{}},
+		{Value: []byte("ab"), Type: common.TokenType_Bytes, Options: common.FormatPreservingOptions{Algorithm: common.FormatPreservingAlgorithmFF31}},
+	}
+	for i, tcase := range testcases {
+		anonymizer, err := NewFormatPreservingPseudoanonymizer(testTokenKeyStore{}, tcase.Options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		token, err := anonymizer.Anonymize(tcase.Value, clientContext, tcase.Type)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if reflect.DeepEqual(token, tcase.Value) {
+			t.Fatalf("[%d] Token is equal to source value\n", i)
+		}
+		// tokens are deterministic without consistent tokenization
+		sameToken, err := anonymizer.Anonymize(tcase.Value, clientContext, tcase.Type)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(token, sameToken) {
+			t.Fatalf("[%d] Expect the same token, took %v and %v\n", i, token, sameToken)
+		}
+		// tokens of other context differ
+		zoneToken, err := anonymizer.Anonymize(tcase.Value, common.TokenContext{ZoneID: []byte(`client`)}, tcase.Type)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reflect.DeepEqual(token, zoneToken) {
+			t.Fatalf("[%d] Expect different tokens for different contexts\n", i)
+		}
+
+		if tcase.Type != common.TokenType_Bytes {
+			source := []byte(reflect.ValueOf(tcase.Value).String())
+			tokenValue := []byte(reflect.ValueOf(token).String())
+			if len(source) != len(tokenValue) {
+				t.Fatalf("[%d] Expect token with length %d, took %d\n", i, len(source), len(tokenValue))
+			}
+			digits := make([]uint16, 0, len(tokenValue))
+			for j := range source {
+				switch {
+				case isDigit(source[j]):
+					if !isDigit(tokenValue[j]) {
+						t.Fatalf("[%d] Expect digit at %d, took %c\n", i, j, tokenValue[j])
+					}
+					digits = append(digits, uint16(tokenValue[j]-'0'))
+				case isLetter(source[j]):
+					if !isLetter(tokenValue[j]) {
+						t.Fatalf("[%d] Expect letter at %d, took %c\n", i, j, tokenValue[j])
+					}
+				default:
+					if source[j] != tokenValue[j] {
+						t.Fatalf("[%d] Expect %c at %d, took %c\n", i, source[j], j, tokenValue[j])
+					}
+				}
+			}
+			if tcase.Options.LuhnValid && !isLuhnValid(digits) {
+				t.Fatalf("[%d] Expect luhn-valid token, took %s\n", i, tokenValue)
+			}
+		}
+
+		value, err := anonymizer.Deanonymize(token, clientContext, tcase.Type)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(value, tcase.Value) {
+			t.Fatalf("[%d] Expect %v, took %v\n", i, tcase.Value, value)
+		}
+	}
+}
+
+func TestFormatPreservingPseudoanonymizerInvalidInput(t *testing.T) {
+	if _, err := NewFormatPreservingPseudoanonymizer(testTokenKeyStore{}, common.FormatPreservingOptions{Algorithm: "ff3"}); err != common.ErrUnsupportedFormatPreservingAlgorithm {
+		t.Fatalf("Expect %s, took %v\n", common.ErrUnsupportedFormatPreservingAlgorithm, err)
+	}
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	testcases := []struct {
+		Value   interface{}
+		Type    common.TokenType
+		Options common.FormatPreservingOptions
+		Err     error
+	}{
+		{Value: int32(1), Type: common.TokenType_Int32, Err: common.ErrUnsupportedFormatPreservingTokenType},
+		{Value: int64(1), Type: common.TokenType_Int64, Err: common.ErrUnsupportedFormatPreservingTokenType},
+		{Value: common.Phone("+380441234567"), Type: common.TokenType_Phone, Err: common.ErrUnsupportedFormatPreservingTokenType},
+		{Value: common.Email("a@b.c"), Type: common.TokenType_Email, Options: common.FormatPreservingOptions{LuhnValid: true}, Err: common.ErrLuhnUnsupportedTokenType},
+		{Value: "4111111111111112", Type: common.TokenType_String, Options: common.FormatPreservingOptions{LuhnValid: true}, Err: ErrInvalidLuhnChecksum},
+		{Value: []byte("string"), Type: common.TokenType_String, Err: common.ErrUnknownTokenType},
+		{Value: "string", Type: common.TokenType_String, Options: common.FormatPreservingOptions{KeyVersion: -1}, Err: common.ErrInvalidFormatPreservingKeyVersion},
+	}
+	for i, tcase := range testcases {
+		anonymizer, err := NewFormatPreservingPseudoanonymizer(testTokenKeyStore{}, tcase.Options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := anonymizer.Anonymize(tcase.Value, context, tcase.Type); err != tcase.Err {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, tcase.Err, err)
+		}
+	}
+}
+
+func TestFormatPreservingMixedRadix(t *testing.T) {
+	anonymizer, err := NewFormatPreservingPseudoanonymizer(testTokenKeyStore{}, common.FormatPreservingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	// all values of small domains are mapped into different tokens and decrypted back
+	for _, values := range [][]string{{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}, {"a", "b", "Y", "Z"}, {"a1", "b2", "c3", "Z9"}} {
+		tokens := make(map[string]bool, len(values))
+		for _, value := range values {
+			token, err := anonymizer.Anonymize(value, context, common.TokenType_String)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tokens[token.(string)] {
+				t.Fatalf("Token %s of %s is taken by another value\n", token, value)
+			}
+			tokens[token.(string)] = true
+			source, err := anonymizer.Deanonymize(token, context, common.TokenType_String)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if source != value {
+				t.Fatalf("Expect %s, took %s\n", value, source)
+			}
+		}
+	}
+}
+
+// rotatedTokenKeyStore return new current token key before keys of testTokenKeyStore
+type rotatedTokenKeyStore struct {
+	testTokenKeyStore
+}
+
+func (store rotatedTokenKeyStore) GetEncryptionTokenSymmetricKey(id []byte, ownerType keystore.KeyOwnerType) ([]byte, error) {
+	key := sha256.Sum256(append([]byte(`rotated`), id...))
+	return key[:], nil
+}
+
+func (store rotatedTokenKeyStore) GetDecryptionTokenSymmetricKeys(id []byte, ownerType keystore.KeyOwnerType) ([][]byte, error) {
+	keys, err := store.testTokenKeyStore.GetDecryptionTokenSymmetricKeys(id, ownerType)
+	if err != nil {
+		return nil, err
+	}
+	key, err := store.GetEncryptionTokenSymmetricKey(id, ownerType)
+	if err != nil {
+		return nil, err
+	}
+	return append([][]byte{key}, keys...), nil
+}
+
+func TestFormatPreservingKeyRotation(t *testing.T) {
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	anonymizer, err := NewFormatPreservingPseudoanonymizer(testTokenKeyStore{}, common.FormatPreservingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := anonymizer.Anonymize("4111-1111-1111-1111", context, common.TokenType_String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// tokens of the same key version stay the same after rotation
+	rotatedAnonymizer, err := NewFormatPreservingPseudoanonymizer(rotatedTokenKeyStore{}, common.FormatPreservingOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotatedToken, err := rotatedAnonymizer.Anonymize("4111-1111-1111-1111", context, common.TokenType_String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotatedToken != token {
+		t.Fatalf("Expect the same token after key rotation, took %s and %s\n", token, rotatedToken)
+	}
+	value, err := rotatedAnonymizer.Deanonymize(token, context, common.TokenType_String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "4111-1111-1111-1111" {
+		t.Fatalf("Expect source value, took %s\n", value)
+	}
+
+	// the next key version uses the new token key
+	newVersionOptions := common.FormatPreservingOptions{KeyVersion: 1}
+	newVersionAnonymizer, err := NewFormatPreservingPseudoanonymizer(rotatedTokenKeyStore{}, newVersionOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newToken, err := newVersionAnonymizer.Anonymize("4111-1111-1111-1111", context, common.TokenType_String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newToken == token {
+		t.Fatalf("Expect different tokens of different key versions, took %s\n", token)
+	}
+	if value, err = newVersionAnonymizer.Deanonymize(newToken, context, common.TokenType_String); err != nil {
+		t.Fatal(err)
+	}
+	if value != "4111-1111-1111-1111" {
+		t.Fatalf("Expect source value, took %s\n", value)
+	}
+	// key version without token key
+	newVersionAnonymizer, err = NewFormatPreservingPseudoanonymizer(testTokenKeyStore{}, newVersionOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newVersionAnonymizer.Deanonymize(newToken, context, common.TokenType_String); err != ErrFormatPreservingKeyVersionNotFound {
+		t.Fatalf("Expect %s, took %v\n", ErrFormatPreservingKeyVersionNotFound, err)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fpe
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"math/big"
+)
+
+// ff1Rounds count of Feistel rounds of FF1
+const ff1Rounds = 10
+
+// ff1MaxLength limits length of numeral strings, the standard allows up to 2^32 numerals
+const ff1MaxLength = 1 << 16
+
+// FF1 implements FF1 mode of format-preserving encryption
+type FF1 struct {
+	block     cipher.Block
+	radix     int
+	minLength int
+}
+
+// NewFF1 return FF1 cipher with AES key of 16, 24 or 32 bytes for numeral strings with specified radix
+func NewFF1(key []byte, radix int) (*FF1, error) {
+	if err := validateRadix(radix); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &FF1{block: block, radix: radix, minLength: minLength(radix)}, nil
+}
+
+// Encrypt numeral string with tweak
+func (ff1 *FF1) Encrypt(numerals []uint16, tweak []byte) ([]uint16, error) {
+	return ff1.process(numerals, tweak, false)
+}
+
+// Decrypt numeral string with tweak
+func (ff1 *FF1) Decrypt(numerals []uint16, tweak []byte) ([]uint16, error) {
+	return ff1.process(numerals, tweak, true)
+}
+
+// prf is CBC-MAC with zero IV over data which length is multiple of block size
+func (ff1 *FF1) prf(data []byte) []byte {
+	result := make([]byte, aes.BlockSize)
+	for i := 0; i < len(data); i += aes.BlockSize {
+		for j := 0; j < aes.BlockSize; j++ {
+			result[j] ^= data[i+j]
+		}
+		ff1.block.Encrypt(result, result)
+	}
+	return result
+}
+
+// process implements FF1.Encrypt and FF1.Decrypt algorithms
+func (ff1 *FF1) process(numerals []uint16, tweak []byte, decrypt bool) ([]uint16, error) {
+	if err := validateNumerals(numerals, ff1.radix, ff1.minLength, ff1MaxLength); err != nil {
+		return nil, err
+	}
+	n := len(numerals)
+	u := n / 2
+	v := n - u
+	a := append([]uint16{}, numerals[:u]...)
+	b := append([]uint16{}, numerals[u:]...)
+
+	radix := big.NewInt(int64(ff1.radix))
+	modU := new(big.Int).Exp(radix, big.NewInt(int64(u)), nil)
+	modV := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+	// b = ceil(ceil(v * log2(radix)) / 8), log2 of radix^v is integer only for powers of 2
+	bitLength := modV.BitLen()
+	if new(big.Int).And(modV, new(big.Int).Sub(modV, big.NewInt(1))).Sign() == 0 {
+		bitLength--
+	}
+	numBytes := (bitLength + 7) / 8
+	d := 4*((numBytes+3)/4) + 4
+
+	t := len(tweak)
+	padding := (16 - (t+numBytes+1)%16) % 16
+	// P || Q where P is fixed block with parameters and Q contains tweak, round number and half of numeral string
+	pq := make([]byte, aes.BlockSize+t+padding+1+numBytes)
+	pq[0], pq[1], pq[2] = 1, 2, 1
+	pq[3], pq[4], pq[5] = byte(ff1.radix>>16), byte(ff1.radix>>8), byte(ff1.radix)
+	pq[6] = 10
+	pq[7] = byte(u)
+	binary.BigEndian.PutUint32(pq[8:12], uint32(n))
+	binary.BigEndian.PutUint32(pq[12:16], uint32(t))
+	copy(pq[aes.BlockSize:], tweak)
+	roundIndex := aes.BlockSize + t + padding
+
+	s := make([]byte, ((d+aes.BlockSize-1)/aes.BlockSize)*aes.BlockSize)
+	y := new(big.Int)
+	c := new(big.Int)
+	for round := 0; round < ff1Rounds; round++ {
+		i := round
+		source := b
+		if decrypt {
+			i = ff1Rounds - 1 - round
+			source = a
+		}
+		pq[roundIndex] = byte(i)
+		putBigInt(pq[roundIndex+1:], num(source, radix))
+		r := ff1.prf(pq)
+		copy(s, r)
+		for j := 1; j*aes.BlockSize < d; j++ {
+			block := s[j*aes.BlockSize : (j+1)*aes.BlockSize]
+			copy(block, r)
+			counter := uint64(j)
+			for k := aes.BlockSize - 1; k >= 0 && counter > 0; k-- {
+				block[k] ^= byte(counter)
+				counter >>= 8
+			}
+			ff1.block.Encrypt(block, block)
+		}
+		y.SetBytes(s[:d])
+
+		m, mod := u, modU
+		if i%2 == 1 {
+			m, mod = v, modV
+		}
+		if decrypt {
+			c.Sub(num(b, radix), y)
+			c.Mod(c, mod)
+			b, a = a, str(c, radix, m)
+		} else {
+			c.Add(num(a, radix), y)
+			c.Mod(c, mod)
+			a, b = b, str(c, radix, m)
+		}
+	}
+	return append(a, b...), nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fpe implements FF1 and FF3-1 format-preserving encryption modes of AES described in NIST SP 800-38G Rev. 1
+// https://doi.org/10.6028/NIST.SP.800-38G
+package fpe
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Cipher encrypts numeral strings into numeral strings with the same radix and length
+type Cipher interface {
+	Encrypt(numerals []uint16, tweak []byte) ([]uint16, error)
+	Decrypt(numerals []uint16, tweak []byte) ([]uint16, error)
+}
+
+// maxRadix is the largest radix supported by the implementation
+const maxRadix = 1 << 16
+
+// minDomainSize is the minimal count of possible values of the numeral string required by the standard
+const minDomainSize = 1000000
+
+// Errors returned for invalid parameters or input
+var (
+	ErrInvalidRadix   = errors.New("radix out of supported range")
+	ErrInvalidLength  = errors.New("length of numeral string out of supported range")
+	ErrInvalidNumeral = errors.New("numeral is not less than radix")
+	ErrInvalidTweak   = errors.New("invalid tweak length")
+)
+
+// validateRadix return error if radix isn't supported
+func validateRadix(radix int) error {
+	if radix < 2 || radix > maxRadix {
+		return ErrInvalidRadix
+	}
+	return nil
+}
+
+// minLength return minimal length of numeral string such that radix^minLength >= 1000000
+func minLength(radix int) int {
+	length := 0
+	for domain := 1; domain < minDomainSize; domain *= radix {
+		length++
+	}
+	if length < 2 {
+		return 2
+	}
+	return length
+}
+
+// validateNumerals return error if numeral string has unsupported length or contains numerals out of radix
+func validateNumerals(numerals []uint16, radix, minLength, maxLength int) error {
+	if len(numerals) < minLength || len(numerals) > maxLength {
+		return ErrInvalidLength
+	}
+	for _, numeral := range numerals {
+		if int(numeral) >= radix {
+			return ErrInvalidNumeral
+		}
+	}
+	return nil
+}
+
+// num return the number represented by the numeral string in the base radix with the most significant numeral first
+func num(numerals []uint16, radix *big.Int) *big.Int {
+	result := new(big.Int)
+	numeral := new(big.Int)
+	for _, value := range numerals {
+		result.Mul(result, radix)
+		result.Add(result, numeral.SetUint64(uint64(value)))
+	}
+	return result
+}
+
+// str return the representation of x as numeral string of the length m in the base radix
+func str(x *big.Int, radix *big.Int, m int) []uint16 {
+	numerals := make([]uint16, m)
+	value := new(big.Int).Set(x)
+	numeral := new(big.Int)
+	for i := m - 1; i >= 0; i-- {
+		value.DivMod(value, radix, numeral)
+		numerals[i] = uint16(numeral.Uint64())
+	}
+	return numerals
+}
+
+// reverse return numeral string in reversed order
+func reverse(numerals []uint16) []uint16 {
+	reversed := make([]uint16, len(numerals))
+	for i, numeral := range numerals {
+		reversed[len(numerals)-1-i] = numeral
+	}
+	return reversed
+}
+
+// reverseBytes return byte string in reversed order
+func reverseBytes(data []byte) []byte {
+	reversed := make([]byte, len(data))
+	for i, b := range data {
+		reversed[len(data)-1-i] = b
+	}
+	return reversed
+}
+
+// putBigInt writes x into buffer as big-endian integer aligned to the right side of buffer
+func putBigInt(buffer []byte, x *big.Int) {
+	for i := range buffer {
+		buffer[i] = 0
+	}
+	data := x.Bytes()
+	copy(buffer[len(buffer)-len(data):], data)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fpe
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+func toNumerals(t *testing.T, value string) []uint16 {
+	numerals := make([]uint16, len(value))
+	for i, c := range value {
+		index := strings.IndexRune(testAlphabet, c)
+		if index < 0 {
+			t.Fatalf("Invalid numeral %c\n", c)
+		}
+		numerals[i] = uint16(index)
+	}
+	return numerals
+}
+
+func fromNumerals(numerals []uint16) string {
+	output := make([]byte, len(numerals))
+	for i, numeral := range numerals {
+		output[i] = testAlphabet[numeral]
+	}
+	return string(output)
+}
+
+func decodeHex(t *testing.T, value string) []byte {
+	data, err := hex.DecodeString(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// Sample vectors from https://csrc.nist.gov/projects/cryptographic-standards-and-guidelines/example-values
+func TestFF1SampleVectors(t *testing.T) {
+	testcases := []struct {
+		key        string
+		radix      int
+		tweak      string
+		plaintext  string
+		ciphertext string
+	}{
+		{"2B7E151628AED2A6ABF7158809CF4F3C", 10, "", "0123456789", "2433477484"},
+		{"2B7E151628AED2A6ABF7158809CF4F3C", 10, "39383736353433323130", "0123456789", "6124200773"},
+		{"2B7E151628AED2A6ABF7158809CF4F3C", 36, "3737373770717273373737", "0123456789abcdefghi", "a9tv40mll9kdu509eum"},
+		{"2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F", 10, "", "0123456789", "2830668132"},
+		{"2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94", 10, "", "0123456789", "6657667009"},
+	}
+	for i, tcase := range testcases {
+		ff1, err := NewFF1(decodeHex(t, tcase.key), tcase.radix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tweak := decodeHex(t, tcase.tweak)
+		ciphertext, err := ff1.Encrypt(toNumerals(t, tcase.plaintext), tweak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromNumerals(ciphertext) != tcase.ciphertext {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.ciphertext, fromNumerals(ciphertext))
+		}
+		plaintext, err := ff1.Decrypt(ciphertext, tweak)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromNumerals(plaintext) != tcase.plaintext {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.plaintext, fromNumerals(plaintext))
+		}
+	}
+}
+
+// FF3-1 differs from FF3 only by tweak size so FF3 sample vectors with 64-bit tweaks used to check rounds
+func TestFF3SampleVectors(t *testing.T) {
+	testcases := []struct {
+		key        string
+		radix      int
+		tweak      string
+		plaintext  string
+		ciphertext string
+	}{
+		{"EF4359D8D580AA4F7F036D6F04FC6A94", 10, "D8E7920AFA330A73", "890121234567890000", "750918814058654607"},
+		{"EF4359D8D580AA4F7F036D6F04FC6A94", 10, "9A768A92F60E12D8", "890121234567890000", "018989839189395384"},
+		{"EF4359D8D580AA4F7F036D6F04FC6A94", 10, "D8E7920AFA330A73", "89012123456789000000789000000", "48598367162252569629397416226"},
+		{"EF4359D8D580AA4F7F036D6F04FC6A94", 26, "9A768A92F60E12D8", "0123456789abcdefghi", "g2pk40i992fn20cjakb"},
+	}
+	for i, tcase := range testcases {
+		ff3, err := NewFF31(decodeHex(t, tcase.key), tcase.radix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tweak := decodeHex(t, tcase.tweak)
+		ciphertext, err := ff3.process(toNumerals(t, tcase.plaintext), tweak[:4], tweak[4:], false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromNumerals(ciphertext) != tcase.ciphertext {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.ciphertext, fromNumerals(ciphertext))
+		}
+		plaintext, err := ff3.process(ciphertext, tweak[:4], tweak[4:], true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromNumerals(plaintext) != tcase.plaintext {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.plaintext, fromNumerals(plaintext))
+		}
+	}
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	key := decodeHex(t, "2B7E151628AED2A6ABF7158809CF4F3CEF4359D8D580AA4F7F036D6F04FC6A94")
+	for _, radix := range []int{10, 52, 256} {
+		ff1, err := NewFF1(key, radix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ff3, err := NewFF31(key, radix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tcase := range []struct {
+			cipher Cipher
+			tweak  []byte
+		}{{ff1, []byte("tweak")}, {ff3, []byte("7 bytes")}} {
+			for length := minLength(radix); length < 20; length++ {
+				numerals := make([]uint16, length)
+				for i := range numerals {
+					numerals[i] = uint16((i*7 + length) % radix)
+				}
+				ciphertext, err := tcase.cipher.Encrypt(numerals, tcase.tweak)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(ciphertext) != length || reflect.DeepEqual(ciphertext, numerals) {
+					t.Fatalf("Expect different ciphertext with length %d, took %v\n", length, ciphertext)
+				}
+				plaintext, err := tcase.cipher.Decrypt(ciphertext, tcase.tweak)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(plaintext, numerals) {
+					t.Fatalf("Expect %v, took %v\n", numerals, plaintext)
+				}
+			}
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	key := make([]byte, 16)
+	if _, err := NewFF1(key, 1); err != ErrInvalidRadix {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidRadix, err)
+	}
+	ff1, err := NewFF1(key, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ff3, err := NewFF31(key, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 10^5 values is less than required minimum
+	if _, err := ff1.Encrypt([]uint16{1, 2, 3, 4, 5}, nil); err != ErrInvalidLength {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidLength, err)
+	}
+	if _, err := ff1.Encrypt([]uint16{1, 2, 3, 4, 5, 10}, nil); err != ErrInvalidNumeral {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidNumeral, err)
+	}
+	// maxlen of FF3-1 for radix 10 is 56
+	if _, err := ff3.Encrypt(make([]uint16, 57), make([]byte, FF31TweakSize)); err != ErrInvalidLength {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidLength, err)
+	}
+	if _, err := ff3.Encrypt(make([]uint16, 10), make([]byte, 8)); err != ErrInvalidTweak {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidTweak, err)
+	}
+}
@@ -19,8 +19,14 @@ package pseudonymization
 import (
 	"crypto/rand"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	mrand "math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
 )
 
 const charset = "abcdefghijklmnopqrstuvwxyz" +
@@ -88,3 +94,132 @@ func randomRead(buf []byte) error {
 	}
 	return nil
 }
+
+// Errors returned for values which don't match format of the token type
+var (
+	ErrInvalidPhoneNumber = errors.New("phone number should be in E.164 format")
+	ErrInvalidUUID        = errors.New("UUID should be in canonical text format")
+	ErrInvalidCardNumber  = errors.New("card number should contain from 12 to 19 digits")
+)
+
+var (
+	phoneRegexp      = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	uuidRegexp       = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	cardNumberRegexp = regexp.MustCompile(`^[0-9]{12,19}$`)
+)
+
+// twoDigitCountryCodes are E.164 country calling codes of 2 digits. Codes which start with 1 and 7 have 1 digit, all
+// other codes have 3 digits
+// https://www.itu.int/pub/T-SP-E.164D
+var twoDigitCountryCodes = map[string]bool{
+	"20": true, "27": true, "30": true, "31": true, "32": true, "33": true, "34": true, "36": true, "39": true,
+	"40": true, "41": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "52": true, "53": true, "54": true, "55": true, "56": true, "57": true, "58": true,
+	"60": true, "61": true, "62": true, "63": true, "64": true, "65": true, "66": true,
+	"81": true, "82": true, "84": true, "86": true,
+	"90": true, "91": true, "92": true, "93": true, "94": true, "95": true, "98": true,
+}
+
+// countryCodeLength return length of the country calling code of phone number digits
+func countryCodeLength(digits string) int {
+	switch {
+	case digits[0] == '1' || digits[0] == '7':
+		return 1
+	case twoDigitCountryCodes[digits[:2]]:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// randomDigits fills buf with random decimal digits
+func randomDigits(buf []byte) {
+	for i := range buf {
+		buf[i] = byte('0' + seededRand.Intn(10))
+	}
+}
+
+// randomPhone return random phone number in E.164 format with the same country calling code and length
+func randomPhone(phone common.Phone) (common.Phone, error) {
+	if !phoneRegexp.MatchString(string(phone)) {
+		return "", ErrInvalidPhoneNumber
+	}
+	// skip leading +
+	codeLength := 1 + countryCodeLength(string(phone[1:]))
+	newPhone := make([]byte, len(phone))
+	copy(newPhone, phone[:codeLength])
+	randomDigits(newPhone[codeLength:])
+	return common.Phone(newPhone), nil
+}
+
+// normalizeUUID validates UUID and return it in lower case as PostgreSQL returns it
+func normalizeUUID(value common.UUID) (common.UUID, error) {
+	if !uuidRegexp.MatchString(string(value)) {
+		return "", ErrInvalidUUID
+	}
+	return common.UUID(strings.ToLower(string(value))), nil
+}
+
+// randomUUID return random UUID of version 4
+// https://datatracker.ietf.org/doc/html/rfc4122#section-4.4
+func randomUUID() (common.UUID, error) {
+	data := make([]byte, 16)
+	if err := randomRead(data); err != nil {
+		return "", err
+	}
+	data[6] = (data[6] & 0x0f) | 0x40
+	data[8] = (data[8] & 0x3f) | 0x80
+	output := make([]byte, 36)
+	hex.Encode(output[0:8], data[0:4])
+	output[8] = '-'
+	hex.Encode(output[9:13], data[4:6])
+	output[13] = '-'
+	hex.Encode(output[14:18], data[6:8])
+	output[18] = '-'
+	hex.Encode(output[19:23], data[8:10])
+	output[23] = '-'
+	hex.Encode(output[24:], data[10:])
+	return common.UUID(output), nil
+}
+
+// Range of generated dates and timestamps. It fits into MySQL's TIMESTAMP type which has the narrowest range
+var (
+	randomTimeRangeStart = time.Date(1970, time.January, 2, 0, 0, 0, 0, time.UTC)
+	randomTimeRangeEnd   = time.Date(2038, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// randomDate return random date without time part
+func randomDate() time.Time {
+	days := int(randomTimeRangeEnd.Sub(randomTimeRangeStart).Hours() / 24)
+	return randomTimeRangeStart.AddDate(0, 0, seededRand.Intn(days))
+}
+
+// randomTimestamp return random timestamp in the same location as value. Generated value has microseconds only if
+// value has fractional seconds because it may be stored in the column without them
+func randomTimestamp(value time.Time) time.Time {
+	seconds := randomTimeRangeEnd.Unix() - randomTimeRangeStart.Unix()
+	timestamp := time.Unix(randomTimeRangeStart.Unix()+seededRand.Int63n(seconds), 0)
+	if value.Nanosecond() != 0 {
+		timestamp = timestamp.Add(time.Duration(seededRand.Int63n(1000000)) * time.Microsecond)
+	}
+	return timestamp.In(value.Location())
+}
+
+// cardBINLength is a length of the bank identification number which is kept in generated card numbers
+const cardBINLength = 6
+
+// randomCardNumber return random card number with the same BIN and length and valid luhn check digit
+func randomCardNumber(card common.CardNumber) (common.CardNumber, error) {
+	if !cardNumberRegexp.MatchString(string(card)) {
+		return "", ErrInvalidCardNumber
+	}
+	newCard := make([]byte, len(card))
+	copy(newCard, card[:cardBINLength])
+	randomDigits(newCard[cardBINLength : len(newCard)-1])
+	digits := make([]uint16, len(newCard)-1)
+	for i := range digits {
+		digits[i] = uint16(newCard[i] - '0')
+	}
+	newCard[len(newCard)-1] = byte('0' + luhnCheckDigit(digits))
+	return common.CardNumber(newCard), nil
+}
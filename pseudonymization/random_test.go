@@ -18,7 +18,11 @@ package pseudonymization
 
 import (
 	"regexp"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
 )
 
 func TestEmailGeneration(t *testing.T) {
@@ -38,3 +42,85 @@ func TestEmailGeneration(t *testing.T) {
 	checkEmail("vassily.poupkine@bigco.has.long.address.net")
 	checkEmail("m@i.ni")
 }
+
+func TestPhoneGeneration(t *testing.T) {
+	testcases := []struct {
+		phone  common.Phone
+		prefix string
+	}{
+		{"+14155552671", "+1"},
+		{"+79161234567", "+7"},
+		{"+442071838750", "+44"},
+		{"+380441234567", "+380"},
+	}
+	for _, tcase := range testcases {
+		phone, err := randomPhone(tcase.phone)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(phone) != len(tcase.phone) || !strings.HasPrefix(string(phone), tcase.prefix) || !phoneRegexp.MatchString(string(phone)) {
+			t.Fatalf("Expect phone with prefix %s and length %d, took %s\n", tcase.prefix, len(tcase.phone), phone)
+		}
+	}
+}
+
+func TestCardNumberGeneration(t *testing.T) {
+	for _, card := range []common.CardNumber{"411111111111", "4111111111111111", "5500000000000004", "3530111333300000000"} {
+		newCard, err := randomCardNumber(card)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(newCard) != len(card) || newCard[:cardBINLength] != card[:cardBINLength] {
+			t.Fatalf("Expect card number with BIN %s and length %d, took %s\n", card[:cardBINLength], len(card), newCard)
+		}
+		digits := make([]uint16, len(newCard))
+		for i := range newCard {
+			digits[i] = uint16(newCard[i] - '0')
+		}
+		if !isLuhnValid(digits) {
+			t.Fatalf("Expect valid luhn check digit, took %s\n", newCard)
+		}
+	}
+}
+
+func TestUUIDGeneration(t *testing.T) {
+	uuidV4Regexp := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	for i := 0; i < 100; i++ {
+		uuid, err := randomUUID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !uuidV4Regexp.MatchString(string(uuid)) {
+			t.Fatalf("Invalid UUID %s\n", uuid)
+		}
+	}
+}
+
+func TestDateTimeGeneration(t *testing.T) {
+	location := time.FixedZone("", 2*60*60)
+	testcases := []struct {
+		value        time.Time
+		microseconds bool
+	}{
+		{time.Date(2021, time.December, 22, 10, 11, 12, 0, time.UTC), false},
+		{time.Date(2021, time.December, 22, 10, 11, 12, 123456000, location), true},
+	}
+	for i := 0; i < 100; i++ {
+		date := randomDate()
+		if date.Before(randomTimeRangeStart) || !date.Before(randomTimeRangeEnd) || date.Hour() != 0 || date.Minute() != 0 || date.Second() != 0 {
+			t.Fatalf("Invalid date %s\n", date)
+		}
+		for _, tcase := range testcases {
+			timestamp := randomTimestamp(tcase.value)
+			if timestamp.Before(randomTimeRangeStart) || !timestamp.Before(randomTimeRangeEnd) || timestamp.Location() != tcase.value.Location() {
+				t.Fatalf("Invalid timestamp %s\n", timestamp)
+			}
+			if !tcase.microseconds && timestamp.Nanosecond() != 0 {
+				t.Fatalf("Expect timestamp without fractional seconds, took %s\n", timestamp)
+			}
+			if timestamp.Nanosecond()%1000 != 0 {
+				t.Fatalf("Expect timestamp with microseconds precision, took %s\n", timestamp)
+			}
+		}
+	}
+}
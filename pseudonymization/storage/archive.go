@@ -0,0 +1,362 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+	"github.com/cossacklabs/themis/gothemis/cell"
+	"github.com/cossacklabs/themis/gothemis/keys"
+	"github.com/cossacklabs/themis/gothemis/message"
+)
+
+// Token archive is a stream of token entries with their metadata:
+//
+//	header: tokenArchiveMagic | version (1 byte)
+//	record: length (4 bytes, big endian) | Secure Cell Seal of the record with tokenArchiveMagic | index (8 bytes) as context
+//
+// Each record is encrypted and authenticated with the archive key and bound to its position, so records can't be
+// modified, reordered or dropped unnoticed. The last record is a trailer with count and checksum of all entries and
+// digest of all records, signed with Secure Message by private key of the exporter. So the archive may be verified
+// with public key by those who know the archive key but shouldn't be able to produce archives. An archive without
+// trailer is incomplete.
+var tokenArchiveMagic = []byte("ACRATOKENS")
+
+const (
+	tokenArchiveVersion byte = 2
+
+	tokenArchiveRecordEntry   byte = 1
+	tokenArchiveRecordTrailer byte = 2
+
+	// records are much smaller, limit protects from allocation of huge buffers for corrupted length
+	maxTokenArchiveRecordLength = 64 * 1024 * 1024
+)
+
+// Errors returned by token archive reader and writer
+var (
+	ErrInvalidTokenArchive         = errors.New("invalid token archive header")
+	ErrUnsupportedTokenArchive     = errors.New("unsupported version of token archive")
+	ErrTokenArchiveCorrupted       = errors.New("token archive is corrupted or encrypted with another key")
+	ErrTokenArchiveTruncated       = errors.New("token archive is incomplete")
+	ErrTokenArchiveChecksum        = errors.New("token archive count or checksum mismatch")
+	ErrTokenArchiveSignature       = errors.New("token archive signature is invalid or made with another key")
+	ErrTokenArchiveAlreadyComplete = errors.New("token archive is already complete")
+)
+
+// TokenEntrySummary is count and order-independent checksum of token entries. Checksum covers context, id and data
+// of entries, so it doesn't change when metadata is updated on access
+type TokenEntrySummary struct {
+	Count    uint64
+	Checksum [sha256.Size]byte
+}
+
+// Add entry to the summary
+func (s *TokenEntrySummary) Add(entry common.TokenEntry) {
+	h := sha256.New()
+	h.Write(lengthPrefixed(entry.ContextHash))
+	h.Write(lengthPrefixed(entry.ID))
+	h.Write(entry.Data)
+	digest := h.Sum(nil)
+	for i := range s.Checksum {
+		s.Checksum[i] ^= digest[i]
+	}
+	s.Count++
+}
+
+// Equal returns true if both count and checksum are equal
+func (s TokenEntrySummary) Equal(other TokenEntrySummary) bool {
+	return s == other
+}
+
+func appendUvarint(data []byte, value uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, value)
+	return append(data, buf[:n]...)
+}
+
+func lengthPrefixed(value []byte) []byte {
+	return append(appendUvarint(nil, uint64(len(value))), value...)
+}
+
+// TokenEntryKey return unique key of the entry by its context and id
+func TokenEntryKey(entry common.TokenEntry) string {
+	return string(lengthPrefixed(entry.ContextHash)) + string(entry.ID)
+}
+
+func tokenArchiveRecordContext(index uint64) []byte {
+	context := make([]byte, len(tokenArchiveMagic)+8)
+	copy(context, tokenArchiveMagic)
+	binary.BigEndian.PutUint64(context[len(tokenArchiveMagic):], index)
+	return context
+}
+
+// tokenArchiveDigest is a hash chain of the header and all records, so the signature of the trailer covers everything
+// written before it, including metadata of entries
+type tokenArchiveDigest [sha256.Size]byte
+
+func newTokenArchiveDigest(header []byte) tokenArchiveDigest {
+	return sha256.Sum256(header)
+}
+
+func (d *tokenArchiveDigest) add(record []byte) {
+	*d = sha256.Sum256(append(append([]byte{}, d[:]...), record...))
+}
+
+func tokenArchiveHeader() []byte {
+	return append(append([]byte{}, tokenArchiveMagic...), tokenArchiveVersion)
+}
+
+// TokenArchiveWriter writes token entries into archive
+type TokenArchiveWriter struct {
+	writer  io.Writer
+	scell   *cell.SecureCell
+	signer  *message.SecureMessage
+	index   uint64
+	digest  tokenArchiveDigest
+	summary TokenEntrySummary
+}
+
+// NewTokenArchiveWriter writes header of archive and return writer which encrypts records with the key and signs the
+// trailer with the private key
+func NewTokenArchiveWriter(writer io.Writer, key []byte, signingKey *keys.PrivateKey) (*TokenArchiveWriter, error) {
+	header := tokenArchiveHeader()
+	if _, err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	return &TokenArchiveWriter{
+		writer: writer,
+		scell:  cell.New(key, cell.ModeSeal),
+		signer: message.New(signingKey, nil),
+		digest: newTokenArchiveDigest(header),
+	}, nil
+}
+
+// ResumeTokenArchiveWriter return writer which appends records to incomplete archive after the last record read by
+// reader. Writer should be positioned at reader.Offset()
+func ResumeTokenArchiveWriter(writer io.Writer, key []byte, signingKey *keys.PrivateKey, reader *TokenArchiveReader) (*TokenArchiveWriter, error) {
+	if reader.complete {
+		return nil, ErrTokenArchiveAlreadyComplete
+	}
+	return &TokenArchiveWriter{
+		writer:  writer,
+		scell:   cell.New(key, cell.ModeSeal),
+		signer:  message.New(signingKey, nil),
+		index:   reader.index,
+		digest:  reader.digest,
+		summary: reader.summary,
+	}, nil
+}
+
+func (w *TokenArchiveWriter) writeRecord(record []byte) error {
+	encrypted, _, err := w.scell.Protect(record, tokenArchiveRecordContext(w.index))
+	if err != nil {
+		return err
+	}
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, uint32(len(encrypted)))
+	if _, err := w.writer.Write(length); err != nil {
+		return err
+	}
+	if _, err := w.writer.Write(encrypted); err != nil {
+		return err
+	}
+	w.index++
+	w.digest.add(record)
+	return nil
+}
+
+// WriteEntry appends entry to the archive
+func (w *TokenArchiveWriter) WriteEntry(entry common.TokenEntry) error {
+	record := []byte{tokenArchiveRecordEntry}
+	record = append(record, lengthPrefixed(entry.ContextHash)...)
+	record = append(record, lengthPrefixed(entry.ID)...)
+	record = append(record, common.EmbedMetadata(entry.Data, entry.Metadata)...)
+	if err := w.writeRecord(record); err != nil {
+		return err
+	}
+	w.summary.Add(entry)
+	return nil
+}
+
+// Close writes trailer with signed summary of written entries and digest of records. Underlying writer isn't closed
+func (w *TokenArchiveWriter) Close() error {
+	trailer := appendUvarint(nil, w.summary.Count)
+	trailer = append(trailer, w.summary.Checksum[:]...)
+	trailer = append(trailer, w.digest[:]...)
+	signed, err := w.signer.Sign(trailer)
+	if err != nil {
+		return err
+	}
+	return w.writeRecord(append([]byte{tokenArchiveRecordTrailer}, signed...))
+}
+
+// Summary return summary of all entries written into the archive, including resumed ones
+func (w *TokenArchiveWriter) Summary() TokenEntrySummary {
+	return w.summary
+}
+
+// TokenArchiveReader reads token entries from archive
+type TokenArchiveReader struct {
+	reader   io.Reader
+	scell    *cell.SecureCell
+	verifier *message.SecureMessage
+	index    uint64
+	offset   int64
+	digest   tokenArchiveDigest
+	summary  TokenEntrySummary
+	complete bool
+}
+
+// NewTokenArchiveReader reads header of archive and return reader which decrypts records with the key and verifies
+// signature of the trailer with the public key. It returns ErrTokenArchiveTruncated if the archive ends before the end
+// of header
+func NewTokenArchiveReader(reader io.Reader, key []byte, verificationKey *keys.PublicKey) (*TokenArchiveReader, error) {
+	header := make([]byte, len(tokenArchiveMagic)+1)
+	if n, err := io.ReadFull(reader, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			// archive may be interrupted while the header was written
+			if bytes.HasPrefix(tokenArchiveMagic, header[:n]) {
+				return nil, ErrTokenArchiveTruncated
+			}
+			return nil, ErrInvalidTokenArchive
+		}
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(tokenArchiveMagic)], tokenArchiveMagic) {
+		return nil, ErrInvalidTokenArchive
+	}
+	if header[len(tokenArchiveMagic)] != tokenArchiveVersion {
+		return nil, ErrUnsupportedTokenArchive
+	}
+	return &TokenArchiveReader{
+		reader:   reader,
+		scell:    cell.New(key, cell.ModeSeal),
+		verifier: message.New(nil, verificationKey),
+		offset:   int64(len(header)),
+		digest:   newTokenArchiveDigest(header),
+	}, nil
+}
+
+// Next return the next entry of the archive. It returns io.EOF after the trailer if its signature is valid and count
+// and checksum of read entries match it, ErrTokenArchiveSignature or ErrTokenArchiveChecksum if they don't and
+// ErrTokenArchiveTruncated if archive ends without trailer
+func (r *TokenArchiveReader) Next() (common.TokenEntry, error) {
+	if r.complete {
+		return common.TokenEntry{}, io.EOF
+	}
+	length := make([]byte, 4)
+	if _, err := io.ReadFull(r.reader, length); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return common.TokenEntry{}, ErrTokenArchiveTruncated
+		}
+		return common.TokenEntry{}, err
+	}
+	recordLength := binary.BigEndian.Uint32(length)
+	if recordLength > maxTokenArchiveRecordLength {
+		return common.TokenEntry{}, ErrTokenArchiveCorrupted
+	}
+	encrypted := make([]byte, recordLength)
+	if _, err := io.ReadFull(r.reader, encrypted); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return common.TokenEntry{}, ErrTokenArchiveTruncated
+		}
+		return common.TokenEntry{}, err
+	}
+	record, err := r.scell.Unprotect(encrypted, nil, tokenArchiveRecordContext(r.index))
+	if err != nil || len(record) == 0 {
+		return common.TokenEntry{}, ErrTokenArchiveCorrupted
+	}
+	switch record[0] {
+	case tokenArchiveRecordEntry:
+		entry, err := parseTokenArchiveEntry(record[1:])
+		if err != nil {
+			return common.TokenEntry{}, err
+		}
+		r.advance(len(length)+len(encrypted), record)
+		r.summary.Add(entry)
+		return entry, nil
+	case tokenArchiveRecordTrailer:
+		trailer, err := r.verifier.Verify(record[1:])
+		if err != nil {
+			return common.TokenEntry{}, ErrTokenArchiveSignature
+		}
+		count, n := binary.Uvarint(trailer)
+		if n <= 0 || len(trailer[n:]) != 2*sha256.Size {
+			return common.TokenEntry{}, ErrTokenArchiveCorrupted
+		}
+		if !bytes.Equal(trailer[n+sha256.Size:], r.digest[:]) {
+			// signed records differ from the read ones
+			return common.TokenEntry{}, ErrTokenArchiveSignature
+		}
+		summary := TokenEntrySummary{Count: count}
+		copy(summary.Checksum[:], trailer[n:])
+		if !summary.Equal(r.summary) {
+			return common.TokenEntry{}, ErrTokenArchiveChecksum
+		}
+		r.advance(len(length)+len(encrypted), record)
+		r.complete = true
+		return common.TokenEntry{}, io.EOF
+	default:
+		return common.TokenEntry{}, ErrTokenArchiveCorrupted
+	}
+}
+
+func (r *TokenArchiveReader) advance(length int, record []byte) {
+	r.index++
+	r.offset += int64(length)
+	r.digest.add(record)
+}
+
+// Offset return count of bytes of the header and records successfully read from the archive
+func (r *TokenArchiveReader) Offset() int64 {
+	return r.offset
+}
+
+// Summary return summary of entries read from the archive
+func (r *TokenArchiveReader) Summary() TokenEntrySummary {
+	return r.summary
+}
+
+// readLengthPrefixed return value prefixed with its length and the rest of data
+func readLengthPrefixed(data []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || uint64(len(data)-n) < length {
+		return nil, nil, ErrTokenArchiveCorrupted
+	}
+	return data[n : n+int(length)], data[n+int(length):], nil
+}
+
+func parseTokenArchiveEntry(record []byte) (common.TokenEntry, error) {
+	ctx, rest, err := readLengthPrefixed(record)
+	if err != nil {
+		return common.TokenEntry{}, err
+	}
+	id, rest, err := readLengthPrefixed(rest)
+	if err != nil {
+		return common.TokenEntry{}, err
+	}
+	data, metadata, err := common.ExtractMetadata(rest)
+	if err != nil {
+		return common.TokenEntry{}, ErrTokenArchiveCorrupted
+	}
+	return common.TokenEntry{ContextHash: ctx, ID: id, Data: data, Metadata: metadata}, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+	"github.com/cossacklabs/themis/gothemis/keys"
+)
+
+var testArchiveKey = []byte(`some test archive key with 32+ bytes`)
+
+func newTestSigningKeys(t *testing.T) *keys.Keypair {
+	keypair, err := keys.New(keys.TypeEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keypair
+}
+
+func testEntries(count int) []common.TokenEntry {
+	entries := make([]common.TokenEntry, count)
+	for i := range entries {
+		entries[i] = common.TokenEntry{
+			ContextHash: common.AggregateTokenContextToBytes(common.TokenContext{ClientID: []byte(fmt.Sprintf("client%d", i%2))}),
+			ID:          []byte(fmt.Sprintf("id%d", i)),
+			Data:        []byte(fmt.Sprintf("data%d", i)),
+			Metadata: common.TokenMetadata{
+				Created:   time.Unix(int64(1000+i), 0),
+				Accessed:  time.Unix(int64(2000+i), 0),
+				Disabled:  i%3 == 0,
+				Retention: common.TokenRetention{TTL: time.Duration(i) * time.Hour},
+			},
+		}
+	}
+	return entries
+}
+
+func writeTestArchive(t *testing.T, entries []common.TokenEntry, signingKeys *keys.Keypair) []byte {
+	output := &bytes.Buffer{}
+	writer, err := NewTokenArchiveWriter(output, testArchiveKey, signingKeys.Private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if err := writer.WriteEntry(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return output.Bytes()
+}
+
+// readTestArchive return all read entries and the error which stopped reading
+func readTestArchive(t *testing.T, archive []byte, key []byte, verificationKey *keys.PublicKey) (*TokenArchiveReader, []common.TokenEntry, error) {
+	reader, err := NewTokenArchiveReader(bytes.NewReader(archive), key, verificationKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	var entries []common.TokenEntry
+	for {
+		entry, err := reader.Next()
+		if err != nil {
+			return reader, entries, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func equalEntries(a, b []common.TokenEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i].ContextHash, b[i].ContextHash) || !bytes.Equal(a[i].ID, b[i].ID) ||
+			!bytes.Equal(a[i].Data, b[i].Data) || !a[i].Metadata.Equal(b[i].Metadata) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestTokenArchive(t *testing.T) {
+	signingKeys := newTestSigningKeys(t)
+	entries := testEntries(10)
+	archive := writeTestArchive(t, entries, signingKeys)
+	reader, read, err := readTestArchive(t, archive, testArchiveKey, signingKeys.Public)
+	if err != io.EOF {
+		t.Fatalf("Expect %s, took %v\n", io.EOF, err)
+	}
+	if !equalEntries(entries, read) {
+		t.Fatal("Read entries are not equal to written ones")
+	}
+	var summary TokenEntrySummary
+	for i := len(entries) - 1; i >= 0; i-- {
+		summary.Add(entries[i])
+	}
+	if !reader.Summary().Equal(summary) || summary.Count != 10 {
+		t.Fatalf("Expect summary %v, took %v\n", summary, reader.Summary())
+	}
+	if reader.Offset() != int64(len(archive)) {
+		t.Fatalf("Expect offset %d, took %d\n", len(archive), reader.Offset())
+	}
+	if _, err := ResumeTokenArchiveWriter(&bytes.Buffer{}, testArchiveKey, signingKeys.Private, reader); err != ErrTokenArchiveAlreadyComplete {
+		t.Fatalf("Expect %s, took %v\n", ErrTokenArchiveAlreadyComplete, err)
+	}
+}
+
+// splitTestArchive return header and records of the archive with their lengths
+func splitTestArchive(archive []byte) ([]byte, [][]byte) {
+	header := len(tokenArchiveMagic) + 1
+	var records [][]byte
+	for offset := header; offset < len(archive); {
+		length := 4 + int(binary.BigEndian.Uint32(archive[offset:]))
+		records = append(records, archive[offset:offset+length])
+		offset += length
+	}
+	return archive[:header], records
+}
+
+func joinTestArchive(header []byte, records ...[]byte) []byte {
+	return append(append([]byte{}, header...), bytes.Join(records, nil)...)
+}
+
+func TestTokenArchiveInvalid(t *testing.T) {
+	signingKeys := newTestSigningKeys(t)
+	entries := testEntries(3)
+	archive := writeTestArchive(t, entries, signingKeys)
+	header, records := splitTestArchive(archive)
+	if len(records) != 4 {
+		t.Fatalf("Expect 3 entries and trailer, took %d records\n", len(records))
+	}
+	corrupted := append([]byte{}, archive...)
+	corrupted[len(header)+10] ^= 0xff
+
+	// archive key alone isn't enough to produce an archive accepted by the owner of the public key
+	forged := writeTestArchive(t, entries, newTestSigningKeys(t))
+	// checksum doesn't cover metadata, but the signed digest of records does
+	modified := append([]common.TokenEntry{}, entries...)
+	modified[0].Metadata.Disabled = !modified[0].Metadata.Disabled
+	_, modifiedRecords := splitTestArchive(writeTestArchive(t, modified, signingKeys))
+
+	testcases := []struct {
+		Name    string
+		Archive []byte
+		Key     []byte
+		Err     error
+	}{
+		{"empty", nil, testArchiveKey, ErrTokenArchiveTruncated},
+		{"partial header", archive[:4], testArchiveKey, ErrTokenArchiveTruncated},
+		{"invalid header", []byte("some other file"), testArchiveKey, ErrInvalidTokenArchive},
+		{"unsupported version", append(append([]byte{}, tokenArchiveMagic...), 1), testArchiveKey, ErrUnsupportedTokenArchive},
+		{"another key", archive, []byte(`another test archive key with 32+ bytes`), ErrTokenArchiveCorrupted},
+		{"reordered", joinTestArchive(header, records[1], records[0], records[2], records[3]), testArchiveKey, ErrTokenArchiveCorrupted},
+		{"dropped entry", joinTestArchive(header, records[0], records[2], records[3]), testArchiveKey, ErrTokenArchiveCorrupted},
+		{"corrupted", corrupted, testArchiveKey, ErrTokenArchiveCorrupted},
+		{"without trailer", joinTestArchive(header, records[:3]...), testArchiveKey, ErrTokenArchiveTruncated},
+		{"torn record", archive[:len(archive)-3], testArchiveKey, ErrTokenArchiveTruncated},
+		{"another signing key", forged, testArchiveKey, ErrTokenArchiveSignature},
+		{"modified metadata", joinTestArchive(header, modifiedRecords[0], records[1], records[2], records[3]), testArchiveKey, ErrTokenArchiveSignature},
+	}
+	for _, tcase := range testcases {
+		if _, _, err := readTestArchive(t, tcase.Archive, tcase.Key, signingKeys.Public); err != tcase.Err {
+			t.Fatalf("[%s] Expect %s, took %v\n", tcase.Name, tcase.Err, err)
+		}
+	}
+}
+
+func TestTokenArchiveResume(t *testing.T) {
+	signingKeys := newTestSigningKeys(t)
+	entries := testEntries(5)
+	archive := writeTestArchive(t, entries, signingKeys)
+	header, records := splitTestArchive(archive)
+	// interrupted while the third record was written
+	interrupted := append(joinTestArchive(header, records[:2]...), records[2][:5]...)
+
+	reader, read, err := readTestArchive(t, interrupted, testArchiveKey, signingKeys.Public)
+	if err != ErrTokenArchiveTruncated {
+		t.Fatalf("Expect %s, took %v\n", ErrTokenArchiveTruncated, err)
+	}
+	if !equalEntries(entries[:2], read) {
+		t.Fatal("Read entries are not equal to written ones")
+	}
+	resumed := bytes.NewBuffer(append([]byte{}, interrupted[:reader.Offset()]...))
+	writer, err := ResumeTokenArchiveWriter(resumed, testArchiveKey, signingKeys.Private, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries[2:] {
+		if err := writer.WriteEntry(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	reader, read, err = readTestArchive(t, resumed.Bytes(), testArchiveKey, signingKeys.Public)
+	if err != io.EOF {
+		t.Fatalf("Expect %s, took %v\n", io.EOF, err)
+	}
+	if !equalEntries(entries, read) {
+		t.Fatal("Read entries are not equal to written ones")
+	}
+	if !reader.Summary().Equal(writer.Summary()) {
+		t.Fatalf("Expect summary %v, took %v\n", writer.Summary(), reader.Summary())
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package storage
 
 import (
+	"bytes"
 	"time"
 
 	"github.com/cossacklabs/acra/pseudonymization/common"
@@ -49,10 +50,17 @@ func (b *boltdbStorage) Save(id []byte, context common.TokenContext, data []byte
 		if err != nil {
 			return err
 		}
-		if ctxBucket.Get(id) != nil {
-			return common.ErrTokenExists
+		if existing := ctxBucket.Get(id); existing != nil {
+			_, metadata, err := common.ExtractMetadata(existing)
+			if err != nil {
+				return err
+			}
+			// Expired tokens are replaced as if they were already removed
+			if !metadata.Expired(time.Now().UTC()) {
+				return common.ErrTokenExists
+			}
 		}
-		value := common.EmbedMetadata(data, common.NewTokenMetadata())
+		value := common.EmbedMetadata(data, common.NewTokenMetadataWithRetention(context.Retention))
 		return ctxBucket.Put(id, value)
 	})
 }
@@ -83,8 +91,11 @@ func (b *boltdbStorage) Get(id []byte, context common.TokenContext) ([]byte, err
 		if metadata.Disabled {
 			return common.ErrTokenDisabled
 		}
-		// Keep last access time updated, but don't update it more often than specified granularity.
 		now := time.Now().UTC()
+		if metadata.Expired(now) {
+			return common.ErrTokenExpired
+		}
+		// Keep last access time updated, but don't update it more often than specified granularity.
 		if metadata.AccessedBefore(now, b.accessGranularity) {
 			metadata.Accessed = now
 			updatedMetadata = common.EmbedMetadata(data, metadata)
@@ -213,3 +224,121 @@ func (b *boltdbStorage) visitBucket(ctxBucket *bolt.Bucket, cb func(dataLength i
 	}
 	return nil
 }
+
+// how many entries to read in one transaction during VisitEntries
+const boltDBEntryBatchSize = 1000
+
+// VisitEntries over token entries in the storage. Entries are read in batches, and the callback is called outside of
+// transaction so it may modify the storage.
+func (b *boltdbStorage) VisitEntries(cb func(entry common.TokenEntry) error) error {
+	var lastCtx, lastID []byte
+	for {
+		var entries []common.TokenEntry
+		err := b.db.View(func(tx *bolt.Tx) error {
+			var err error
+			entries, err = b.readEntries(tx, lastCtx, lastID, boltDBEntryBatchSize)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := cb(entry); err != nil {
+				return err
+			}
+		}
+		if len(entries) < boltDBEntryBatchSize {
+			return nil
+		}
+		lastCtx, lastID = entries[len(entries)-1].ContextHash, entries[len(entries)-1].ID
+	}
+}
+
+// readEntries return up to limit entries after lastCtx/lastID in the order of keys, from the start if lastCtx is nil
+func (b *boltdbStorage) readEntries(tx *bolt.Tx, lastCtx, lastID []byte, limit int) ([]common.TokenEntry, error) {
+	bucket := tx.Bucket(tokenBucket)
+	if bucket == nil {
+		return nil, nil
+	}
+	var entries []common.TokenEntry
+	cursor := bucket.Cursor()
+	ctx, v := cursor.First()
+	if lastCtx != nil {
+		ctx, v = cursor.Seek(lastCtx)
+	}
+	for ; ctx != nil && len(entries) < limit; ctx, v = cursor.Next() {
+		// Skip non-buckets. (There shouldn't be any, but just in case.)
+		if v != nil {
+			continue
+		}
+		ctxCursor := bucket.Bucket(ctx).Cursor()
+		id, value := ctxCursor.First()
+		if lastCtx != nil && bytes.Equal(ctx, lastCtx) {
+			id, value = ctxCursor.Seek(lastID)
+			if id != nil && bytes.Equal(id, lastID) {
+				id, value = ctxCursor.Next()
+			}
+		}
+		for ; id != nil && len(entries) < limit; id, value = ctxCursor.Next() {
+			if value == nil {
+				continue
+			}
+			data, metadata, err := common.ExtractMetadata(value)
+			if err != nil {
+				return nil, err
+			}
+			// byte slices are valid only during the transaction, so keep copies
+			entries = append(entries, common.TokenEntry{
+				ContextHash: append([]byte{}, ctx...),
+				ID:          append([]byte{}, id...),
+				Data:        append([]byte{}, data...),
+				Metadata:    metadata,
+			})
+		}
+	}
+	return entries, nil
+}
+
+// SaveEntry saves token entry with its metadata.
+func (b *boltdbStorage) SaveEntry(entry common.TokenEntry) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(tokenBucket)
+		if err != nil {
+			return err
+		}
+		ctxBucket, err := bucket.CreateBucketIfNotExists(entry.ContextHash)
+		if err != nil {
+			return err
+		}
+		if ctxBucket.Get(entry.ID) != nil {
+			return common.ErrTokenExists
+		}
+		return ctxBucket.Put(entry.ID, common.EmbedMetadata(entry.Data, entry.Metadata))
+	})
+}
+
+// ReplaceEntryData replaces data of token entry if it wasn't changed.
+func (b *boltdbStorage) ReplaceEntryData(entry common.TokenEntry, data []byte) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(tokenBucket)
+		if bucket == nil {
+			return common.ErrTokenNotFound
+		}
+		ctxBucket := bucket.Bucket(entry.ContextHash)
+		if ctxBucket == nil {
+			return common.ErrTokenNotFound
+		}
+		existing := ctxBucket.Get(entry.ID)
+		if existing == nil {
+			return common.ErrTokenNotFound
+		}
+		existingData, metadata, err := common.ExtractMetadata(existing)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(existingData, entry.Data) {
+			return common.ErrTokenDataChanged
+		}
+		return ctxBucket.Put(entry.ID, common.EmbedMetadata(data, metadata))
+	})
+}
@@ -42,4 +42,6 @@ func TestBoltdbStorage(t *testing.T) {
 	}
 	boltStorage := NewBoltDBTokenStorage(db)
 	testStorage(boltStorage, t)
+	testStorageRetention(boltStorage, t)
+	testStorageEntries(boltStorage, t)
 }
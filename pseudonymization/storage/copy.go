@@ -0,0 +1,121 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"errors"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+// Errors returned by TokenEntryCopier
+var (
+	ErrTokenEntriesMismatch   = errors.New("count or checksum of copied token entries mismatch")
+	ErrTokenEntryNotSupported = errors.New("token storage doesn't support export and import of entries")
+)
+
+// AsTokenEntryStorage return storage as TokenEntryStorage or ErrTokenEntryNotSupported if it doesn't implement it
+func AsTokenEntryStorage(storage common.TokenStorage) (common.TokenEntryStorage, error) {
+	entryStorage, ok := storage.(common.TokenEntryStorage)
+	if !ok {
+		return nil, ErrTokenEntryNotSupported
+	}
+	return entryStorage, nil
+}
+
+// TokenEntryCopier saves token entries into the target storage and verifies them after copying
+type TokenEntryCopier struct {
+	target       common.TokenEntryStorage
+	skipExisting bool
+	dryRun       bool
+
+	summary TokenEntrySummary
+	keys    map[string]struct{}
+	saved   int
+	skipped int
+}
+
+// NewTokenEntryCopier return copier into target. Entries which already exist in the target are skipped if skipExisting
+// is true, otherwise copying fails with ErrTokenExists. Entries are only counted without saving if dryRun is true
+func NewTokenEntryCopier(target common.TokenEntryStorage, skipExisting, dryRun bool) *TokenEntryCopier {
+	return &TokenEntryCopier{target: target, skipExisting: skipExisting, dryRun: dryRun, keys: make(map[string]struct{})}
+}
+
+// Save entry into the target storage
+func (c *TokenEntryCopier) Save(entry common.TokenEntry) error {
+	key := TokenEntryKey(entry)
+	if _, ok := c.keys[key]; ok {
+		// storages may visit the same entry twice if modified concurrently
+		return nil
+	}
+	c.keys[key] = struct{}{}
+	c.summary.Add(entry)
+	if c.dryRun {
+		return nil
+	}
+	err := c.target.SaveEntry(entry)
+	if err == common.ErrTokenExists && c.skipExisting {
+		c.skipped++
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	c.saved++
+	return nil
+}
+
+// Summary return summary of all entries passed to Save
+func (c *TokenEntryCopier) Summary() TokenEntrySummary {
+	return c.summary
+}
+
+// Saved return count of entries saved into the target storage
+func (c *TokenEntryCopier) Saved() int {
+	return c.saved
+}
+
+// Skipped return count of entries which already existed in the target storage
+func (c *TokenEntryCopier) Skipped() int {
+	return c.skipped
+}
+
+// Verify that the target storage contains all entries passed to Save with the same data. Entries skipped as existing
+// are verified too, so they should be equal to the copied ones. It returns ErrTokenEntriesMismatch otherwise
+func (c *TokenEntryCopier) Verify() error {
+	var summary TokenEntrySummary
+	visited := make(map[string]struct{}, len(c.keys))
+	err := c.target.VisitEntries(func(entry common.TokenEntry) error {
+		key := TokenEntryKey(entry)
+		if _, ok := c.keys[key]; !ok {
+			return nil
+		}
+		if _, ok := visited[key]; ok {
+			return nil
+		}
+		visited[key] = struct{}{}
+		summary.Add(entry)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !summary.Equal(c.summary) {
+		return ErrTokenEntriesMismatch
+	}
+	return nil
+}
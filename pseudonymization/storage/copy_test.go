@@ -0,0 +1,93 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+func TestTokenEntryCopier(t *testing.T) {
+	source, err := NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := testEntries(6)
+	for _, entry := range entries {
+		if err := source.SaveEntry(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	target, err := NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// dry run doesn't change the target
+	copier := NewTokenEntryCopier(target, false, true)
+	if err := source.VisitEntries(copier.Save); err != nil {
+		t.Fatal(err)
+	}
+	if copier.Summary().Count != 6 || copier.Saved() != 0 {
+		t.Fatalf("Expect 6 counted and 0 saved entries, took %d and %d\n", copier.Summary().Count, copier.Saved())
+	}
+	if err := copier.Verify(); err != ErrTokenEntriesMismatch {
+		t.Fatalf("Expect %s, took %v\n", ErrTokenEntriesMismatch, err)
+	}
+
+	// interrupted copy
+	copier = NewTokenEntryCopier(target, false, false)
+	for _, entry := range entries[:2] {
+		if err := copier.Save(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := source.VisitEntries(NewTokenEntryCopier(target, false, false).Save); err != common.ErrTokenExists {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenExists, err)
+	}
+
+	// resumed copy skips existing entries
+	copier = NewTokenEntryCopier(target, true, false)
+	if err := source.VisitEntries(copier.Save); err != nil {
+		t.Fatal(err)
+	}
+	if copier.Saved()+copier.Skipped() != 6 || copier.Skipped() < 2 {
+		t.Fatalf("Expect 6 saved or skipped entries, took %d and %d\n", copier.Saved(), copier.Skipped())
+	}
+	if err := copier.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	// existing entries with other data don't pass verification
+	conflicting := entries[0]
+	conflicting.Data = []byte(`other data`)
+	other, err := NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.SaveEntry(conflicting); err != nil {
+		t.Fatal(err)
+	}
+	copier = NewTokenEntryCopier(other, true, false)
+	if err := source.VisitEntries(copier.Save); err != nil {
+		t.Fatal(err)
+	}
+	if err := copier.Verify(); err != ErrTokenEntriesMismatch {
+		t.Fatalf("Expect %s, took %v\n", ErrTokenEntriesMismatch, err)
+	}
+}
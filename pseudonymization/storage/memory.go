@@ -17,6 +17,7 @@ limitations under the License.
 package storage
 
 import (
+	"bytes"
 	"encoding/hex"
 	"sync"
 	"time"
@@ -59,11 +60,11 @@ func (m *MemoryTokenStorage) Save(id []byte, context common.TokenContext, data [
 		ctxMap = make(map[string]*memoryTokenData)
 		m.data[ctxStr] = ctxMap
 	}
-	_, ok = ctxMap[idStr]
-	if ok {
+	// Expired tokens are replaced as if they were already removed
+	if value, ok := ctxMap[idStr]; ok && !value.metadata.Expired(time.Now().UTC()) {
 		return common.ErrTokenExists
 	}
-	ctxMap[idStr] = &memoryTokenData{data, common.NewTokenMetadata()}
+	ctxMap[idStr] = &memoryTokenData{data, common.NewTokenMetadataWithRetention(context.Retention)}
 	return nil
 }
 
@@ -86,8 +87,11 @@ func (m *MemoryTokenStorage) Get(id []byte, context common.TokenContext) ([]byte
 	if value.metadata.Disabled {
 		return nil, common.ErrTokenDisabled
 	}
-	// Keep last access time updated, but don't update it more often than specified granularity.
 	now := time.Now().UTC()
+	if value.metadata.Expired(now) {
+		return nil, common.ErrTokenExpired
+	}
+	// Keep last access time updated, but don't update it more often than specified granularity.
 	if value.metadata.AccessedBefore(now, m.accessGranularity) {
 		value.metadata.Accessed = now
 	}
@@ -141,3 +145,70 @@ func (m *MemoryTokenStorage) VisitMetadata(cb func(dataLength int, metadata comm
 	}
 	return nil
 }
+
+// VisitEntries over token entries in the storage.
+func (m *MemoryTokenStorage) VisitEntries(cb func(entry common.TokenEntry) error) error {
+	// take a snapshot to let the callback modify the storage
+	m.mutex.RLock()
+	var entries []common.TokenEntry
+	for ctxStr, ctxMap := range m.data {
+		ctx, err := hex.DecodeString(ctxStr)
+		if err != nil {
+			m.mutex.RUnlock()
+			return err
+		}
+		for idStr, token := range ctxMap {
+			id, err := hex.DecodeString(idStr)
+			if err != nil {
+				m.mutex.RUnlock()
+				return err
+			}
+			entries = append(entries, common.TokenEntry{ContextHash: ctx, ID: id, Data: token.data, Metadata: token.metadata})
+		}
+	}
+	m.mutex.RUnlock()
+
+	for _, entry := range entries {
+		if err := cb(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveEntry saves token entry with its metadata.
+func (m *MemoryTokenStorage) SaveEntry(entry common.TokenEntry) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	idStr := hex.EncodeToString(entry.ID)
+	ctxStr := hex.EncodeToString(entry.ContextHash)
+	ctxMap, ok := m.data[ctxStr]
+	if !ok {
+		ctxMap = make(map[string]*memoryTokenData)
+		m.data[ctxStr] = ctxMap
+	}
+	if _, ok := ctxMap[idStr]; ok {
+		return common.ErrTokenExists
+	}
+	ctxMap[idStr] = &memoryTokenData{entry.Data, entry.Metadata}
+	return nil
+}
+
+// ReplaceEntryData replaces data of token entry if it wasn't changed.
+func (m *MemoryTokenStorage) ReplaceEntryData(entry common.TokenEntry, data []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	ctxMap, ok := m.data[hex.EncodeToString(entry.ContextHash)]
+	if !ok {
+		return common.ErrTokenNotFound
+	}
+	value, ok := ctxMap[hex.EncodeToString(entry.ID)]
+	if !ok {
+		return common.ErrTokenNotFound
+	}
+	if !bytes.Equal(value.data, entry.Data) {
+		return common.ErrTokenDataChanged
+	}
+	value.data = data
+	return nil
+}
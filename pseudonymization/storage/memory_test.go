@@ -68,4 +68,6 @@ func TestMemoryStorage(t *testing.T) {
 		t.Fatal(err)
 	}
 	testStorage(memoryStorage, t)
+	testStorageRetention(memoryStorage, t)
+	testStorageEntries(memoryStorage, t)
 }
@@ -17,8 +17,11 @@ limitations under the License.
 package storage
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/hex"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/cossacklabs/acra/pseudonymization/common"
@@ -58,58 +61,145 @@ func NewRedisStorage(client *redis.Client) (*RedisStorage, error) {
 const redisTokensPrefix = "tokens/"
 
 func (m *RedisStorage) generateKey(id []byte, context common.TokenContext) string {
-	contextKey := hex.EncodeToString(common.AggregateTokenContextToBytes(context))
+	return m.entryKey(common.AggregateTokenContextToBytes(context), id)
+}
+
+// entryKey return key of the token by context hash and id
+func (m *RedisStorage) entryKey(ctx, id []byte) string {
+	contextKey := hex.EncodeToString(ctx)
 	idKey := hex.EncodeToString(id)
 	return redisTokensPrefix + contextKey + "/" + idKey
 }
 
+// ErrInvalidRedisTokenKey is returned for keys with tokens prefix which don't have "tokens/<context>/<id>" format
+var ErrInvalidRedisTokenKey = errors.New("invalid key of token in redis")
+
+// parseKey return context hash and id of the token from the key generated by entryKey
+func (m *RedisStorage) parseKey(key string) ([]byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(key, redisTokensPrefix), "/")
+	if len(parts) != 2 {
+		return nil, nil, ErrInvalidRedisTokenKey
+	}
+	ctx, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, nil, ErrInvalidRedisTokenKey
+	}
+	id, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, ErrInvalidRedisTokenKey
+	}
+	return ctx, id, nil
+}
+
 // Save data with defined id and context
 func (m *RedisStorage) Save(id []byte, context common.TokenContext, data []byte) error {
 	key := m.generateKey(id, context)
-	value := common.EmbedMetadata(data, common.NewTokenMetadata())
+	value := common.EmbedMetadata(data, common.NewTokenMetadataWithRetention(context.Retention))
 	valueStr := hex.EncodeToString(value)
 	set, err := m.client.SetNX(key, valueStr, noExpiration).Result()
 	if err != nil {
 		return err
 	}
 	if !set {
-		return common.ErrTokenExists
+		return m.replaceExpired(key, valueStr)
 	}
 	return nil
 }
 
+// replaceExpired replaces existing token with new value if the token is expired, otherwise returns ErrTokenExists.
+// Token is replaced in transaction to not overwrite the token saved by a concurrent call
+func (m *RedisStorage) replaceExpired(key, valueStr string) error {
+	err := m.client.Watch(func(tx *redis.Tx) error {
+		existingStr, err := tx.Get(key).Result()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if err == nil {
+			existing, err := hex.DecodeString(existingStr)
+			if err != nil {
+				return err
+			}
+			_, metadata, err := common.ExtractMetadata(existing)
+			if err != nil {
+				return err
+			}
+			if !metadata.Expired(time.Now().UTC()) {
+				return common.ErrTokenExists
+			}
+		}
+		_, err = tx.TxPipelined(func(pipe redis.Pipeliner) error {
+			pipe.Set(key, valueStr, noExpiration)
+			return nil
+		})
+		return err
+	}, key)
+	// transaction fails if the key was changed concurrently so the token exists
+	if err == redis.TxFailedErr {
+		return common.ErrTokenExists
+	}
+	return err
+}
+
+// how many times Get re-reads the token if it was changed concurrently while last access time was updated
+const redisMaxAccessUpdateRetries = 10
+
 // Get data with defined id and context
 func (m *RedisStorage) Get(id []byte, context common.TokenContext) ([]byte, error) {
 	key := m.generateKey(id, context)
-	valueStr, err := m.client.Get(key).Result()
-	if err == redis.Nil {
-		return nil, common.ErrTokenNotFound
-	}
-	if err != nil {
-		return nil, err
-	}
-	value, err := hex.DecodeString(valueStr)
-	if err != nil {
-		return nil, err
-	}
-	data, metadata, err := common.ExtractMetadata(value)
-	if err != nil {
-		return nil, err
+	for i := 0; i < redisMaxAccessUpdateRetries; i++ {
+		data, err := m.get(key)
+		// token was changed concurrently (e.g. re-encrypted by rekey), read the new value
+		if err == redis.TxFailedErr {
+			continue
+		}
+		return data, err
 	}
-	// If the token is disabled, pretend that it's not there. (Don't update last access time either.)
-	if metadata.Disabled {
-		return nil, common.ErrTokenDisabled
-	}
-	// Keep last access time updated, but don't update it more often than specified granularity.
-	now := time.Now().UTC()
-	if metadata.AccessedBefore(now, m.accessGranularity) {
-		metadata.Accessed = now
-		value := common.EmbedMetadata(data, metadata)
-		valueStr := hex.EncodeToString(value)
-		_, err := m.client.SetXX(key, valueStr, noExpiration).Result()
+	return nil, redis.TxFailedErr
+}
+
+// get reads the token and updates its last access time in transaction to not overwrite the token changed by
+// a concurrent call. Returns redis.TxFailedErr if the token was changed
+func (m *RedisStorage) get(key string) ([]byte, error) {
+	var data []byte
+	err := m.client.Watch(func(tx *redis.Tx) error {
+		valueStr, err := tx.Get(key).Result()
+		if err == redis.Nil {
+			return common.ErrTokenNotFound
+		}
+		if err != nil {
+			return err
+		}
+		value, err := hex.DecodeString(valueStr)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		var metadata common.TokenMetadata
+		data, metadata, err = common.ExtractMetadata(value)
+		if err != nil {
+			return err
+		}
+		// If the token is disabled, pretend that it's not there. (Don't update last access time either.)
+		if metadata.Disabled {
+			return common.ErrTokenDisabled
+		}
+		now := time.Now().UTC()
+		if metadata.Expired(now) {
+			return common.ErrTokenExpired
+		}
+		// Keep last access time updated, but don't update it more often than specified granularity.
+		if metadata.AccessedBefore(now, m.accessGranularity) {
+			metadata.Accessed = now
+			valueStr := hex.EncodeToString(common.EmbedMetadata(data, metadata))
+			_, err := tx.TxPipelined(func(pipe redis.Pipeliner) error {
+				pipe.SetXX(key, valueStr, noExpiration)
+				return nil
+			})
+			return err
 		}
+		return nil
+	}, key)
+	if err != nil {
+		return nil, err
 	}
 	return data, nil
 }
@@ -217,3 +307,96 @@ func (m *RedisStorage) VisitMetadata(cb func(dataLength int, metadata common.Tok
 	}
 	return nil
 }
+
+// VisitEntries over token entries in the storage.
+func (m *RedisStorage) VisitEntries(cb func(entry common.TokenEntry) error) error {
+	var cursor uint64
+	for {
+		nextKeys, nextCursor, err := m.client.Scan(cursor, redisTokensPrefix+"*", redisDefaultKeyCount).Result()
+		if err != nil {
+			return err
+		}
+		if len(nextKeys) > 0 {
+			valueStrings, err := m.client.MGet(nextKeys...).Result()
+			if err != nil {
+				return err
+			}
+			for i, valueStr := range valueStrings {
+				// MGET may return nil values if keys have been removed during iteration. Just skip them.
+				if valueStr == nil {
+					continue
+				}
+				ctx, id, err := m.parseKey(nextKeys[i])
+				if err != nil {
+					return err
+				}
+				value, err := hex.DecodeString(valueStr.(string))
+				if err != nil {
+					return err
+				}
+				data, metadata, err := common.ExtractMetadata(value)
+				if err != nil {
+					return err
+				}
+				if err := cb(common.TokenEntry{ContextHash: ctx, ID: id, Data: data, Metadata: metadata}); err != nil {
+					return err
+				}
+			}
+		}
+		cursor = nextCursor
+		if cursor == 0 {
+			break
+		}
+	}
+	return nil
+}
+
+// SaveEntry saves token entry with its metadata.
+func (m *RedisStorage) SaveEntry(entry common.TokenEntry) error {
+	key := m.entryKey(entry.ContextHash, entry.ID)
+	valueStr := hex.EncodeToString(common.EmbedMetadata(entry.Data, entry.Metadata))
+	set, err := m.client.SetNX(key, valueStr, noExpiration).Result()
+	if err != nil {
+		return err
+	}
+	if !set {
+		return common.ErrTokenExists
+	}
+	return nil
+}
+
+// ReplaceEntryData replaces data of token entry if it wasn't changed.
+func (m *RedisStorage) ReplaceEntryData(entry common.TokenEntry, data []byte) error {
+	key := m.entryKey(entry.ContextHash, entry.ID)
+	err := m.client.Watch(func(tx *redis.Tx) error {
+		existingStr, err := tx.Get(key).Result()
+		if err == redis.Nil {
+			return common.ErrTokenNotFound
+		}
+		if err != nil {
+			return err
+		}
+		existing, err := hex.DecodeString(existingStr)
+		if err != nil {
+			return err
+		}
+		existingData, metadata, err := common.ExtractMetadata(existing)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(existingData, entry.Data) {
+			return common.ErrTokenDataChanged
+		}
+		valueStr := hex.EncodeToString(common.EmbedMetadata(data, metadata))
+		_, err = tx.TxPipelined(func(pipe redis.Pipeliner) error {
+			pipe.Set(key, valueStr, noExpiration)
+			return nil
+		})
+		return err
+	}, key)
+	// transaction fails if the key was changed concurrently
+	if err == redis.TxFailedErr {
+		return common.ErrTokenDataChanged
+	}
+	return err
+}
@@ -51,4 +51,6 @@ func TestRedisStorage(t *testing.T) {
 		t.Fatal(err)
 	}
 	testStorage(redisStorage, t)
+	testStorageRetention(redisStorage, t)
+	testStorageEntries(redisStorage, t)
 }
@@ -0,0 +1,148 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"errors"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+// ErrTokenRekeyNotSupported is returned for TokenEncryptor which doesn't implement TokenReencryptor
+var ErrTokenRekeyNotSupported = errors.New("token encryptor doesn't support re-encryption")
+
+// how many entries are visited between calls of progress callback
+const tokenRekeyProgressInterval = 1000
+
+// how many times entries changed concurrently are re-read and re-encrypted again
+const tokenRekeyRetries = 3
+
+// TokenRekeyStats is count of token entries processed by TokenRekeyer
+type TokenRekeyStats struct {
+	// Total count of visited entries
+	Total int
+	// Rekeyed entries are re-encrypted with the current key
+	Rekeyed int
+	// Current entries are already encrypted with the current key
+	Current int
+	// Changed entries were updated concurrently on every retry and may still be encrypted with previous keys
+	Changed int
+	// Removed entries were removed concurrently
+	Removed int
+	// Unknown entries belong to contexts which weren't passed to TokenRekeyer
+	Unknown int
+	// Failed entries can't be decrypted with any key of their context
+	Failed int
+}
+
+// TokenRekeyer re-encrypts data of token entries encrypted with previous keys of ClientID/ZoneID with the current one,
+// so previous keys may be destroyed. Entries are replaced only if they weren't changed concurrently, and changed
+// entries are re-read and re-encrypted again, so it's safe to run while the storage is used.
+type TokenRekeyer struct {
+	storage   common.TokenEntryStorage
+	encryptor TokenReencryptor
+	contexts  map[string]common.TokenContext
+	dryRun    bool
+}
+
+// NewTokenRekeyer return rekeyer of entries of the storage encrypted by encryptor for contexts. Context of the entry
+// can't be restored from its hash, so entries of other contexts are skipped. Entries are only counted if dryRun is true
+func NewTokenRekeyer(storage common.TokenEntryStorage, encryptor TokenEncryptor, contexts []common.TokenContext, dryRun bool) (*TokenRekeyer, error) {
+	reencryptor, ok := encryptor.(TokenReencryptor)
+	if !ok {
+		return nil, ErrTokenRekeyNotSupported
+	}
+	contextsByHash := make(map[string]common.TokenContext, len(contexts))
+	for _, context := range contexts {
+		contextsByHash[string(common.AggregateTokenContextToBytes(context))] = context
+	}
+	return &TokenRekeyer{storage: storage, encryptor: reencryptor, contexts: contextsByHash, dryRun: dryRun}, nil
+}
+
+// tokenEntryKey identifies token entry in the storage
+type tokenEntryKey struct {
+	contextHash string
+	id          string
+}
+
+func newTokenEntryKey(entry common.TokenEntry) tokenEntryKey {
+	return tokenEntryKey{contextHash: string(entry.ContextHash), id: string(entry.ID)}
+}
+
+// Rekey all entries of the storage. Entries changed concurrently are retried with their new data up to
+// tokenRekeyRetries times, stats.Changed is count of entries which weren't re-encrypted after that.
+// Progress callback is called periodically with current stats if not nil
+func (r *TokenRekeyer) Rekey(progress func(stats TokenRekeyStats)) (TokenRekeyStats, error) {
+	var stats TokenRekeyStats
+	changed := make(map[tokenEntryKey]struct{})
+	err := r.storage.VisitEntries(func(entry common.TokenEntry) error {
+		stats.Total++
+		if progress != nil && stats.Total%tokenRekeyProgressInterval == 0 {
+			progress(stats)
+		}
+		return r.rekeyEntry(entry, &stats, changed)
+	})
+	for retry := 0; err == nil && len(changed) > 0 && retry < tokenRekeyRetries; retry++ {
+		retried := changed
+		changed = make(map[tokenEntryKey]struct{})
+		err = r.storage.VisitEntries(func(entry common.TokenEntry) error {
+			key := newTokenEntryKey(entry)
+			if _, ok := retried[key]; !ok {
+				return nil
+			}
+			delete(retried, key)
+			return r.rekeyEntry(entry, &stats, changed)
+		})
+		// entries which weren't visited again were removed concurrently
+		stats.Removed += len(retried)
+	}
+	stats.Changed = len(changed)
+	return stats, err
+}
+
+// rekeyEntry re-encrypts data of the entry and counts it in stats. Entries changed concurrently are added to changed
+func (r *TokenRekeyer) rekeyEntry(entry common.TokenEntry, stats *TokenRekeyStats, changed map[tokenEntryKey]struct{}) error {
+	context, ok := r.contexts[string(entry.ContextHash)]
+	if !ok {
+		stats.Unknown++
+		return nil
+	}
+	data, reencrypted, err := r.encryptor.Reencrypt(entry.Data, context)
+	if err != nil {
+		stats.Failed++
+		return nil
+	}
+	if !reencrypted {
+		stats.Current++
+		return nil
+	}
+	if r.dryRun {
+		stats.Rekeyed++
+		return nil
+	}
+	switch err := r.storage.ReplaceEntryData(entry, data); err {
+	case nil:
+		stats.Rekeyed++
+	case common.ErrTokenDataChanged:
+		changed[newTokenEntryKey(entry)] = struct{}{}
+	case common.ErrTokenNotFound:
+		stats.Removed++
+	default:
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+// rotatingKeyStore return keys from the newest to the oldest, copies are returned because they are zeroized after use
+type rotatingKeyStore struct {
+	keys [][]byte
+}
+
+func (k *rotatingKeyStore) rotate(key string) {
+	k.keys = append([][]byte{[]byte(key)}, k.keys...)
+}
+
+func (k *rotatingKeyStore) GetClientIDSymmetricKeys(id []byte) ([][]byte, error) {
+	if bytes.Equal(id, []byte(`client without keys`)) {
+		return nil, nil
+	}
+	keys := make([][]byte, len(k.keys))
+	for i, key := range k.keys {
+		keys[i] = append([]byte{}, key...)
+	}
+	return keys, nil
+}
+
+func (k *rotatingKeyStore) GetZoneIDSymmetricKeys(id []byte) ([][]byte, error) {
+	return k.GetClientIDSymmetricKeys(id)
+}
+
+func TestTokenRekeyer(t *testing.T) {
+	keyStore := &rotatingKeyStore{}
+	keyStore.rotate(`first key`)
+	encryptor, err := NewSCellEncryptor(keyStore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memoryStorage, err := NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenStorage := WrapStorageWithEncryption(memoryStorage, encryptor)
+	clientContext := common.TokenContext{ClientID: []byte(`client`)}
+	zoneContext := common.TokenContext{ZoneID: []byte(`zone`)}
+	unknownContext := common.TokenContext{ClientID: []byte(`unknown`)}
+	for _, context := range []common.TokenContext{clientContext, zoneContext, unknownContext} {
+		if err := tokenStorage.Save([]byte(`old`), context, []byte(`old value`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	metadata, err := tokenStorage.Stat([]byte(`old`), clientContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyStore.rotate(`second key`)
+	if err := tokenStorage.Save([]byte(`new`), clientContext, []byte(`new value`)); err != nil {
+		t.Fatal(err)
+	}
+	contexts := []common.TokenContext{clientContext, zoneContext, {ClientID: []byte(`client without keys`)}}
+
+	rekeyer, err := NewTokenRekeyer(memoryStorage, encryptor, contexts, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := rekeyer.Rekey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := TokenRekeyStats{Total: 4, Rekeyed: 2, Current: 1, Unknown: 1}
+	if stats != expected {
+		t.Fatalf("Expect %+v, took %+v\n", expected, stats)
+	}
+
+	rekeyer, err = NewTokenRekeyer(memoryStorage, encryptor, contexts, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats, err = rekeyer.Rekey(nil); err != nil {
+		t.Fatal(err)
+	}
+	if stats != expected {
+		t.Fatalf("Expect %+v, took %+v\n", expected, stats)
+	}
+	// all entries of known contexts are encrypted with the current key
+	if stats, err = rekeyer.Rekey(nil); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (TokenRekeyStats{Total: 4, Current: 3, Unknown: 1}); stats != expected {
+		t.Fatalf("Expect %+v, took %+v\n", expected, stats)
+	}
+	// and can be decrypted without previous keys
+	keyStore.keys = keyStore.keys[:1]
+	for _, context := range []common.TokenContext{clientContext, zoneContext} {
+		value, err := tokenStorage.Get([]byte(`old`), context)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(value, []byte(`old value`)) {
+			t.Fatalf("Expect old value, took %s\n", value)
+		}
+	}
+	if _, err := tokenStorage.Get([]byte(`old`), unknownContext); err == nil {
+		t.Fatal("Expect error for entry encrypted with removed key")
+	}
+	newMetadata, err := tokenStorage.Stat([]byte(`old`), clientContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !newMetadata.Equal(metadata) {
+		t.Fatalf("Expect metadata %v, took %v\n", metadata, newMetadata)
+	}
+
+	// entries which can't be decrypted are counted as failed
+	rekeyer, err = NewTokenRekeyer(memoryStorage, encryptor, append(contexts, unknownContext), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats, err = rekeyer.Rekey(nil); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (TokenRekeyStats{Total: 4, Current: 3, Failed: 1}); stats != expected {
+		t.Fatalf("Expect %+v, took %+v\n", expected, stats)
+	}
+}
+
+type notReencryptor struct {
+	TokenEncryptor
+}
+
+func TestTokenRekeyerInvalidParameters(t *testing.T) {
+	memoryStorage, err := NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewTokenRekeyer(memoryStorage, notReencryptor{}, nil, false); err != ErrTokenRekeyNotSupported {
+		t.Fatalf("Expect %s, took %v\n", ErrTokenRekeyNotSupported, err)
+	}
+	encryptor, err := NewSCellEncryptor(&rotatingKeyStore{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := encryptor.(TokenReencryptor).Reencrypt([]byte(`not acrablock`), common.TokenContext{}); err == nil {
+		t.Fatal("Expect error for invalid data")
+	}
+}
+
+// conflictingStorage fails ReplaceEntryData with ErrTokenDataChanged first conflicts times as if entries were changed
+// concurrently
+type conflictingStorage struct {
+	common.TokenEntryStorage
+	conflicts int
+}
+
+func (s *conflictingStorage) ReplaceEntryData(entry common.TokenEntry, data []byte) error {
+	if s.conflicts > 0 {
+		s.conflicts--
+		return common.ErrTokenDataChanged
+	}
+	return s.TokenEntryStorage.ReplaceEntryData(entry, data)
+}
+
+func TestTokenRekeyerRetryChanged(t *testing.T) {
+	keyStore := &rotatingKeyStore{}
+	keyStore.rotate(`first key`)
+	encryptor, err := NewSCellEncryptor(keyStore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memoryStorage, err := NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenStorage := WrapStorageWithEncryption(memoryStorage, encryptor)
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	if err := tokenStorage.Save([]byte(`old`), context, []byte(`old value`)); err != nil {
+		t.Fatal(err)
+	}
+	keyStore.rotate(`second key`)
+
+	// entry changed on every retry is left encrypted with the previous key
+	storage := &conflictingStorage{TokenEntryStorage: memoryStorage, conflicts: tokenRekeyRetries + 1}
+	rekeyer, err := NewTokenRekeyer(storage, encryptor, []common.TokenContext{context}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats, err := rekeyer.Rekey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (TokenRekeyStats{Total: 1, Changed: 1}); stats != expected {
+		t.Fatalf("Expect %+v, took %+v\n", expected, stats)
+	}
+
+	// entry is re-read and re-encrypted after concurrent changes
+	storage.conflicts = tokenRekeyRetries
+	if stats, err = rekeyer.Rekey(nil); err != nil {
+		t.Fatal(err)
+	}
+	if expected := (TokenRekeyStats{Total: 1, Rekeyed: 1}); stats != expected {
+		t.Fatalf("Expect %+v, took %+v\n", expected, stats)
+	}
+	keyStore.keys = keyStore.keys[:1]
+	value, err := tokenStorage.Get([]byte(`old`), context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(value, []byte(`old value`)) {
+		t.Fatalf("Expect old value, took %s\n", value)
+	}
+}
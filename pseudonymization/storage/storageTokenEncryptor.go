@@ -29,6 +29,13 @@ type TokenEncryptor interface {
 	Decrypt(data []byte, ctx common.TokenContext) ([]byte, error)
 }
 
+// TokenReencryptor is implemented by TokenEncryptor which supports key rotation
+type TokenReencryptor interface {
+	// Reencrypt return data encrypted with the current key and true if data was encrypted with one of previous keys,
+	// or data as is and false if it's already encrypted with the current key
+	Reencrypt(data []byte, ctx common.TokenContext) ([]byte, bool, error)
+}
+
 type scellEncryptor struct {
 	tokenKeystore keystore.SymmetricEncryptionKeyStore
 }
@@ -38,8 +45,8 @@ func NewSCellEncryptor(tokenKeystore keystore.SymmetricEncryptionKeyStore) (Toke
 	return &scellEncryptor{tokenKeystore: tokenKeystore}, nil
 }
 
-// Encrypt data with context
-func (s *scellEncryptor) Encrypt(data []byte, ctx common.TokenContext) ([]byte, error) {
+// getKeys return encryption context and keys of ZoneID or ClientID from the newest to the oldest
+func (s *scellEncryptor) getKeys(ctx common.TokenContext) ([]byte, [][]byte, error) {
 	var context []byte
 	var keys [][]byte
 	var err error
@@ -51,10 +58,19 @@ func (s *scellEncryptor) Encrypt(data []byte, ctx common.TokenContext) ([]byte,
 		keys, err = s.tokenKeystore.GetClientIDSymmetricKeys(ctx.ClientID)
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if len(keys) == 0 {
-		return nil, keystore.ErrKeysNotFound
+		return nil, nil, keystore.ErrKeysNotFound
+	}
+	return context, keys, nil
+}
+
+// Encrypt data with context
+func (s *scellEncryptor) Encrypt(data []byte, ctx common.TokenContext) ([]byte, error) {
+	context, keys, err := s.getKeys(ctx)
+	if err != nil {
+		return nil, err
 	}
 	encrypted, err := acrablock.CreateAcraBlock(data, keys[0], context)
 	for _, key := range keys {
@@ -69,24 +85,42 @@ func (s *scellEncryptor) Decrypt(data []byte, ctx common.TokenContext) ([]byte,
 	if err != nil {
 		return nil, err
 	}
-	var context []byte
-	var keys [][]byte
-	if len(ctx.ZoneID) != 0 {
-		context = ctx.ZoneID
-		keys, err = s.tokenKeystore.GetZoneIDSymmetricKeys(ctx.ZoneID)
-	} else {
-		context = ctx.ClientID
-		keys, err = s.tokenKeystore.GetClientIDSymmetricKeys(ctx.ClientID)
-	}
+	context, keys, err := s.getKeys(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if len(keys) == 0 {
-		return nil, keystore.ErrKeysNotFound
-	}
 	decrypted, err := block.Decrypt(keys, context)
 	for _, key := range keys {
 		utils.ZeroizeSymmetricKey(key)
 	}
 	return decrypted, err
 }
+
+// Reencrypt data encrypted with previous keys of the context with the current one
+func (s *scellEncryptor) Reencrypt(data []byte, ctx common.TokenContext) ([]byte, bool, error) {
+	block, err := acrablock.NewAcraBlockFromData(data)
+	if err != nil {
+		return nil, false, err
+	}
+	context, keys, err := s.getKeys(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		for _, key := range keys {
+			utils.ZeroizeSymmetricKey(key)
+		}
+	}()
+	if _, err := block.Decrypt(keys[:1], context); err == nil {
+		return data, false, nil
+	}
+	decrypted, err := block.Decrypt(keys[1:], context)
+	if err != nil {
+		return nil, false, err
+	}
+	encrypted, err := acrablock.CreateAcraBlock(decrypted, keys[0], context)
+	if err != nil {
+		return nil, false, err
+	}
+	return encrypted, true, nil
+}
@@ -232,6 +232,75 @@ func testStorage(storage common.TokenStorage, t *testing.T) {
 	}
 }
 
+// testStorageRetention checks that tokens expire according to retention of the context. Storage should be empty
+func testStorageRetention(storage common.TokenStorage, t *testing.T) {
+	expiringContext := common.TokenContext{ClientID: []byte(`client`), Retention: common.TokenRetention{TTL: time.Second}}
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	if err := storage.Save([]byte(`expiring`), expiringContext, []byte(`value1`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Save([]byte(`replaced`), expiringContext, []byte(`value2`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Save([]byte(`persistent`), context, []byte(`value3`)); err != nil {
+		t.Fatal(err)
+	}
+	metadata, err := storage.Stat([]byte(`expiring`), context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.Retention != expiringContext.Retention {
+		t.Fatalf("Expect retention %v, took %v\n", expiringContext.Retention, metadata.Retention)
+	}
+	// retention isn't a part of the context so tokens are found with any retention
+	if _, err := storage.Get([]byte(`expiring`), context); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.Save([]byte(`replaced`), expiringContext, []byte(`value4`)); err != common.ErrTokenExists {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenExists, err)
+	}
+
+	// metadata stores times with precision of a second
+	time.Sleep(time.Second + time.Millisecond*100)
+
+	if _, err := storage.Get([]byte(`expiring`), context); err != common.ErrTokenExpired {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenExpired, err)
+	}
+	// expired tokens are replaced by new ones
+	if err := storage.Save([]byte(`replaced`), context, []byte(`value4`)); err != nil {
+		t.Fatal(err)
+	}
+	value, err := storage.Get([]byte(`replaced`), context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(value, []byte(`value4`)) {
+		t.Fatal("Fetched value not equal to saved value")
+	}
+
+	for _, action := range []common.TokenAction{common.TokenDisable, common.TokenRemove} {
+		sweeper, err := NewSweeper(storage, time.Second, action)
+		if err != nil {
+			t.Fatal(err)
+		}
+		count, err := sweeper.Sweep()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Fatalf("Expect 1 swept token with action %d, took %d\n", action, count)
+		}
+	}
+	if _, err := storage.Get([]byte(`expiring`), context); err != common.ErrTokenNotFound {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenNotFound, err)
+	}
+	for _, id := range []string{`replaced`, `persistent`} {
+		if _, err := storage.Get([]byte(id), context); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
 func timeBetween(b, t, a time.Time) bool {
 	// Metadata stores times with precision of a second. Take that into account.
 	const precision = time.Second
@@ -242,3 +311,87 @@ func timeBetween(b, t, a time.Time) bool {
 	// make sure to test b <= t <= a rather than b < t < a.
 	return !(t.After(a) || t.Before(b))
 }
+
+// testStorageEntries checks export and import of token entries with their metadata
+func testStorageEntries(storage common.TokenStorage, t *testing.T) {
+	entryStorage, err := AsTokenEntryStorage(storage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	context := common.TokenContext{ClientID: []byte(`entries`)}
+	if err := storage.Save([]byte(`saved`), context, []byte(`value1`)); err != nil {
+		t.Fatal(err)
+	}
+	imported := common.TokenEntry{
+		ContextHash: common.AggregateTokenContextToBytes(context),
+		ID:          []byte(`imported`),
+		Data:        []byte(`value2`),
+		Metadata: common.TokenMetadata{
+			Created:   time.Unix(1000, 0),
+			Accessed:  time.Unix(2000, 0),
+			Disabled:  true,
+			Retention: common.TokenRetention{IdleTTL: time.Hour},
+		},
+	}
+	if err := entryStorage.SaveEntry(imported); err != nil {
+		t.Fatal(err)
+	}
+	if err := entryStorage.SaveEntry(imported); err != common.ErrTokenExists {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenExists, err)
+	}
+	metadata, err := storage.Stat(imported.ID, context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !metadata.Equal(imported.Metadata) {
+		t.Fatalf("Expect metadata %v, took %v\n", imported.Metadata, metadata)
+	}
+	if _, err := storage.Get(imported.ID, context); err != common.ErrTokenDisabled {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenDisabled, err)
+	}
+
+	entries := make(map[string]common.TokenEntry)
+	err = entryStorage.VisitEntries(func(entry common.TokenEntry) error {
+		if bytes.Equal(entry.ContextHash, imported.ContextHash) {
+			entries[string(entry.ID)] = entry
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expect 2 entries, took %d\n", len(entries))
+	}
+	if entry := entries[`saved`]; !bytes.Equal(entry.Data, []byte(`value1`)) {
+		t.Fatalf("Expect saved value, took %v\n", entry)
+	}
+	if entry := entries[`imported`]; !bytes.Equal(entry.Data, imported.Data) || !entry.Metadata.Equal(imported.Metadata) {
+		t.Fatalf("Expect imported entry, took %v\n", entry)
+	}
+
+	// data is replaced only if it wasn't changed, metadata is kept
+	if err := entryStorage.ReplaceEntryData(imported, []byte(`value3`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := entryStorage.ReplaceEntryData(imported, []byte(`value4`)); err != common.ErrTokenDataChanged {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenDataChanged, err)
+	}
+	missing := imported
+	missing.ID = []byte(`missing`)
+	if err := entryStorage.ReplaceEntryData(missing, []byte(`value4`)); err != common.ErrTokenNotFound {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenNotFound, err)
+	}
+	if metadata, err := storage.Stat(imported.ID, context); err != nil || !metadata.Equal(imported.Metadata) {
+		t.Fatalf("Expect metadata %v, took %v (%v)\n", imported.Metadata, metadata, err)
+	}
+	err = entryStorage.VisitEntries(func(entry common.TokenEntry) error {
+		if bytes.Equal(entry.ContextHash, imported.ContextHash) && bytes.Equal(entry.ID, imported.ID) && !bytes.Equal(entry.Data, []byte(`value3`)) {
+			t.Fatalf("Expect replaced value, took %s\n", entry.Data)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cossacklabs/acra/logging"
+	"github.com/cossacklabs/acra/pseudonymization/common"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors returned by NewSweeper
+var (
+	ErrInvalidSweepInterval = errors.New("sweep interval should be positive")
+	ErrInvalidSweepAction   = errors.New("unsupported sweep action, expected disable or remove")
+)
+
+// Names of sweep actions accepted by ParseSweepAction
+const (
+	SweepActionDisable = "disable"
+	SweepActionRemove  = "remove"
+)
+
+// ParseSweepAction return TokenAction applied by Sweeper to expired tokens by its name
+func ParseSweepAction(name string) (common.TokenAction, error) {
+	switch name {
+	case SweepActionDisable:
+		return common.TokenDisable, nil
+	case SweepActionRemove:
+		return common.TokenRemove, nil
+	default:
+		return common.TokenContinue, ErrInvalidSweepAction
+	}
+}
+
+// Sweeper periodically disables or removes tokens which outlived their retention
+type Sweeper struct {
+	storage  common.TokenStorage
+	interval time.Duration
+	action   common.TokenAction
+	logger   *logrus.Entry
+}
+
+// NewSweeper return new Sweeper which applies action to expired tokens of the storage each interval
+func NewSweeper(storage common.TokenStorage, interval time.Duration, action common.TokenAction) (*Sweeper, error) {
+	if interval <= 0 {
+		return nil, ErrInvalidSweepInterval
+	}
+	if action != common.TokenDisable && action != common.TokenRemove {
+		return nil, ErrInvalidSweepAction
+	}
+	return &Sweeper{storage: storage, interval: interval, action: action, logger: logrus.WithField("service", "token_sweeper")}, nil
+}
+
+// Sweep applies action to all tokens expired at the moment and return their count
+func (s *Sweeper) Sweep() (int, error) {
+	now := time.Now().UTC()
+	count := 0
+	err := s.storage.VisitMetadata(func(dataLength int, metadata common.TokenMetadata) (common.TokenAction, error) {
+		if !metadata.Expired(now) {
+			return common.TokenContinue, nil
+		}
+		// already disabled tokens are skipped to not count them on each sweep
+		if s.action == common.TokenDisable && metadata.Disabled {
+			return common.TokenContinue, nil
+		}
+		count++
+		return s.action, nil
+	})
+	return count, err
+}
+
+// Run sweeps the storage each interval until ctx is done
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := s.Sweep()
+			if err != nil {
+				s.logger.WithError(err).WithField(logging.FieldKeyEventCode, logging.EventCodeErrorTokenStorageSweep).
+					Errorln("Can't sweep expired tokens")
+				continue
+			}
+			if count > 0 {
+				s.logger.WithField("count", count).Infoln("Swept expired tokens")
+			}
+		}
+	}
+}
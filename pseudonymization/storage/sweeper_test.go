@@ -0,0 +1,49 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+func TestNewSweeperInvalidParameters(t *testing.T) {
+	memoryStorage, err := NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSweeper(memoryStorage, 0, common.TokenRemove); err != ErrInvalidSweepInterval {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidSweepInterval, err)
+	}
+	if _, err := NewSweeper(memoryStorage, time.Second, common.TokenEnable); err != ErrInvalidSweepAction {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidSweepAction, err)
+	}
+	if _, err := ParseSweepAction("enable"); err != ErrInvalidSweepAction {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidSweepAction, err)
+	}
+	for name, expected := range map[string]common.TokenAction{SweepActionDisable: common.TokenDisable, SweepActionRemove: common.TokenRemove} {
+		action, err := ParseSweepAction(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if action != expected {
+			t.Fatalf("Expect %d action for %s, took %d\n", expected, name, action)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pseudonymization
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cossacklabs/acra/pseudonymization/common"
+)
+
+// DateLayout is a text format of date token values
+const DateLayout = "2006-01-02"
+
+// timestampLayouts are text formats of timestamp token values accepted from databases and clients. Token is returned in
+// the same format as source value to keep time zone offset if the source value had it
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999",
+	time.RFC3339Nano,
+}
+
+// ErrInvalidTimestamp returned for timestamp values in unsupported format
+var ErrInvalidTimestamp = errors.New("unsupported timestamp format")
+
+// parseTimestamp parse timestamp value and return layout used to parse it
+func parseTimestamp(data []byte) (time.Time, string, error) {
+	for _, layout := range timestampLayouts {
+		timestamp, err := time.Parse(layout, string(data))
+		if err == nil {
+			return timestamp, layout, nil
+		}
+	}
+	return time.Time{}, "", ErrInvalidTimestamp
+}
+
+type processValueFunc func(data interface{}, context common.TokenContext, dataType common.TokenType) (interface{}, error)
+
+// processTextValue parse text value of phone, uuid, date, timestamp and card number token types, process it with
+// tokenizer and return result in the same text format
+func processTextValue(data []byte, context common.TokenContext, tokenType common.TokenType, process processValueFunc) ([]byte, error) {
+	switch tokenType {
+	case common.TokenType_Phone:
+		newVal, err := process(common.Phone(data), context, tokenType)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(newVal.(common.Phone)), nil
+
+	case common.TokenType_UUID:
+		newVal, err := process(common.UUID(data), context, tokenType)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(newVal.(common.UUID)), nil
+
+	case common.TokenType_CardNumber:
+		newVal, err := process(common.CardNumber(data), context, tokenType)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(newVal.(common.CardNumber)), nil
+
+	case common.TokenType_Date:
+		date, err := time.Parse(DateLayout, string(data))
+		if err != nil {
+			return nil, err
+		}
+		newVal, err := process(date, context, tokenType)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(newVal.(time.Time).Format(DateLayout)), nil
+
+	case common.TokenType_Timestamp:
+		timestamp, layout, err := parseTimestamp(data)
+		if err != nil {
+			return nil, err
+		}
+		newVal, err := process(timestamp, context, tokenType)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(newVal.(time.Time).In(timestamp.Location()).Format(layout)), nil
+	}
+	return nil, ErrDataTypeMismatch
+}
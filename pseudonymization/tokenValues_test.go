@@ -0,0 +1,157 @@
+/*
+Copyright 2026, Cossack Labs Limited
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pseudonymization
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/cossacklabs/acra/encryptor/config"
+	"github.com/cossacklabs/acra/pseudonymization/common"
+	"github.com/cossacklabs/acra/pseudonymization/storage"
+)
+
+func TestDataTokenizerTextFormats(t *testing.T) {
+	tokenStorage, err := storage.NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pseudoanonymizer, err := NewPseudoanonymizer(tokenStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer, err := NewDataTokenizer(pseudoanonymizer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		tokenType string
+		value     string
+		// token should match pattern to keep format of the source value
+		pattern string
+	}{
+		{"phone", "+380441234567", `^\+380[0-9]{9}$`},
+		{"uuid", "C8D6BB3A-1B3C-4B4E-9F6E-1C2D3E4F5A6B", `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`},
+		{"card_number", "4111111111111111", `^411111[0-9]{10}$`},
+		{"date", "2021-12-22", `^[0-9]{4}-[0-9]{2}-[0-9]{2}$`},
+		{"timestamp", "2021-12-22 10:11:12", `^[0-9]{4}-[0-9]{2}-[0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2}$`},
+		{"timestamp", "2021-12-22 10:11:12.123456+02", `^[0-9]{4}-[0-9]{2}-[0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?\+02$`},
+		{"timestamp", "2021-12-22T10:11:12Z", `^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}Z$`},
+	}
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	for i, tcase := range testcases {
+		setting := &config.BasicColumnEncryptionSetting{Tokenized: true, TokenType: tcase.tokenType}
+		token, err := tokenizer.Tokenize([]byte(tcase.value), context, setting)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		if !regexp.MustCompile(tcase.pattern).Match(token) {
+			t.Fatalf("[%d] Token %s doesn't match %s\n", i, token, tcase.pattern)
+		}
+		value, err := tokenizer.Detokenize(token, context, setting)
+		if err != nil {
+			t.Fatalf("[%d] Unexpected error: %s\n", i, err)
+		}
+		// source value of UUID returned in lower case
+		if tcase.tokenType == "uuid" {
+			tcase.value = "c8d6bb3a-1b3c-4b4e-9f6e-1c2d3e4f5a6b"
+		}
+		if string(value) != tcase.value {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.value, value)
+		}
+	}
+
+	// timestamptz returned by database in another time zone
+	setting := &config.BasicColumnEncryptionSetting{Tokenized: true, TokenType: "timestamp"}
+	token, err := tokenizer.Tokenize([]byte("2021-12-22 10:11:12+02"), context, setting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	timestamp, layout, err := parseTimestamp(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := tokenizer.Detokenize([]byte(timestamp.UTC().Format(layout)), context, setting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != "2021-12-22 08:11:12+00" {
+		t.Fatalf("Expect source value in UTC, took %s\n", value)
+	}
+
+	if _, err := tokenizer.Tokenize([]byte("22.12.2021 10:11:12"), context, setting); err != ErrInvalidTimestamp {
+		t.Fatalf("Expect %s, took %v\n", ErrInvalidTimestamp, err)
+	}
+}
+
+func TestDataTokenizerExpiredTokens(t *testing.T) {
+	tokenStorage, err := storage.NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pseudoanonymizer, err := NewPseudoanonymizer(tokenStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer, err := NewDataTokenizer(pseudoanonymizer, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemaStore, err := config.MapTableSchemaStoreFromConfig([]byte(`
+schemas:
+  - table: test_table
+    columns:
+      - data
+    encrypted:
+      - column: data
+        tokenized: true
+        consistent_tokenization: true
+        token_type: str
+        token_ttl: 1s
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	setting := schemaStore.GetTableSchema("test_table").GetColumnEncryptionSettings("data")
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	token, err := tokenizer.Tokenize([]byte(`value`), context, setting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tokenizer.Detokenize(token, context, setting); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Second + time.Millisecond*100)
+
+	if _, err := tokenizer.Detokenize(token, context, setting); err != common.ErrTokenExpired {
+		t.Fatalf("Expect %s, took %v\n", common.ErrTokenExpired, err)
+	}
+	// consistent tokenization generates new token instead of expired one
+	newToken, err := tokenizer.Tokenize([]byte(`value`), context, setting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := tokenizer.Detokenize(newToken, context, setting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != `value` {
+		t.Fatalf("Expect value, took %s\n", value)
+	}
+}
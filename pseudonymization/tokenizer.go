@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/cossacklabs/acra/pseudonymization/common"
 	"github.com/sirupsen/logrus"
@@ -61,6 +62,38 @@ func (a anonymizer) Anonymize(data interface{}, context common.TokenContext, dat
 			return nil, common.ErrUnknownTokenType
 		}
 		return a.AnonymizeBytes(v, context)
+	case common.TokenType_Phone:
+		v, ok := data.(common.Phone)
+		if !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		return randomPhone(v)
+	case common.TokenType_UUID:
+		v, ok := data.(common.UUID)
+		if !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		if _, err := normalizeUUID(v); err != nil {
+			return nil, err
+		}
+		return randomUUID()
+	case common.TokenType_Date:
+		if _, ok := data.(time.Time); !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		return randomDate(), nil
+	case common.TokenType_Timestamp:
+		v, ok := data.(time.Time)
+		if !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		return randomTimestamp(v), nil
+	case common.TokenType_CardNumber:
+		v, ok := data.(common.CardNumber)
+		if !ok {
+			return nil, common.ErrUnknownTokenType
+		}
+		return randomCardNumber(v)
 	default:
 		return nil, common.ErrUnknownTokenType
 	}
@@ -156,6 +189,27 @@ type newValueFunc func(interface{}, common.TokenContext) (interface{}, error)
 // ErrGenerationRandomValue return when can't new random value which wasn't generated before and exceed count of tries to generate another value
 var ErrGenerationRandomValue = errors.New("can't generate new random value, try count exceed")
 
+// generateConsistencyID return id of data used for lookup of consistent tokens. Retention is a part of the id so columns
+// with different token_ttl/idle_ttl don't share tokens and each token expires according to the column it was issued for.
+// Id of tokens without retention stays equal to the data id to keep previously issued tokens.
+func (p *pseudoanonymizer) generateConsistencyID(data []byte, context common.TokenContext, dataType common.TokenType) ([]byte, error) {
+	dataID, err := p.generateDataID(data, context, dataType)
+	if err != nil {
+		return nil, err
+	}
+	if context.Retention.IsEmpty() {
+		return dataID, nil
+	}
+	h := sha256.New()
+	h.Write(dataID)
+	h.Write(dataIDDelim)
+	h.Write([]byte(`retention`))
+	h.Write([]byte(strconv.FormatInt(int64(context.Retention.TTL/time.Second), 10)))
+	h.Write(dataIDDelim)
+	h.Write([]byte(strconv.FormatInt(int64(context.Retention.IdleTTL/time.Second), 10)))
+	return h.Sum(nil), nil
+}
+
 func (p *pseudoanonymizer) generateKeyForHash(key []byte) []byte {
 	return append([]byte(`h.`), key...)
 }
@@ -278,6 +332,16 @@ func bytesToGolangValue(data []byte, dataType common.TokenType) (interface{}, er
 			return nil, err
 		}
 		return v, nil
+	case common.TokenType_Phone:
+		return common.Phone(data), nil
+	case common.TokenType_UUID:
+		return common.UUID(data), nil
+	case common.TokenType_CardNumber:
+		return common.CardNumber(data), nil
+	case common.TokenType_Date:
+		return time.Parse(DateLayout, string(data))
+	case common.TokenType_Timestamp:
+		return time.Parse(time.RFC3339Nano, string(data))
 	default:
 		return nil, common.ErrUnknownTokenType
 	}
@@ -330,6 +394,11 @@ func (p *pseudoanonymizer) Anonymize(data interface{}, context common.TokenConte
 		f = func(v interface{}, context common.TokenContext) (interface{}, error) {
 			return p.anonymizer.AnonymizeBytes(val, context)
 		}
+	case common.TokenType_Phone, common.TokenType_UUID, common.TokenType_Date, common.TokenType_Timestamp, common.TokenType_CardNumber:
+		// anonymizer validates type and format of the value
+		f = func(v interface{}, context common.TokenContext) (interface{}, error) {
+			return p.anonymizer.Anonymize(data, context, dataType)
+		}
 	default:
 		return nil, common.ErrUnknownTokenType
 	}
@@ -346,7 +415,7 @@ func (p *pseudoanonymizer) AnonymizeConsistently(data interface{}, context commo
 	if err != nil {
 		return nil, err
 	}
-	digest, err := p.generateDataID(dataBytes, context, dataType)
+	digest, err := p.generateConsistencyID(dataBytes, context, dataType)
 	if err != nil {
 		return nil, err
 	}
@@ -393,6 +462,10 @@ func (p *pseudoanonymizer) Deanonymize(token interface{}, context common.TokenCo
 	}
 	key = p.generateKeyForToken(key)
 	data, err := p.storage.Get(key, context)
+	// refuse to detokenize expired tokens instead of returning them as is
+	if err == common.ErrTokenExpired {
+		return nil, err
+	}
 	if err != nil {
 		p.logger.Warningln("Token not found, return as is")
 		return token, nil
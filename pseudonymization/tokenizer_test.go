@@ -19,7 +19,9 @@ package pseudonymization
 import (
 	"encoding/hex"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cossacklabs/acra/pseudonymization/common"
 	"github.com/cossacklabs/acra/pseudonymization/storage"
@@ -52,6 +54,11 @@ func TestPseudoanonymizer_Anonymize(t *testing.T) {
 		{Value: common.Email("string"), Type: common.TokenType_Email, Context: common.TokenContext{ClientID: []byte(`some context5`)}},
 		{Value: common.Email("string"), Type: common.TokenType_Email, Context: common.TokenContext{ZoneID: []byte(`some context5`)}},
 		{Value: common.Email("string"), Type: common.TokenType_Email, Context: common.TokenContext{ClientID: []byte(`some context5`), ZoneID: []byte(`some context5`)}},
+		{Value: common.Phone("+380441234567"), Type: common.TokenType_Phone, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: common.UUID("c8d6bb3a-1b3c-4b4e-9f6e-1c2d3e4f5a6b"), Type: common.TokenType_UUID, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: time.Date(2021, time.December, 22, 0, 0, 0, 0, time.UTC), Type: common.TokenType_Date, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: time.Date(2021, time.December, 22, 10, 11, 12, 123456000, time.UTC), Type: common.TokenType_Timestamp, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: common.CardNumber("4111111111111111"), Type: common.TokenType_CardNumber, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
 	}
 
 	tokenStorage, err := storage.NewMemoryTokenStorage()
@@ -128,6 +135,11 @@ func TestPseudoanonymizer_AnonymizeConsistently(t *testing.T) {
 		{Value: common.Email("string"), Type: common.TokenType_Email, Context: common.TokenContext{ClientID: []byte(`some context5`)}},
 		{Value: common.Email("string"), Type: common.TokenType_Email, Context: common.TokenContext{ZoneID: []byte(`some context5`)}},
 		{Value: common.Email("string"), Type: common.TokenType_Email, Context: common.TokenContext{ClientID: []byte(`some context5`), ZoneID: []byte(`some context5`)}},
+		{Value: common.Phone("+380441234567"), Type: common.TokenType_Phone, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: common.UUID("c8d6bb3a-1b3c-4b4e-9f6e-1c2d3e4f5a6b"), Type: common.TokenType_UUID, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: time.Date(2021, time.December, 22, 0, 0, 0, 0, time.UTC), Type: common.TokenType_Date, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: time.Date(2021, time.December, 22, 10, 11, 12, 123456000, time.UTC), Type: common.TokenType_Timestamp, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
+		{Value: common.CardNumber("4111111111111111"), Type: common.TokenType_CardNumber, Context: common.TokenContext{ClientID: []byte(`some context6`)}},
 	}
 
 	tokenStorage, err := storage.NewMemoryTokenStorage()
@@ -267,3 +279,117 @@ func incorrectTokenType(token common.TokenType) common.TokenType {
 	}
 	return common.TokenType_String
 }
+
+func TestPseudoanonymizerInvalidValues(t *testing.T) {
+	tokenStorage, err := storage.NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer, err := NewPseudoanonymizer(tokenStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testcases := []struct {
+		Value interface{}
+		Type  common.TokenType
+		Err   error
+	}{
+		{common.Phone("380441234567"), common.TokenType_Phone, ErrInvalidPhoneNumber},
+		{common.Phone("+3804412345678901"), common.TokenType_Phone, ErrInvalidPhoneNumber},
+		{common.UUID("c8d6bb3a1b3c4b4e9f6e1c2d3e4f5a6b"), common.TokenType_UUID, ErrInvalidUUID},
+		{common.CardNumber("4111 1111 1111 1111"), common.TokenType_CardNumber, ErrInvalidCardNumber},
+		{common.CardNumber("41111111111"), common.TokenType_CardNumber, ErrInvalidCardNumber},
+		{"2021-12-22", common.TokenType_Date, common.ErrUnknownTokenType},
+	}
+	for i, tcase := range testcases {
+		if _, err := tokenizer.Anonymize(tcase.Value, common.TokenContext{}, tcase.Type); err != tcase.Err {
+			t.Fatalf("[%d] Expect %s, took %v\n", i, tcase.Err, err)
+		}
+	}
+}
+
+func TestPseudoanonymizerUUIDCaseInsensitive(t *testing.T) {
+	tokenStorage, err := storage.NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer, err := NewPseudoanonymizer(tokenStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	context := common.TokenContext{ClientID: []byte(`client`)}
+	token, err := tokenizer.AnonymizeConsistently(common.UUID("C8D6BB3A-1B3C-4B4E-9F6E-1C2D3E4F5A6B"), context, common.TokenType_UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sameToken, err := tokenizer.AnonymizeConsistently(common.UUID("c8d6bb3a-1b3c-4b4e-9f6e-1c2d3e4f5a6b"), context, common.TokenType_UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != sameToken {
+		t.Fatalf("Expect the same token, took %s and %s\n", token, sameToken)
+	}
+	value, err := tokenizer.Deanonymize(common.UUID(strings.ToUpper(string(token.(common.UUID)))), context, common.TokenType_UUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != common.UUID("c8d6bb3a-1b3c-4b4e-9f6e-1c2d3e4f5a6b") {
+		t.Fatalf("Expect source value in lower case, took %s\n", value)
+	}
+}
+
+func TestPseudoanonymizerConsistentlyWithRetention(t *testing.T) {
+	tokenStorage, err := storage.NewMemoryTokenStorage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenizer, err := NewPseudoanonymizer(tokenStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withoutRetention := common.TokenContext{ClientID: []byte(`client`)}
+	shortRetention := common.TokenContext{ClientID: []byte(`client`), Retention: common.TokenRetention{TTL: time.Hour}}
+	longRetention := common.TokenContext{ClientID: []byte(`client`), Retention: common.TokenRetention{TTL: 90 * 24 * time.Hour}}
+	idleRetention := common.TokenContext{ClientID: []byte(`client`), Retention: common.TokenRetention{IdleTTL: time.Hour}}
+
+	tokens := make(map[string]common.TokenContext, 4)
+	for _, context := range []common.TokenContext{withoutRetention, shortRetention, longRetention, idleRetention} {
+		token, err := tokenizer.AnonymizeConsistently("value", context, common.TokenType_String)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sameToken, err := tokenizer.AnonymizeConsistently("value", context, common.TokenType_String)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != sameToken {
+			t.Fatalf("Expect the same token for the same retention, took %s and %s\n", token, sameToken)
+		}
+		if _, ok := tokens[token.(string)]; ok {
+			t.Fatalf("Expect different tokens for different retention, took %s twice\n", token)
+		}
+		tokens[token.(string)] = context
+	}
+
+	// tokens are stored with retention of the context they were issued in and detokenized in any context
+	for token, context := range tokens {
+		tokenID, err := tokenizer.(*pseudoanonymizer).generateDataID([]byte(token), context, common.TokenType_String)
+		if err != nil {
+			t.Fatal(err)
+		}
+		metadata, err := tokenStorage.Stat(tokenizer.(*pseudoanonymizer).generateKeyForToken(tokenID), context)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if metadata.Retention != context.Retention {
+			t.Fatalf("Expect retention %+v, took %+v\n", context.Retention, metadata.Retention)
+		}
+		value, err := tokenizer.Deanonymize(token, withoutRetention, common.TokenType_String)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != "value" {
+			t.Fatalf("Expect source value, took %s\n", value)
+		}
+	}
+}
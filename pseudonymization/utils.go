@@ -18,6 +18,8 @@ package pseudonymization
 
 import (
 	"encoding/binary"
+	"time"
+
 	"github.com/cossacklabs/acra/pseudonymization/common"
 )
 
@@ -68,6 +70,36 @@ func encodeToBytes(data interface{}, dataType common.TokenType) ([]byte, error)
 			return nil, ErrDataTypeMismatch
 		}
 		v = []byte(value)
+	case common.Phone:
+		if dataType != common.TokenType_Phone {
+			return nil, ErrDataTypeMismatch
+		}
+		v = []byte(value)
+	case common.UUID:
+		if dataType != common.TokenType_UUID {
+			return nil, ErrDataTypeMismatch
+		}
+		// the same UUID may be written in upper and lower case
+		normalized, err := normalizeUUID(value)
+		if err != nil {
+			return nil, err
+		}
+		v = []byte(normalized)
+	case common.CardNumber:
+		if dataType != common.TokenType_CardNumber {
+			return nil, ErrDataTypeMismatch
+		}
+		v = []byte(value)
+	case time.Time:
+		switch dataType {
+		case common.TokenType_Date:
+			v = []byte(value.Format(DateLayout))
+		case common.TokenType_Timestamp:
+			// the same moment may be returned by database in different time zones
+			v = []byte(value.UTC().Format(time.RFC3339Nano))
+		default:
+			return nil, ErrDataTypeMismatch
+		}
 	default:
 		return nil, common.ErrUnknownTokenType
 	}
@@ -123,6 +123,8 @@ func (*Stream) iStatement()            {}
 func (*Insert) iStatement()            {}
 func (*Update) iStatement()            {}
 func (*Delete) iStatement()            {}
+func (*Copy) iStatement()              {}
+func (*LoadData) iStatement()          {}
 func (*Set) iStatement()               {}
 func (*DBDDL) iStatement()             {}
 func (*DDL) iStatement()               {}
@@ -170,6 +172,7 @@ func (NotParsedStatement) iStatement() {}
 
 // Select represents a SELECT statement.
 type Select struct {
+	With        *With
 	Cache       string
 	Comments    Comments
 	Distinct    string
@@ -275,6 +278,7 @@ func (node *ParenSelect) SetLimit(limit *Limit) {
 
 // Union represents a UNION statement.
 type Union struct {
+	With        *With
 	Type        string
 	Left, Right SelectStatement
 	OrderBy     OrderBy
@@ -315,6 +319,7 @@ type Stream struct {
 // of the implications the deletion part may have on vindexes.
 // If you add fields here, consider adding them to calls to validateSubquerySamePlan.
 type Insert struct {
+	With       *With
 	Action     string
 	Comments   Comments
 	Ignore     string
@@ -324,6 +329,7 @@ type Insert struct {
 	Columns    Columns
 	Rows       InsertRows
 	OnDup      OnDup
+	OnConflict *OnConflict
 	Returning  Returning
 }
 
@@ -347,6 +353,7 @@ func (*ParenSelect) iInsertRows() {}
 // Update represents an UPDATE statement.
 // If you add fields here, consider adding them to calls to validateSubquerySamePlan.
 type Update struct {
+	With       *With
 	Comments   Comments
 	TableExprs TableExprs
 	Exprs      UpdateExprs
@@ -358,6 +365,7 @@ type Update struct {
 // Delete represents a DELETE statement.
 // If you add fields here, consider adding them to calls to validateSubquerySamePlan.
 type Delete struct {
+	With       *With
 	Comments   Comments
 	Targets    TableNames
 	TableExprs TableExprs
@@ -367,6 +375,75 @@ type Delete struct {
 	Limit      *Limit
 }
 
+// Copy represents a PostgreSQL COPY statement which transfers data between table and client.
+// Query is set for COPY (query) TO STDOUT instead of Table and Columns.
+type Copy struct {
+	Table     TableName
+	Columns   Columns
+	Query     SelectStatement
+	Direction string
+	Options   CopyOptions
+}
+
+// Copy.Direction
+const (
+	CopyFromStdinStr = "from stdin"
+	CopyToStdoutStr  = "to stdout"
+)
+
+// CopyOptions represents options of the COPY statement
+type CopyOptions []*CopyOption
+
+// CopyOption represents one option of the COPY statement with an optional value. Options of the legacy syntax
+// (COPY ... WITH CSV HEADER) are stored in the same form as for the parenthesized syntax.
+type CopyOption struct {
+	Name  ColIdent
+	Value Expr
+}
+
+// Names of the COPY options
+const (
+	CopyFormatOption    = "format"
+	CopyDelimiterOption = "delimiter"
+	CopyNullOption      = "null"
+	CopyHeaderOption    = "header"
+	CopyQuoteOption     = "quote"
+	CopyEscapeOption    = "escape"
+)
+
+// LoadData represents a MySQL LOAD DATA statement which loads rows from the file into the table.
+// FieldsTerminatedBy, EnclosedBy, EscapedBy, LinesStartingBy and LinesTerminatedBy are nil if not specified
+type LoadData struct {
+	Priority           string
+	Local              bool
+	Infile             *SQLVal
+	Duplicate          string
+	Table              TableName
+	Partitions         Partitions
+	Charset            string
+	FieldsTerminatedBy *SQLVal
+	EnclosedBy         *SQLVal
+	OptionallyEnclosed bool
+	EscapedBy          *SQLVal
+	LinesStartingBy    *SQLVal
+	LinesTerminatedBy  *SQLVal
+	IgnoreLines        *SQLVal
+	Columns            Columns
+	SetExprs           UpdateExprs
+}
+
+// LoadData.Priority
+const (
+	LowPriorityStr = "low_priority "
+	ConcurrentStr  = "concurrent "
+)
+
+// LoadData.Duplicate
+const (
+	LoadDataReplaceStr = "replace "
+	LoadDataIgnoreStr  = "ignore "
+)
+
 // Set represents a SET statement.
 type Set struct {
 	Comments Comments
@@ -1689,6 +1766,32 @@ type SetExpr struct {
 // OnDup represents an ON DUPLICATE KEY clause.
 type OnDup UpdateExprs
 
+// OnConflict represents PostgreSQL ON CONFLICT clause of INSERT statement:
+// ON CONFLICT [(columns) [WHERE condition] | ON CONSTRAINT name] DO NOTHING | DO UPDATE SET update_list [WHERE condition]
+type OnConflict struct {
+	Columns     Columns
+	TargetWhere *Where
+	Constraint  ColIdent
+	DoNothing   bool
+	Exprs       UpdateExprs
+	Where       *Where
+}
+
+// With represents WITH clause with common table expressions:
+// WITH [RECURSIVE] name [(columns)] AS (statement) [, ...]
+type With struct {
+	Recursive bool
+	CTEs      []*CommonTableExpr
+}
+
+// CommonTableExpr represents one common table expression of WITH clause. Statement is SelectStatement or
+// *Insert, *Update, *Delete for PostgreSQL's data-modifying statements
+type CommonTableExpr struct {
+	Name      TableIdent
+	Columns   Columns
+	Statement Statement
+}
+
 // Returning represents RETURNING clause from postgresql syntex
 type Returning Exprs
 
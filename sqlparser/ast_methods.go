@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/cossacklabs/acra/sqlparser/dependency/querypb"
 	"github.com/cossacklabs/acra/sqlparser/dependency/sqltypes"
 	"github.com/cossacklabs/acra/sqlparser/dialect"
+	"github.com/cossacklabs/acra/sqlparser/dialect/postgresql"
 )
 
 //Mode enum type used for sqlparser.Parser mode definition
@@ -200,19 +202,38 @@ func (NotParsedStatement) walkSubtree(Visit) error     { return nil }
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
-		node.From, node.Where,
+	buf.Myprintf("%vselect %v%s%s%s%v",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs)
+	// PostgreSQL doesn't have DUAL table that is used by parser for SELECT without FROM clause
+	if _, ok := buf.dialect.(*postgresql.PostgreSQLDialect); !ok || !isDualTable(node.From) {
+		buf.Myprintf(" from %v", node.From)
+	}
+	buf.Myprintf("%v%v%v%v%v%s",
+		node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
 		node.Limit, node.Lock)
 }
 
+// isDualTable return true if tables is the only DUAL table without schema and alias
+func isDualTable(tables TableExprs) bool {
+	if len(tables) != 1 {
+		return false
+	}
+	aliasedTable, ok := tables[0].(*AliasedTableExpr)
+	if !ok || !aliasedTable.As.IsEmpty() {
+		return false
+	}
+	tableName, ok := aliasedTable.Expr.(TableName)
+	return ok && tableName.Qualifier.IsEmpty() && tableName.Name.quote == 0 && strings.ToLower(tableName.Name.v) == "dual"
+}
+
 func (node *Select) walkSubtree(visit Visit) error {
 	if node == nil {
 		return nil
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.SelectExprs,
 		node.From,
@@ -241,7 +262,7 @@ func (node *ParenSelect) walkSubtree(visit Visit) error {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -251,6 +272,7 @@ func (node *Union) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Left,
 		node.Right,
 	)
@@ -276,11 +298,12 @@ func (node *Stream) walkSubtree(visit Visit) error {
 
 // Format formats the node.
 func (node *Insert) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v", node.With)
 	if !node.Default {
-		buf.Myprintf("%s %v%sinto %v%v%v %v%v%v",
+		buf.Myprintf("%s %v%sinto %v%v%v %v%v%v%v",
 			node.Action,
 			node.Comments, node.Ignore,
-			node.Table, node.Partitions, node.Columns, node.Rows, node.OnDup, node.Returning)
+			node.Table, node.Partitions, node.Columns, node.Rows, node.OnDup, node.OnConflict, node.Returning)
 	} else {
 		buf.Myprintf("%s %v%sinto %v default values",
 			node.Action,
@@ -295,18 +318,20 @@ func (node *Insert) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.Table,
 		node.Columns,
 		node.Rows,
 		node.OnDup,
+		node.OnConflict,
 	)
 }
 
 // Format formats the node.
 func (node *Update) Format(buf *TrackedBuffer) {
-	buf.Myprintf("update %v%v set %v%v%v%v",
-		node.Comments, node.TableExprs,
+	buf.Myprintf("%vupdate %v%v set %v%v%v%v",
+		node.With, node.Comments, node.TableExprs,
 		node.Exprs, node.Where, node.OrderBy, node.Limit)
 }
 
@@ -316,6 +341,7 @@ func (node *Update) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.TableExprs,
 		node.Exprs,
@@ -327,7 +353,7 @@ func (node *Update) walkSubtree(visit Visit) error {
 
 // Format formats the node.
 func (node *Delete) Format(buf *TrackedBuffer) {
-	buf.Myprintf("delete %v", node.Comments)
+	buf.Myprintf("%vdelete %v", node.With, node.Comments)
 	if node.Targets != nil {
 		buf.Myprintf("%v ", node.Targets)
 	}
@@ -340,6 +366,7 @@ func (node *Delete) walkSubtree(visit Visit) error {
 	}
 	return Walk(
 		visit,
+		node.With,
 		node.Comments,
 		node.Targets,
 		node.TableExprs,
@@ -1887,6 +1914,241 @@ func (node OnDup) walkSubtree(visit Visit) error {
 	return Walk(visit, UpdateExprs(node))
 }
 
+// Format formats the node.
+func (node *OnConflict) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf(" on conflict")
+	if len(node.Columns) > 0 {
+		buf.Myprintf(" %v%v", node.Columns, node.TargetWhere)
+	} else if !node.Constraint.IsEmpty() {
+		buf.Myprintf(" on constraint %v", node.Constraint)
+	}
+	if node.DoNothing {
+		buf.Myprintf(" do nothing")
+		return
+	}
+	buf.Myprintf(" do update set %v%v", node.Exprs, node.Where)
+}
+
+func (node *OnConflict) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Columns,
+		node.TargetWhere,
+		node.Constraint,
+		node.Exprs,
+		node.Where,
+	)
+}
+
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.Myprintf("with ")
+	if node.Recursive {
+		buf.Myprintf("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.Myprintf("%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.Myprintf(" ")
+}
+
+func (node *With) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	for _, cte := range node.CTEs {
+		if err := Walk(visit, cte); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as (%v)", node.Name, node.Columns, node.Statement)
+}
+
+func (node *CommonTableExpr) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Name,
+		node.Columns,
+		node.Statement,
+	)
+}
+
+// Format formats the node.
+func (node *Copy) Format(buf *TrackedBuffer) {
+	if node.Query != nil {
+		buf.Myprintf("copy (%v) %s%v", node.Query, node.Direction, node.Options)
+		return
+	}
+	buf.Myprintf("copy %v%v %s%v", node.Table, node.Columns, node.Direction, node.Options)
+}
+
+func (node *Copy) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		node.Table,
+		node.Columns,
+		node.Query,
+		node.Options,
+	)
+}
+
+// Option returns value of the option with the name and true if the option was specified in the statement.
+// Value is nil for options specified without a value like HEADER
+func (node *Copy) Option(name string) (Expr, bool) {
+	for _, option := range node.Options {
+		if option.Name.EqualString(name) {
+			return option.Value, true
+		}
+	}
+	return nil, false
+}
+
+// OptionString returns value of the option with the name as string and true if the option was specified in the
+// statement. Identifiers and literals are returned without quotes, empty string is returned for options without value
+func (node *Copy) OptionString(name string) (string, bool) {
+	value, ok := node.Option(name)
+	if !ok {
+		return "", false
+	}
+	switch value := value.(type) {
+	case nil:
+		return "", true
+	case *ColName:
+		return value.Name.String(), true
+	case *SQLVal:
+		return string(value.Val), true
+	case BoolVal:
+		return strconv.FormatBool(bool(value)), true
+	}
+	return String(value), true
+}
+
+// Format formats the node.
+func (node *LoadData) Format(buf *TrackedBuffer) {
+	local := ""
+	if node.Local {
+		local = "local "
+	}
+	buf.Myprintf("load data %s%sinfile %v %sinto table %v%v", node.Priority, local, node.Infile, node.Duplicate, node.Table, node.Partitions)
+	if node.Charset != "" {
+		buf.Myprintf(" character set %s", node.Charset)
+	}
+	if node.FieldsTerminatedBy != nil || node.EnclosedBy != nil || node.EscapedBy != nil {
+		buf.WriteString(" fields")
+		if node.FieldsTerminatedBy != nil {
+			buf.Myprintf(" terminated by %v", node.FieldsTerminatedBy)
+		}
+		if node.EnclosedBy != nil {
+			if node.OptionallyEnclosed {
+				buf.WriteString(" optionally")
+			}
+			buf.Myprintf(" enclosed by %v", node.EnclosedBy)
+		}
+		if node.EscapedBy != nil {
+			buf.Myprintf(" escaped by %v", node.EscapedBy)
+		}
+	}
+	if node.LinesStartingBy != nil || node.LinesTerminatedBy != nil {
+		buf.WriteString(" lines")
+		if node.LinesStartingBy != nil {
+			buf.Myprintf(" starting by %v", node.LinesStartingBy)
+		}
+		if node.LinesTerminatedBy != nil {
+			buf.Myprintf(" terminated by %v", node.LinesTerminatedBy)
+		}
+	}
+	if node.IgnoreLines != nil {
+		buf.Myprintf(" ignore %v lines", node.IgnoreLines)
+	}
+	if node.Columns != nil {
+		if len(node.Columns) == 0 {
+			buf.WriteString(" ()")
+		} else {
+			buf.Myprintf(" %v", node.Columns)
+		}
+	}
+	if node.SetExprs != nil {
+		buf.Myprintf(" set %v", node.SetExprs)
+	}
+}
+
+func (node *LoadData) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	nodes := []SQLNode{node.Table, node.Partitions}
+	// skip options which weren't specified to not pass nil values to the visitor
+	for _, value := range []*SQLVal{node.Infile, node.FieldsTerminatedBy, node.EnclosedBy, node.EscapedBy,
+		node.LinesStartingBy, node.LinesTerminatedBy, node.IgnoreLines} {
+		if value != nil {
+			nodes = append(nodes, value)
+		}
+	}
+	nodes = append(nodes, node.Columns, node.SetExprs)
+	return Walk(visit, nodes...)
+}
+
+// Format formats the node.
+func (node CopyOptions) Format(buf *TrackedBuffer) {
+	if len(node) == 0 {
+		return
+	}
+	prefix := " with ("
+	for _, option := range node {
+		buf.Myprintf("%s%v", prefix, option)
+		prefix = ", "
+	}
+	buf.WriteString(")")
+}
+
+func (node CopyOptions) walkSubtree(visit Visit) error {
+	for _, option := range node {
+		if err := Walk(visit, option); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Format formats the node.
+func (node *CopyOption) Format(buf *TrackedBuffer) {
+	// option names are keywords of the COPY statement and shouldn't be quoted as identifiers
+	if node.Value == nil {
+		buf.Myprintf("%s", node.Name.Lowered())
+		return
+	}
+	buf.Myprintf("%s %v", node.Name.Lowered(), node.Value)
+}
+
+func (node *CopyOption) walkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name, node.Value)
+}
+
 // Format formats the node.
 func (node Returning) Format(buf *TrackedBuffer) {
 	if node == nil {
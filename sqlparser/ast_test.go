@@ -52,7 +52,7 @@ func TestAppend(t *testing.T) {
 }
 
 func TestParseQueryErrorExit(t *testing.T) {
-	query := "WITH test AS (SELECT * FROM t) SELECT * FROM t WHERE id < 20"
+	query := "SELECT * FROM t FETCH FIRST 10 ROWS ONLY"
 
 	t.Run("parseQueryErrorExit - false", func(t *testing.T) {
 		statement, err := New(ModeDefault).Parse(query)
@@ -75,6 +75,28 @@ func TestParseQueryErrorExit(t *testing.T) {
 	})
 }
 
+func TestSelectWithoutFrom(t *testing.T) {
+	testcases := []struct {
+		query    string
+		dialect  dialect.Dialect
+		expected string
+	}{
+		{"insert into a(b) select 1", mysql.NewMySQLDialect(), "insert into a(b) select 1 from dual"},
+		{"insert into a(b) select 1", postgresql.NewPostgreSQLDialect(), "insert into a(b) select 1"},
+		{"select 1 from DUAL", postgresql.NewPostgreSQLDialect(), "select 1"},
+		{"select 1 from dual as d", postgresql.NewPostgreSQLDialect(), "select 1 from dual as d"},
+	}
+	for i, tcase := range testcases {
+		statement, err := ParseWithDialect(tcase.dialect, tcase.query)
+		if err != nil {
+			t.Fatalf("[%d] Can't parse query: %s\n", i, err)
+		}
+		if formatted := StringWithDialect(tcase.dialect, statement); formatted != tcase.expected {
+			t.Fatalf("[%d] Expect %s, took %s\n", i, tcase.expected, formatted)
+		}
+	}
+}
+
 func TestSelect(t *testing.T) {
 	parser := New(ModeStrict)
 	tree, err := parser.Parse("select * from t where a = 1")
@@ -627,6 +627,75 @@ var (
 		input: "insert /* bool in on duplicate */ into a values (1, 2, 3) on duplicate key update b = values(a.b), c = d",
 	}, {
 		input: "insert /* bool expression on duplicate */ into a values (1, 2) on duplicate key update b = func(a), c = a > d",
+	}, {
+		input: "insert /* on conflict do nothing */ into a values (1, 2) on conflict do nothing",
+	}, {
+		input: "insert /* on conflict columns */ into a(b, c) values (1, 2) on conflict (b) do nothing",
+	}, {
+		input: "insert /* on conflict constraint */ into a(b, c) values (1, 2) on conflict on constraint a_pkey do update set c = excluded.c",
+	}, {
+		input: "insert /* on conflict do update */ into a(b, c) values (1, 2) on conflict (b) where c > 0 do update set c = 3, d = excluded.d where a.c < 3 returning b",
+	}, {
+		input:   "insert /* on conflict placeholders */ into a(b, c) values ($1, $2) on conflict (b) do update set c = $3",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:  "insert /* on conflict keywords as identifiers */ into conflict(nothing) values (1)",
+		output: "insert /* on conflict keywords as identifiers */ into `conflict`(`nothing`) values (1)",
+	}, {
+		input: "with x as (select 1 from dual) insert /* with */ into a(b) select b from x",
+	}, {
+		input: "with x(b, c) as (select 1, 2 from dual), y as (select b from x) insert /* with columns */ into a(b) select b from y",
+	}, {
+		input: "with recursive x as (select 1 from dual) select /* with recursive */ * from x",
+	}, {
+		input: "with x as (select 1 from dual) select /* with union */ 1 from x union select 2 from dual",
+	}, {
+		input:   "with x as (insert into a(b) values ($1) returning b) update /* with insert */ c set d = 1 where e in (select b from x)",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input: "with x as (select 1 from dual) delete /* with */ from a where b in (select * from x)",
+	}, {
+		input:  "insert /* recursive as identifier */ into recursive(recursive) values (1)",
+		output: "insert /* recursive as identifier */ into `recursive`(`recursive`) values (1)",
+	}, {
+		input:   "copy a from stdin",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:   "copy s.a(b, c) from stdin with (format csv, header true, delimiter ';', null '')",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:   "COPY a (b, c) FROM STDIN (FORMAT text, FORCE_NOT_NULL (b, c))",
+		output:  "copy a(b, c) from stdin with (format `text`, force_not_null (b, c))",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:   "copy a(b) from stdin with csv header delimiter as '|' null as 'null' quote '\"' escape '\\\\'",
+		output:  "copy a(b) from stdin with (format csv, header, delimiter '|', null 'null', quote '\\\"', escape '\\\\')",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:   "copy a to stdout with binary",
+		output:  "copy a to stdout with (format `binary`)",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:   "copy (select b, c from a where d = 1) to stdout with (format csv)",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:   "select /* array comparison */ 1 from t where a = any($1) and b != ALL($2) and c = some('{1,2}')",
+		output:  "select /* array comparison */ 1 from t where a = any($1) and b != all($2) and c = some('{1,2}')",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input:   "insert into copy(stdin, stdout) values (1, 2)",
+		output:  "insert into `copy`(`stdin`, `stdout`) values (1, 2)",
+		dialect: postgresql.NewPostgreSQLDialect(),
+	}, {
+		input: "load data local infile '/tmp/a.csv' into table a",
+	}, {
+		input: "load data low_priority local infile '/tmp/a.csv' replace into table s.a partition (p0) character set utf8mb4 fields terminated by ',' optionally enclosed by '\\\"' escaped by '\\\\' lines starting by 'row:' terminated by '\\r\\n' ignore 1 lines (b, @c) set d = @c",
+	}, {
+		input:  "LOAD DATA CONCURRENT INFILE 'a.tsv' IGNORE INTO TABLE a COLUMNS ESCAPED BY '' TERMINATED BY '\\t' IGNORE 2 ROWS ()",
+		output: "load data concurrent infile 'a.tsv' ignore into table a fields terminated by '\\t' escaped by '' ignore 2 lines ()",
+	}, {
+		input:  "insert into load(infile, lines, terminated) values (1, 2, 3)",
+		output: "insert into `load`(`infile`, `lines`, `terminated`) values (1, 2, 3)",
 	}, {
 		input: "update /* simple */ a set b = 3",
 	}, {
@@ -1502,6 +1571,9 @@ func TestKeywords(t *testing.T) {
 	}, {
 		input:  "select /* non-reserved keywords as unqualified cols */ date, view, offset from t",
 		output: "select /* non-reserved keywords as unqualified cols */ `date`, `view`, `offset` from t",
+	}, {
+		input:  "select /* do as col */ do from t where do = 1",
+		output: "select /* do as col */ `do` from t where `do` = 1",
 	}, {
 		input:  "select /* share and mode as cols */ share, mode from t where share = 'foo'",
 		output: "select /* share and mode as cols */ `share`, `mode` from t where `share` = 'foo'",
@@ -65,6 +65,13 @@ type yySymType struct {
 	tableNames         TableNames
 	indexHints         *IndexHints
 	returning          Returning
+	onConflict         *OnConflict
+	with               *With
+	ctes               []*CommonTableExpr
+	cte                *CommonTableExpr
+	copyOptions        CopyOptions
+	copyOption         *CopyOption
+	loadData           *LoadData
 	expr               Expr
 	exprs              Exprs
 	boolVal            BoolVal
@@ -365,6 +372,22 @@ const QUERY = 57598
 const EXPANSION = 57599
 const UNUSED = 57600
 const RETURNING = 57601
+const CONFLICT = 57602
+const DO = 57603
+const NOTHING = 57604
+const RECURSIVE = 57605
+const COPY = 57606
+const STDIN = 57607
+const STDOUT = 57608
+const LOAD = 57609
+const INFILE = 57610
+const LOW_PRIORITY = 57611
+const TERMINATED = 57612
+const OPTIONALLY = 57613
+const ENCLOSED = 57614
+const ESCAPED = 57615
+const LINES = 57616
+const STARTING = 57617
 
 var yyToknames = [...]string{
 	"$end",
@@ -643,6 +666,22 @@ var yyToknames = [...]string{
 	"EXPANSION",
 	"UNUSED",
 	"RETURNING",
+	"CONFLICT",
+	"DO",
+	"NOTHING",
+	"RECURSIVE",
+	"COPY",
+	"STDIN",
+	"STDOUT",
+	"LOAD",
+	"INFILE",
+	"LOW_PRIORITY",
+	"TERMINATED",
+	"OPTIONALLY",
+	"ENCLOSED",
+	"ESCAPED",
+	"LINES",
+	"STARTING",
 	"';'",
 }
 var yyStatenames = [...]string{}
@@ -657,1301 +696,1576 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 3,
-	5, 30,
+	5, 106,
 	-2, 4,
-	-1, 39,
-	174, 273,
-	175, 273,
-	-2, 263,
-	-1, 250,
-	133, 626,
-	-2, 524,
-	-1, 251,
-	133, 628,
-	-2, 523,
-	-1, 252,
-	133, 629,
-	-2, 622,
-	-1, 253,
-	133, 630,
-	-2, 623,
-	-1, 325,
-	105, 808,
-	-2, 62,
-	-1, 326,
-	105, 769,
-	-2, 63,
-	-1, 331,
-	105, 753,
-	-2, 588,
-	-1, 333,
-	105, 790,
-	-2, 590,
-	-1, 552,
-	71, 523,
-	133, 628,
-	-2, 456,
-	-1, 603,
-	52, 45,
-	54, 45,
-	-2, 47,
-	-1, 754,
-	133, 632,
-	-2, 625,
-	-1, 985,
-	5, 31,
-	-2, 421,
-	-1, 1010,
-	5, 30,
-	-2, 560,
-	-1, 1244,
-	5, 31,
-	-2, 561,
-	-1, 1291,
-	5, 30,
-	-2, 563,
+	-1, 42,
+	174, 350,
+	175, 350,
+	-2, 340,
+	-1, 65,
+	5, 106,
+	-2, 25,
+	-1, 283,
+	133, 709,
+	-2, 602,
+	-1, 284,
+	133, 711,
+	-2, 601,
+	-1, 285,
+	133, 712,
+	-2, 705,
+	-1, 286,
+	133, 713,
+	-2, 706,
+	-1, 358,
+	105, 903,
+	-2, 139,
+	-1, 359,
+	105, 857,
+	-2, 140,
+	-1, 364,
+	105, 836,
+	-2, 671,
+	-1, 366,
+	105, 882,
+	-2, 673,
+	-1, 593,
+	71, 601,
+	133, 711,
+	-2, 534,
+	-1, 644,
+	52, 122,
+	54, 122,
+	-2, 124,
+	-1, 807,
+	133, 715,
+	-2, 708,
+	-1, 1011,
+	55, 97,
+	-2, 106,
+	-1, 1056,
+	5, 107,
+	-2, 499,
+	-1, 1081,
+	5, 106,
+	-2, 638,
+	-1, 1188,
+	58, 433,
+	60, 433,
+	-2, 58,
 	-1, 1354,
-	5, 31,
-	-2, 564,
+	5, 107,
+	-2, 639,
+	-1, 1421,
+	5, 106,
+	-2, 641,
+	-1, 1493,
+	5, 107,
+	-2, 642,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 12194
+const yyLast = 14806
 
 var yyAct = [...]int{
 
-	285, 53, 1345, 924, 679, 500, 255, 840, 1302, 858,
-	876, 549, 1176, 1148, 880, 1149, 229, 1250, 1073, 882,
-	1145, 284, 598, 918, 879, 59, 24, 548, 3, 220,
-	904, 1014, 712, 841, 1121, 791, 1030, 779, 977, 1076,
-	1064, 330, 613, 596, 890, 1019, 627, 788, 828, 756,
-	478, 484, 914, 427, 53, 324, 612, 600, 836, 585,
-	498, 238, 234, 959, 490, 321, 319, 58, 316, 1239,
-	1374, 1364, 1372, 312, 1352, 1370, 221, 222, 223, 224,
-	311, 228, 925, 1363, 1140, 1236, 431, 1038, 233, 1351,
-	1037, 1311, 259, 1039, 1170, 256, 248, 1171, 1172, 871,
-	565, 242, 186, 182, 183, 184, 872, 873, 614, 466,
-	615, 310, 1327, 514, 513, 523, 524, 516, 517, 518,
-	519, 520, 521, 522, 515, 707, 235, 525, 54, 29,
-	30, 468, 452, 790, 621, 622, 623, 1183, 1184, 63,
-	709, 1055, 897, 1187, 1262, 905, 1185, 710, 1224, 440,
-	1277, 1222, 225, 219, 463, 464, 1371, 1369, 1346, 1097,
-	315, 838, 1303, 476, 441, 859, 861, 65, 66, 67,
-	68, 69, 434, 837, 179, 180, 180, 717, 1305, 687,
-	621, 622, 623, 678, 1029, 1332, 1028, 1027, 429, 437,
-	197, 253, 1309, 181, 1094, 1247, 892, 1108, 941, 892,
-	1096, 993, 458, 458, 458, 458, 971, 458, 877, 525,
-	537, 538, 940, 185, 728, 458, 505, 447, 454, 515,
-	456, 951, 525, 763, 725, 82, 1101, 474, 1049, 194,
-	497, 1337, 194, 1201, 1017, 53, 616, 761, 762, 760,
-	945, 495, 1142, 194, 829, 453, 455, 892, 682, 1191,
-	534, 939, 898, 496, 495, 536, 990, 497, 486, 194,
-	194, 82, 487, 860, 540, 194, 1304, 82, 1053, 1328,
-	497, 787, 1340, 547, 492, 1356, 428, 518, 519, 520,
-	521, 522, 515, 457, 1278, 525, 551, 433, 554, 555,
-	556, 557, 558, 559, 560, 561, 905, 564, 566, 566,
-	566, 566, 566, 566, 566, 566, 574, 575, 576, 577,
-	838, 1350, 891, 1310, 1308, 891, 1095, 597, 1093, 1268,
-	936, 933, 934, 1192, 932, 952, 1186, 1100, 451, 496,
-	495, 203, 1267, 1335, 443, 444, 445, 496, 495, 731,
-	732, 829, 894, 1000, 1144, 1068, 497, 535, 619, 943,
-	946, 746, 748, 749, 497, 1067, 214, 541, 542, 543,
-	544, 545, 546, 891, 56, 435, 436, 895, 889, 887,
-	178, 194, 888, 194, 1179, 488, 747, 1056, 1178, 194,
-	1050, 55, 759, 553, 927, 604, 938, 782, 610, 194,
-	727, 693, 692, 82, 82, 82, 82, 477, 82, 567,
-	568, 569, 570, 571, 572, 573, 82, 683, 937, 315,
-	496, 495, 968, 969, 970, 681, 676, 194, 516, 517,
-	518, 519, 520, 521, 522, 515, 726, 497, 525, 1315,
-	198, 458, 1084, 449, 442, 200, 82, 309, 428, 458,
-	227, 1314, 206, 202, 780, 942, 1188, 621, 622, 623,
-	458, 458, 458, 458, 458, 458, 458, 458, 944, 1360,
-	477, 1082, 1295, 1343, 458, 458, 1015, 496, 495, 204,
-	1040, 793, 209, 621, 622, 623, 865, 207, 606, 989,
-	60, 988, 1295, 477, 497, 1242, 460, 461, 462, 696,
-	465, 582, 718, 718, 621, 622, 623, 26, 470, 713,
-	713, 194, 199, 733, 1295, 1296, 719, 1200, 194, 194,
-	194, 694, 1259, 1258, 82, 1167, 477, 1246, 477, 983,
-	82, 721, 496, 495, 1198, 1197, 459, 1194, 1195, 201,
-	757, 210, 211, 212, 213, 217, 1016, 53, 1111, 497,
-	216, 215, 1194, 1193, 56, 781, 995, 754, 1083, 607,
-	735, 1016, 551, 1088, 1085, 1078, 1079, 1086, 1081, 1080,
-	750, 983, 477, 953, 477, 821, 824, 582, 477, 752,
-	1087, 830, 793, 477, 626, 625, 1090, 1146, 582, 795,
-	1015, 983, 1196, 316, 316, 316, 316, 316, 842, 994,
-	608, 1042, 606, 1015, 992, 870, 327, 983, 597, 955,
-	862, 758, 609, 729, 755, 235, 316, 764, 765, 766,
-	767, 768, 769, 770, 771, 772, 773, 774, 775, 776,
-	777, 778, 82, 833, 56, 795, 826, 26, 194, 194,
-	82, 1272, 194, 783, 786, 194, 680, 991, 899, 194,
-	581, 82, 82, 82, 82, 82, 82, 82, 82, 866,
-	919, 734, 56, 855, 1290, 82, 82, 844, 845, 863,
-	847, 194, 864, 843, 582, 868, 846, 194, 869, 26,
-	1161, 906, 907, 908, 56, 315, 315, 315, 315, 315,
-	884, 82, 458, 1045, 458, 194, 1020, 1021, 817, 818,
-	315, 82, 458, 1008, 825, 915, 1009, 910, 315, 920,
-	909, 71, 922, 475, 472, 244, 1181, 1146, 832, 1069,
-	834, 835, 792, 794, 677, 473, 56, 587, 590, 591,
-	592, 588, 686, 589, 593, 916, 917, 1020, 1021, 1023,
-	831, 690, 469, 697, 698, 699, 700, 701, 702, 703,
-	704, 741, 1026, 1025, 852, 849, 56, 705, 706, 853,
-	1122, 848, 239, 240, 972, 587, 590, 591, 592, 588,
-	857, 589, 593, 850, 854, 1368, 591, 592, 851, 1362,
-	754, 194, 1107, 956, 194, 194, 194, 194, 194, 1124,
-	491, 960, 757, 1367, 961, 1012, 194, 966, 965, 194,
-	479, 1060, 624, 194, 489, 450, 1052, 194, 194, 1273,
-	1342, 82, 480, 1341, 1288, 1046, 1240, 929, 689, 1105,
-	973, 595, 236, 237, 1126, 491, 1130, 82, 1125, 1123,
-	1132, 964, 230, 1320, 1128, 231, 60, 1319, 1275, 963,
-	1016, 493, 1329, 1127, 1263, 724, 62, 1011, 64, 1013,
-	715, 7, 716, 6, 714, 5, 1129, 1131, 605, 327,
-	57, 1, 267, 758, 266, 796, 722, 1238, 974, 975,
-	976, 539, 926, 1072, 1010, 935, 316, 1344, 1301, 1175,
-	194, 886, 878, 82, 967, 82, 999, 426, 70, 194,
-	1336, 885, 194, 82, 954, 1307, 1261, 893, 900, 901,
-	902, 903, 1024, 1054, 1043, 896, 1032, 1041, 1034, 1180,
-	1339, 1051, 632, 631, 911, 912, 913, 629, 630, 1033,
-	628, 634, 633, 1035, 720, 1059, 205, 1061, 1062, 1063,
-	322, 594, 617, 982, 458, 921, 494, 72, 1092, 1065,
-	1065, 1057, 1058, 1047, 1048, 1091, 481, 485, 931, 1099,
-	708, 950, 467, 208, 533, 962, 980, 1036, 328, 458,
-	1153, 1066, 981, 506, 730, 483, 1318, 1084, 315, 985,
-	997, 1075, 1274, 998, 562, 928, 827, 930, 258, 745,
-	271, 268, 272, 1089, 270, 949, 269, 736, 1007, 507,
-	257, 986, 987, 246, 314, 578, 1082, 586, 584, 996,
-	583, 550, 1022, 1018, 1002, 313, 1003, 1004, 1005, 1006,
-	1104, 563, 1110, 1235, 1326, 740, 80, 28, 61, 781,
-	1151, 241, 53, 1115, 23, 842, 1147, 1154, 22, 1120,
-	1114, 21, 842, 1141, 19, 1134, 18, 1133, 1163, 1164,
-	1165, 1150, 753, 17, 20, 754, 16, 15, 14, 1152,
-	1156, 32, 329, 13, 12, 11, 10, 9, 432, 1157,
-	1155, 8, 4, 232, 82, 25, 2, 194, 1168, 1174,
-	0, 0, 0, 0, 0, 1169, 0, 1173, 1189, 1190,
-	0, 82, 1182, 1083, 0, 1117, 1118, 0, 1088, 1085,
-	1078, 1079, 1086, 1081, 1080, 0, 0, 0, 1135, 1136,
-	0, 1138, 1139, 0, 0, 1087, 0, 280, 273, 784,
-	785, 1077, 275, 276, 277, 278, 1202, 0, 274, 281,
-	0, 279, 316, 0, 82, 82, 0, 82, 0, 1204,
-	1209, 0, 1207, 0, 0, 718, 0, 0, 0, 0,
-	0, 0, 713, 1225, 0, 0, 327, 0, 1211, 0,
-	82, 1234, 1212, 194, 194, 0, 1119, 194, 0, 0,
-	1220, 0, 881, 0, 194, 1213, 0, 0, 0, 0,
-	0, 0, 0, 82, 0, 0, 0, 0, 1241, 0,
-	0, 0, 0, 1249, 329, 329, 329, 329, 0, 329,
-	0, 1252, 1253, 1254, 0, 1257, 0, 329, 1043, 1255,
-	0, 0, 0, 0, 1166, 0, 0, 0, 0, 0,
-	458, 743, 744, 82, 315, 82, 0, 1071, 0, 0,
-	0, 0, 0, 1215, 1265, 0, 1271, 504, 0, 1270,
-	0, 0, 0, 0, 1264, 0, 1266, 0, 0, 0,
-	0, 0, 1098, 0, 82, 0, 82, 82, 0, 0,
-	0, 0, 0, 1151, 0, 0, 1292, 0, 0, 1276,
-	0, 1287, 1293, 1294, 0, 753, 1285, 550, 1289, 0,
-	0, 194, 819, 820, 1150, 1300, 0, 0, 0, 82,
-	0, 0, 1306, 1291, 1317, 1312, 0, 1313, 1214, 0,
-	0, 0, 82, 194, 0, 1216, 0, 0, 0, 82,
-	1316, 1151, 0, 53, 0, 329, 0, 1226, 1227, 1228,
-	82, 618, 1231, 194, 1330, 0, 0, 0, 0, 0,
-	1334, 0, 1150, 0, 0, 1243, 1244, 1245, 0, 1248,
-	1331, 0, 875, 0, 0, 1338, 1279, 1280, 0, 1281,
-	1282, 1283, 1348, 0, 0, 0, 842, 1353, 1355, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1358, 0,
-	0, 0, 0, 82, 0, 82, 82, 82, 194, 82,
-	1357, 0, 1365, 1366, 0, 82, 0, 0, 0, 0,
-	0, 0, 1373, 0, 0, 1217, 1218, 0, 1219, 0,
-	0, 1221, 0, 1223, 0, 0, 0, 0, 0, 0,
-	0, 82, 82, 82, 0, 0, 0, 0, 0, 1284,
-	0, 0, 0, 329, 0, 0, 881, 0, 0, 0,
-	0, 329, 0, 0, 0, 0, 1297, 1298, 1299, 0,
-	0, 0, 329, 329, 329, 329, 329, 329, 329, 329,
-	1260, 0, 957, 958, 0, 485, 329, 329, 82, 82,
-	0, 0, 1321, 1322, 1323, 1324, 1325, 0, 0, 0,
-	0, 82, 1074, 0, 0, 0, 0, 26, 27, 54,
-	29, 30, 737, 0, 82, 0, 0, 0, 0, 0,
-	1375, 0, 504, 0, 0, 0, 45, 0, 329, 0,
-	0, 31, 0, 1269, 0, 0, 0, 82, 0, 0,
-	1349, 0, 0, 0, 0, 1354, 0, 0, 1113, 984,
-	40, 0, 0, 0, 56, 0, 0, 0, 1359, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1137, 0, 0, 0, 82, 0, 0, 0, 0, 0,
-	0, 822, 822, 0, 0, 1001, 1116, 822, 82, 1377,
-	1378, 0, 0, 0, 0, 0, 0, 0, 188, 0,
-	0, 0, 0, 0, 822, 0, 514, 513, 523, 524,
-	516, 517, 518, 519, 520, 521, 522, 515, 0, 881,
-	525, 881, 0, 0, 0, 0, 0, 649, 0, 320,
-	0, 0, 329, 0, 430, 0, 0, 33, 34, 36,
-	35, 38, 0, 0, 0, 0, 0, 0, 329, 0,
-	0, 482, 0, 0, 0, 0, 0, 0, 39, 46,
-	47, 0, 0, 48, 49, 37, 513, 523, 524, 516,
-	517, 518, 519, 520, 521, 522, 515, 41, 42, 525,
-	43, 44, 50, 51, 52, 1113, 0, 0, 0, 189,
-	0, 654, 218, 523, 524, 516, 517, 518, 519, 520,
-	521, 522, 515, 226, 329, 525, 329, 0, 0, 0,
-	0, 0, 0, 0, 329, 0, 0, 245, 0, 189,
-	189, 0, 0, 0, 0, 189, 0, 0, 637, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	438, 0, 439, 0, 0, 0, 0, 0, 446, 0,
-	881, 329, 0, 0, 0, 0, 1143, 650, 448, 0,
-	0, 0, 55, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1158, 1159, 0, 0, 1160, 1074, 881, 1162,
-	0, 664, 665, 666, 667, 668, 669, 670, 0, 671,
-	672, 673, 674, 675, 651, 652, 653, 635, 636, 663,
-	0, 638, 0, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 655, 656, 657, 658, 659, 660, 661,
-	662, 0, 0, 0, 0, 1232, 477, 0, 0, 0,
-	0, 189, 1233, 189, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 0, 0, 0, 0, 0, 1210, 514, 513,
-	523, 524, 516, 517, 518, 519, 520, 521, 522, 515,
-	580, 0, 525, 0, 0, 0, 0, 471, 0, 603,
-	0, 0, 1230, 0, 0, 1031, 0, 514, 513, 523,
-	524, 516, 517, 518, 519, 520, 521, 522, 515, 0,
-	1237, 525, 329, 978, 0, 0, 0, 550, 797, 798,
-	799, 800, 801, 802, 803, 804, 806, 807, 808, 809,
-	810, 811, 812, 813, 814, 815, 816, 805, 0, 514,
-	513, 523, 524, 516, 517, 518, 519, 520, 521, 522,
-	515, 0, 0, 525, 0, 1070, 329, 0, 329, 514,
-	513, 523, 524, 516, 517, 518, 519, 520, 521, 522,
-	515, 189, 0, 525, 0, 0, 0, 0, 189, 602,
-	189, 329, 0, 0, 0, 0, 0, 1229, 477, 514,
-	513, 523, 524, 516, 517, 518, 519, 520, 521, 522,
-	515, 0, 0, 525, 329, 550, 0, 684, 685, 0,
-	0, 688, 0, 0, 691, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 329, 0, 514, 513,
-	523, 524, 516, 517, 518, 519, 520, 521, 522, 515,
-	711, 822, 525, 0, 504, 0, 1031, 0, 822, 514,
-	513, 523, 524, 516, 517, 518, 519, 520, 521, 522,
-	515, 0, 0, 525, 742, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 329, 0, 329, 1177, 0,
-	0, 0, 0, 0, 0, 1347, 550, 0, 0, 0,
-	0, 979, 0, 0, 0, 0, 0, 0, 189, 189,
-	0, 0, 189, 0, 0, 189, 0, 0, 0, 695,
-	1203, 514, 513, 523, 524, 516, 517, 518, 519, 520,
-	521, 522, 515, 1205, 0, 525, 0, 0, 0, 0,
-	1208, 189, 0, 0, 0, 0, 0, 723, 0, 0,
-	0, 329, 0, 0, 0, 0, 0, 0, 0, 0,
-	839, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	0, 0, 509, 0, 512, 0, 0, 695, 0, 0,
-	526, 527, 528, 529, 530, 531, 532, 867, 510, 511,
-	508, 514, 513, 523, 524, 516, 517, 518, 519, 520,
-	521, 522, 515, 0, 1251, 525, 1251, 1251, 1251, 0,
-	1256, 0, 0, 0, 0, 0, 329, 0, 0, 245,
-	0, 0, 0, 0, 0, 0, 245, 245, 0, 0,
-	823, 823, 245, 0, 0, 0, 823, 0, 0, 0,
-	0, 0, 329, 329, 329, 0, 245, 245, 245, 245,
-	0, 189, 0, 823, 189, 189, 189, 189, 189, 923,
-	477, 0, 0, 0, 0, 0, 856, 0, 947, 189,
-	0, 948, 0, 602, 0, 0, 0, 189, 189, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 504,
-	504, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1251, 0, 0, 0, 0,
-	0, 514, 513, 523, 524, 516, 517, 518, 519, 520,
-	521, 522, 515, 0, 0, 525, 0, 0, 1333, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	0, 0, 189, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 822, 0, 0, 504, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1361,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	695, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 0, 0,
-	0, 0, 0, 1109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1102, 1103, 0, 0, 1106, 0, 0,
-	0, 0, 0, 0, 189, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	1199, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 695, 0, 0, 0, 0,
-	0, 0, 1206, 0, 0, 0, 0, 0, 0, 0,
-	823, 0, 0, 0, 0, 0, 0, 823, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	415, 405, 0, 377, 417, 355, 369, 425, 370, 371,
-	398, 341, 385, 131, 367, 0, 358, 336, 364, 337,
-	356, 379, 99, 382, 354, 407, 388, 113, 423, 115,
-	393, 189, 148, 124, 0, 0, 381, 409, 383, 403,
-	376, 399, 346, 392, 418, 368, 396, 419, 0, 0,
-	0, 378, 81, 189, 0, 621, 622, 623, 883, 0,
-	0, 0, 0, 92, 0, 0, 0, 395, 414, 366,
-	0, 0, 0, 189, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	397, 335, 394, 0, 339, 342, 424, 412, 361, 362,
-	1044, 0, 0, 0, 0, 0, 0, 380, 384, 400,
-	374, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	0, 391, 0, 0, 0, 343, 340, 0, 602, 0,
-	0, 345, 0, 360, 401, 0, 334, 404, 410, 375,
-	195, 413, 373, 372, 416, 137, 0, 0, 151, 104,
-	103, 112, 408, 357, 365, 95, 363, 143, 133, 163,
-	390, 134, 142, 116, 155, 138, 162, 196, 170, 153,
-	169, 84, 152, 161, 93, 145, 0, 0, 0, 86,
-	159, 150, 122, 108, 109, 85, 0, 141, 98, 102,
-	97, 130, 156, 157, 96, 89, 168, 88, 90, 167,
-	129, 154, 160, 123, 120, 87, 158, 121, 119, 111,
-	100, 105, 135, 118, 136, 106, 126, 125, 127, 0,
-	338, 0, 149, 165, 177, 353, 411, 171, 172, 173,
-	174, 0, 0, 0, 128, 91, 107, 146, 110, 117,
-	140, 176, 132, 144, 94, 164, 147, 349, 352, 347,
-	348, 386, 387, 420, 421, 422, 402, 344, 0, 350,
-	351, 0, 406, 389, 83, 0, 114, 175, 139, 101,
-	166, 0, 0, 0, 0, 0, 415, 405, 0, 377,
-	417, 355, 369, 425, 370, 371, 398, 341, 385, 131,
-	367, 823, 358, 336, 364, 337, 356, 379, 99, 382,
-	354, 407, 388, 113, 423, 115, 393, 0, 148, 124,
-	0, 0, 381, 409, 383, 403, 376, 399, 346, 392,
-	418, 368, 396, 419, 0, 0, 0, 378, 81, 0,
-	0, 621, 622, 623, 883, 0, 0, 0, 0, 92,
-	0, 0, 0, 395, 414, 366, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 397, 335, 394, 0,
-	339, 342, 424, 412, 361, 362, 0, 0, 0, 0,
-	0, 0, 0, 380, 384, 400, 374, 0, 0, 0,
-	0, 0, 0, 0, 0, 359, 0, 391, 0, 0,
-	0, 343, 340, 0, 0, 0, 0, 345, 0, 360,
-	401, 0, 334, 404, 410, 375, 195, 413, 373, 372,
-	416, 137, 0, 0, 151, 104, 103, 112, 408, 357,
-	365, 95, 363, 143, 133, 163, 390, 134, 142, 116,
-	155, 138, 162, 196, 170, 153, 169, 84, 152, 161,
-	93, 145, 0, 0, 0, 86, 159, 150, 122, 108,
-	109, 85, 0, 141, 98, 102, 97, 130, 156, 157,
-	96, 89, 168, 88, 90, 167, 129, 154, 160, 123,
-	120, 87, 158, 121, 119, 111, 100, 105, 135, 118,
-	136, 106, 126, 125, 127, 0, 338, 0, 149, 165,
-	177, 353, 411, 171, 172, 173, 174, 0, 0, 0,
-	128, 91, 107, 146, 110, 117, 140, 176, 132, 144,
-	94, 164, 147, 349, 352, 347, 348, 386, 387, 420,
-	421, 422, 402, 344, 0, 350, 351, 0, 406, 389,
-	83, 0, 114, 175, 139, 101, 166, 415, 405, 0,
-	377, 417, 355, 369, 425, 370, 371, 398, 341, 385,
-	131, 367, 0, 358, 336, 364, 337, 356, 379, 99,
-	382, 354, 407, 388, 113, 423, 115, 393, 0, 148,
-	124, 0, 0, 381, 409, 383, 403, 376, 399, 346,
-	392, 418, 368, 396, 419, 0, 0, 0, 378, 252,
-	0, 0, 192, 190, 191, 0, 0, 0, 0, 0,
-	92, 0, 0, 0, 395, 414, 366, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 397, 335, 394,
-	0, 339, 342, 424, 412, 361, 362, 0, 0, 0,
-	0, 0, 0, 0, 380, 384, 400, 374, 0, 0,
-	0, 0, 0, 0, 751, 0, 359, 0, 391, 0,
-	0, 0, 343, 340, 0, 0, 0, 0, 345, 0,
-	360, 401, 0, 334, 404, 410, 375, 195, 413, 373,
-	372, 416, 137, 0, 0, 151, 104, 103, 112, 408,
-	357, 365, 95, 363, 143, 133, 163, 390, 134, 142,
-	116, 155, 138, 162, 196, 170, 153, 169, 84, 152,
-	161, 93, 145, 0, 0, 0, 86, 159, 150, 122,
-	108, 109, 85, 0, 141, 98, 102, 97, 130, 156,
-	157, 96, 89, 168, 88, 90, 167, 129, 154, 160,
-	123, 120, 87, 158, 121, 119, 111, 100, 105, 135,
-	118, 136, 106, 126, 125, 127, 0, 338, 0, 149,
-	165, 177, 353, 411, 171, 172, 173, 174, 0, 0,
-	0, 128, 91, 107, 146, 110, 117, 140, 176, 132,
-	144, 94, 164, 147, 349, 352, 347, 348, 386, 387,
-	420, 421, 422, 402, 344, 0, 350, 351, 0, 406,
-	389, 83, 0, 114, 175, 139, 101, 166, 415, 405,
-	0, 377, 417, 355, 369, 425, 370, 371, 398, 341,
-	385, 131, 367, 0, 358, 336, 364, 337, 356, 379,
-	99, 382, 354, 407, 388, 113, 423, 115, 393, 0,
-	148, 124, 0, 0, 381, 409, 383, 403, 376, 399,
-	346, 392, 418, 368, 396, 419, 0, 0, 0, 378,
-	252, 0, 0, 192, 190, 191, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 395, 414, 366, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 397, 335,
-	394, 0, 339, 342, 424, 412, 361, 362, 0, 0,
-	0, 0, 0, 0, 0, 380, 384, 400, 374, 0,
-	0, 0, 0, 0, 0, 0, 0, 359, 0, 391,
-	0, 0, 0, 343, 340, 0, 0, 0, 0, 345,
-	0, 360, 401, 0, 334, 404, 410, 375, 195, 413,
-	373, 372, 416, 137, 0, 0, 151, 104, 103, 112,
-	408, 357, 365, 95, 363, 143, 133, 163, 390, 134,
-	142, 116, 155, 138, 162, 196, 170, 153, 169, 84,
-	152, 161, 93, 145, 0, 0, 0, 86, 159, 150,
-	122, 108, 109, 85, 0, 141, 98, 102, 97, 130,
-	156, 157, 96, 89, 168, 88, 90, 167, 129, 154,
-	160, 123, 120, 87, 158, 121, 119, 111, 100, 105,
-	135, 118, 136, 106, 126, 125, 127, 0, 338, 0,
-	149, 165, 177, 353, 411, 171, 172, 173, 174, 0,
-	0, 0, 128, 91, 107, 146, 110, 117, 140, 176,
-	132, 144, 94, 164, 147, 349, 352, 347, 348, 386,
-	387, 420, 421, 422, 402, 344, 0, 350, 351, 0,
-	406, 389, 83, 0, 114, 175, 139, 101, 166, 415,
-	405, 0, 377, 417, 355, 369, 425, 370, 371, 398,
-	341, 385, 131, 367, 0, 358, 336, 364, 337, 356,
-	379, 99, 382, 354, 407, 388, 113, 423, 115, 393,
-	0, 148, 124, 0, 0, 381, 409, 383, 403, 376,
-	399, 346, 392, 418, 368, 396, 419, 0, 0, 0,
-	378, 193, 0, 0, 192, 190, 191, 0, 0, 0,
-	0, 0, 92, 0, 0, 0, 395, 414, 366, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
-	335, 394, 0, 339, 342, 424, 412, 361, 362, 0,
-	0, 0, 0, 0, 0, 0, 380, 384, 400, 374,
-	0, 0, 0, 0, 0, 0, 0, 0, 359, 0,
-	391, 0, 0, 0, 343, 340, 0, 0, 0, 0,
-	345, 0, 360, 401, 0, 334, 404, 410, 375, 195,
-	413, 373, 372, 416, 137, 0, 0, 151, 104, 103,
-	112, 408, 357, 365, 95, 363, 143, 133, 163, 390,
-	134, 142, 116, 155, 138, 162, 196, 170, 153, 169,
-	84, 152, 161, 93, 145, 0, 0, 0, 86, 159,
-	150, 122, 108, 109, 85, 0, 141, 98, 102, 97,
-	130, 156, 157, 96, 89, 168, 88, 90, 167, 129,
-	154, 160, 123, 120, 87, 158, 121, 119, 111, 100,
-	105, 135, 118, 136, 106, 126, 125, 127, 0, 338,
-	0, 149, 165, 177, 353, 411, 171, 172, 173, 174,
-	0, 0, 0, 128, 91, 107, 146, 110, 117, 140,
-	176, 132, 144, 94, 164, 147, 349, 352, 347, 348,
-	386, 387, 420, 421, 422, 402, 344, 0, 350, 351,
-	0, 406, 389, 83, 0, 114, 175, 139, 101, 166,
-	415, 405, 0, 377, 417, 355, 369, 425, 370, 371,
-	398, 341, 385, 131, 367, 0, 358, 336, 364, 337,
-	356, 379, 99, 382, 354, 407, 388, 113, 423, 115,
-	393, 0, 148, 124, 0, 0, 381, 409, 383, 403,
-	376, 399, 346, 392, 418, 368, 396, 419, 56, 0,
-	0, 378, 81, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 395, 414, 366,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	397, 335, 394, 0, 339, 342, 424, 412, 361, 362,
-	0, 0, 0, 0, 0, 0, 0, 380, 384, 400,
-	374, 0, 0, 0, 0, 0, 0, 0, 0, 359,
-	0, 391, 0, 0, 0, 343, 340, 0, 0, 0,
-	0, 345, 0, 360, 401, 0, 334, 404, 410, 375,
-	195, 413, 373, 372, 416, 137, 0, 0, 151, 104,
-	103, 112, 408, 357, 365, 95, 363, 143, 133, 163,
-	390, 134, 142, 116, 155, 138, 162, 196, 170, 153,
-	169, 84, 152, 161, 93, 145, 0, 0, 0, 86,
-	159, 150, 122, 108, 109, 85, 0, 141, 98, 102,
-	97, 130, 156, 157, 96, 89, 168, 88, 90, 167,
-	129, 154, 160, 123, 120, 87, 158, 121, 119, 111,
-	100, 105, 135, 118, 136, 106, 126, 125, 127, 0,
-	338, 0, 149, 165, 177, 353, 411, 171, 172, 173,
-	174, 0, 0, 0, 128, 91, 107, 146, 110, 117,
-	140, 176, 132, 144, 94, 164, 147, 349, 352, 347,
-	348, 386, 387, 420, 421, 422, 402, 344, 0, 350,
-	351, 0, 406, 389, 83, 0, 114, 175, 139, 101,
-	166, 415, 405, 0, 377, 417, 355, 369, 425, 370,
-	371, 398, 341, 385, 131, 367, 0, 358, 336, 364,
-	337, 356, 379, 99, 382, 354, 407, 388, 113, 423,
-	115, 393, 0, 148, 124, 0, 0, 381, 409, 383,
-	403, 376, 399, 346, 392, 418, 368, 396, 419, 0,
-	0, 0, 378, 81, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 92, 0, 0, 0, 395, 414,
-	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 397, 335, 394, 0, 339, 342, 424, 412, 361,
-	362, 0, 0, 0, 0, 0, 0, 0, 380, 384,
-	400, 374, 0, 0, 0, 0, 0, 0, 1112, 0,
-	359, 0, 391, 0, 0, 0, 343, 340, 0, 0,
-	0, 0, 345, 0, 360, 401, 0, 334, 404, 410,
-	375, 195, 413, 373, 372, 416, 137, 0, 0, 151,
-	104, 103, 112, 408, 357, 365, 95, 363, 143, 133,
-	163, 390, 134, 142, 116, 155, 138, 162, 196, 170,
-	153, 169, 84, 152, 161, 93, 145, 0, 0, 0,
-	86, 159, 150, 122, 108, 109, 85, 0, 141, 98,
-	102, 97, 130, 156, 157, 96, 89, 168, 88, 90,
-	167, 129, 154, 160, 123, 120, 87, 158, 121, 119,
-	111, 100, 105, 135, 118, 136, 106, 126, 125, 127,
-	0, 338, 0, 149, 165, 177, 353, 411, 171, 172,
-	173, 174, 0, 0, 0, 128, 91, 107, 146, 110,
-	117, 140, 176, 132, 144, 94, 164, 147, 349, 352,
-	347, 348, 386, 387, 420, 421, 422, 402, 344, 0,
-	350, 351, 0, 406, 389, 83, 0, 114, 175, 139,
-	101, 166, 415, 405, 0, 377, 417, 355, 369, 425,
-	370, 371, 398, 341, 385, 131, 367, 0, 358, 336,
-	364, 337, 356, 379, 99, 382, 354, 407, 388, 113,
-	423, 115, 393, 0, 148, 124, 0, 0, 381, 409,
-	383, 403, 376, 399, 346, 392, 418, 368, 396, 419,
-	0, 0, 0, 378, 81, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 395,
-	414, 366, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 397, 335, 394, 0, 339, 342, 424, 412,
-	361, 362, 0, 0, 0, 0, 0, 0, 0, 380,
-	384, 400, 374, 0, 0, 0, 0, 0, 0, 0,
-	0, 359, 0, 391, 0, 0, 0, 343, 340, 0,
-	0, 0, 0, 345, 0, 360, 401, 0, 334, 404,
-	410, 375, 195, 413, 373, 372, 416, 137, 0, 0,
-	151, 104, 103, 112, 408, 357, 365, 95, 363, 143,
-	133, 163, 390, 134, 142, 116, 155, 138, 162, 196,
-	170, 153, 169, 84, 152, 161, 93, 145, 0, 0,
-	0, 86, 159, 150, 122, 108, 109, 85, 0, 141,
-	98, 102, 97, 130, 156, 157, 96, 89, 168, 88,
-	90, 167, 129, 154, 160, 123, 120, 87, 158, 121,
-	119, 111, 100, 105, 135, 118, 136, 106, 126, 125,
-	127, 0, 338, 0, 149, 165, 177, 353, 411, 171,
-	172, 173, 174, 0, 0, 0, 128, 91, 107, 146,
-	110, 117, 140, 176, 132, 144, 94, 164, 147, 349,
-	352, 347, 348, 386, 387, 420, 421, 422, 402, 344,
-	0, 350, 351, 0, 406, 389, 83, 0, 114, 175,
-	139, 101, 166, 415, 405, 0, 377, 417, 355, 369,
-	425, 370, 371, 398, 341, 385, 131, 367, 0, 358,
-	336, 364, 337, 356, 379, 99, 382, 354, 407, 388,
-	113, 423, 115, 393, 0, 148, 124, 0, 0, 381,
-	409, 383, 403, 376, 399, 346, 392, 418, 368, 396,
-	419, 0, 0, 0, 378, 81, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 0, 0, 0,
-	395, 414, 366, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 397, 335, 394, 0, 339, 342, 424,
-	412, 361, 362, 0, 0, 0, 0, 0, 0, 0,
-	380, 384, 400, 374, 0, 0, 0, 0, 0, 0,
-	0, 0, 359, 0, 391, 0, 0, 0, 343, 340,
-	0, 0, 0, 0, 345, 0, 360, 401, 0, 334,
-	404, 410, 375, 195, 413, 373, 372, 416, 137, 0,
-	0, 151, 104, 103, 112, 408, 357, 365, 95, 363,
-	143, 133, 163, 390, 134, 142, 116, 155, 138, 162,
-	196, 170, 153, 169, 84, 152, 161, 93, 145, 0,
-	0, 0, 86, 159, 150, 122, 108, 109, 85, 0,
-	141, 98, 102, 97, 130, 156, 157, 96, 89, 168,
-	88, 332, 167, 129, 154, 160, 123, 120, 87, 158,
-	121, 119, 111, 100, 105, 135, 118, 136, 106, 126,
-	125, 127, 0, 338, 0, 149, 165, 177, 353, 411,
-	171, 172, 173, 174, 0, 0, 0, 333, 331, 107,
-	146, 110, 117, 140, 176, 132, 144, 94, 164, 147,
-	349, 352, 347, 348, 386, 387, 420, 421, 422, 402,
-	344, 0, 350, 351, 0, 406, 389, 83, 0, 114,
-	175, 139, 101, 166, 415, 405, 0, 377, 417, 355,
-	369, 425, 370, 371, 398, 341, 385, 131, 367, 0,
-	358, 336, 364, 337, 356, 379, 99, 382, 354, 407,
-	388, 113, 423, 115, 393, 0, 148, 124, 0, 0,
-	381, 409, 383, 403, 376, 399, 346, 392, 418, 368,
-	396, 419, 0, 0, 0, 378, 81, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
-	0, 395, 414, 366, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 397, 335, 394, 0, 339, 342,
-	424, 412, 361, 362, 0, 0, 0, 0, 0, 0,
-	0, 380, 384, 400, 374, 0, 0, 0, 0, 0,
-	0, 0, 0, 359, 0, 391, 0, 0, 0, 343,
-	340, 0, 0, 0, 0, 345, 0, 360, 401, 0,
-	334, 404, 410, 375, 195, 413, 373, 372, 416, 137,
-	0, 0, 151, 104, 103, 112, 408, 357, 365, 95,
-	363, 143, 133, 163, 390, 134, 142, 116, 155, 138,
-	162, 196, 170, 153, 169, 84, 152, 611, 93, 145,
-	0, 0, 0, 86, 159, 150, 122, 108, 109, 85,
-	0, 141, 98, 102, 97, 130, 156, 157, 96, 89,
-	168, 88, 332, 167, 129, 154, 160, 123, 120, 87,
-	158, 121, 119, 111, 100, 105, 135, 118, 136, 106,
-	126, 125, 127, 0, 338, 0, 149, 165, 177, 353,
-	411, 171, 172, 173, 174, 0, 0, 0, 333, 331,
-	107, 146, 110, 117, 140, 176, 132, 144, 94, 164,
-	147, 349, 352, 347, 348, 386, 387, 420, 421, 422,
-	402, 344, 0, 350, 351, 0, 406, 389, 83, 0,
-	114, 175, 139, 101, 166, 415, 405, 0, 377, 417,
-	355, 369, 425, 370, 371, 398, 341, 385, 131, 367,
-	0, 358, 336, 364, 337, 356, 379, 99, 382, 354,
-	407, 388, 113, 423, 115, 393, 0, 148, 124, 0,
-	0, 381, 409, 383, 403, 376, 399, 346, 392, 418,
-	368, 396, 419, 0, 0, 0, 378, 81, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 92, 0,
-	0, 0, 395, 414, 366, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 397, 335, 394, 0, 339,
-	342, 424, 412, 361, 362, 0, 0, 0, 0, 0,
-	0, 0, 380, 384, 400, 374, 0, 0, 0, 0,
-	0, 0, 0, 0, 359, 0, 391, 0, 0, 0,
-	343, 340, 0, 0, 0, 0, 345, 0, 360, 401,
-	0, 334, 404, 410, 375, 195, 413, 373, 372, 416,
-	137, 0, 0, 151, 104, 103, 112, 408, 357, 365,
-	95, 363, 143, 133, 163, 390, 134, 142, 116, 155,
-	138, 162, 196, 170, 153, 169, 84, 152, 323, 93,
-	145, 0, 0, 0, 86, 159, 150, 122, 108, 109,
-	85, 0, 141, 98, 102, 97, 130, 156, 157, 96,
-	89, 168, 88, 332, 167, 129, 154, 160, 123, 120,
-	87, 158, 121, 119, 111, 100, 105, 135, 118, 136,
-	106, 126, 125, 127, 0, 338, 0, 149, 165, 177,
-	353, 411, 171, 172, 173, 174, 0, 0, 0, 333,
-	331, 326, 325, 110, 117, 140, 176, 132, 144, 94,
-	164, 147, 349, 352, 347, 348, 386, 387, 420, 421,
-	422, 402, 344, 0, 350, 351, 0, 406, 389, 83,
-	0, 114, 175, 139, 101, 166, 131, 0, 0, 789,
-	0, 254, 0, 0, 0, 99, 0, 249, 0, 0,
-	113, 296, 115, 0, 0, 148, 124, 0, 0, 0,
-	0, 287, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 56, 0, 0, 286, 252, 280, 273, 251, 250,
-	191, 275, 276, 277, 278, 0, 92, 274, 281, 0,
-	279, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 265, 0, 295, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 262, 263,
-	243, 0, 0, 0, 307, 0, 264, 0, 0, 260,
-	261, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 195, 0, 0, 305, 0, 137, 0,
-	0, 151, 104, 103, 112, 0, 0, 0, 95, 0,
-	143, 133, 163, 0, 134, 142, 116, 155, 138, 162,
-	196, 170, 153, 169, 84, 152, 161, 93, 145, 0,
-	0, 0, 86, 159, 150, 122, 108, 109, 85, 0,
-	141, 98, 102, 97, 130, 156, 157, 96, 89, 168,
-	88, 90, 167, 129, 154, 160, 123, 120, 87, 158,
-	121, 119, 111, 100, 105, 135, 118, 136, 106, 126,
-	125, 127, 0, 0, 0, 149, 165, 177, 0, 0,
-	171, 172, 173, 174, 0, 0, 0, 128, 91, 107,
-	146, 110, 117, 140, 176, 132, 144, 94, 164, 147,
-	297, 306, 303, 304, 301, 302, 300, 299, 298, 308,
-	289, 290, 291, 292, 294, 0, 293, 83, 0, 114,
-	175, 139, 101, 166, 131, 0, 0, 0, 0, 254,
-	0, 0, 0, 99, 0, 249, 0, 0, 113, 296,
-	115, 0, 0, 148, 124, 0, 0, 0, 0, 287,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 56,
-	0, 0, 286, 252, 280, 273, 251, 250, 191, 275,
-	276, 277, 278, 0, 92, 274, 281, 0, 279, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 265, 0, 295, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 262, 263, 1286, 0,
-	0, 0, 307, 0, 264, 0, 0, 260, 261, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 195, 0, 0, 305, 0, 137, 0, 0, 151,
-	104, 103, 112, 0, 0, 0, 95, 0, 143, 133,
-	163, 0, 134, 142, 116, 155, 138, 162, 196, 170,
-	153, 169, 84, 152, 161, 93, 145, 0, 0, 0,
-	86, 159, 150, 122, 108, 109, 85, 0, 141, 98,
-	102, 97, 130, 156, 157, 96, 89, 168, 88, 90,
-	167, 129, 154, 160, 123, 120, 87, 158, 121, 119,
-	111, 100, 105, 135, 118, 136, 106, 126, 125, 127,
-	0, 0, 0, 149, 165, 177, 0, 0, 171, 172,
-	173, 174, 0, 0, 0, 128, 91, 107, 146, 110,
-	117, 140, 176, 132, 144, 94, 164, 147, 297, 306,
-	303, 304, 301, 302, 300, 299, 298, 308, 289, 290,
-	291, 292, 294, 0, 293, 83, 0, 114, 175, 139,
-	101, 166, 131, 0, 0, 0, 0, 254, 0, 0,
-	0, 99, 0, 249, 0, 0, 113, 296, 115, 0,
-	0, 148, 124, 0, 0, 0, 0, 287, 288, 0,
-	0, 0, 0, 0, 0, 0, 0, 56, 0, 477,
-	286, 252, 280, 273, 251, 250, 191, 275, 276, 277,
-	278, 0, 92, 274, 281, 0, 279, 282, 283, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 265, 0, 295, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 262, 263, 0, 0, 0, 0,
-	307, 0, 264, 0, 0, 260, 261, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
-	0, 0, 305, 0, 137, 0, 0, 151, 104, 103,
-	112, 0, 0, 0, 95, 0, 143, 133, 163, 0,
-	134, 142, 116, 155, 138, 162, 196, 170, 153, 169,
-	84, 152, 161, 93, 145, 0, 0, 0, 86, 159,
-	150, 122, 108, 109, 85, 0, 141, 98, 102, 97,
-	130, 156, 157, 96, 89, 168, 88, 90, 167, 129,
-	154, 160, 123, 120, 87, 158, 121, 119, 111, 100,
-	105, 135, 118, 136, 106, 126, 125, 127, 0, 0,
-	0, 149, 165, 177, 0, 0, 171, 172, 173, 174,
-	0, 0, 0, 128, 91, 107, 146, 110, 117, 140,
-	176, 132, 144, 94, 164, 147, 297, 306, 303, 304,
-	301, 302, 300, 299, 298, 308, 289, 290, 291, 292,
-	294, 0, 293, 83, 0, 114, 175, 139, 101, 166,
-	131, 0, 0, 0, 0, 254, 0, 0, 0, 99,
-	0, 249, 0, 0, 113, 296, 115, 0, 0, 148,
-	124, 0, 0, 0, 0, 287, 288, 0, 0, 0,
-	0, 0, 0, 0, 0, 56, 0, 0, 286, 252,
-	280, 273, 251, 250, 191, 275, 276, 277, 278, 0,
-	92, 274, 281, 0, 279, 282, 283, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	265, 0, 295, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 262, 263, 243, 0, 0, 0, 307, 0,
-	264, 0, 0, 260, 261, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 195, 0, 0,
-	305, 0, 137, 0, 0, 151, 104, 103, 112, 0,
-	0, 0, 95, 0, 143, 133, 163, 0, 134, 142,
-	116, 155, 138, 162, 196, 170, 153, 169, 84, 152,
-	161, 93, 145, 0, 0, 0, 86, 159, 150, 122,
-	108, 109, 85, 0, 141, 98, 102, 97, 130, 156,
-	157, 96, 89, 168, 88, 90, 167, 129, 154, 160,
-	123, 120, 87, 158, 121, 119, 111, 100, 105, 135,
-	118, 136, 106, 126, 125, 127, 0, 0, 0, 149,
-	165, 177, 0, 0, 171, 172, 173, 174, 0, 0,
-	0, 128, 91, 107, 146, 110, 117, 140, 176, 132,
-	144, 94, 164, 147, 297, 306, 303, 304, 301, 302,
-	300, 299, 298, 308, 289, 290, 291, 292, 294, 0,
-	293, 83, 0, 114, 175, 139, 101, 166, 131, 0,
-	0, 0, 0, 254, 0, 0, 0, 99, 0, 249,
-	0, 0, 113, 296, 115, 0, 0, 148, 124, 0,
-	0, 0, 0, 287, 288, 0, 0, 0, 0, 0,
-	0, 874, 0, 56, 0, 0, 286, 252, 280, 273,
-	251, 250, 191, 275, 276, 277, 278, 0, 92, 274,
-	281, 0, 279, 282, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 265, 0,
-	295, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	262, 263, 0, 0, 0, 0, 307, 0, 264, 0,
-	0, 260, 261, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 195, 0, 0, 305, 0,
-	137, 0, 0, 151, 104, 103, 112, 0, 0, 0,
-	95, 0, 143, 133, 163, 0, 134, 142, 116, 155,
-	138, 162, 196, 170, 153, 169, 84, 152, 161, 93,
-	145, 0, 0, 0, 86, 159, 150, 122, 108, 109,
-	85, 0, 141, 98, 102, 97, 130, 156, 157, 96,
-	89, 168, 88, 90, 167, 129, 154, 160, 123, 120,
-	87, 158, 121, 119, 111, 100, 105, 135, 118, 136,
-	106, 126, 125, 127, 0, 0, 0, 149, 165, 177,
-	0, 0, 171, 172, 173, 174, 0, 0, 0, 128,
-	91, 107, 146, 110, 117, 140, 176, 132, 144, 94,
-	164, 147, 297, 306, 303, 304, 301, 302, 300, 299,
-	298, 308, 289, 290, 291, 292, 294, 26, 293, 83,
-	0, 114, 175, 139, 101, 166, 0, 0, 0, 131,
-	0, 0, 0, 0, 254, 0, 0, 0, 99, 0,
-	249, 0, 0, 113, 296, 115, 0, 0, 148, 124,
-	0, 0, 0, 0, 287, 288, 0, 0, 0, 0,
-	0, 0, 0, 0, 56, 0, 0, 286, 252, 280,
-	273, 251, 250, 191, 275, 276, 277, 278, 0, 92,
-	274, 281, 0, 279, 282, 283, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 265,
-	0, 295, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 262, 263, 0, 0, 0, 0, 307, 0, 264,
-	0, 0, 260, 261, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 195, 0, 0, 305,
-	0, 137, 0, 0, 151, 104, 103, 112, 0, 0,
-	0, 95, 0, 143, 133, 163, 0, 134, 142, 116,
-	155, 138, 162, 196, 170, 153, 169, 84, 152, 161,
-	93, 145, 0, 0, 0, 86, 159, 150, 122, 108,
-	109, 85, 0, 141, 98, 102, 97, 130, 156, 157,
-	96, 89, 168, 88, 90, 167, 129, 154, 160, 123,
-	120, 87, 158, 121, 119, 111, 100, 105, 135, 118,
-	136, 106, 126, 125, 127, 0, 0, 0, 149, 165,
-	177, 0, 0, 171, 172, 173, 174, 0, 0, 0,
-	128, 91, 107, 146, 110, 117, 140, 176, 132, 144,
-	94, 164, 147, 297, 306, 303, 304, 301, 302, 300,
-	299, 298, 308, 289, 290, 291, 292, 294, 0, 293,
-	83, 0, 114, 175, 139, 101, 166, 131, 0, 0,
-	0, 0, 254, 0, 0, 0, 99, 0, 249, 0,
-	0, 113, 296, 115, 0, 0, 148, 124, 0, 0,
-	0, 0, 287, 288, 0, 0, 0, 0, 0, 0,
-	0, 0, 56, 0, 0, 286, 252, 280, 273, 251,
-	250, 191, 275, 276, 277, 278, 0, 92, 274, 281,
-	0, 279, 282, 283, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 265, 0, 295,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
-	263, 0, 0, 0, 0, 307, 0, 264, 0, 0,
-	260, 261, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 195, 0, 0, 305, 0, 137,
-	0, 0, 151, 104, 103, 112, 0, 0, 0, 95,
-	0, 143, 133, 163, 0, 134, 142, 116, 155, 138,
-	162, 196, 170, 153, 169, 84, 152, 161, 93, 145,
-	0, 0, 0, 86, 159, 150, 122, 108, 109, 85,
-	0, 141, 98, 102, 97, 130, 156, 157, 96, 89,
-	168, 88, 90, 167, 129, 154, 160, 123, 120, 87,
-	158, 121, 119, 111, 100, 105, 135, 118, 136, 106,
-	126, 125, 127, 0, 0, 0, 149, 165, 177, 0,
-	0, 171, 172, 173, 174, 0, 0, 0, 128, 91,
-	107, 146, 110, 117, 140, 176, 132, 144, 94, 164,
-	147, 297, 306, 303, 304, 301, 302, 300, 299, 298,
-	308, 289, 290, 291, 292, 294, 131, 293, 83, 0,
-	114, 175, 139, 101, 166, 99, 0, 0, 0, 0,
-	113, 296, 115, 0, 0, 148, 124, 0, 0, 0,
-	0, 287, 288, 0, 0, 0, 0, 0, 0, 0,
-	0, 56, 0, 0, 286, 252, 280, 273, 251, 250,
-	191, 275, 276, 277, 278, 0, 92, 274, 281, 0,
-	279, 282, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 0, 295, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 262, 263,
-	0, 0, 0, 0, 307, 0, 264, 0, 0, 260,
-	261, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 195, 0, 0, 305, 0, 137, 0,
-	0, 151, 104, 103, 112, 0, 0, 0, 95, 0,
-	143, 133, 163, 1376, 134, 142, 116, 155, 138, 162,
-	196, 170, 153, 169, 84, 152, 161, 93, 145, 0,
-	0, 0, 86, 159, 150, 122, 108, 109, 85, 0,
-	141, 98, 102, 97, 130, 156, 157, 96, 89, 168,
-	88, 90, 167, 129, 154, 160, 123, 120, 87, 158,
-	121, 119, 111, 100, 105, 135, 118, 136, 106, 126,
-	125, 127, 0, 0, 0, 149, 165, 177, 0, 0,
-	171, 172, 173, 174, 0, 0, 0, 128, 91, 107,
-	146, 110, 117, 140, 176, 132, 144, 94, 164, 147,
-	297, 306, 303, 304, 301, 302, 300, 299, 298, 308,
-	289, 290, 291, 292, 294, 131, 293, 83, 0, 114,
-	175, 139, 101, 166, 99, 0, 0, 0, 0, 113,
-	296, 115, 0, 0, 148, 124, 0, 0, 0, 0,
-	287, 288, 0, 0, 0, 0, 0, 0, 0, 0,
-	56, 0, 0, 286, 252, 280, 273, 251, 250, 191,
-	275, 276, 277, 278, 0, 92, 274, 281, 0, 279,
-	282, 283, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 295, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 262, 263, 0,
-	0, 0, 0, 307, 0, 264, 0, 0, 260, 261,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 195, 0, 0, 305, 0, 137, 0, 0,
-	151, 104, 103, 112, 0, 0, 0, 95, 0, 143,
-	133, 163, 0, 134, 142, 116, 155, 138, 162, 196,
-	170, 153, 169, 84, 152, 161, 93, 145, 0, 0,
-	0, 86, 159, 150, 122, 108, 109, 85, 0, 141,
-	98, 102, 97, 130, 156, 157, 96, 89, 168, 88,
-	90, 167, 129, 154, 160, 123, 120, 87, 158, 121,
-	119, 111, 100, 105, 135, 118, 136, 106, 126, 125,
-	127, 0, 0, 0, 149, 165, 177, 0, 0, 171,
-	172, 173, 174, 0, 0, 0, 128, 91, 107, 146,
-	110, 117, 140, 176, 132, 144, 94, 164, 147, 297,
-	306, 303, 304, 301, 302, 300, 299, 298, 308, 289,
-	290, 291, 292, 294, 131, 293, 83, 0, 114, 175,
-	139, 101, 166, 99, 0, 0, 0, 0, 113, 296,
-	115, 0, 0, 148, 124, 0, 0, 0, 0, 287,
-	288, 0, 0, 0, 0, 0, 0, 0, 0, 56,
-	0, 0, 286, 252, 280, 273, 552, 250, 191, 275,
-	276, 277, 278, 0, 92, 274, 281, 0, 279, 282,
-	283, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 295, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 262, 263, 0, 0,
-	0, 0, 307, 0, 264, 0, 0, 260, 261, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 195, 0, 0, 305, 0, 137, 0, 0, 151,
-	104, 103, 112, 0, 0, 0, 95, 0, 143, 133,
-	163, 0, 134, 142, 116, 155, 138, 162, 196, 170,
-	153, 169, 84, 152, 161, 93, 145, 0, 0, 0,
-	86, 159, 150, 122, 108, 109, 85, 0, 141, 98,
-	102, 97, 130, 156, 157, 96, 89, 168, 88, 90,
-	167, 129, 154, 160, 123, 120, 87, 158, 121, 119,
-	111, 100, 105, 135, 118, 136, 106, 126, 125, 127,
-	0, 0, 0, 149, 165, 177, 0, 0, 171, 172,
-	173, 174, 0, 0, 0, 128, 91, 107, 146, 110,
-	117, 140, 176, 132, 144, 94, 164, 147, 297, 306,
-	303, 304, 301, 302, 300, 299, 298, 308, 289, 290,
-	291, 292, 294, 0, 293, 83, 0, 114, 175, 139,
-	101, 166, 131, 0, 0, 0, 499, 0, 0, 0,
-	0, 99, 0, 0, 0, 0, 113, 0, 115, 0,
-	0, 148, 124, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 81, 0, 0, 501, 502, 503, 0, 0, 0,
-	0, 0, 92, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 496,
-	495, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 497, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
-	0, 0, 0, 0, 137, 0, 0, 151, 104, 103,
-	112, 0, 0, 0, 95, 0, 143, 133, 163, 0,
-	134, 142, 116, 155, 138, 162, 196, 170, 153, 169,
-	84, 152, 161, 93, 145, 0, 0, 0, 86, 159,
-	150, 122, 108, 109, 85, 0, 141, 98, 102, 97,
-	130, 156, 157, 96, 89, 168, 88, 90, 167, 129,
-	154, 160, 123, 120, 87, 158, 121, 119, 111, 100,
-	105, 135, 118, 136, 106, 126, 125, 127, 0, 0,
-	0, 149, 165, 177, 0, 0, 171, 172, 173, 174,
-	0, 0, 0, 128, 91, 107, 146, 110, 117, 140,
-	176, 132, 144, 94, 164, 147, 0, 0, 0, 26,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 131, 0, 83, 0, 114, 175, 139, 101, 166,
-	99, 0, 0, 0, 0, 113, 0, 115, 0, 0,
-	148, 124, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 56, 0, 0, 0,
-	81, 0, 0, 501, 502, 503, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 195, 0,
-	0, 0, 0, 137, 0, 0, 151, 104, 103, 112,
-	0, 0, 0, 95, 0, 143, 133, 163, 0, 134,
-	142, 116, 155, 138, 162, 196, 170, 153, 169, 84,
-	152, 161, 93, 145, 0, 0, 0, 86, 159, 150,
-	122, 108, 109, 85, 0, 141, 98, 102, 97, 130,
-	156, 157, 96, 89, 168, 88, 90, 167, 129, 154,
-	160, 123, 120, 87, 158, 121, 119, 111, 100, 105,
-	135, 118, 136, 106, 126, 125, 127, 0, 0, 0,
-	149, 165, 177, 0, 0, 171, 172, 173, 174, 0,
-	0, 0, 128, 91, 107, 146, 110, 117, 140, 176,
-	132, 144, 94, 164, 147, 0, 0, 0, 26, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	131, 0, 83, 0, 114, 175, 139, 101, 166, 99,
-	0, 0, 0, 0, 113, 0, 115, 0, 0, 148,
-	124, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 56, 0, 0, 0, 193,
-	0, 0, 192, 190, 191, 0, 0, 0, 0, 0,
-	92, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	286, 1478, 958, 590, 1085, 978, 720, 894, 890, 1240,
+	1429, 1023, 912, 1306, 1267, 589, 3, 771, 767, 1241,
+	768, 317, 1386, 1305, 1144, 290, 756, 7, 281, 1186,
+	934, 930, 639, 972, 262, 1010, 1236, 92, 757, 6,
+	65, 220, 1345, 637, 220, 755, 5, 1182, 1185, 936,
+	292, 68, 933, 635, 1101, 220, 895, 220, 220, 1212,
+	288, 246, 833, 67, 69, 363, 350, 845, 1147, 27,
+	66, 1135, 753, 654, 1048, 261, 944, 882, 220, 220,
+	92, 842, 952, 1090, 220, 810, 92, 519, 668, 525,
+	460, 653, 968, 641, 357, 626, 539, 531, 354, 271,
+	289, 256, 606, 352, 344, 1533, 64, 214, 844, 345,
+	1532, 247, 248, 249, 250, 1546, 1029, 1523, 1525, 1524,
+	1526, 343, 1515, 492, 258, 1536, 776, 1193, 348, 1019,
+	1018, 1462, 1490, 1348, 1346, 29, 30, 60, 32, 33,
+	1538, 266, 1503, 275, 1527, 353, 1487, 1517, 979, 1502,
+	463, 1486, 73, 1231, 48, 1343, 1196, 464, 254, 34,
+	1459, 555, 554, 564, 565, 557, 558, 559, 560, 561,
+	562, 563, 556, 1109, 1438, 566, 1108, 62, 43, 1110,
+	1261, 1188, 62, 75, 76, 77, 78, 79, 268, 925,
+	60, 32, 33, 1262, 1263, 655, 1189, 656, 29, 748,
+	60, 32, 33, 360, 926, 927, 220, 499, 220, 995,
+	212, 208, 209, 210, 220, 750, 501, 1126, 951, 1369,
+	473, 959, 751, 994, 220, 1404, 1331, 1329, 92, 92,
+	92, 92, 485, 92, 251, 245, 496, 497, 1513, 758,
+	1519, 92, 662, 663, 664, 62, 1479, 891, 892, 1168,
+	1464, 999, 220, 474, 1521, 1187, 220, 467, 1436, 205,
+	1430, 206, 993, 769, 206, 36, 37, 39, 38, 41,
+	1195, 946, 471, 1022, 472, 515, 1432, 728, 92, 719,
+	479, 1100, 1099, 528, 1188, 1190, 42, 49, 50, 946,
+	481, 51, 52, 40, 913, 915, 1098, 462, 541, 1189,
+	1120, 1400, 470, 223, 207, 44, 45, 1304, 46, 47,
+	53, 54, 55, 578, 579, 1282, 1198, 1460, 487, 1064,
+	489, 211, 582, 583, 584, 585, 586, 587, 1041, 1017,
+	527, 990, 987, 988, 781, 986, 946, 305, 576, 546,
+	480, 556, 931, 220, 566, 486, 488, 566, 594, 1545,
+	220, 220, 220, 778, 774, 775, 92, 1233, 1187, 538,
+	997, 1000, 92, 1005, 1431, 461, 662, 663, 664, 1274,
+	1275, 959, 62, 1485, 90, 1278, 1188, 1172, 1276, 1437,
+	1435, 1470, 1292, 1349, 892, 1088, 657, 945, 1190, 1283,
+	61, 1189, 914, 883, 723, 1155, 1184, 992, 770, 536,
+	348, 56, 1489, 1473, 1124, 945, 1165, 581, 841, 621,
+	57, 660, 1167, 58, 510, 538, 948, 362, 644, 991,
+	533, 529, 1531, 465, 1153, 537, 536, 883, 484, 1071,
+	817, 476, 477, 478, 608, 609, 610, 611, 612, 613,
+	614, 949, 538, 61, 815, 816, 814, 204, 1494, 1405,
+	1187, 1375, 945, 61, 651, 645, 996, 943, 941, 537,
+	536, 942, 1374, 1060, 92, 1059, 1235, 1006, 1139, 998,
+	220, 220, 92, 1138, 220, 1127, 538, 220, 1171, 360,
+	1190, 220, 1510, 92, 92, 92, 92, 92, 92, 92,
+	92, 559, 560, 561, 562, 563, 556, 92, 92, 566,
+	799, 801, 802, 220, 1024, 1025, 537, 536, 62, 220,
+	220, 1154, 1393, 92, 1392, 92, 1159, 1156, 1149, 1150,
+	1157, 1152, 1151, 538, 342, 800, 813, 1468, 1166, 1061,
+	1164, 92, 1270, 1158, 466, 220, 725, 726, 1269, 1161,
+	729, 92, 1121, 732, 981, 836, 737, 557, 558, 559,
+	560, 561, 562, 563, 556, 759, 759, 566, 1277, 734,
+	733, 541, 786, 780, 518, 362, 362, 362, 362, 752,
+	362, 811, 1038, 1039, 1040, 754, 754, 808, 362, 760,
+	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
+	828, 829, 830, 831, 832, 724, 762, 735, 722, 779,
+	812, 795, 537, 536, 662, 663, 664, 717, 849, 482,
+	475, 765, 807, 461, 260, 545, 835, 253, 1442, 538,
+	1087, 220, 468, 469, 220, 220, 220, 220, 220, 788,
+	784, 785, 1021, 518, 1498, 518, 220, 803, 1441, 220,
+	537, 536, 1279, 220, 1016, 518, 1086, 220, 220, 1021,
+	1476, 92, 847, 918, 849, 1384, 518, 538, 1021, 1423,
+	875, 878, 1086, 805, 1366, 1365, 884, 92, 834, 1352,
+	806, 662, 663, 664, 348, 348, 348, 348, 348, 837,
+	840, 1258, 518, 896, 960, 961, 962, 893, 62, 348,
+	920, 1111, 623, 362, 662, 663, 664, 348, 887, 659,
+	880, 537, 536, 1291, 871, 872, 1289, 1288, 1285, 1286,
+	879, 1285, 1284, 648, 921, 1054, 518, 29, 538, 29,
+	220, 1007, 518, 92, 886, 92, 888, 889, 897, 220,
+	909, 900, 220, 92, 898, 899, 917, 901, 623, 518,
+	847, 518, 29, 1079, 923, 922, 1080, 667, 666, 1351,
+	974, 1201, 1086, 70, 649, 1087, 647, 1066, 938, 954,
+	955, 956, 957, 622, 62, 1063, 62, 1379, 919, 1420,
+	647, 1418, 505, 62, 360, 965, 966, 967, 1287, 1113,
+	924, 1054, 1011, 506, 1009, 650, 977, 623, 782, 62,
+	935, 268, 1054, 1014, 1054, 1001, 509, 623, 1002, 511,
+	1065, 362, 953, 973, 1252, 1013, 970, 971, 1062, 362,
+	1116, 969, 1012, 1213, 62, 1091, 1092, 1097, 964, 963,
+	362, 362, 362, 362, 362, 362, 362, 362, 81, 1272,
+	62, 721, 976, 508, 362, 362, 811, 1238, 62, 1140,
+	1094, 731, 1215, 502, 1045, 1046, 1047, 807, 794, 1096,
+	545, 903, 772, 555, 554, 564, 565, 557, 558, 559,
+	560, 561, 562, 563, 556, 812, 906, 566, 790, 902,
+	904, 907, 1030, 1511, 1031, 905, 1501, 1217, 545, 1221,
+	1197, 1216, 1214, 1223, 362, 1026, 908, 1219, 632, 633,
+	272, 273, 1508, 532, 277, 1083, 1218, 1036, 1049, 1035,
+	1044, 1380, 1037, 1542, 1504, 806, 1081, 530, 1131, 1220,
+	1222, 1123, 628, 631, 632, 633, 629, 92, 630, 634,
+	220, 520, 665, 1103, 483, 1105, 1475, 1155, 1474, 1415,
+	1317, 1117, 983, 521, 92, 1348, 730, 876, 876, 313,
+	306, 838, 839, 876, 308, 309, 310, 311, 636, 1179,
+	307, 314, 1053, 312, 1176, 532, 1153, 1128, 1129, 1070,
+	876, 269, 270, 1104, 1114, 628, 631, 632, 633, 629,
+	348, 630, 634, 263, 1034, 1091, 1092, 92, 92, 70,
+	92, 1112, 1033, 1551, 1095, 1548, 1547, 1537, 362, 1068,
+	1130, 1535, 1132, 1133, 1134, 1534, 1452, 264, 1106, 1451,
+	1402, 1087, 534, 92, 362, 1461, 220, 220, 1370, 777,
+	220, 72, 74, 1136, 1136, 646, 63, 92, 92, 1118,
+	1119, 1, 92, 564, 565, 557, 558, 559, 560, 561,
+	562, 563, 556, 220, 300, 566, 1137, 1180, 1181, 299,
+	850, 763, 92, 1154, 580, 980, 1143, 1146, 1159, 1156,
+	1149, 1150, 1157, 1152, 1151, 989, 1160, 935, 1477, 1428,
+	362, 1266, 362, 940, 932, 1158, 459, 1191, 80, 1469,
+	362, 1148, 1205, 939, 1434, 1368, 947, 1125, 1208, 1209,
+	950, 1271, 1472, 92, 1122, 92, 673, 1239, 672, 670,
+	671, 1226, 1227, 669, 1229, 1230, 1175, 675, 1244, 1199,
+	674, 1245, 1242, 1145, 761, 231, 355, 658, 975, 1260,
+	835, 535, 82, 1163, 92, 1162, 92, 92, 985, 362,
+	1206, 1225, 1211, 1224, 1204, 1170, 749, 1004, 500, 234,
+	807, 574, 1032, 1232, 1107, 361, 1541, 1529, 1520, 1246,
+	896, 220, 1194, 1248, 1522, 1514, 1265, 896, 1516, 92,
+	1247, 1509, 516, 26, 1385, 513, 25, 24, 522, 526,
+	1280, 1281, 92, 220, 1416, 1203, 1264, 1237, 1259, 92,
+	783, 524, 1450, 1273, 1401, 547, 1069, 603, 881, 291,
+	798, 304, 301, 303, 92, 302, 789, 1078, 1228, 548,
+	279, 347, 619, 627, 625, 624, 1093, 1089, 346, 92,
+	1200, 1342, 220, 1458, 793, 1318, 31, 1290, 71, 1293,
+	274, 23, 22, 591, 21, 1312, 19, 18, 17, 20,
+	16, 1300, 1295, 604, 15, 1298, 759, 14, 35, 1297,
+	13, 12, 11, 1311, 10, 9, 1322, 935, 8, 935,
+	4, 1316, 1313, 1314, 1315, 1319, 754, 265, 28, 1302,
+	2, 1327, 348, 92, 1102, 92, 92, 92, 220, 92,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	1358, 362, 1359, 1360, 1361, 0, 1350, 0, 0, 0,
+	1347, 0, 1357, 0, 0, 318, 59, 1371, 0, 1373,
+	1362, 92, 92, 92, 1364, 1114, 1324, 1325, 0, 1326,
+	0, 0, 1328, 0, 1330, 92, 0, 0, 92, 0,
+	59, 92, 0, 0, 1141, 362, 1377, 362, 0, 1011,
+	0, 0, 1203, 1378, 0, 1382, 0, 0, 1403, 0,
+	1014, 1394, 1395, 1390, 1396, 1372, 1397, 1398, 1399, 1381,
+	362, 1312, 1013, 259, 0, 59, 0, 0, 0, 1012,
+	1414, 1367, 0, 0, 545, 545, 0, 267, 0, 1192,
+	0, 0, 0, 349, 0, 1406, 1407, 92, 1408, 1409,
+	1410, 1421, 1419, 0, 1242, 1427, 0, 0, 0, 362,
+	92, 1433, 0, 0, 0, 92, 0, 0, 935, 0,
+	0, 0, 92, 1412, 0, 0, 0, 1443, 1446, 0,
+	0, 220, 362, 1439, 0, 1440, 0, 0, 0, 1447,
+	0, 0, 0, 0, 0, 1145, 935, 876, 92, 0,
+	545, 0, 1102, 0, 876, 0, 0, 0, 0, 0,
+	1465, 796, 797, 0, 0, 0, 1463, 0, 1466, 1467,
+	0, 0, 1242, 0, 0, 0, 0, 0, 0, 0,
+	0, 362, 0, 362, 1268, 0, 1483, 0, 1481, 0,
+	0, 0, 0, 0, 0, 92, 0, 1449, 0, 1488,
+	490, 0, 0, 0, 0, 0, 0, 1340, 0, 0,
+	92, 0, 1471, 1496, 1500, 0, 1294, 591, 0, 0,
+	0, 0, 873, 874, 0, 0, 1505, 0, 0, 1296,
+	1507, 0, 1506, 0, 0, 0, 1299, 0, 0, 0,
+	0, 0, 1512, 491, 491, 491, 491, 1518, 491, 0,
+	0, 1307, 896, 1495, 517, 0, 491, 0, 0, 0,
+	0, 92, 0, 0, 0, 0, 362, 0, 507, 0,
+	0, 0, 0, 512, 514, 59, 1549, 1550, 1544, 1552,
+	1555, 0, 929, 59, 0, 0, 0, 1539, 0, 1557,
+	1558, 0, 0, 1559, 0, 896, 0, 0, 575, 0,
+	0, 0, 0, 577, 555, 554, 564, 565, 557, 558,
+	559, 560, 561, 562, 563, 556, 0, 0, 566, 0,
+	772, 588, 772, 772, 772, 0, 1363, 1339, 518, 0,
+	523, 0, 362, 896, 592, 0, 595, 596, 597, 598,
+	599, 600, 601, 602, 0, 605, 607, 607, 607, 607,
+	607, 607, 607, 607, 615, 616, 617, 618, 362, 362,
+	362, 0, 0, 1337, 0, 638, 0, 0, 0, 0,
+	0, 215, 545, 0, 244, 1387, 0, 0, 1307, 0,
+	0, 0, 0, 0, 0, 252, 0, 257, 215, 555,
+	554, 564, 565, 557, 558, 559, 560, 561, 562, 563,
+	556, 0, 0, 566, 1027, 1028, 278, 526, 215, 215,
+	0, 0, 0, 0, 215, 0, 0, 0, 0, 229,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 493,
+	494, 495, 0, 498, 1268, 0, 0, 0, 0, 0,
+	0, 503, 0, 0, 240, 0, 0, 772, 0, 0,
+	0, 0, 1307, 0, 0, 0, 0, 0, 0, 772,
+	555, 554, 564, 565, 557, 558, 559, 560, 561, 562,
+	563, 556, 1055, 0, 566, 0, 0, 0, 0, 491,
+	0, 0, 0, 0, 0, 545, 0, 491, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 491, 491,
+	491, 491, 491, 491, 491, 491, 0, 0, 1072, 0,
+	0, 0, 491, 491, 0, 0, 0, 0, 224, 0,
+	0, 0, 0, 226, 0, 0, 0, 766, 0, 876,
+	232, 228, 1492, 0, 0, 0, 215, 0, 215, 0,
+	0, 0, 0, 0, 215, 0, 0, 1499, 0, 0,
+	0, 0, 0, 0, 215, 0, 0, 230, 0, 0,
+	235, 0, 0, 0, 0, 233, 0, 0, 0, 0,
+	0, 0, 876, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 504, 0, 0, 0, 257, 0, 0, 0,
+	225, 0, 0, 59, 0, 0, 0, 0, 772, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 592, 0,
+	876, 0, 0, 0, 0, 0, 0, 227, 0, 236,
+	237, 238, 239, 243, 0, 0, 0, 0, 242, 241,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
+	349, 349, 349, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 638, 0, 916, 0, 1336, 518,
+	0, 0, 349, 0, 718, 0, 0, 0, 0, 0,
+	0, 0, 727, 215, 0, 0, 0, 0, 0, 0,
+	215, 643, 215, 738, 739, 740, 741, 742, 743, 744,
+	745, 0, 0, 0, 0, 0, 1234, 746, 747, 0,
+	555, 554, 564, 565, 557, 558, 559, 560, 561, 562,
+	563, 556, 1249, 1250, 566, 0, 1251, 0, 0, 1253,
+	555, 554, 564, 565, 557, 558, 559, 560, 561, 562,
+	563, 556, 0, 550, 566, 553, 0, 0, 491, 0,
+	491, 567, 568, 569, 570, 571, 572, 573, 491, 551,
+	552, 549, 555, 554, 564, 565, 557, 558, 559, 560,
+	561, 562, 563, 556, 0, 0, 566, 0, 0, 0,
+	773, 554, 564, 565, 557, 558, 559, 560, 561, 562,
+	563, 556, 59, 787, 566, 0, 518, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1301, 0, 0,
+	215, 215, 0, 0, 215, 0, 0, 215, 0, 0,
+	0, 736, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1042, 0, 0, 1043, 0, 0, 0, 0,
+	0, 0, 0, 215, 0, 0, 0, 0, 0, 764,
+	257, 0, 0, 0, 846, 848, 0, 555, 554, 564,
+	565, 557, 558, 559, 560, 561, 562, 563, 556, 1207,
+	1344, 566, 885, 0, 0, 215, 0, 0, 591, 0,
+	0, 0, 0, 0, 0, 0, 0, 736, 0, 555,
+	554, 564, 565, 557, 558, 559, 560, 561, 562, 563,
+	556, 0, 911, 566, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1082, 0, 1084, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 982, 0, 984, 278, 278, 0, 0,
+	877, 877, 278, 1003, 0, 349, 877, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 278, 278, 278, 278,
+	0, 215, 0, 877, 215, 215, 215, 215, 215, 0,
+	1050, 0, 0, 0, 0, 0, 910, 0, 0, 215,
+	0, 591, 0, 643, 0, 0, 0, 215, 215, 0,
+	555, 554, 564, 565, 557, 558, 559, 560, 561, 562,
+	563, 556, 0, 491, 566, 0, 851, 852, 853, 854,
+	855, 856, 857, 858, 860, 861, 862, 863, 864, 865,
+	866, 867, 868, 869, 870, 859, 1008, 0, 491, 0,
+	0, 0, 1015, 0, 0, 0, 1020, 0, 0, 0,
+	0, 0, 0, 0, 1183, 1183, 0, 555, 554, 564,
+	565, 557, 558, 559, 560, 561, 562, 563, 556, 0,
+	215, 566, 0, 0, 0, 0, 0, 0, 0, 215,
+	0, 0, 215, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1482, 591, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1051, 0, 0, 0, 0, 1243, 1052, 59, 0,
+	0, 0, 0, 0, 1056, 0, 0, 0, 0, 0,
+	0, 0, 736, 0, 1254, 1255, 1256, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 1057, 1058, 0, 0,
+	0, 0, 0, 0, 1067, 0, 0, 0, 0, 1073,
+	0, 1074, 1075, 1076, 1077, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 1142, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1303, 0, 0, 0, 0,
+	1310, 0, 0, 1169, 0, 0, 0, 0, 0, 1183,
+	0, 278, 0, 0, 0, 0, 0, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1332,
+	0, 0, 0, 0, 0, 0, 0, 1341, 0, 0,
+	215, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 491, 0, 1210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 59,
+	0, 0, 0, 1391, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1173, 1174, 0, 0,
+	1177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1257, 0, 0, 0,
+	0, 0, 0, 215, 0, 1417, 0, 0, 1243, 0,
+	0, 1422, 0, 278, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 278, 0, 690, 0, 0,
+	0, 0, 0, 0, 0, 736, 1444, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	877, 0, 0, 0, 0, 0, 0, 877, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1243, 0, 59, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 695, 0, 0, 0, 0, 0, 0, 0, 1320,
+	1321, 0, 0, 0, 0, 0, 0, 1323, 0, 0,
+	0, 215, 0, 0, 0, 0, 0, 0, 0, 1333,
+	1334, 1335, 0, 0, 1338, 0, 0, 0, 678, 0,
+	0, 1376, 0, 215, 0, 0, 0, 0, 1353, 1354,
+	1355, 1356, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 691, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 215, 0, 0, 1528, 1530, 0, 0, 0,
+	0, 705, 706, 707, 708, 709, 710, 711, 0, 712,
+	713, 714, 715, 716, 692, 693, 694, 676, 677, 704,
+	1383, 679, 0, 680, 681, 682, 683, 684, 685, 686,
+	687, 688, 689, 696, 697, 698, 699, 700, 701, 702,
+	703, 0, 0, 0, 0, 0, 0, 0, 643, 0,
+	0, 0, 0, 0, 0, 0, 0, 1411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1424, 1425, 1426, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1445, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1448, 0, 0, 0, 0, 0, 0, 0, 0,
+	1453, 1454, 1455, 1456, 1457, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1480, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1484, 0, 0, 0, 0, 1491, 0,
+	0, 1493, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 215, 1497, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1543, 0, 0, 0, 0,
+	0, 0, 877, 0, 1553, 1554, 0, 0, 0, 1556,
+	448, 438, 0, 410, 450, 388, 402, 458, 403, 404,
+	431, 374, 418, 151, 400, 0, 391, 369, 397, 370,
+	389, 412, 112, 415, 387, 440, 421, 129, 456, 131,
+	426, 0, 170, 143, 0, 877, 414, 442, 416, 436,
+	409, 432, 379, 425, 451, 401, 429, 452, 0, 0,
+	0, 411, 91, 0, 0, 662, 663, 664, 937, 0,
+	0, 0, 0, 102, 0, 0, 0, 428, 447, 399,
+	0, 0, 0, 877, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	430, 368, 427, 0, 372, 375, 457, 445, 394, 395,
+	1115, 0, 0, 0, 0, 0, 0, 413, 417, 433,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 424, 0, 0, 0, 376, 373, 0, 0, 0,
+	0, 378, 0, 393, 434, 0, 367, 437, 443, 408,
+	221, 446, 406, 405, 449, 158, 0, 0, 173, 119,
+	118, 128, 441, 390, 398, 107, 396, 165, 153, 189,
+	423, 155, 164, 132, 181, 159, 188, 222, 196, 176,
+	195, 94, 174, 187, 103, 167, 0, 0, 0, 96,
+	185, 172, 141, 124, 125, 95, 0, 162, 111, 117,
+	109, 150, 182, 183, 108, 99, 194, 98, 100, 193,
+	148, 180, 186, 142, 139, 97, 184, 140, 138, 127,
+	114, 120, 156, 135, 157, 121, 145, 144, 146, 0,
+	371, 0, 171, 191, 203, 386, 444, 197, 198, 199,
+	200, 0, 0, 0, 147, 101, 122, 168, 126, 133,
+	161, 202, 152, 166, 104, 190, 169, 382, 385, 380,
+	381, 419, 420, 453, 454, 455, 435, 377, 0, 383,
+	384, 0, 439, 422, 93, 0, 130, 201, 160, 116,
+	192, 0, 105, 110, 149, 163, 106, 177, 178, 136,
+	123, 137, 179, 154, 113, 115, 134, 175, 448, 438,
+	0, 410, 450, 388, 402, 458, 403, 404, 431, 374,
+	418, 151, 400, 0, 391, 369, 397, 370, 389, 412,
+	112, 415, 387, 440, 421, 129, 456, 131, 426, 0,
+	170, 143, 0, 0, 414, 442, 416, 436, 409, 432,
+	379, 425, 451, 401, 429, 452, 0, 0, 0, 411,
+	91, 0, 0, 662, 663, 664, 937, 0, 0, 0,
+	0, 102, 0, 0, 0, 428, 447, 399, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 430, 368,
+	427, 0, 372, 375, 457, 445, 394, 395, 0, 0,
+	0, 0, 0, 0, 0, 413, 417, 433, 407, 0,
+	0, 0, 0, 0, 0, 0, 0, 392, 0, 424,
+	0, 0, 0, 376, 373, 0, 0, 0, 0, 378,
+	0, 393, 434, 0, 367, 437, 443, 408, 221, 446,
+	406, 405, 449, 158, 0, 0, 173, 119, 118, 128,
+	441, 390, 398, 107, 396, 165, 153, 189, 423, 155,
+	164, 132, 181, 159, 188, 222, 196, 176, 195, 94,
+	174, 187, 103, 167, 0, 0, 0, 96, 185, 172,
+	141, 124, 125, 95, 0, 162, 111, 117, 109, 150,
+	182, 183, 108, 99, 194, 98, 100, 193, 148, 180,
+	186, 142, 139, 97, 184, 140, 138, 127, 114, 120,
+	156, 135, 157, 121, 145, 144, 146, 0, 371, 0,
+	171, 191, 203, 386, 444, 197, 198, 199, 200, 0,
+	0, 0, 147, 101, 122, 168, 126, 133, 161, 202,
+	152, 166, 104, 190, 169, 382, 385, 380, 381, 419,
+	420, 453, 454, 455, 435, 377, 0, 383, 384, 0,
+	439, 422, 93, 0, 130, 201, 160, 116, 192, 0,
+	105, 110, 149, 163, 106, 177, 178, 136, 123, 137,
+	179, 154, 113, 115, 134, 175, 448, 438, 0, 410,
+	450, 388, 402, 458, 403, 404, 431, 374, 418, 151,
+	400, 0, 391, 369, 397, 370, 389, 412, 112, 415,
+	387, 440, 421, 129, 456, 131, 426, 0, 170, 143,
+	0, 0, 414, 442, 416, 436, 409, 432, 379, 425,
+	451, 401, 429, 452, 0, 0, 0, 411, 285, 0,
+	0, 218, 216, 217, 0, 0, 0, 0, 0, 102,
+	0, 0, 0, 428, 447, 399, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 430, 368, 427, 0,
+	372, 375, 457, 445, 394, 395, 0, 0, 0, 0,
+	0, 0, 0, 413, 417, 433, 407, 0, 0, 0,
+	0, 0, 0, 804, 0, 392, 0, 424, 0, 0,
+	0, 376, 373, 0, 0, 0, 0, 378, 0, 393,
+	434, 0, 367, 437, 443, 408, 221, 446, 406, 405,
+	449, 158, 0, 0, 173, 119, 118, 128, 441, 390,
+	398, 107, 396, 165, 153, 189, 423, 155, 164, 132,
+	181, 159, 188, 222, 196, 176, 195, 94, 174, 187,
+	103, 167, 0, 0, 0, 96, 185, 172, 141, 124,
+	125, 95, 0, 162, 111, 117, 109, 150, 182, 183,
+	108, 99, 194, 98, 100, 193, 148, 180, 186, 142,
+	139, 97, 184, 140, 138, 127, 114, 120, 156, 135,
+	157, 121, 145, 144, 146, 0, 371, 0, 171, 191,
+	203, 386, 444, 197, 198, 199, 200, 0, 0, 0,
+	147, 101, 122, 168, 126, 133, 161, 202, 152, 166,
+	104, 190, 169, 382, 385, 380, 381, 419, 420, 453,
+	454, 455, 435, 377, 0, 383, 384, 0, 439, 422,
+	93, 0, 130, 201, 160, 116, 192, 0, 105, 110,
+	149, 163, 106, 177, 178, 136, 123, 137, 179, 154,
+	113, 115, 134, 175, 448, 438, 0, 410, 450, 388,
+	402, 458, 403, 404, 431, 374, 418, 151, 400, 0,
+	391, 369, 397, 370, 389, 412, 112, 415, 387, 440,
+	421, 129, 456, 131, 426, 0, 170, 143, 0, 0,
+	414, 442, 416, 436, 409, 432, 379, 425, 451, 401,
+	429, 452, 0, 0, 0, 411, 285, 0, 0, 218,
+	216, 217, 0, 0, 0, 0, 0, 102, 0, 0,
+	0, 428, 447, 399, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 430, 368, 427, 0, 372, 375,
+	457, 445, 394, 395, 0, 0, 0, 0, 0, 0,
+	0, 413, 417, 433, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 424, 0, 0, 0, 376,
+	373, 0, 0, 0, 0, 378, 0, 393, 434, 0,
+	367, 437, 443, 408, 221, 446, 406, 405, 449, 158,
+	0, 0, 173, 119, 118, 128, 441, 390, 398, 107,
+	396, 165, 153, 189, 423, 155, 164, 132, 181, 159,
+	188, 222, 196, 176, 195, 94, 174, 187, 103, 167,
+	0, 0, 0, 96, 185, 172, 141, 124, 125, 95,
+	0, 162, 111, 117, 109, 150, 182, 183, 108, 99,
+	194, 98, 100, 193, 148, 180, 186, 142, 139, 97,
+	184, 140, 138, 127, 114, 120, 156, 135, 157, 121,
+	145, 144, 146, 0, 371, 0, 171, 191, 203, 386,
+	444, 197, 198, 199, 200, 0, 0, 0, 147, 101,
+	122, 168, 126, 133, 161, 202, 152, 166, 104, 190,
+	169, 382, 385, 380, 381, 419, 420, 453, 454, 455,
+	435, 377, 0, 383, 384, 0, 439, 422, 93, 0,
+	130, 201, 160, 116, 192, 0, 105, 110, 149, 163,
+	106, 177, 178, 136, 123, 137, 179, 154, 113, 115,
+	134, 175, 448, 438, 0, 410, 450, 388, 402, 458,
+	403, 404, 431, 374, 418, 151, 400, 0, 391, 369,
+	397, 370, 389, 412, 112, 415, 387, 440, 421, 129,
+	456, 131, 426, 0, 170, 143, 0, 0, 414, 442,
+	416, 436, 409, 432, 379, 425, 451, 401, 429, 452,
+	0, 0, 0, 411, 219, 0, 0, 218, 216, 217,
+	0, 0, 0, 0, 0, 102, 0, 0, 0, 428,
+	447, 399, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 430, 368, 427, 0, 372, 375, 457, 445,
+	394, 395, 0, 0, 0, 0, 0, 0, 0, 413,
+	417, 433, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 392, 0, 424, 0, 0, 0, 376, 373, 0,
+	0, 0, 0, 378, 0, 393, 434, 0, 367, 437,
+	443, 408, 221, 446, 406, 405, 449, 158, 0, 0,
+	173, 119, 118, 128, 441, 390, 398, 107, 396, 165,
+	153, 189, 423, 155, 164, 132, 181, 159, 188, 222,
+	196, 176, 195, 94, 174, 187, 103, 167, 0, 0,
+	0, 96, 185, 172, 141, 124, 125, 95, 0, 162,
+	111, 117, 109, 150, 182, 183, 108, 99, 194, 98,
+	100, 193, 148, 180, 186, 142, 139, 97, 184, 140,
+	138, 127, 114, 120, 156, 135, 157, 121, 145, 144,
+	146, 0, 371, 0, 171, 191, 203, 386, 444, 197,
+	198, 199, 200, 0, 0, 0, 147, 101, 122, 168,
+	126, 133, 161, 202, 152, 166, 104, 190, 169, 382,
+	385, 380, 381, 419, 420, 453, 454, 455, 435, 377,
+	0, 383, 384, 0, 439, 422, 93, 0, 130, 201,
+	160, 116, 192, 0, 105, 110, 149, 163, 106, 177,
+	178, 136, 123, 137, 179, 154, 113, 115, 134, 175,
+	448, 438, 0, 410, 450, 388, 402, 458, 403, 404,
+	431, 374, 418, 151, 400, 0, 391, 369, 397, 370,
+	389, 412, 112, 415, 387, 440, 421, 129, 456, 131,
+	426, 0, 170, 143, 0, 0, 414, 442, 416, 436,
+	409, 432, 379, 425, 451, 401, 429, 452, 62, 0,
+	0, 411, 91, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 102, 0, 0, 0, 428, 447, 399,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	430, 368, 427, 0, 372, 375, 457, 445, 394, 395,
+	0, 0, 0, 0, 0, 0, 0, 413, 417, 433,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 424, 0, 0, 0, 376, 373, 0, 0, 0,
+	0, 378, 0, 393, 434, 0, 367, 437, 443, 408,
+	221, 446, 406, 405, 449, 158, 0, 0, 173, 119,
+	118, 128, 441, 390, 398, 107, 396, 165, 153, 189,
+	423, 155, 164, 132, 181, 159, 188, 222, 196, 176,
+	195, 94, 174, 187, 103, 167, 0, 0, 0, 96,
+	185, 172, 141, 124, 125, 95, 0, 162, 111, 117,
+	109, 150, 182, 183, 108, 99, 194, 98, 100, 193,
+	148, 180, 186, 142, 139, 97, 184, 140, 138, 127,
+	114, 120, 156, 135, 157, 121, 145, 144, 146, 0,
+	371, 0, 171, 191, 203, 386, 444, 197, 198, 199,
+	200, 0, 0, 0, 147, 101, 122, 168, 126, 133,
+	161, 202, 152, 166, 104, 190, 169, 382, 385, 380,
+	381, 419, 420, 453, 454, 455, 435, 377, 0, 383,
+	384, 0, 439, 422, 93, 0, 130, 201, 160, 116,
+	192, 0, 105, 110, 149, 163, 106, 177, 178, 136,
+	123, 137, 179, 154, 113, 115, 134, 175, 448, 438,
+	0, 410, 450, 388, 402, 458, 403, 404, 431, 374,
+	418, 151, 400, 0, 391, 369, 397, 370, 389, 412,
+	112, 415, 387, 440, 421, 129, 456, 131, 426, 0,
+	170, 143, 0, 0, 414, 442, 416, 436, 409, 432,
+	379, 425, 451, 401, 429, 452, 0, 0, 0, 411,
+	91, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 0, 0, 0, 428, 447, 399, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 430, 368,
+	427, 0, 372, 375, 457, 445, 394, 395, 0, 0,
+	0, 0, 0, 0, 0, 413, 417, 433, 407, 0,
+	0, 0, 0, 0, 0, 1202, 0, 392, 0, 424,
+	0, 0, 0, 376, 373, 0, 0, 0, 0, 378,
+	0, 393, 434, 0, 367, 437, 443, 408, 221, 446,
+	406, 405, 449, 158, 0, 0, 173, 119, 118, 128,
+	441, 390, 398, 107, 396, 165, 153, 189, 423, 155,
+	164, 132, 181, 159, 188, 222, 196, 176, 195, 94,
+	174, 187, 103, 167, 0, 0, 0, 96, 185, 172,
+	141, 124, 125, 95, 0, 162, 111, 117, 109, 150,
+	182, 183, 108, 99, 194, 98, 100, 193, 148, 180,
+	186, 142, 139, 97, 184, 140, 138, 127, 114, 120,
+	156, 135, 157, 121, 145, 144, 146, 0, 371, 0,
+	171, 191, 203, 386, 444, 197, 198, 199, 200, 0,
+	0, 0, 147, 101, 122, 168, 126, 133, 161, 202,
+	152, 166, 104, 190, 169, 382, 385, 380, 381, 419,
+	420, 453, 454, 455, 435, 377, 0, 383, 384, 0,
+	439, 422, 93, 0, 130, 201, 160, 116, 192, 0,
+	105, 110, 149, 163, 106, 177, 178, 136, 123, 137,
+	179, 154, 113, 115, 134, 175, 448, 438, 0, 410,
+	450, 388, 402, 458, 403, 404, 431, 374, 418, 151,
+	400, 0, 391, 369, 397, 370, 389, 412, 112, 415,
+	387, 440, 421, 129, 456, 131, 426, 0, 170, 143,
+	0, 0, 414, 442, 416, 436, 409, 432, 379, 425,
+	451, 401, 429, 452, 0, 0, 0, 411, 91, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 102,
+	0, 0, 0, 428, 447, 399, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 430, 368, 427, 0,
+	372, 375, 457, 445, 394, 395, 0, 0, 0, 0,
+	0, 0, 0, 413, 417, 433, 407, 0, 0, 0,
+	0, 0, 0, 0, 0, 392, 0, 424, 0, 0,
+	0, 376, 373, 0, 0, 0, 0, 378, 0, 393,
+	434, 0, 367, 437, 443, 408, 221, 446, 406, 405,
+	449, 158, 0, 0, 173, 119, 118, 128, 441, 390,
+	398, 107, 396, 165, 153, 189, 423, 155, 164, 132,
+	181, 159, 188, 222, 196, 176, 195, 94, 174, 187,
+	103, 167, 0, 0, 0, 96, 185, 172, 141, 124,
+	125, 95, 0, 162, 111, 117, 109, 150, 182, 183,
+	108, 99, 194, 98, 100, 193, 148, 180, 186, 142,
+	139, 97, 184, 140, 138, 127, 114, 120, 156, 135,
+	157, 121, 145, 144, 146, 0, 371, 0, 171, 191,
+	203, 386, 444, 197, 198, 199, 200, 0, 0, 0,
+	147, 101, 122, 168, 126, 133, 161, 202, 152, 166,
+	104, 190, 169, 382, 385, 380, 381, 419, 420, 453,
+	454, 455, 435, 377, 0, 383, 384, 0, 439, 422,
+	93, 0, 130, 201, 160, 116, 192, 0, 105, 110,
+	149, 163, 106, 177, 178, 136, 123, 137, 179, 154,
+	113, 115, 134, 175, 448, 438, 0, 410, 450, 388,
+	402, 458, 403, 404, 431, 374, 418, 151, 400, 0,
+	391, 369, 397, 370, 389, 412, 112, 415, 387, 440,
+	421, 129, 456, 131, 426, 0, 170, 143, 0, 0,
+	414, 442, 416, 436, 409, 432, 379, 425, 451, 401,
+	429, 452, 0, 0, 0, 411, 91, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 102, 0, 0,
+	0, 428, 447, 399, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 430, 368, 427, 0, 372, 375,
+	457, 445, 394, 395, 0, 0, 0, 0, 0, 0,
+	0, 413, 417, 433, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 424, 0, 0, 0, 376,
+	373, 0, 0, 0, 0, 378, 0, 393, 434, 0,
+	367, 437, 443, 408, 221, 446, 406, 405, 449, 158,
+	0, 0, 173, 119, 118, 128, 441, 390, 398, 107,
+	396, 165, 153, 189, 423, 155, 164, 132, 181, 159,
+	188, 222, 196, 176, 195, 94, 174, 187, 103, 167,
+	0, 0, 0, 96, 185, 172, 141, 124, 125, 95,
+	0, 162, 111, 117, 109, 150, 182, 183, 108, 99,
+	194, 98, 365, 193, 148, 180, 186, 142, 139, 97,
+	184, 140, 138, 127, 114, 120, 156, 135, 157, 121,
+	145, 144, 146, 0, 371, 0, 171, 191, 203, 386,
+	444, 197, 198, 199, 200, 0, 0, 0, 366, 364,
+	122, 168, 126, 133, 161, 202, 152, 166, 104, 190,
+	169, 382, 385, 380, 381, 419, 420, 453, 454, 455,
+	435, 377, 0, 383, 384, 0, 439, 422, 93, 0,
+	130, 201, 160, 116, 192, 0, 105, 110, 149, 163,
+	106, 177, 178, 136, 123, 137, 179, 154, 113, 115,
+	134, 175, 448, 438, 0, 410, 450, 388, 402, 458,
+	403, 404, 431, 374, 418, 151, 400, 0, 391, 369,
+	397, 370, 389, 412, 112, 415, 387, 440, 421, 129,
+	456, 131, 426, 0, 170, 143, 0, 0, 414, 442,
+	416, 436, 409, 432, 379, 425, 451, 401, 429, 452,
+	0, 0, 0, 411, 91, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 102, 0, 0, 0, 428,
+	447, 399, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 430, 368, 427, 0, 372, 375, 457, 445,
+	394, 395, 0, 0, 0, 0, 0, 0, 0, 413,
+	417, 433, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 392, 0, 424, 0, 0, 0, 376, 373, 0,
+	0, 0, 0, 378, 0, 393, 434, 0, 367, 437,
+	443, 408, 221, 446, 406, 405, 449, 158, 0, 0,
+	173, 119, 118, 128, 441, 390, 398, 107, 396, 165,
+	153, 189, 423, 155, 164, 132, 181, 159, 188, 222,
+	196, 176, 195, 94, 174, 652, 103, 167, 0, 0,
+	0, 96, 185, 172, 141, 124, 125, 95, 0, 162,
+	111, 117, 109, 150, 182, 183, 108, 99, 194, 98,
+	365, 193, 148, 180, 186, 142, 139, 97, 184, 140,
+	138, 127, 114, 120, 156, 135, 157, 121, 145, 144,
+	146, 0, 371, 0, 171, 191, 203, 386, 444, 197,
+	198, 199, 200, 0, 0, 0, 366, 364, 122, 168,
+	126, 133, 161, 202, 152, 166, 104, 190, 169, 382,
+	385, 380, 381, 419, 420, 453, 454, 455, 435, 377,
+	0, 383, 384, 0, 439, 422, 93, 0, 130, 201,
+	160, 116, 192, 0, 105, 110, 149, 163, 106, 177,
+	178, 136, 123, 137, 179, 154, 113, 115, 134, 175,
+	448, 438, 0, 410, 450, 388, 402, 458, 403, 404,
+	431, 374, 418, 151, 400, 0, 391, 369, 397, 370,
+	389, 412, 112, 415, 387, 440, 421, 129, 456, 131,
+	426, 0, 170, 143, 0, 0, 414, 442, 416, 436,
+	409, 432, 379, 425, 451, 401, 429, 452, 0, 0,
+	0, 411, 91, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 102, 0, 0, 0, 428, 447, 399,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	430, 368, 427, 0, 372, 375, 457, 445, 394, 395,
+	0, 0, 0, 0, 0, 0, 0, 413, 417, 433,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 392,
+	0, 424, 0, 0, 0, 376, 373, 0, 0, 0,
+	0, 378, 0, 393, 434, 0, 367, 437, 443, 408,
+	221, 446, 406, 405, 449, 158, 0, 0, 173, 119,
+	118, 128, 441, 390, 398, 107, 396, 165, 153, 189,
+	423, 155, 164, 132, 181, 159, 188, 222, 196, 176,
+	195, 94, 174, 356, 103, 167, 0, 0, 0, 96,
+	185, 172, 141, 124, 125, 95, 0, 162, 111, 117,
+	109, 150, 182, 183, 108, 99, 194, 98, 365, 193,
+	148, 180, 186, 142, 139, 97, 184, 140, 138, 127,
+	114, 120, 156, 135, 157, 121, 145, 144, 146, 0,
+	371, 0, 171, 191, 203, 386, 444, 197, 198, 199,
+	200, 0, 0, 0, 366, 364, 359, 358, 126, 133,
+	161, 202, 152, 166, 104, 190, 169, 382, 385, 380,
+	381, 419, 420, 453, 454, 455, 435, 377, 0, 383,
+	384, 0, 439, 422, 93, 0, 130, 201, 160, 116,
+	192, 0, 105, 110, 149, 163, 106, 177, 178, 136,
+	123, 137, 179, 154, 113, 115, 134, 175, 151, 0,
+	0, 843, 0, 287, 0, 0, 0, 112, 0, 282,
+	0, 0, 129, 329, 131, 0, 0, 170, 143, 0,
+	0, 0, 0, 320, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 62, 0, 0, 319, 285, 313, 306,
+	284, 283, 217, 308, 309, 310, 311, 0, 102, 307,
+	314, 0, 312, 315, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 280, 298, 0,
+	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 296, 276, 0, 0, 0, 340, 0, 297, 0,
+	0, 293, 294, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 338, 0,
+	158, 0, 0, 173, 119, 118, 128, 0, 0, 0,
+	107, 0, 165, 153, 189, 0, 155, 164, 132, 181,
+	159, 188, 222, 196, 176, 195, 94, 174, 187, 103,
+	167, 0, 0, 0, 96, 185, 172, 141, 124, 125,
+	95, 0, 162, 111, 117, 109, 150, 182, 183, 108,
+	99, 194, 98, 100, 193, 148, 180, 186, 142, 139,
+	97, 184, 140, 138, 127, 114, 120, 156, 135, 157,
+	121, 145, 144, 146, 0, 0, 0, 171, 191, 203,
+	0, 0, 197, 198, 199, 200, 0, 0, 0, 147,
+	101, 122, 168, 126, 133, 161, 202, 152, 166, 104,
+	190, 169, 330, 339, 336, 337, 334, 335, 333, 332,
+	331, 341, 322, 323, 324, 325, 327, 0, 326, 93,
+	0, 130, 201, 160, 116, 192, 0, 105, 110, 149,
+	163, 106, 177, 178, 136, 123, 137, 179, 154, 113,
+	115, 134, 175, 151, 0, 0, 0, 0, 287, 0,
+	0, 0, 112, 0, 282, 0, 0, 129, 329, 131,
+	0, 0, 170, 143, 0, 0, 0, 0, 320, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 319, 285, 313, 306, 284, 283, 217, 308, 309,
+	310, 311, 0, 102, 307, 314, 0, 312, 315, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 298, 0, 328, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 295, 296, 1413, 0, 0,
+	0, 340, 0, 297, 0, 0, 293, 294, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	221, 0, 0, 338, 0, 158, 0, 0, 173, 119,
+	118, 128, 0, 0, 0, 107, 0, 165, 153, 189,
+	0, 155, 164, 132, 181, 159, 188, 222, 196, 176,
+	195, 94, 174, 187, 103, 167, 0, 0, 0, 96,
+	185, 172, 141, 124, 125, 95, 0, 162, 111, 117,
+	109, 150, 182, 183, 108, 99, 194, 98, 100, 193,
+	148, 180, 186, 142, 139, 97, 184, 140, 138, 127,
+	114, 120, 156, 135, 157, 121, 145, 144, 146, 0,
+	0, 0, 171, 191, 203, 0, 0, 197, 198, 199,
+	200, 0, 0, 0, 147, 101, 122, 168, 126, 133,
+	161, 202, 152, 166, 104, 190, 169, 330, 339, 336,
+	337, 334, 335, 333, 332, 331, 341, 322, 323, 324,
+	325, 327, 0, 326, 93, 0, 130, 201, 160, 116,
+	192, 0, 105, 110, 149, 163, 106, 177, 178, 136,
+	123, 137, 179, 154, 113, 115, 134, 175, 151, 0,
+	0, 0, 0, 287, 0, 0, 0, 112, 0, 282,
+	0, 0, 129, 329, 131, 0, 0, 170, 143, 0,
+	0, 0, 0, 320, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 62, 0, 518, 319, 285, 313, 306,
+	284, 283, 217, 308, 309, 310, 311, 0, 102, 307,
+	314, 0, 312, 315, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 280, 298, 0,
+	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 296, 0, 0, 0, 0, 340, 0, 297, 0,
+	0, 293, 294, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 338, 0,
+	158, 0, 0, 173, 119, 118, 128, 0, 0, 0,
+	107, 0, 165, 153, 189, 0, 155, 164, 132, 181,
+	159, 188, 222, 196, 176, 195, 94, 174, 187, 103,
+	167, 0, 0, 0, 96, 185, 172, 141, 124, 125,
+	95, 0, 162, 111, 117, 109, 150, 182, 183, 108,
+	99, 194, 98, 100, 193, 148, 180, 186, 142, 139,
+	97, 184, 140, 138, 127, 114, 120, 156, 135, 157,
+	121, 145, 144, 146, 0, 0, 0, 171, 191, 203,
+	0, 0, 197, 198, 199, 200, 0, 0, 0, 147,
+	101, 122, 168, 126, 133, 161, 202, 152, 166, 104,
+	190, 169, 330, 339, 336, 337, 334, 335, 333, 332,
+	331, 341, 322, 323, 324, 325, 327, 0, 326, 93,
+	0, 130, 201, 160, 116, 192, 0, 105, 110, 149,
+	163, 106, 177, 178, 136, 123, 137, 179, 154, 113,
+	115, 134, 175, 151, 0, 0, 0, 0, 287, 0,
+	0, 0, 112, 0, 282, 0, 0, 129, 329, 131,
+	0, 0, 170, 143, 0, 0, 0, 0, 320, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
+	0, 319, 285, 313, 306, 284, 283, 217, 308, 309,
+	310, 311, 0, 102, 307, 314, 0, 312, 315, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 298, 0, 328, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 295, 296, 276, 0, 0,
+	0, 340, 0, 297, 0, 0, 293, 294, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	221, 0, 0, 338, 0, 158, 0, 0, 173, 119,
+	118, 128, 0, 0, 0, 107, 0, 165, 153, 189,
+	0, 155, 164, 132, 181, 159, 188, 222, 196, 176,
+	195, 94, 174, 187, 103, 167, 0, 0, 0, 96,
+	185, 172, 141, 124, 125, 95, 0, 162, 111, 117,
+	109, 150, 182, 183, 108, 99, 194, 98, 100, 193,
+	148, 180, 186, 142, 139, 97, 184, 140, 138, 127,
+	114, 120, 156, 135, 157, 121, 145, 144, 146, 0,
+	0, 0, 171, 191, 203, 0, 0, 197, 198, 199,
+	200, 0, 0, 0, 147, 101, 122, 168, 126, 133,
+	161, 202, 152, 166, 104, 190, 169, 330, 339, 336,
+	337, 334, 335, 333, 332, 331, 341, 322, 323, 324,
+	325, 327, 0, 326, 93, 0, 130, 201, 160, 116,
+	192, 0, 105, 110, 149, 163, 106, 177, 178, 136,
+	123, 137, 179, 154, 113, 115, 134, 175, 151, 0,
+	0, 0, 0, 287, 0, 0, 0, 112, 0, 282,
+	0, 0, 129, 329, 131, 0, 0, 170, 143, 0,
+	0, 0, 0, 320, 321, 0, 0, 0, 0, 0,
+	0, 928, 0, 62, 0, 0, 319, 285, 313, 306,
+	284, 283, 217, 308, 309, 310, 311, 0, 102, 307,
+	314, 0, 312, 315, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 280, 298, 0,
+	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	295, 296, 0, 0, 0, 0, 340, 0, 297, 0,
+	0, 293, 294, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 338, 0,
+	158, 0, 0, 173, 119, 118, 128, 0, 0, 0,
+	107, 0, 165, 153, 189, 0, 155, 164, 132, 181,
+	159, 188, 222, 196, 176, 195, 94, 174, 187, 103,
+	167, 0, 0, 0, 96, 185, 172, 141, 124, 125,
+	95, 0, 162, 111, 117, 109, 150, 182, 183, 108,
+	99, 194, 98, 100, 193, 148, 180, 186, 142, 139,
+	97, 184, 140, 138, 127, 114, 120, 156, 135, 157,
+	121, 145, 144, 146, 0, 0, 0, 171, 191, 203,
+	0, 0, 197, 198, 199, 200, 0, 0, 0, 147,
+	101, 122, 168, 126, 133, 161, 202, 152, 166, 104,
+	190, 169, 330, 339, 336, 337, 334, 335, 333, 332,
+	331, 341, 322, 323, 324, 325, 327, 0, 326, 93,
+	0, 130, 201, 160, 116, 192, 0, 105, 110, 149,
+	163, 106, 177, 178, 136, 123, 137, 179, 154, 113,
+	115, 134, 175, 29, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 151, 0, 0, 0, 0,
+	287, 0, 0, 0, 112, 0, 282, 0, 0, 129,
+	329, 131, 0, 0, 170, 143, 0, 0, 0, 0,
+	320, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 0, 319, 285, 313, 306, 284, 283, 217,
+	308, 309, 310, 311, 0, 102, 307, 314, 0, 312,
+	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 298, 0, 328, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 296, 0,
+	0, 0, 0, 340, 0, 297, 0, 0, 293, 294,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 0, 338, 0, 158, 0, 0,
+	173, 119, 118, 128, 0, 0, 0, 107, 0, 165,
+	153, 189, 0, 155, 164, 132, 181, 159, 188, 222,
+	196, 176, 195, 94, 174, 187, 103, 167, 0, 0,
+	0, 96, 185, 172, 141, 124, 125, 95, 0, 162,
+	111, 117, 109, 150, 182, 183, 108, 99, 194, 98,
+	100, 193, 148, 180, 186, 142, 139, 97, 184, 140,
+	138, 127, 114, 120, 156, 135, 157, 121, 145, 144,
+	146, 0, 0, 0, 171, 191, 203, 0, 0, 197,
+	198, 199, 200, 0, 0, 0, 147, 101, 122, 168,
+	126, 133, 161, 202, 152, 166, 104, 190, 169, 330,
+	339, 336, 337, 334, 335, 333, 332, 331, 341, 322,
+	323, 324, 325, 327, 0, 326, 93, 0, 130, 201,
+	160, 116, 192, 0, 105, 110, 149, 163, 106, 177,
+	178, 136, 123, 137, 179, 154, 113, 115, 134, 175,
+	151, 0, 0, 0, 0, 287, 0, 0, 0, 112,
+	0, 282, 0, 0, 129, 329, 131, 0, 0, 170,
+	143, 0, 0, 0, 0, 320, 321, 0, 0, 0,
+	0, 0, 0, 0, 0, 62, 0, 0, 319, 285,
+	313, 306, 284, 283, 217, 308, 309, 310, 311, 0,
+	102, 307, 314, 0, 312, 315, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 280,
+	298, 0, 328, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 296, 0, 0, 0, 0, 340, 0,
+	297, 0, 0, 293, 294, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
+	338, 0, 158, 0, 0, 173, 119, 118, 128, 0,
+	0, 0, 107, 0, 165, 153, 189, 0, 155, 164,
+	132, 181, 159, 188, 222, 196, 176, 195, 94, 174,
+	187, 103, 167, 0, 0, 0, 96, 185, 172, 141,
+	124, 125, 95, 0, 162, 111, 117, 109, 150, 182,
+	183, 108, 99, 194, 98, 100, 193, 148, 180, 186,
+	142, 139, 97, 184, 140, 138, 127, 114, 120, 156,
+	135, 157, 121, 145, 144, 146, 0, 0, 0, 171,
+	191, 203, 0, 0, 197, 198, 199, 200, 0, 0,
+	0, 147, 101, 122, 168, 126, 133, 161, 202, 152,
+	166, 104, 190, 169, 330, 339, 336, 337, 334, 335,
+	333, 332, 331, 341, 322, 323, 324, 325, 327, 0,
+	326, 93, 0, 130, 201, 160, 116, 192, 0, 105,
+	110, 149, 163, 106, 177, 178, 136, 123, 137, 179,
+	154, 113, 115, 134, 175, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 129,
+	329, 131, 0, 0, 170, 143, 0, 0, 0, 0,
+	320, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 0, 319, 285, 313, 306, 284, 283, 217,
+	308, 309, 310, 311, 0, 102, 307, 314, 0, 312,
+	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 298, 0, 328, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 296, 0,
+	0, 0, 0, 340, 0, 297, 0, 0, 293, 294,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 0, 338, 0, 158, 0, 0,
+	173, 119, 118, 128, 0, 0, 0, 107, 0, 165,
+	153, 189, 1540, 155, 164, 132, 181, 159, 188, 222,
+	196, 176, 195, 94, 174, 187, 103, 167, 0, 0,
+	0, 96, 185, 172, 141, 124, 125, 95, 0, 162,
+	111, 117, 109, 150, 182, 183, 108, 99, 194, 98,
+	100, 193, 148, 180, 186, 142, 139, 97, 184, 140,
+	138, 127, 114, 120, 156, 135, 157, 121, 145, 144,
+	146, 0, 0, 0, 171, 191, 203, 0, 0, 197,
+	198, 199, 200, 0, 0, 0, 147, 101, 122, 168,
+	126, 133, 161, 202, 152, 166, 104, 190, 169, 330,
+	339, 336, 337, 334, 335, 333, 332, 331, 341, 322,
+	323, 324, 325, 327, 0, 326, 93, 0, 130, 201,
+	160, 116, 192, 0, 105, 110, 149, 163, 106, 177,
+	178, 136, 123, 137, 179, 154, 113, 115, 134, 175,
+	151, 0, 809, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 129, 329, 131, 0, 0, 170,
+	143, 0, 0, 0, 0, 320, 321, 0, 0, 0,
+	0, 0, 0, 0, 0, 62, 0, 0, 319, 285,
+	313, 306, 284, 283, 217, 308, 309, 310, 311, 0,
+	102, 307, 314, 0, 312, 315, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	298, 0, 328, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 296, 0, 0, 0, 0, 340, 0,
+	297, 0, 0, 293, 294, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
+	338, 0, 158, 0, 0, 173, 119, 118, 128, 0,
+	0, 0, 107, 0, 165, 153, 189, 0, 155, 164,
+	132, 181, 159, 188, 222, 196, 176, 195, 94, 174,
+	187, 103, 167, 0, 0, 0, 96, 185, 172, 141,
+	124, 125, 95, 0, 162, 111, 117, 109, 150, 182,
+	183, 108, 99, 194, 98, 100, 193, 148, 180, 186,
+	142, 139, 97, 184, 140, 138, 127, 114, 120, 156,
+	135, 157, 121, 145, 144, 146, 0, 0, 0, 171,
+	191, 203, 0, 0, 197, 198, 199, 200, 0, 0,
+	0, 147, 101, 122, 168, 126, 133, 161, 202, 152,
+	166, 104, 190, 169, 330, 339, 336, 337, 334, 335,
+	333, 332, 331, 341, 322, 323, 324, 325, 327, 0,
+	326, 93, 0, 130, 201, 160, 116, 192, 0, 105,
+	110, 149, 163, 106, 177, 178, 136, 123, 137, 179,
+	154, 113, 115, 134, 175, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 129,
+	329, 131, 0, 0, 170, 143, 0, 0, 0, 0,
+	320, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 0, 319, 285, 313, 306, 284, 283, 217,
+	308, 309, 310, 311, 0, 102, 307, 314, 0, 312,
+	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 298, 0, 328, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 295, 296, 0,
+	0, 0, 0, 340, 0, 297, 0, 0, 293, 294,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 0, 338, 0, 158, 0, 0,
+	173, 119, 118, 128, 0, 0, 0, 107, 0, 165,
+	153, 189, 0, 155, 164, 132, 181, 159, 188, 222,
+	196, 176, 195, 94, 174, 187, 103, 167, 0, 0,
+	0, 96, 185, 172, 141, 124, 125, 95, 0, 162,
+	111, 117, 109, 150, 182, 183, 108, 99, 194, 98,
+	100, 193, 148, 180, 186, 142, 139, 97, 184, 140,
+	138, 127, 114, 120, 156, 135, 157, 121, 145, 144,
+	146, 0, 0, 0, 171, 191, 203, 0, 0, 197,
+	198, 199, 200, 0, 0, 0, 147, 101, 122, 168,
+	126, 133, 161, 202, 152, 166, 104, 190, 169, 330,
+	339, 336, 337, 334, 335, 333, 332, 331, 341, 322,
+	323, 324, 325, 327, 0, 326, 93, 0, 130, 201,
+	160, 116, 192, 0, 105, 110, 149, 163, 106, 177,
+	178, 136, 123, 137, 179, 154, 113, 115, 134, 175,
+	151, 0, 0, 0, 0, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 129, 329, 131, 0, 0, 170,
+	143, 0, 0, 0, 0, 320, 321, 0, 0, 0,
+	0, 0, 0, 0, 0, 62, 0, 0, 319, 285,
+	313, 306, 593, 283, 217, 308, 309, 310, 311, 0,
+	102, 307, 314, 0, 312, 315, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	298, 0, 328, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 296, 0, 0, 0, 0, 340, 0,
+	297, 0, 0, 293, 294, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
+	338, 0, 158, 0, 0, 173, 119, 118, 128, 0,
+	0, 0, 107, 0, 165, 153, 189, 0, 155, 164,
+	132, 181, 159, 188, 222, 196, 176, 195, 94, 174,
+	187, 103, 167, 0, 0, 0, 96, 185, 172, 141,
+	124, 125, 95, 0, 162, 111, 117, 109, 150, 182,
+	183, 108, 99, 194, 98, 100, 193, 148, 180, 186,
+	142, 139, 97, 184, 140, 138, 127, 114, 120, 156,
+	135, 157, 121, 145, 144, 146, 0, 0, 0, 171,
+	191, 203, 0, 0, 197, 198, 199, 200, 0, 0,
+	0, 147, 101, 122, 168, 126, 133, 161, 202, 152,
+	166, 104, 190, 169, 330, 339, 336, 337, 334, 335,
+	333, 332, 331, 341, 322, 323, 324, 325, 327, 0,
+	326, 93, 0, 130, 201, 160, 116, 192, 0, 105,
+	110, 149, 163, 106, 177, 178, 136, 123, 137, 179,
+	154, 113, 115, 134, 175, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 112, 0, 0, 0, 0, 129,
+	0, 131, 0, 0, 170, 143, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	62, 0, 0, 0, 91, 1393, 0, 1392, 0, 0,
+	1389, 0, 0, 0, 0, 102, 0, 0, 0, 0,
+	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1388, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 0, 0, 0, 158, 0, 0,
+	173, 119, 118, 128, 0, 0, 0, 107, 0, 165,
+	153, 189, 0, 155, 164, 132, 181, 159, 188, 222,
+	196, 176, 195, 94, 174, 187, 103, 167, 0, 0,
+	0, 96, 185, 172, 141, 124, 125, 95, 0, 162,
+	111, 117, 109, 150, 182, 183, 108, 99, 194, 98,
+	100, 193, 148, 180, 186, 142, 139, 97, 184, 140,
+	138, 127, 114, 120, 156, 135, 157, 121, 145, 144,
+	146, 0, 0, 0, 171, 191, 203, 0, 0, 197,
+	198, 199, 200, 0, 0, 0, 147, 101, 122, 168,
+	126, 133, 161, 202, 152, 166, 104, 190, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 93, 0, 130, 201,
+	160, 116, 192, 0, 105, 110, 149, 163, 106, 177,
+	178, 136, 123, 137, 179, 154, 113, 115, 134, 175,
+	151, 0, 0, 0, 540, 0, 0, 0, 0, 112,
+	0, 0, 0, 0, 129, 0, 131, 0, 0, 170,
+	143, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 91,
+	0, 0, 542, 543, 544, 0, 0, 0, 0, 0,
+	102, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 537, 536, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 538, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
+	0, 0, 158, 0, 0, 173, 119, 118, 128, 0,
+	0, 0, 107, 0, 165, 153, 189, 0, 155, 164,
+	132, 181, 159, 188, 222, 196, 176, 195, 94, 174,
+	187, 103, 167, 0, 0, 0, 96, 185, 172, 141,
+	124, 125, 95, 0, 162, 111, 117, 109, 150, 182,
+	183, 108, 99, 194, 98, 100, 193, 148, 180, 186,
+	142, 139, 97, 184, 140, 138, 127, 114, 120, 156,
+	135, 157, 121, 145, 144, 146, 0, 0, 0, 171,
+	191, 203, 0, 0, 197, 198, 199, 200, 0, 0,
+	0, 147, 101, 122, 168, 126, 133, 161, 202, 152,
+	166, 104, 190, 169, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 93, 0, 130, 201, 160, 116, 192, 0, 105,
+	110, 149, 163, 106, 177, 178, 136, 123, 137, 179,
+	154, 113, 115, 134, 175, 29, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 151, 0, 0,
+	0, 0, 0, 0, 0, 0, 112, 0, 0, 0,
+	0, 129, 0, 131, 0, 0, 170, 143, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 62, 0, 0, 0, 91, 0, 0, 542,
+	543, 544, 0, 0, 0, 0, 0, 102, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 221, 0, 0, 0, 0, 158,
+	0, 0, 173, 119, 118, 128, 0, 0, 0, 107,
+	0, 165, 153, 189, 0, 155, 164, 132, 181, 159,
+	188, 222, 196, 176, 195, 94, 174, 187, 103, 167,
+	0, 0, 0, 96, 185, 172, 141, 124, 125, 95,
+	0, 162, 111, 117, 109, 150, 182, 183, 108, 99,
+	194, 98, 100, 193, 148, 180, 186, 142, 139, 97,
+	184, 140, 138, 127, 114, 120, 156, 135, 157, 121,
+	145, 144, 146, 0, 0, 0, 171, 191, 203, 0,
+	0, 197, 198, 199, 200, 0, 0, 0, 147, 101,
+	122, 168, 126, 133, 161, 202, 152, 166, 104, 190,
+	169, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 93, 0,
+	130, 201, 160, 116, 192, 0, 105, 110, 149, 163,
+	106, 177, 178, 136, 123, 137, 179, 154, 113, 115,
+	134, 175, 29, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 151, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 0, 0, 0, 129, 0,
+	131, 0, 0, 170, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 62,
+	0, 0, 0, 219, 0, 0, 218, 216, 217, 0,
+	0, 0, 0, 0, 102, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 195, 0, 0,
-	0, 0, 137, 0, 0, 151, 104, 103, 112, 0,
-	0, 0, 95, 0, 143, 133, 163, 0, 134, 142,
-	116, 155, 138, 162, 196, 170, 153, 169, 84, 152,
-	161, 93, 145, 0, 0, 0, 86, 159, 150, 122,
-	108, 109, 85, 0, 141, 98, 102, 97, 130, 156,
-	157, 96, 89, 168, 88, 90, 167, 129, 154, 160,
-	123, 120, 87, 158, 121, 119, 111, 100, 105, 135,
-	118, 136, 106, 126, 125, 127, 0, 0, 0, 149,
-	165, 177, 0, 0, 171, 172, 173, 174, 0, 0,
-	0, 128, 91, 107, 146, 110, 117, 140, 176, 132,
-	144, 94, 164, 147, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
-	0, 83, 0, 114, 175, 139, 101, 166, 99, 0,
-	0, 0, 0, 113, 0, 115, 0, 0, 148, 124,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 81, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 74, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 158, 0, 0, 173,
+	119, 118, 128, 0, 0, 0, 107, 0, 165, 153,
+	189, 0, 155, 164, 132, 181, 159, 188, 222, 196,
+	176, 195, 94, 174, 187, 103, 167, 0, 0, 0,
+	96, 185, 172, 141, 124, 125, 95, 0, 162, 111,
+	117, 109, 150, 182, 183, 108, 99, 194, 98, 100,
+	193, 148, 180, 186, 142, 139, 97, 184, 140, 138,
+	127, 114, 120, 156, 135, 157, 121, 145, 144, 146,
+	0, 0, 0, 171, 191, 203, 0, 0, 197, 198,
+	199, 200, 0, 0, 0, 147, 101, 122, 168, 126,
+	133, 161, 202, 152, 166, 104, 190, 169, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 0, 130, 201, 160,
+	116, 192, 0, 105, 110, 149, 163, 106, 177, 178,
+	136, 123, 137, 179, 154, 113, 115, 134, 175, 151,
+	0, 0, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 129, 0, 131, 0, 0, 170, 143,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 91, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 102,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 77, 78, 0, 73, 0, 0, 0,
-	79, 137, 0, 0, 151, 104, 103, 112, 0, 0,
-	0, 95, 0, 143, 133, 163, 0, 134, 142, 116,
-	155, 138, 162, 75, 170, 153, 169, 84, 152, 161,
-	93, 145, 0, 0, 0, 86, 159, 150, 122, 108,
-	109, 85, 0, 141, 98, 102, 97, 130, 156, 157,
-	96, 89, 168, 88, 90, 167, 129, 154, 160, 123,
-	120, 87, 158, 121, 119, 111, 100, 105, 135, 118,
-	136, 106, 126, 125, 127, 0, 0, 0, 149, 165,
-	177, 0, 0, 171, 172, 173, 174, 0, 0, 0,
-	128, 91, 107, 146, 110, 117, 140, 176, 132, 144,
-	94, 164, 147, 0, 76, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 84, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	83, 0, 114, 175, 139, 101, 166, 131, 0, 0,
-	0, 601, 0, 0, 0, 0, 99, 0, 0, 0,
-	0, 113, 0, 115, 0, 0, 148, 124, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 193, 0, 0, 192,
-	190, 191, 0, 0, 0, 0, 0, 92, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 87, 88, 0, 83, 0, 0, 0,
+	89, 158, 0, 0, 173, 119, 118, 128, 0, 0,
+	0, 107, 0, 165, 153, 189, 0, 155, 164, 132,
+	181, 159, 188, 85, 196, 176, 195, 94, 174, 187,
+	103, 167, 0, 0, 0, 96, 185, 172, 141, 124,
+	125, 95, 0, 162, 111, 117, 109, 150, 182, 183,
+	108, 99, 194, 98, 100, 193, 148, 180, 186, 142,
+	139, 97, 184, 140, 138, 127, 114, 120, 156, 135,
+	157, 121, 145, 144, 146, 0, 0, 0, 171, 191,
+	203, 0, 0, 197, 198, 199, 200, 0, 0, 0,
+	147, 101, 122, 168, 126, 133, 161, 202, 152, 166,
+	104, 190, 169, 0, 86, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	93, 0, 130, 201, 160, 116, 192, 0, 105, 110,
+	149, 163, 106, 177, 178, 136, 123, 137, 179, 154,
+	113, 115, 134, 175, 151, 0, 0, 0, 642, 0,
+	0, 0, 0, 112, 0, 0, 0, 0, 129, 0,
+	131, 0, 0, 170, 143, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 219, 0, 0, 218, 216, 217, 0,
+	0, 0, 0, 0, 102, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 195, 0, 0, 0, 0, 137,
-	0, 0, 151, 104, 103, 112, 0, 0, 0, 95,
-	0, 143, 133, 163, 0, 134, 142, 116, 155, 138,
-	162, 196, 170, 153, 169, 84, 152, 161, 93, 145,
-	0, 0, 0, 86, 159, 150, 122, 108, 109, 85,
-	0, 141, 98, 102, 97, 130, 156, 157, 96, 89,
-	168, 88, 90, 167, 129, 154, 160, 123, 120, 87,
-	158, 121, 119, 111, 100, 105, 135, 118, 136, 106,
-	126, 125, 127, 0, 0, 0, 149, 165, 177, 0,
-	0, 171, 172, 173, 174, 0, 0, 0, 128, 91,
-	107, 146, 110, 117, 140, 176, 132, 144, 94, 164,
-	147, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 131, 0, 83, 0,
-	114, 175, 139, 101, 166, 99, 0, 0, 0, 0,
-	113, 0, 115, 0, 0, 148, 124, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 56, 0, 0, 0, 193, 0, 0, 192, 190,
-	191, 0, 0, 0, 0, 0, 92, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 158, 0, 0, 173,
+	119, 118, 128, 0, 0, 0, 107, 0, 165, 153,
+	189, 0, 155, 164, 132, 181, 159, 188, 222, 196,
+	176, 195, 94, 174, 187, 103, 167, 0, 0, 0,
+	96, 185, 172, 141, 124, 125, 95, 0, 162, 111,
+	117, 109, 150, 182, 183, 108, 99, 194, 98, 100,
+	193, 148, 180, 186, 142, 139, 97, 184, 140, 138,
+	127, 114, 120, 156, 135, 157, 121, 145, 144, 146,
+	0, 0, 0, 171, 191, 203, 0, 0, 197, 198,
+	199, 200, 0, 0, 0, 147, 101, 122, 168, 126,
+	133, 161, 202, 152, 166, 104, 190, 169, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 0, 130, 201, 160,
+	116, 192, 0, 105, 110, 149, 163, 106, 177, 178,
+	136, 123, 137, 179, 154, 113, 115, 134, 175, 151,
+	0, 0, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 129, 0, 131, 0, 0, 170, 143,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 62, 0, 0, 0, 219, 0,
+	0, 218, 216, 217, 0, 0, 0, 0, 0, 102,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 195, 0, 0, 0, 0, 137, 0,
-	0, 151, 104, 103, 112, 0, 0, 0, 95, 0,
-	143, 133, 163, 0, 134, 142, 116, 155, 138, 162,
-	196, 170, 153, 169, 84, 152, 161, 93, 145, 0,
-	0, 0, 86, 159, 150, 122, 108, 109, 85, 0,
-	141, 98, 102, 97, 130, 156, 157, 96, 89, 168,
-	88, 90, 167, 129, 154, 160, 123, 120, 87, 158,
-	121, 119, 111, 100, 105, 135, 118, 136, 106, 126,
-	125, 127, 0, 0, 0, 149, 165, 177, 0, 0,
-	171, 172, 173, 174, 0, 0, 0, 128, 91, 107,
-	146, 110, 117, 140, 176, 132, 144, 94, 164, 147,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 131, 0, 83, 0, 114,
-	175, 139, 101, 166, 99, 0, 620, 0, 0, 113,
-	0, 115, 0, 0, 148, 124, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 81, 0, 0, 621, 622, 623,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
+	0, 158, 0, 0, 173, 119, 118, 128, 0, 0,
+	0, 107, 0, 165, 153, 189, 0, 155, 164, 132,
+	181, 159, 188, 222, 196, 176, 195, 94, 174, 187,
+	103, 167, 0, 0, 0, 96, 185, 172, 141, 124,
+	125, 95, 0, 162, 111, 117, 109, 150, 182, 183,
+	108, 99, 194, 98, 100, 193, 148, 180, 186, 142,
+	139, 97, 184, 140, 138, 127, 114, 120, 156, 135,
+	157, 121, 145, 144, 146, 0, 0, 0, 171, 191,
+	203, 0, 0, 197, 198, 199, 200, 0, 0, 0,
+	147, 101, 122, 168, 126, 133, 161, 202, 152, 166,
+	104, 190, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	93, 0, 130, 201, 160, 116, 192, 0, 105, 110,
+	149, 163, 106, 177, 178, 136, 123, 137, 179, 154,
+	113, 115, 134, 175, 151, 0, 0, 0, 0, 0,
+	0, 0, 0, 112, 0, 661, 0, 0, 129, 0,
+	131, 0, 0, 170, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 91, 0, 0, 662, 663, 664, 0,
+	0, 0, 0, 0, 102, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 158, 0, 0, 173,
+	119, 118, 128, 0, 0, 0, 107, 0, 165, 153,
+	189, 0, 155, 164, 132, 181, 159, 188, 222, 196,
+	176, 195, 94, 174, 187, 103, 167, 0, 0, 0,
+	96, 185, 172, 141, 124, 125, 95, 0, 162, 111,
+	117, 109, 150, 182, 183, 108, 99, 194, 98, 100,
+	193, 148, 180, 186, 142, 139, 97, 184, 140, 138,
+	127, 114, 120, 156, 135, 157, 121, 145, 144, 146,
+	0, 0, 0, 171, 191, 203, 0, 0, 197, 198,
+	199, 200, 0, 0, 0, 147, 101, 122, 168, 126,
+	133, 161, 202, 152, 166, 104, 190, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 0, 130, 201, 160,
+	116, 192, 0, 105, 110, 149, 163, 106, 177, 178,
+	136, 123, 137, 179, 154, 113, 115, 134, 175, 151,
+	0, 0, 0, 642, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 129, 0, 131, 0, 0, 170, 143,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 219, 0,
+	0, 218, 216, 217, 0, 0, 0, 0, 0, 102,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
+	0, 158, 0, 0, 173, 119, 118, 128, 0, 0,
+	0, 107, 0, 165, 153, 189, 0, 640, 164, 132,
+	181, 159, 188, 222, 196, 176, 195, 94, 174, 187,
+	103, 167, 0, 0, 0, 96, 185, 172, 141, 124,
+	125, 95, 0, 162, 111, 117, 109, 150, 182, 183,
+	108, 99, 194, 98, 100, 193, 148, 180, 186, 142,
+	139, 97, 184, 140, 138, 127, 114, 120, 156, 135,
+	157, 121, 145, 144, 146, 0, 0, 0, 171, 191,
+	203, 0, 0, 197, 198, 199, 200, 0, 0, 0,
+	147, 101, 122, 168, 126, 133, 161, 202, 152, 166,
+	104, 190, 169, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	93, 0, 130, 201, 160, 116, 192, 0, 105, 110,
+	149, 163, 106, 177, 178, 136, 123, 137, 179, 154,
+	113, 115, 134, 175, 151, 0, 0, 0, 0, 0,
+	0, 0, 620, 112, 0, 0, 0, 0, 129, 0,
+	131, 0, 0, 170, 143, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 219, 0, 0, 218, 216, 217, 0,
+	0, 0, 0, 0, 102, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 158, 0, 0, 173,
+	119, 118, 128, 0, 0, 0, 107, 0, 165, 153,
+	189, 0, 155, 164, 132, 181, 159, 188, 222, 196,
+	176, 195, 94, 174, 187, 103, 167, 0, 0, 0,
+	96, 185, 172, 141, 124, 125, 95, 0, 162, 111,
+	117, 109, 150, 182, 183, 108, 99, 194, 98, 100,
+	193, 148, 180, 186, 142, 139, 97, 184, 140, 138,
+	127, 114, 120, 156, 135, 157, 121, 145, 144, 146,
+	0, 0, 0, 171, 191, 203, 0, 0, 197, 198,
+	199, 200, 0, 0, 0, 147, 101, 122, 168, 126,
+	133, 161, 202, 152, 166, 104, 190, 169, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 93, 0, 130, 201, 160,
+	116, 192, 0, 105, 110, 149, 163, 106, 177, 178,
+	136, 123, 137, 179, 154, 113, 115, 134, 175, 351,
+	0, 0, 0, 0, 0, 0, 151, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	129, 0, 131, 0, 0, 170, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 219, 0, 0, 218, 216,
+	217, 0, 0, 0, 0, 0, 102, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 195, 0, 0, 0, 0, 137, 0, 0,
-	151, 104, 103, 112, 0, 0, 0, 95, 0, 143,
-	133, 163, 0, 134, 142, 116, 155, 138, 162, 196,
-	170, 153, 169, 84, 152, 161, 93, 145, 0, 0,
-	0, 86, 159, 150, 122, 108, 109, 85, 0, 141,
-	98, 102, 97, 130, 156, 157, 96, 89, 168, 88,
-	90, 167, 129, 154, 160, 123, 120, 87, 158, 121,
-	119, 111, 100, 105, 135, 118, 136, 106, 126, 125,
-	127, 0, 0, 0, 149, 165, 177, 0, 0, 171,
-	172, 173, 174, 0, 0, 0, 128, 91, 107, 146,
-	110, 117, 140, 176, 132, 144, 94, 164, 147, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 83, 0, 114, 175,
-	139, 101, 166, 131, 0, 0, 0, 601, 0, 0,
-	0, 0, 99, 0, 0, 0, 0, 113, 0, 115,
-	0, 0, 148, 124, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 193, 0, 0, 192, 190, 191, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 158, 0,
+	0, 173, 119, 118, 128, 0, 0, 0, 107, 0,
+	165, 153, 189, 0, 155, 164, 132, 181, 159, 188,
+	222, 196, 176, 195, 94, 174, 187, 103, 167, 0,
+	0, 0, 96, 185, 172, 141, 124, 125, 95, 0,
+	162, 111, 117, 109, 150, 182, 183, 108, 99, 194,
+	98, 100, 193, 148, 180, 186, 142, 139, 97, 184,
+	140, 138, 127, 114, 120, 156, 135, 157, 121, 145,
+	144, 146, 0, 0, 0, 171, 191, 203, 0, 0,
+	197, 198, 199, 200, 0, 0, 0, 147, 101, 122,
+	168, 126, 133, 161, 202, 152, 166, 104, 190, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 130,
+	201, 160, 116, 192, 0, 105, 110, 149, 163, 106,
+	177, 178, 136, 123, 137, 179, 154, 113, 115, 134,
+	175, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 129, 0, 131, 0, 0,
+	170, 143, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	219, 0, 0, 218, 216, 217, 0, 0, 0, 0,
+	0, 102, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	195, 0, 0, 0, 0, 137, 0, 0, 151, 104,
-	103, 112, 0, 0, 0, 95, 0, 143, 133, 163,
-	0, 599, 142, 116, 155, 138, 162, 196, 170, 153,
-	169, 84, 152, 161, 93, 145, 0, 0, 0, 86,
-	159, 150, 122, 108, 109, 85, 0, 141, 98, 102,
-	97, 130, 156, 157, 96, 89, 168, 88, 90, 167,
-	129, 154, 160, 123, 120, 87, 158, 121, 119, 111,
-	100, 105, 135, 118, 136, 106, 126, 125, 127, 0,
-	0, 0, 149, 165, 177, 0, 0, 171, 172, 173,
-	174, 0, 0, 0, 128, 91, 107, 146, 110, 117,
-	140, 176, 132, 144, 94, 164, 147, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 131, 83, 0, 114, 175, 139, 101,
-	166, 579, 99, 0, 0, 0, 0, 113, 0, 115,
-	0, 0, 148, 124, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 213, 0, 221, 0,
+	0, 0, 0, 158, 0, 0, 173, 119, 118, 128,
+	0, 0, 0, 107, 0, 165, 153, 189, 0, 155,
+	164, 132, 181, 159, 188, 222, 196, 176, 195, 94,
+	174, 187, 103, 167, 0, 0, 0, 96, 185, 172,
+	141, 124, 125, 95, 0, 162, 111, 117, 109, 150,
+	182, 183, 108, 99, 194, 98, 100, 193, 148, 180,
+	186, 142, 139, 97, 184, 140, 138, 127, 114, 120,
+	156, 135, 157, 121, 145, 144, 146, 0, 0, 0,
+	171, 191, 203, 0, 0, 197, 198, 199, 200, 0,
+	0, 0, 147, 101, 122, 168, 126, 133, 161, 202,
+	152, 166, 104, 190, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 193, 0, 0, 192, 190, 191, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 0, 130, 201, 160, 116, 192, 0,
+	105, 110, 149, 163, 106, 177, 178, 136, 123, 137,
+	179, 154, 113, 115, 134, 175, 151, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	129, 0, 131, 0, 0, 170, 143, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 285, 0, 0, 218, 216,
+	217, 0, 0, 0, 0, 0, 102, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	195, 0, 0, 0, 0, 137, 0, 0, 151, 104,
-	103, 112, 0, 0, 0, 95, 0, 143, 133, 163,
-	0, 134, 142, 116, 155, 138, 162, 196, 170, 153,
-	169, 84, 152, 161, 93, 145, 0, 0, 0, 86,
-	159, 150, 122, 108, 109, 85, 0, 141, 98, 102,
-	97, 130, 156, 157, 96, 89, 168, 88, 90, 167,
-	129, 154, 160, 123, 120, 87, 158, 121, 119, 111,
-	100, 105, 135, 118, 136, 106, 126, 125, 127, 0,
-	0, 0, 149, 165, 177, 0, 0, 171, 172, 173,
-	174, 0, 0, 0, 128, 91, 107, 146, 110, 117,
-	140, 176, 132, 144, 94, 164, 147, 0, 0, 0,
-	0, 0, 0, 0, 0, 318, 0, 0, 0, 0,
-	0, 0, 131, 0, 83, 0, 114, 175, 139, 101,
-	166, 99, 0, 0, 0, 0, 113, 0, 115, 0,
-	0, 148, 124, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 193, 0, 0, 192, 190, 191, 0, 0, 0,
-	0, 0, 92, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 158, 0,
+	0, 173, 119, 118, 128, 0, 0, 0, 107, 0,
+	165, 153, 189, 0, 155, 164, 132, 181, 159, 188,
+	222, 196, 176, 195, 94, 174, 187, 103, 167, 0,
+	0, 0, 96, 185, 172, 141, 124, 125, 95, 0,
+	162, 111, 117, 109, 150, 182, 183, 108, 99, 194,
+	98, 100, 193, 148, 180, 186, 142, 139, 97, 184,
+	140, 138, 127, 114, 120, 156, 135, 157, 121, 145,
+	144, 146, 0, 0, 0, 171, 191, 203, 0, 0,
+	197, 198, 199, 200, 0, 0, 0, 147, 101, 122,
+	168, 126, 133, 161, 202, 152, 166, 104, 190, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 130,
+	201, 160, 116, 192, 0, 105, 110, 149, 163, 106,
+	177, 178, 136, 123, 137, 179, 154, 113, 115, 134,
+	175, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 129, 0, 131, 0, 0,
+	170, 143, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 0, 0, 542, 543, 544, 0, 0, 0, 0,
+	0, 102, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 195,
-	0, 0, 0, 0, 137, 0, 0, 151, 104, 103,
-	112, 0, 0, 0, 95, 0, 143, 133, 163, 0,
-	134, 142, 116, 155, 138, 162, 196, 170, 153, 169,
-	84, 152, 161, 93, 145, 0, 0, 0, 86, 159,
-	150, 122, 108, 109, 85, 0, 141, 98, 102, 97,
-	130, 156, 157, 96, 89, 168, 88, 90, 167, 129,
-	154, 160, 123, 120, 87, 158, 121, 119, 111, 100,
-	105, 135, 118, 136, 106, 126, 125, 127, 0, 0,
-	0, 149, 165, 177, 0, 0, 171, 172, 173, 174,
-	0, 0, 0, 128, 91, 107, 146, 110, 117, 140,
-	176, 132, 144, 94, 164, 147, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 131, 0, 83, 0, 114, 175, 139, 101, 166,
-	99, 0, 0, 0, 0, 113, 0, 115, 0, 0,
-	148, 124, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	193, 0, 0, 192, 190, 191, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 158, 0, 0, 173, 119, 118, 128,
+	0, 0, 0, 107, 0, 165, 153, 189, 0, 155,
+	164, 132, 181, 159, 188, 222, 196, 176, 195, 94,
+	174, 187, 103, 167, 0, 0, 0, 96, 185, 172,
+	141, 124, 125, 95, 0, 162, 111, 117, 109, 150,
+	182, 183, 108, 99, 194, 98, 100, 193, 148, 180,
+	186, 142, 139, 97, 184, 140, 138, 127, 114, 120,
+	156, 135, 157, 121, 145, 144, 146, 0, 0, 0,
+	171, 191, 203, 0, 0, 197, 198, 199, 200, 0,
+	0, 0, 147, 101, 122, 168, 126, 133, 161, 202,
+	152, 166, 104, 190, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 0, 130, 201, 160, 116, 192, 0,
+	105, 110, 149, 163, 106, 177, 178, 136, 123, 137,
+	179, 154, 113, 115, 134, 175, 151, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	129, 0, 131, 0, 0, 170, 143, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 219, 0, 0, 218, 216,
+	217, 0, 0, 0, 0, 0, 102, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 195, 0,
-	0, 0, 0, 137, 0, 0, 151, 104, 103, 112,
-	0, 0, 0, 95, 0, 143, 133, 163, 0, 134,
-	142, 116, 155, 138, 162, 196, 170, 153, 169, 84,
-	152, 161, 93, 145, 0, 0, 0, 86, 159, 150,
-	122, 108, 109, 85, 0, 141, 98, 102, 97, 130,
-	156, 157, 96, 89, 168, 88, 90, 167, 129, 154,
-	160, 123, 120, 87, 158, 121, 119, 111, 100, 105,
-	135, 118, 136, 106, 126, 125, 127, 0, 0, 0,
-	149, 165, 177, 0, 0, 171, 172, 173, 174, 0,
-	0, 0, 128, 91, 107, 146, 110, 117, 140, 176,
-	132, 144, 94, 164, 147, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	131, 0, 83, 0, 114, 175, 139, 101, 166, 99,
-	0, 0, 0, 0, 113, 0, 115, 0, 0, 148,
-	124, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
-	0, 0, 501, 502, 503, 0, 0, 0, 0, 0,
-	92, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 158, 0,
+	0, 173, 119, 118, 128, 0, 0, 0, 107, 0,
+	165, 153, 189, 0, 155, 164, 132, 181, 159, 188,
+	222, 196, 176, 195, 94, 174, 187, 103, 167, 0,
+	0, 0, 96, 185, 172, 141, 124, 125, 95, 0,
+	162, 111, 117, 109, 150, 182, 183, 108, 99, 194,
+	98, 100, 193, 148, 180, 186, 142, 139, 97, 184,
+	140, 138, 127, 114, 120, 156, 135, 157, 121, 145,
+	144, 146, 0, 0, 0, 171, 191, 203, 0, 0,
+	197, 198, 199, 200, 0, 0, 0, 147, 101, 122,
+	168, 126, 133, 161, 202, 152, 166, 104, 190, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 130,
+	201, 160, 116, 192, 0, 105, 110, 149, 163, 106,
+	177, 178, 136, 123, 137, 179, 154, 113, 115, 134,
+	175, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 129, 0, 131, 0, 0,
+	170, 143, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	219, 0, 0, 218, 216, 217, 0, 0, 0, 0,
+	0, 102, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 195, 0, 0,
-	0, 0, 137, 0, 0, 151, 104, 103, 112, 0,
-	0, 0, 95, 0, 143, 133, 163, 0, 134, 142,
-	116, 155, 138, 162, 196, 170, 153, 169, 84, 152,
-	161, 93, 145, 0, 0, 0, 86, 159, 150, 122,
-	108, 109, 85, 0, 141, 98, 102, 97, 130, 156,
-	157, 96, 89, 168, 88, 90, 167, 129, 154, 160,
-	123, 120, 87, 158, 121, 119, 111, 100, 105, 135,
-	118, 136, 106, 126, 125, 127, 0, 0, 0, 149,
-	165, 177, 0, 0, 171, 172, 173, 174, 0, 0,
-	0, 128, 91, 107, 146, 110, 117, 140, 176, 132,
-	144, 94, 164, 147, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 131,
-	0, 83, 0, 114, 175, 139, 101, 166, 99, 0,
-	0, 0, 0, 113, 0, 115, 0, 0, 148, 124,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
-	0, 192, 190, 191, 0, 0, 0, 0, 0, 92,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 158, 0, 0, 173, 119, 118, 128,
+	0, 0, 0, 107, 0, 165, 153, 189, 0, 155,
+	164, 132, 181, 159, 188, 222, 196, 176, 195, 94,
+	174, 187, 103, 167, 0, 0, 0, 96, 185, 172,
+	141, 124, 125, 95, 0, 162, 111, 117, 109, 150,
+	182, 183, 108, 99, 194, 98, 100, 193, 148, 180,
+	186, 142, 139, 97, 184, 140, 138, 127, 114, 120,
+	156, 135, 157, 121, 145, 144, 146, 0, 0, 0,
+	171, 191, 203, 0, 0, 197, 198, 199, 200, 0,
+	0, 0, 147, 101, 122, 168, 126, 133, 161, 202,
+	152, 166, 104, 190, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 0, 130, 201, 160, 116, 192, 0,
+	105, 110, 149, 255, 106, 177, 178, 136, 123, 137,
+	179, 154, 113, 115, 134, 175, 151, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	129, 0, 131, 0, 0, 170, 143, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 91, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 0, 0, 0,
+	1308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 195, 0, 0, 0,
-	0, 137, 0, 0, 151, 104, 103, 112, 0, 0,
-	0, 95, 0, 143, 133, 163, 0, 134, 142, 116,
-	155, 138, 162, 196, 170, 153, 169, 84, 152, 161,
-	93, 145, 0, 0, 0, 86, 159, 150, 122, 108,
-	109, 85, 0, 141, 98, 102, 97, 130, 156, 157,
-	96, 89, 168, 88, 90, 167, 129, 154, 160, 123,
-	120, 87, 158, 121, 119, 111, 100, 105, 135, 118,
-	136, 106, 126, 125, 127, 0, 0, 0, 149, 165,
-	177, 0, 0, 171, 172, 173, 174, 0, 0, 0,
-	128, 91, 107, 146, 110, 117, 140, 176, 132, 144,
-	94, 164, 147, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 131, 0,
-	83, 0, 114, 175, 139, 101, 166, 99, 0, 0,
-	0, 0, 113, 0, 115, 0, 0, 148, 124, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 193, 0, 0,
-	192, 190, 191, 0, 0, 0, 0, 0, 92, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 158, 0,
+	0, 173, 119, 118, 128, 0, 0, 0, 107, 1309,
+	165, 153, 189, 0, 155, 164, 132, 181, 159, 188,
+	222, 196, 176, 195, 94, 174, 187, 103, 167, 0,
+	0, 0, 96, 185, 172, 141, 124, 125, 95, 0,
+	162, 111, 117, 109, 150, 182, 183, 108, 99, 194,
+	98, 100, 193, 148, 180, 186, 142, 139, 97, 184,
+	140, 138, 127, 114, 120, 156, 135, 157, 121, 145,
+	144, 146, 0, 0, 0, 171, 191, 203, 0, 0,
+	197, 198, 199, 200, 0, 0, 0, 147, 101, 122,
+	168, 126, 133, 161, 202, 152, 166, 104, 190, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 130,
+	201, 160, 116, 192, 0, 105, 110, 149, 163, 106,
+	177, 178, 136, 123, 137, 179, 154, 113, 115, 134,
+	175, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 129, 0, 131, 0, 0,
+	170, 143, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 0, 0, 0, 0, 0, 791, 0, 0, 792,
+	0, 102, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 195, 0, 0, 0, 0,
-	137, 0, 0, 151, 104, 103, 112, 0, 0, 0,
-	95, 0, 143, 133, 163, 0, 134, 142, 116, 155,
-	138, 162, 196, 170, 153, 169, 84, 152, 161, 93,
-	145, 0, 0, 0, 86, 159, 150, 122, 108, 109,
-	85, 0, 141, 98, 102, 97, 130, 156, 157, 96,
-	89, 168, 88, 90, 167, 129, 154, 160, 123, 120,
-	87, 158, 121, 119, 111, 100, 105, 135, 118, 136,
-	106, 126, 125, 127, 0, 0, 0, 149, 165, 177,
-	0, 0, 171, 172, 173, 174, 0, 0, 0, 128,
-	91, 107, 146, 110, 117, 140, 176, 132, 144, 94,
-	164, 147, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 131, 0, 83,
-	0, 114, 175, 139, 101, 166, 99, 0, 0, 0,
-	0, 113, 0, 115, 0, 0, 148, 124, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 81, 0, 0, 0,
-	0, 0, 738, 0, 0, 739, 0, 92, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 158, 0, 0, 173, 119, 118, 128,
+	0, 0, 0, 107, 0, 165, 153, 189, 0, 155,
+	164, 132, 181, 159, 188, 222, 196, 176, 195, 94,
+	174, 187, 103, 167, 0, 0, 0, 96, 185, 172,
+	141, 124, 125, 95, 0, 162, 111, 117, 109, 150,
+	182, 183, 108, 99, 194, 98, 100, 193, 148, 180,
+	186, 142, 139, 97, 184, 140, 138, 127, 114, 120,
+	156, 135, 157, 121, 145, 144, 146, 0, 0, 0,
+	171, 191, 203, 0, 0, 197, 198, 199, 200, 0,
+	0, 0, 147, 101, 122, 168, 126, 133, 161, 202,
+	152, 166, 104, 190, 169, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 0, 130, 201, 160, 116, 192, 0,
+	105, 110, 149, 163, 106, 177, 178, 136, 123, 137,
+	179, 154, 113, 115, 134, 175, 151, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 0, 0,
+	129, 0, 131, 0, 0, 170, 143, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 518, 0, 91, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 102, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 195, 0, 0, 0, 0, 137,
-	0, 0, 151, 104, 103, 112, 0, 0, 0, 95,
-	0, 143, 133, 163, 0, 134, 142, 116, 155, 138,
-	162, 196, 170, 153, 169, 84, 152, 161, 93, 145,
-	0, 0, 0, 86, 159, 150, 122, 108, 109, 85,
-	0, 141, 98, 102, 97, 130, 156, 157, 96, 89,
-	168, 88, 90, 167, 129, 154, 160, 123, 120, 87,
-	158, 121, 119, 111, 100, 105, 135, 118, 136, 106,
-	126, 125, 127, 0, 0, 0, 149, 165, 177, 0,
-	0, 171, 172, 173, 174, 0, 0, 0, 128, 91,
-	107, 146, 110, 117, 140, 176, 132, 144, 94, 164,
-	147, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 131, 0, 83, 0,
-	114, 175, 139, 101, 166, 99, 0, 0, 0, 0,
-	113, 0, 115, 0, 0, 148, 124, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 81, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 158, 0,
+	0, 173, 119, 118, 128, 0, 0, 0, 107, 0,
+	165, 153, 189, 0, 155, 164, 132, 181, 159, 188,
+	222, 196, 176, 195, 94, 174, 187, 103, 167, 0,
+	0, 0, 96, 185, 172, 141, 124, 125, 95, 0,
+	162, 111, 117, 109, 150, 182, 183, 108, 99, 194,
+	98, 100, 193, 148, 180, 186, 142, 139, 97, 184,
+	140, 138, 127, 114, 120, 156, 135, 157, 121, 145,
+	144, 146, 0, 0, 0, 171, 191, 203, 0, 0,
+	197, 198, 199, 200, 0, 0, 0, 147, 101, 122,
+	168, 126, 133, 161, 202, 152, 166, 104, 190, 169,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 93, 0, 130,
+	201, 160, 116, 192, 0, 105, 110, 149, 163, 106,
+	177, 178, 136, 123, 137, 179, 154, 113, 115, 134,
+	175, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 129, 0, 131, 0, 0,
+	170, 143, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	91, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 102, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 195, 0, 0, 0, 0, 137, 0,
-	0, 151, 104, 103, 112, 0, 0, 0, 95, 0,
-	143, 133, 163, 0, 134, 142, 116, 155, 138, 162,
-	196, 170, 153, 169, 84, 152, 161, 93, 145, 0,
-	0, 0, 86, 159, 150, 122, 108, 109, 85, 0,
-	141, 98, 102, 97, 130, 156, 157, 96, 89, 168,
-	88, 90, 167, 129, 154, 160, 123, 120, 87, 158,
-	121, 119, 111, 100, 105, 135, 118, 136, 106, 126,
-	125, 127, 0, 0, 0, 149, 165, 177, 0, 0,
-	171, 172, 173, 174, 0, 0, 0, 128, 91, 107,
-	146, 110, 117, 140, 176, 132, 144, 94, 164, 147,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 83, 0, 114,
-	175, 139, 101, 166,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 158, 0, 0, 173, 119, 118, 128,
+	0, 0, 0, 107, 0, 165, 153, 189, 0, 155,
+	164, 132, 181, 159, 188, 222, 196, 176, 195, 94,
+	174, 187, 103, 167, 0, 0, 0, 96, 185, 172,
+	141, 124, 125, 95, 0, 162, 111, 117, 109, 150,
+	182, 183, 108, 99, 194, 98, 100, 193, 148, 180,
+	186, 142, 139, 97, 184, 140, 138, 127, 114, 120,
+	156, 135, 157, 121, 145, 144, 146, 0, 0, 0,
+	171, 191, 203, 0, 0, 197, 198, 199, 200, 0,
+	0, 0, 147, 101, 122, 168, 126, 133, 161, 202,
+	152, 166, 104, 190, 169, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 93, 0, 130, 201, 160, 116, 192, 0,
+	105, 110, 149, 163, 106, 177, 178, 136, 123, 137,
+	179, 154, 113, 115, 134, 175,
 }
 var yyPact = [...]int{
 
-	1451, -1000, -210, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	129, -1000, -187, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 192, -1000, -1000, 964, 1006, -1000,
+	-1000, -1000, -1000, -1000, -1000, 775, 10381, 114, 161, 68,
+	12313, 160, 1657, 13138, -1000, 57, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 52, 13138, 560, 13413, 10931, 557, 711,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 956,
+	981, 785, 941, 851, -1000, 7045, 117, 10931, 12038, 5945,
+	-1000, 556, 153, 13138, -104, 14513, 109, 109, 109, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 811, 831, -1000, -1000, -1000, -1000,
-	-1000, -1000, 648, 8911, 29, 50, -40, 10673, 47, 299,
-	11420, -1000, -25, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-30, 11420, 383, 491, -1000, -1000, -1000, -1000, -1000, 805,
-	809, 599, 792, 713, -1000, 6382, 28, 9418, 10424, 5350,
-	-1000, 381, 44, 11420, -175, 11918, 24, 24, 24, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -1960,24 +2274,24 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 159, 13138, -1000, 13138, 105, 553,
+	105, 105, 105, 13138, -1000, 207, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 13138, 552, 894, 175, 4505, 4505, 4505,
+	4505, 62, 4505, -36, -21, 792, -1000, -1000, -1000, -1000,
+	4505, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 13138, 761, 781, 742, 13138, -1000, 777, 635, 711,
+	-1000, 509, 902, 7882, 7882, 964, -1000, 711, -1000, -1000,
+	-1000, 872, -1000, -1000, 353, 991, -1000, 9532, 206, -1000,
+	7882, 1906, 635, -1000, -1000, -1000, -1000, 635, 179, 336,
+	-1000, -1000, -1000, 8707, 8707, 8707, 8707, 8707, 8707, -1000,
+	-1000, -1000, -1000, -1000, -1000, 635, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7607, 8982,
+	635, 635, 635, 635, 635, 635, 635, 635, 7882, 635,
+	635, 635, 635, 635, 635, 635, 635, 635, 635, 635,
+	635, 635, 11756, 733, 871, -1000, -1000, -1000, 926, 10106,
+	11481, 13138, 702, -1000, 731, 5657, -48, -1000, -1000, -1000,
+	281, 11206, -1000, -1000, -1000, 892, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 46, 11420,
-	-1000, 11420, 16, 377, 16, 16, 16, 11420, -1000, 84,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 11420, 376, 765,
-	75, 3995, 3995, 3995, 3995, -20, 3995, -134, -106, 681,
-	-1000, -1000, -1000, -1000, 3995, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 11420, 693, 651, 342, 771,
-	7159, 7159, 811, -1000, 491, -1000, -1000, -1000, 759, -1000,
-	-1000, 207, 820, -1000, 8164, 83, -1000, 7159, 2005, 571,
-	-1000, -1000, -1000, -1000, 571, 76, 193, -1000, -1000, -1000,
-	7657, 7657, 7657, 7657, 7657, 7657, -1000, -1000, -1000, -1000,
-	-1000, -1000, 571, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 6901, 7906, 571, 571, 571,
-	571, 571, 571, 571, 571, 7159, 571, 571, 571, 571,
-	571, 571, 571, 571, 571, 571, 571, 571, 571, 10175,
-	610, 714, -1000, -1000, -1000, 789, 8662, 9925, 11420, 538,
-	-1000, 548, 5079, -135, -1000, -1000, -1000, 131, 9667, -1000,
-	-1000, -1000, 762, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -1985,281 +2299,315 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 693,
+	-1000, 2627, 550, 4505, 134, 779, 541, 297, 538, 13138,
+	13138, 4505, 131, 13138, 913, 790, 13138, 503, 502, -1000,
+	4217, -1000, 4505, 4505, 4505, 4505, 4505, 4505, 4505, 4505,
+	-1000, -1000, -1000, -1000, -1000, -1000, 4505, 4505, -1000, -45,
+	-16, -1000, 13138, -1000, -1000, 182, 182, 2627, 13138, 13138,
+	742, 635, 12863, 252, 14513, 509, 69, -1000, -1000, -1000,
+	1000, 238, 545, 201, 734, -1000, 606, 956, 509, 851,
+	13963, 806, -1000, -1000, 13138, -1000, 7882, 7882, 428, -1000,
+	12863, -1000, -1000, -1000, -1000, -1000, 3641, 247, 8432, 455,
+	331, 8707, 8707, 8707, 8707, 8707, 8707, 8707, 8707, 8707,
+	8707, 8707, 8707, 8707, 8707, 8707, 611, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 488, -1000, 711, 881, 881,
+	337, -1000, 217, 217, 217, 217, 217, 217, 6220, 509,
+	686, 330, 7607, -1000, 2191, 7045, 7045, 7882, 7882, 12588,
+	12588, 7045, 934, 292, 330, 12588, -1000, 509, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 7045, 7045, 7045, 7045, 218,
+	13138, -1000, 12588, 10931, 10931, 10931, 10931, 10931, -1000, 828,
+	810, -1000, 829, 825, 845, 13138, -1000, 684, 10106, 245,
+	635, -1000, 13138, -1000, 82, 716, 10931, 13138, -1000, -1000,
+	5369, 731, -48, 726, -1000, -55, -42, 7320, 212, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3353, 308, 344, -10,
+	-1000, -1000, -1000, -1000, 749, -1000, 749, 749, 749, 749,
+	18, 18, 18, 18, -1000, -1000, -1000, -1000, -1000, 766,
+	765, -1000, 749, 749, 749, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 520, -1000, 1547, 359,
-	3995, 38, 584, 358, 151, 350, 11420, 11420, 3995, 33,
-	11420, 785, 680, 11420, 335, 334, -1000, 3724, -1000, 3995,
-	3995, 3995, 3995, 3995, 3995, 3995, 3995, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3995, 3995, -1000, -119, -91, -1000, 11420,
-	-1000, -1000, 120, 120, 1547, 11420, -1000, -1000, -1000, 826,
-	109, 372, 81, 549, -1000, 315, 805, 342, 713, 11669,
-	699, -1000, -1000, 11420, -1000, 7159, 7159, 279, -1000, 10922,
-	-1000, -1000, -1000, -1000, -1000, 3182, 118, 7657, 311, 124,
-	7657, 7657, 7657, 7657, 7657, 7657, 7657, 7657, 7657, 7657,
-	7657, 7657, 7657, 7657, 7657, 387, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 330, -1000, 491, 1039, 1039, 200,
-	-1000, 79, 79, 79, 79, 79, 79, 5608, 342, 518,
-	158, 6901, -1000, 1783, 6382, 6382, 7159, 7159, 11171, 11171,
-	6382, 794, 143, 158, 11171, -1000, 342, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 6382, 6382, 6382, 6382, 144, 11420,
-	-1000, 11171, 9418, 9418, 9418, 9418, 9418, -1000, 710, 704,
-	-1000, 722, 703, 723, 11420, -1000, 513, 8662, 116, 571,
-	-1000, 11420, -1000, -5, 424, 9418, 11420, -1000, -1000, 4808,
-	548, -135, 541, -1000, -145, -140, 6640, 78, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 2911, 219, 270, -86, -1000,
-	-1000, -1000, -1000, 585, -1000, 585, 585, 585, 585, -58,
-	-58, -58, -58, -1000, -1000, -1000, -1000, -1000, 647, 644,
-	-1000, 585, 585, 585, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 758, 758, 758, 750, 750, 780, -1000, 13138,
+	-124, 487, 4505, 909, 4505, -1000, 194, -1000, 13138, -1000,
+	-1000, 13138, 4505, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 352,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 642, 642, 642, 597, 597, 650, -1000, 11420, -190,
-	327, 3995, 784, 3995, -1000, 183, -1000, 11420, -1000, -1000,
-	11420, 3995, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 210, -1000,
+	-1000, 667, -1000, 730, -1000, -1000, 192, 590, 196, -152,
+	-154, 578, -1000, 127, 444, -1000, -1000, -1000, 848, 7882,
+	7882, 3929, 7882, -1000, -1000, -1000, 902, -1000, 934, 963,
+	-1000, 866, 864, 7045, -1000, -1000, 247, 303, -1000, -1000,
+	500, -1000, -1000, -1000, -1000, 195, 635, -1000, 1854, 635,
+	-1000, -1000, -1000, -1000, 455, 8707, 8707, 8707, 737, 1854,
+	2134, 905, 1924, 217, 369, 369, 214, 214, 214, 214,
+	214, 427, 427, -1000, -1000, -1000, 509, 336, -1000, -1000,
+	336, -1000, 509, 7045, 727, -1000, -1000, 7882, -1000, 509,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	509, -1000, 545, -1000, -1000, 736, 7159, 7159, 3453, 7159,
-	-1000, -1000, -1000, 771, -1000, 794, 810, -1000, 755, 754,
-	6382, -1000, -1000, 118, 145, -1000, -1000, 340, -1000, -1000,
-	-1000, -1000, 73, 571, -1000, 1852, -1000, -1000, -1000, -1000,
-	311, 7657, 7657, 7657, 1692, 1852, 1935, 1525, 1499, 79,
-	155, 155, 92, 92, 92, 92, 92, 298, 298, -1000,
-	-1000, -1000, 342, 193, -1000, -1000, 193, -1000, 342, 6382,
-	543, -1000, -1000, 7159, -1000, 342, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 507, 507, 427,
-	234, 583, -1000, 68, 535, 507, 6382, 240, -1000, 7159,
-	342, -1000, 507, 342, 507, 507, 663, 752, 571, -1000,
-	539, -1000, 129, 714, 635, 678, 676, -1000, -1000, -1000,
-	-1000, 702, -1000, 701, -1000, -1000, -1000, -1000, -1000, 43,
-	42, 40, 11918, -1000, 818, 9418, 524, -1000, -1000, 541,
-	-135, -158, -1000, -1000, -1000, 158, -1000, 413, 537, 2635,
-	-1000, -1000, -1000, -1000, -1000, -1000, 630, 777, 168, 171,
-	323, -1000, -1000, 767, -1000, 196, -88, -1000, -1000, 314,
-	-58, -58, -1000, -1000, 78, 761, 78, 78, 78, 434,
-	434, -1000, -1000, -1000, -1000, 292, -1000, -1000, -1000, 282,
-	-1000, 658, 11918, 3995, -1000, 4537, -1000, -1000, -1000, -1000,
-	-1000, -1000, 929, 404, 172, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -7, -1000, 3995, -1000,
-	214, 11420, 11420, 1547, 787, 11420, 734, 158, 158, 64,
-	-1000, -1000, 11420, -1000, -1000, -1000, -1000, 527, -1000, -1000,
-	-1000, 4266, 6382, -1000, 1692, 1852, 1440, -1000, 7657, 7657,
-	-1000, -1000, 507, 6382, 158, -1000, -1000, -1000, 619, 387,
-	619, 7657, 7657, 3453, 7657, 7657, -185, 465, 138, -1000,
-	7159, 242, -1000, -1000, -1000, -1000, -1000, 656, 11171, 571,
-	-1000, 8413, -1000, 11918, 811, 11171, 7159, 7159, -1000, -1000,
-	7159, 617, -1000, 7159, -1000, -1000, -1000, 571, 571, 571,
-	461, -1000, 811, 524, -1000, -1000, -1000, -151, -152, -1000,
-	-1000, -1000, 2911, -1000, 2911, 11918, -1000, 321, 317, -1000,
-	-1000, 655, 74, -1000, -1000, -1000, 391, 78, 78, -1000,
-	192, -1000, -1000, -1000, 488, -1000, 473, 528, 470, 11420,
-	-1000, -1000, 453, -1000, 128, -1000, -1000, 11918, -1000, -1000,
+	-1000, 661, 661, 411, 507, 754, -1000, 186, 746, 661,
+	7045, 326, -1000, 7882, 509, -1000, 661, 509, 661, 661,
+	713, 862, 635, -1000, 608, -1000, 280, 871, 764, 789,
+	924, -1000, -1000, -1000, -1000, 808, -1000, 776, -1000, -1000,
+	-1000, -1000, -1000, 152, 138, 137, 14513, -1000, 989, 10931,
+	743, -1000, -1000, 726, -48, -72, -1000, -1000, -1000, 330,
+	-1000, 634, 725, 3065, -1000, -1000, -1000, -1000, -1000, -1000,
+	757, 903, 261, 243, 485, -1000, -1000, 882, -1000, 332,
+	-12, -1000, -1000, 412, 18, 18, -1000, -1000, 212, 878,
+	212, 212, 212, 544, 544, -1000, -1000, -1000, -1000, 410,
+	-1000, -1000, -1000, 405, -1000, 788, 14513, 4505, -1000, 5081,
+	-1000, -1000, -1000, -1000, -1000, -1000, 899, 367, 384, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	11918, 11420, -1000, -1000, -1000, -1000, -1000, 11918, -1000, -1000,
-	434, 7159, -1000, -1000, -1000, 120, -1000, -1000, 4537, -1000,
-	818, 9418, -1000, -1000, 342, -1000, 7657, 1852, 1852, -1000,
-	-1000, 342, 585, 585, -1000, 585, 597, -1000, 585, -38,
-	585, -41, 571, 342, 342, 1873, 1813, -1000, 1721, 1763,
-	571, -182, -1000, 158, 7159, -207, 779, 526, 431, -1000,
-	-1000, 6124, 342, 463, 62, 461, 805, -1000, 158, 158,
-	158, 11918, 158, 11918, 11918, 11918, 9169, 11918, 805, -1000,
-	-1000, -1000, -1000, 2635, -1000, 458, -1000, 585, -1000, -1000,
-	-81, 825, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -58, 434, -58, 269, -1000, 256, 3995,
-	4537, 2911, -1000, 578, -1000, -1000, -1000, -1000, 773, -1000,
-	158, -1000, 815, 437, -1000, 1852, -1000, -1000, 93, -1000,
-	-1000, -1000, -1000, -1000, -1000, 221, -1000, -1000, -1000, 7657,
-	7657, -1000, 7657, 7657, 7657, 342, 434, 158, -1000, 5866,
-	776, -1000, 571, -1000, -1000, 621, 10922, 10922, -1000, -1000,
-	450, -1000, 428, 428, 428, 116, -1000, -1000, 110, 11918,
-	-1000, 164, -1000, -161, 78, -1000, 78, 386, 374, -1000,
-	-1000, -1000, 11918, 571, 813, 807, -1000, -1000, 342, 2135,
-	2135, 2135, 2135, -3, -1000, -1000, -1000, 417, 823, -1000,
-	571, -1000, 491, 52, -1000, 11918, -1000, -1000, -1000, -1000,
-	-1000, 110, -1000, 276, 126, 434, -1000, 204, 775, -1000,
-	772, -1000, -1000, -1000, -1000, -1000, 408, -8, -1000, 7159,
-	7159, -1000, -1000, -1000, -1000, -1000, 342, 41, -199, 11171,
-	431, 342, 10922, -1000, -1000, -1000, 212, -1000, -1000, -1000,
-	434, -1000, -1000, 584, 405, -1000, 11918, 158, 417, -1000,
-	731, -188, -203, 412, -1000, -1000, -1000, -1000, -190, -1000,
-	-8, 750, -1000, 727, -1000, -1000, -1000, -11, -197, -13,
-	-201, 571, -204, 7408, -1000, 2135, 342, -1000, -1000,
+	83, -1000, 4505, -1000, 365, 13138, 13138, 2627, 932, 13138,
+	509, -1000, -1000, -1000, -1000, 927, 12863, 12863, 124, 124,
+	-1000, 14513, -156, 9, -1000, -1000, 842, 330, 330, 183,
+	-1000, -1000, 13138, -1000, -1000, -1000, -1000, 740, -1000, -1000,
+	-1000, 4793, 7045, 8707, -1000, 737, 1854, 2033, -1000, 8707,
+	8707, -1000, -1000, 661, 7045, 330, -1000, -1000, -1000, 682,
+	611, 682, 8707, 8707, 3929, 8707, 8707, -116, 738, 253,
+	-1000, 7882, 364, -1000, -1000, -1000, -1000, -1000, 786, 12588,
+	635, -1000, 9819, -1000, 14513, 964, 12588, 7882, 7882, -1000,
+	-1000, 7882, 751, -1000, 7882, -1000, -1000, -1000, 635, 635,
+	635, 627, -1000, 964, 743, -1000, -1000, -1000, -65, -56,
+	-1000, -1000, -1000, 3353, -1000, 3353, 14513, -1000, 481, 475,
+	-1000, -1000, 778, 306, -1000, -1000, -1000, 587, 212, 212,
+	-1000, 258, -1000, -1000, -1000, 657, -1000, 654, 724, 652,
+	13138, -1000, -1000, 649, -1000, 277, -1000, -1000, 14513, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 14513, 13138, -1000, -1000, -1000, -1000, -1000, 14513, -1000,
+	-1000, 544, 7882, -1000, -1000, -1000, 182, -1000, -1000, 635,
+	174, -1000, -1000, 13688, 319, 227, -1000, -1000, 926, 926,
+	926, -1000, -1000, 124, 904, -1000, -1000, -1000, 5081, -1000,
+	989, 10931, -1000, -1000, 509, 2001, -1000, 8707, 1854, 1854,
+	-1000, -1000, 509, 749, 749, -1000, 749, 750, -1000, 749,
+	38, 749, 37, 635, 509, 509, 1874, 1614, -1000, 1543,
+	1458, 635, -112, -1000, 330, 7882, -142, -142, 106, 698,
+	615, -1000, -1000, 6770, 509, 590, 627, 956, -1000, 330,
+	330, 330, 14513, 330, 14513, 14513, 14513, 10656, 14513, 956,
+	-1000, -1000, -1000, -1000, 3065, -1000, 610, -1000, 749, -1000,
+	-1000, -6, 999, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 18, 544, 18, 399, -1000, 388,
+	4505, 5081, 3353, -1000, 714, -1000, -1000, -1000, -1000, 875,
+	-1000, 330, -1000, 192, 12863, 601, -1000, 9257, 454, 454,
+	13688, 227, -1000, 454, 454, 454, -1000, 158, 987, 638,
+	-1000, -1000, 1854, -1000, -1000, 168, -1000, -1000, -1000, -1000,
+	-1000, -1000, 386, -1000, -1000, -1000, 8707, 8707, -1000, 8707,
+	8707, 8707, 509, 544, 330, -1000, 6495, -1000, 901, 720,
+	-1000, 908, 635, -1000, -1000, 736, -1000, -1000, 604, 578,
+	578, 578, 245, -1000, -1000, 208, 14513, -1000, 230, -1000,
+	-78, 212, -1000, 212, 583, 563, -1000, -1000, -1000, 14513,
+	635, 509, -1000, -1000, 13688, -1000, -1000, -1000, -1000, -1000,
+	-1000, 14513, -1000, -1000, -1000, -1000, 601, -1000, -1000, -1000,
+	13138, 985, 980, -1000, -1000, 509, 2001, 2001, 2001, 2001,
+	45, -1000, -1000, -1000, 598, 996, -147, 12863, 98, -1000,
+	635, -1000, 711, -1000, -1000, -1000, -1000, -1000, 208, -1000,
+	470, 276, 544, -1000, 335, 900, -1000, 898, -1000, -1000,
+	-1000, -1000, -1000, 595, 80, -1000, -1000, 578, -1000, 82,
+	-1000, 7882, 7882, -1000, -1000, -1000, -1000, -1000, 509, 103,
+	-127, 12588, 123, 590, 14513, 615, 509, -1000, -1000, 385,
+	-1000, -1000, -1000, 544, -1000, -1000, 779, 580, -1000, 14513,
+	-1000, 18, 330, 598, -1000, 838, -122, -132, 592, -1000,
+	874, 989, -1000, -1000, -1000, -1000, -124, -1000, 80, 859,
+	425, -1000, 835, -1000, 12588, -1000, -1000, -1000, 70, -169,
+	-1000, -125, 608, 71, 107, -1000, -170, -129, -1000, 635,
+	635, 359, -182, 979, 975, -164, 971, -134, 8157, 873,
+	14238, 58, 970, 969, 444, 444, 967, 444, -1000, 2001,
+	509, -1000, 12588, -1000, 578, -1000, -1000, 444, 444, -1000,
+	-1000, 444, -1000, -1000, -1000, 592, -1000, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1056, 27, 26, 1055, 1053, 1052, 844, 842, 840,
-	1051, 1047, 1046, 1045, 1044, 1043, 1041, 1038, 1037, 1036,
-	1034, 1033, 1026, 1024, 1021, 1018, 1014, 139, 1011, 1008,
-	1007, 64, 1005, 61, 1004, 1003, 38, 133, 47, 35,
-	705, 1002, 43, 80, 73, 995, 45, 993, 992, 66,
-	990, 59, 988, 987, 1510, 985, 984, 9, 31, 983,
-	980, 979, 978, 95, 96, 977, 976, 974, 971, 970,
-	969, 49, 11, 13, 21, 15, 968, 92, 6, 966,
-	48, 964, 963, 962, 956, 25, 955, 51, 954, 16,
-	50, 950, 17, 58, 36, 20, 7, 65, 56, 948,
-	33, 55, 42, 947, 945, 370, 944, 943, 942, 941,
-	940, 939, 149, 287, 938, 935, 928, 927, 41, 191,
-	972, 526, 60, 926, 925, 5, 922, 1601, 63, 57,
-	22, 921, 29, 283, 37, 920, 916, 34, 914, 46,
-	912, 911, 910, 908, 907, 903, 902, 252, 901, 900,
-	899, 30, 10, 895, 893, 52, 23, 887, 886, 885,
-	40, 53, 881, 44, 880, 878, 877, 872, 24, 14,
-	871, 12, 869, 8, 868, 867, 2, 865, 18, 863,
-	3, 862, 4, 39, 861, 857, 32, 856, 855, 19,
-	854, 852, 851, 850, 0, 163, 848, 838, 100,
+	0, 1250, 15, 69, 1248, 1247, 1240, 45, 38, 26,
+	1238, 1235, 1234, 1232, 1231, 1230, 1228, 1227, 1224, 1220,
+	1219, 1218, 1217, 1216, 1214, 1212, 1211, 152, 1210, 1208,
+	1206, 97, 1204, 99, 1203, 1201, 74, 108, 81, 67,
+	894, 1200, 43, 104, 109, 1198, 83, 1197, 1196, 103,
+	1195, 95, 1194, 1193, 66, 1192, 1191, 12, 4, 1190,
+	25, 1189, 1187, 100, 28, 1186, 1185, 1183, 1182, 1181,
+	1180, 85, 3, 9, 21, 19, 1179, 50, 60, 1178,
+	77, 1177, 1176, 1174, 1172, 64, 1171, 89, 1170, 34,
+	87, 18, 17, 8, 54, 36, 1167, 1164, 1157, 158,
+	101, 35, 1156, 1155, 47, 23, 48, 13, 29, 1154,
+	22, 1153, 1152, 1151, 1148, 1145, 1144, 1142, 11, 1138,
+	1137, 1136, 7, 98, 91, 1135, 56, 94, 73, 1134,
+	1132, 447, 1131, 1129, 1128, 1127, 1126, 1125, 220, 534,
+	1118, 1115, 1113, 1112, 65, 0, 337, 123, 96, 1111,
+	1108, 20, 1107, 1600, 116, 93, 32, 53, 61, 1470,
+	62, 1106, 1105, 59, 1104, 88, 1100, 1097, 1093, 1090,
+	1089, 1088, 1086, 82, 1084, 1082, 1081, 2, 31, 1080,
+	1077, 92, 33, 1076, 1075, 1074, 71, 90, 1073, 76,
+	1069, 1068, 1066, 1064, 52, 30, 1063, 14, 1061, 10,
+	1059, 1058, 1, 1055, 24, 1046, 5, 1045, 6, 68,
+	1044, 42, 72, 1041, 1040, 49, 1039, 1034, 1021, 1016,
+	1285, 1524, 1015, 1012, 102,
 }
 var yyR1 = [...]int{
 
-	0, 192, 193, 193, 1, 1, 1, 1, 1, 1,
+	0, 218, 219, 219, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 2, 6, 3,
-	4, 4, 5, 5, 7, 7, 7, 30, 30, 8,
-	9, 9, 9, 196, 196, 49, 49, 93, 93, 10,
-	10, 10, 10, 98, 98, 102, 102, 102, 103, 103,
-	103, 103, 135, 135, 11, 11, 11, 11, 11, 11,
-	11, 182, 182, 181, 180, 180, 179, 179, 178, 16,
-	165, 166, 166, 166, 161, 138, 138, 139, 139, 139,
-	139, 139, 146, 142, 142, 140, 140, 140, 140, 140,
-	140, 140, 141, 141, 141, 141, 141, 143, 143, 143,
-	143, 143, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 145, 145, 145,
-	145, 145, 145, 145, 145, 160, 160, 147, 147, 155,
-	155, 156, 156, 156, 153, 153, 154, 154, 157, 157,
-	157, 148, 148, 148, 148, 148, 148, 148, 150, 150,
-	158, 158, 151, 151, 151, 152, 152, 152, 159, 159,
-	159, 159, 159, 149, 149, 162, 162, 174, 174, 173,
-	173, 173, 164, 164, 170, 170, 170, 170, 170, 163,
-	163, 172, 172, 171, 167, 167, 167, 168, 168, 168,
-	169, 169, 169, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 183, 183, 183, 183, 183, 183, 183, 183,
-	183, 183, 183, 177, 175, 175, 176, 176, 13, 14,
-	14, 14, 14, 14, 15, 15, 17, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 102, 102, 102, 103, 103, 104, 104, 104, 104,
+	104, 105, 105, 107, 107, 107, 107, 109, 109, 109,
+	109, 109, 109, 110, 110, 106, 106, 108, 108, 108,
+	108, 108, 111, 112, 112, 112, 118, 118, 117, 117,
+	117, 113, 113, 114, 114, 114, 114, 114, 115, 115,
+	116, 116, 116, 119, 119, 119, 120, 120, 120, 121,
+	121, 98, 98, 99, 99, 100, 100, 101, 101, 101,
+	101, 2, 2, 2, 6, 3, 4, 4, 5, 5,
+	7, 7, 7, 7, 30, 30, 8, 9, 9, 9,
+	222, 222, 49, 49, 93, 93, 10, 10, 10, 10,
+	124, 124, 128, 128, 128, 129, 129, 129, 129, 161,
+	161, 11, 11, 11, 11, 11, 11, 11, 208, 208,
+	207, 206, 206, 205, 205, 204, 16, 191, 192, 192,
+	192, 187, 164, 164, 165, 165, 165, 165, 165, 172,
+	168, 168, 166, 166, 166, 166, 166, 166, 166, 167,
+	167, 167, 167, 167, 169, 169, 169, 169, 169, 170,
+	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
+	170, 170, 170, 170, 171, 171, 171, 171, 171, 171,
+	171, 171, 186, 186, 173, 173, 181, 181, 182, 182,
+	182, 179, 179, 180, 180, 183, 183, 183, 174, 174,
+	174, 174, 174, 174, 174, 176, 176, 184, 184, 177,
+	177, 177, 178, 178, 178, 185, 185, 185, 185, 185,
+	175, 175, 188, 188, 200, 200, 199, 199, 199, 190,
+	190, 196, 196, 196, 196, 196, 189, 189, 198, 198,
+	197, 193, 193, 193, 194, 194, 194, 195, 195, 195,
+	12, 12, 12, 12, 12, 12, 12, 12, 12, 209,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	203, 201, 201, 202, 202, 13, 14, 14, 14, 14,
+	14, 15, 15, 17, 18, 18, 18, 18, 18, 18,
 	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 110, 110, 107, 107, 108, 108, 109, 109, 109,
-	111, 111, 111, 136, 136, 136, 19, 19, 21, 21,
-	22, 23, 20, 20, 20, 20, 20, 24, 25, 25,
-	25, 186, 186, 186, 186, 186, 186, 26, 26, 187,
-	187, 197, 27, 28, 28, 29, 29, 29, 33, 33,
-	33, 31, 31, 32, 32, 38, 38, 37, 37, 39,
-	39, 39, 39, 123, 123, 123, 125, 125, 125, 125,
-	122, 41, 41, 42, 42, 43, 43, 44, 44, 44,
-	56, 56, 92, 92, 94, 94, 45, 45, 45, 45,
-	46, 46, 47, 47, 48, 48, 131, 131, 130, 130,
-	130, 129, 50, 50, 50, 52, 51, 51, 51, 51,
-	53, 53, 55, 55, 54, 54, 57, 57, 57, 57,
-	58, 58, 40, 40, 40, 40, 40, 40, 40, 106,
-	106, 60, 60, 59, 59, 59, 59, 59, 59, 59,
-	59, 59, 59, 70, 70, 70, 70, 70, 70, 61,
-	61, 61, 61, 61, 61, 61, 36, 36, 71, 71,
-	71, 77, 72, 72, 64, 64, 64, 64, 64, 64,
+	18, 18, 18, 18, 18, 18, 18, 18, 136, 136,
+	133, 133, 134, 134, 135, 135, 135, 137, 137, 137,
+	162, 162, 162, 19, 19, 21, 21, 22, 23, 20,
+	20, 20, 20, 20, 24, 25, 25, 25, 212, 212,
+	212, 212, 212, 212, 26, 26, 213, 213, 223, 27,
+	28, 28, 29, 29, 29, 33, 33, 33, 31, 31,
+	32, 32, 38, 38, 37, 37, 39, 39, 39, 39,
+	149, 149, 149, 151, 151, 151, 151, 148, 41, 41,
+	42, 42, 43, 43, 44, 44, 44, 56, 56, 92,
+	92, 94, 94, 45, 45, 45, 45, 46, 46, 47,
+	47, 48, 48, 157, 157, 156, 156, 156, 155, 50,
+	50, 50, 52, 51, 51, 51, 51, 53, 53, 55,
+	55, 54, 54, 57, 57, 57, 57, 58, 58, 40,
+	40, 40, 40, 40, 40, 40, 132, 132, 60, 60,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 70, 70, 70, 70, 70, 70, 61, 61, 61,
+	61, 61, 61, 61, 36, 36, 71, 71, 71, 77,
+	72, 72, 64, 64, 64, 64, 64, 64, 64, 64,
 	64, 64, 64, 64, 64, 64, 64, 64, 64, 64,
 	64, 64, 64, 64, 64, 64, 64, 64, 64, 64,
-	64, 64, 64, 64, 64, 64, 191, 190, 68, 68,
-	68, 66, 66, 66, 66, 66, 66, 66, 66, 66,
-	66, 66, 66, 66, 66, 66, 67, 67, 67, 67,
-	67, 67, 67, 67, 198, 198, 69, 69, 69, 69,
-	34, 34, 34, 34, 34, 134, 134, 137, 137, 137,
-	137, 137, 137, 137, 137, 137, 137, 137, 137, 137,
-	137, 81, 81, 35, 35, 79, 79, 80, 82, 82,
-	78, 78, 78, 63, 63, 63, 63, 63, 63, 63,
-	63, 63, 63, 63, 63, 184, 184, 65, 65, 65,
-	83, 83, 84, 84, 85, 85, 86, 86, 87, 88,
-	88, 88, 89, 89, 89, 89, 90, 90, 90, 62,
-	62, 62, 62, 62, 62, 91, 91, 91, 91, 95,
-	95, 73, 73, 75, 75, 74, 76, 185, 185, 185,
-	96, 96, 100, 97, 97, 101, 101, 101, 99, 99,
-	99, 126, 126, 126, 104, 104, 112, 112, 113, 113,
-	105, 105, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 115, 115, 115, 116, 116, 117, 117, 117,
-	124, 124, 120, 120, 121, 121, 127, 127, 127, 127,
-	127, 128, 128, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 189, 189, 189, 194, 195, 132, 133, 133,
-	133,
+	64, 64, 64, 64, 217, 216, 68, 68, 68, 66,
+	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
+	66, 66, 66, 66, 67, 67, 67, 67, 67, 67,
+	67, 67, 224, 224, 69, 69, 69, 69, 34, 34,
+	34, 34, 34, 160, 160, 163, 163, 163, 163, 163,
+	163, 163, 163, 163, 163, 163, 163, 163, 163, 81,
+	81, 35, 35, 79, 79, 80, 82, 82, 78, 78,
+	78, 63, 63, 63, 63, 63, 63, 63, 63, 63,
+	63, 63, 63, 210, 210, 65, 65, 65, 83, 83,
+	84, 84, 85, 85, 86, 86, 87, 88, 88, 88,
+	89, 89, 89, 89, 90, 90, 90, 62, 62, 62,
+	62, 62, 62, 91, 91, 91, 91, 95, 95, 96,
+	96, 97, 97, 97, 73, 73, 75, 75, 74, 76,
+	211, 211, 211, 122, 122, 126, 123, 123, 127, 127,
+	127, 125, 125, 125, 152, 152, 152, 130, 130, 138,
+	138, 139, 139, 131, 131, 140, 140, 140, 140, 140,
+	140, 140, 140, 140, 140, 141, 141, 141, 142, 142,
+	143, 143, 143, 150, 150, 146, 146, 147, 147, 153,
+	153, 153, 153, 153, 154, 154, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
+	145, 215, 215, 215, 220, 221, 158, 159, 159, 159,
 }
 var yyR2 = [...]int{
 
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 6, 7, 5, 10,
-	1, 3, 1, 3, 8, 8, 6, 1, 1, 8,
-	8, 7, 6, 1, 1, 1, 3, 0, 4, 3,
-	4, 5, 4, 1, 3, 3, 2, 2, 2, 2,
-	2, 1, 1, 1, 2, 8, 4, 6, 5, 5,
-	5, 0, 2, 1, 0, 2, 1, 3, 3, 4,
-	4, 1, 3, 3, 8, 1, 3, 3, 1, 1,
-	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 2, 1, 2, 2,
-	2, 1, 4, 4, 2, 2, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
-	5, 0, 3, 5, 0, 1, 0, 1, 0, 1,
-	2, 0, 2, 2, 2, 2, 2, 2, 0, 3,
-	0, 1, 0, 3, 3, 0, 2, 2, 0, 2,
-	1, 2, 1, 0, 2, 5, 4, 1, 2, 2,
-	3, 2, 0, 1, 2, 3, 3, 2, 2, 1,
-	1, 1, 3, 2, 0, 1, 3, 1, 2, 3,
-	1, 1, 1, 6, 7, 7, 12, 7, 7, 7,
-	4, 5, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 7, 1, 3, 8, 8, 5, 4,
-	6, 5, 4, 4, 3, 2, 3, 4, 4, 4,
-	4, 4, 4, 4, 4, 3, 3, 3, 3, 4,
-	3, 4, 6, 4, 2, 4, 2, 2, 2, 2,
-	3, 1, 1, 0, 1, 0, 1, 0, 2, 2,
-	0, 2, 2, 0, 1, 1, 2, 1, 1, 2,
-	1, 1, 2, 2, 2, 2, 2, 3, 4, 4,
-	7, 1, 1, 1, 1, 1, 1, 2, 4, 1,
-	3, 0, 2, 0, 2, 1, 2, 2, 0, 1,
-	1, 0, 1, 0, 1, 0, 1, 1, 3, 1,
-	2, 3, 5, 0, 1, 2, 1, 1, 1, 1,
-	1, 0, 2, 1, 3, 1, 1, 1, 3, 3,
-	3, 7, 1, 3, 1, 3, 4, 4, 4, 3,
-	2, 4, 0, 1, 0, 2, 0, 1, 0, 1,
-	2, 1, 1, 2, 2, 1, 2, 3, 2, 3,
-	2, 2, 2, 1, 1, 3, 0, 5, 5, 5,
-	0, 2, 1, 3, 3, 2, 3, 1, 2, 0,
-	3, 1, 1, 3, 3, 4, 4, 5, 3, 4,
-	5, 6, 2, 1, 2, 1, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
-	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 1,
+	1, 6, 6, 7, 0, 3, 0, 3, 4, 1,
+	2, 1, 3, 1, 2, 2, 2, 1, 1, 1,
+	1, 1, 3, 1, 1, 1, 2, 1, 1, 3,
+	3, 3, 16, 0, 2, 2, 1, 1, 0, 1,
+	1, 0, 2, 0, 4, 4, 5, 4, 0, 2,
+	0, 4, 4, 0, 3, 3, 0, 2, 3, 0,
+	2, 2, 3, 1, 3, 5, 8, 1, 1, 1,
+	1, 4, 6, 7, 5, 10, 1, 3, 1, 3,
+	8, 8, 8, 6, 1, 1, 8, 8, 7, 6,
+	1, 1, 1, 3, 0, 4, 3, 4, 5, 4,
+	1, 3, 3, 2, 2, 2, 2, 2, 1, 1,
+	1, 2, 8, 4, 6, 5, 5, 5, 0, 2,
+	1, 0, 2, 1, 3, 3, 4, 4, 1, 3,
+	3, 8, 1, 3, 3, 1, 1, 1, 1, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 2, 2, 2, 1, 2, 2, 2, 1, 4,
+	4, 2, 2, 3, 3, 3, 3, 1, 1, 1,
+	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
+	5, 0, 1, 0, 1, 0, 1, 2, 0, 2,
+	2, 2, 2, 2, 2, 0, 3, 0, 1, 0,
+	3, 3, 0, 2, 2, 0, 2, 1, 2, 1,
+	0, 2, 5, 4, 1, 2, 2, 3, 2, 0,
+	1, 2, 3, 3, 2, 2, 1, 1, 1, 3,
+	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
+	6, 7, 7, 12, 7, 7, 7, 4, 5, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	7, 1, 3, 8, 8, 5, 4, 6, 5, 4,
+	4, 3, 2, 3, 4, 4, 4, 4, 4, 4,
+	4, 4, 3, 3, 3, 3, 4, 3, 4, 6,
+	4, 2, 4, 2, 2, 2, 2, 3, 1, 1,
+	0, 1, 0, 1, 0, 2, 2, 0, 2, 2,
+	0, 1, 1, 2, 1, 1, 2, 1, 1, 2,
+	2, 2, 2, 2, 3, 4, 4, 7, 1, 1,
+	1, 1, 1, 1, 2, 4, 1, 3, 0, 2,
+	0, 2, 1, 2, 2, 0, 1, 1, 0, 1,
+	0, 1, 0, 1, 1, 3, 1, 2, 3, 5,
+	0, 1, 2, 1, 1, 1, 1, 1, 0, 2,
+	1, 3, 1, 1, 1, 3, 3, 3, 7, 1,
+	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
+	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
+	2, 2, 1, 2, 3, 2, 3, 2, 2, 2,
+	1, 1, 3, 0, 5, 5, 5, 0, 2, 1,
+	3, 3, 2, 3, 1, 2, 0, 3, 1, 1,
+	3, 6, 3, 4, 4, 5, 3, 4, 5, 6,
+	2, 1, 2, 1, 2, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 0, 2, 1, 1, 1, 3,
+	1, 3, 1, 1, 1, 1, 1, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 2, 2, 2,
-	1, 1, 1, 1, 1, 1, 2, 3, 4, 5,
-	6, 4, 4, 6, 6, 6, 6, 8, 8, 6,
-	8, 8, 9, 7, 5, 4, 2, 2, 2, 2,
-	2, 2, 2, 2, 0, 2, 4, 4, 4, 4,
-	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
-	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
-	4, 0, 1, 0, 2, 1, 2, 4, 0, 2,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 1, 2, 1, 2, 2,
-	0, 3, 0, 2, 0, 3, 1, 3, 2, 0,
-	1, 1, 0, 2, 4, 4, 0, 2, 4, 2,
-	1, 3, 5, 4, 6, 1, 3, 3, 5, 0,
-	5, 1, 3, 1, 2, 3, 1, 0, 2, 2,
-	1, 3, 3, 1, 3, 3, 3, 3, 1, 2,
-	1, 1, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 1, 0, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 2, 3, 4, 5, 6, 4,
+	4, 6, 6, 6, 6, 8, 8, 6, 8, 8,
+	9, 7, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 0, 2, 4, 4, 4, 4, 0, 3,
+	4, 7, 3, 1, 1, 2, 3, 3, 1, 2,
+	2, 1, 2, 1, 2, 2, 1, 2, 4, 0,
+	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
+	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 1, 2, 1, 2, 2, 0, 3,
+	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
+	0, 2, 4, 4, 0, 2, 4, 2, 1, 3,
+	5, 4, 6, 1, 3, 3, 5, 0, 5, 5,
+	8, 0, 4, 3, 1, 3, 1, 2, 3, 1,
+	0, 2, 2, 1, 3, 3, 1, 3, 3, 3,
+	3, 1, 2, 1, 1, 1, 1, 1, 1, 0,
+	2, 0, 3, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
+	0, 1, 1, 0, 2, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2281,290 +2629,327 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 0, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -192, -1, -2, -6, -7, -8, -9, -10, -11,
+	-1000, -218, -1, -2, -6, -7, -8, -9, -10, -11,
 	-12, -13, -14, -15, -17, -18, -19, -21, -22, -23,
-	-20, -24, -25, -26, -3, -4, 6, 7, -30, 9,
-	10, 30, -16, 136, 137, 139, 138, 164, 140, 157,
-	49, 176, 177, 179, 180, 25, 158, 159, 162, 163,
-	181, 182, 183, -194, 8, 261, 53, -193, 277, -85,
-	15, -29, 5, -27, -197, -27, -27, -27, -27, -27,
-	-165, 53, -117, 145, 95, 172, 253, 142, 143, 149,
-	-120, 57, -119, 269, 176, 190, 184, 210, 202, 200,
-	203, 240, 68, 179, 249, 160, 199, 195, 193, 27,
-	215, 274, 194, 155, 154, 216, 220, 241, 188, 189,
-	243, 214, 156, 32, 271, 34, 168, 244, 218, 213,
-	209, 212, 187, 208, 38, 222, 221, 223, 239, 205,
-	196, 18, 247, 163, 166, 217, 219, 150, 170, 273,
-	245, 192, 167, 162, 248, 180, 242, 251, 37, 227,
-	186, 153, 177, 174, 206, 169, 197, 198, 211, 185,
-	207, 178, 171, 164, 250, 228, 275, 204, 201, 175,
-	173, 232, 233, 234, 235, 272, 246, 229, -105, 145,
-	147, 143, 143, 144, 145, 253, 142, 143, -54, -127,
-	61, 62, 60, 57, -119, 145, 172, 143, 131, 203,
-	136, 230, 144, 32, 170, -136, 143, 178, -107, 173,
-	232, 233, 234, 235, 57, 242, 241, 236, -127, 178,
-	-132, -132, -132, -132, -132, 182, -127, 57, -2, -89,
-	17, 16, -5, -3, -194, 6, 20, 21, -33, 39,
-	40, -28, -39, 122, -40, -127, -59, 97, -64, 29,
-	61, 60, 57, -119, 23, -78, -63, -60, -76, -77,
-	131, 132, 120, 121, 128, 98, -190, -191, -68, -66,
-	-67, -69, -120, 59, 69, 63, 64, 65, 66, 72,
-	58, 70, 73, 74, -74, -194, 56, 43, 44, 262,
-	263, 264, 265, 268, 266, 100, 33, 252, 260, 259,
-	258, 256, 257, 254, 255, 148, 253, 126, 261, -105,
-	-42, -43, -44, -45, -56, -77, -194, -54, 11, -49,
-	-54, -97, -135, 178, -101, 242, 241, -121, -99, -120,
-	-118, 240, 203, 239, 141, 96, 22, 24, 225, 99,
-	131, 16, 100, 130, 262, 136, 47, 254, 255, 252,
-	264, 265, 253, 230, 29, 10, 25, 158, 21, 124,
-	138, 103, 104, 161, 23, 159, 74, 19, 50, 11,
-	13, 14, 148, 147, 115, 144, 45, 8, 56, 26,
-	112, 41, 28, 43, 113, 17, 256, 257, 31, 268,
-	165, 126, 48, 35, 97, 72, 51, 95, 15, 46,
-	114, 139, 261, 44, 142, 6, 267, 30, 157, 42,
-	143, 231, 102, 146, 73, 5, 149, 9, 49, 52,
-	258, 259, 260, 33, 101, 12, -166, -161, 57, 144,
-	-54, 261, -120, -113, 148, -113, -113, 143, -54, -54,
-	-112, 148, 57, -112, -112, -112, -54, 133, -54, 57,
-	30, 253, 57, 170, 143, 171, 145, -133, -194, -121,
-	-133, -133, -133, 174, 175, -133, 243, -108, 237, 51,
-	-133, -127, 11, 22, -194, 52, -195, 55, -90, 19,
-	31, -40, -127, -86, -87, -40, -85, -2, -27, 35,
-	-31, 21, 67, 11, -123, 96, 95, 112, -122, 22,
-	-125, 60, 61, 62, -120, 133, -40, -61, 115, 97,
-	113, 114, 99, 117, 116, 127, 120, 121, 122, 123,
-	124, 125, 126, 118, 119, 130, 105, 106, 107, 108,
-	109, 110, 111, -106, -194, -77, -194, 134, 135, -184,
-	71, -64, -64, -64, -64, -64, -64, -194, -2, -72,
-	-40, -194, 60, -64, -194, -194, -194, -194, -194, -194,
-	-194, -194, -81, -40, -194, -198, -194, -198, -198, -198,
-	-198, -198, -198, -198, -194, -194, -194, -194, -55, 26,
-	-54, 30, 54, -50, -52, -51, -53, 41, 45, 47,
-	42, 43, 44, 48, -131, 22, -42, -194, -130, 166,
-	-129, 22, -127, -54, -49, -196, 54, 11, 52, 54,
-	-97, 178, -98, -102, 243, 245, 105, -126, -120, -189,
-	29, 60, 61, 62, 30, 55, 54, -139, -142, -144,
-	-143, -145, -146, -140, -141, 200, 201, 131, 204, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 30,
-	160, 197, 198, 199, 94, 216, 217, 218, 219, 220,
-	221, 222, 223, 202, 184, 185, 186, 187, 188, 189,
-	190, 192, 193, 194, 195, 196, 57, -133, 145, -182,
-	52, 57, 97, 57, -54, -54, -133, 146, -54, 23,
-	51, -54, 57, 57, -128, -127, -118, -133, -133, -133,
-	-133, -133, -133, -133, -133, -133, -133, 244, -110, 231,
-	238, -54, -186, -3, -7, -9, -8, 57, -189, -186,
-	-138, -139, -187, -127, 9, 115, 54, 18, 133, 54,
-	-88, 24, 25, -89, -195, -33, -65, -120, 63, 66,
-	-32, 42, -54, -40, -40, -70, 72, 97, 73, 74,
-	-122, 122, -128, -121, -118, -64, -71, -74, -77, 71,
-	115, 113, 114, 99, -64, -64, -64, -64, -64, -64,
-	-64, -64, -64, -64, -64, -64, -64, -64, -64, -134,
-	57, -189, 57, -63, 60, 61, -63, 71, -38, 21,
-	-37, -39, -195, 54, -195, -2, -188, 75, 76, 77,
-	78, 79, 80, 81, 82, 94, 83, 84, 85, 86,
-	87, 88, 89, 90, 91, 92, 93, -37, -37, -40,
-	-40, -78, -120, -127, -78, -37, -31, -79, -80, 101,
-	-78, -195, -37, -38, -37, -37, -93, 29, 166, -54,
-	-96, -100, -78, -43, -44, -44, -43, -44, 41, 41,
-	41, 46, 41, 46, 41, -51, -127, -195, -57, 49,
-	147, 50, -194, -129, -93, 52, -42, -54, -101, -98,
-	54, 244, 246, 247, 51, -40, -152, 130, -167, -168,
-	-169, -121, -189, 63, -161, -162, -170, 150, 153, 149,
-	-163, 144, 28, -157, 72, 97, -153, 228, -147, 53,
-	-147, -147, -147, -147, -151, 203, -151, -151, -151, 53,
-	53, -147, -147, -147, -155, 53, -155, -155, -156, 53,
-	-156, -124, 52, -54, -180, 272, -181, 57, -133, 23,
-	-133, -114, 141, 138, 139, -177, 137, 225, 203, 68,
-	29, 15, 262, 166, 275, 57, 167, -54, -54, -133,
-	-109, 11, 115, 54, -195, 54, 37, -40, -40, -128,
-	-87, -90, -104, 19, 11, 33, 33, -37, 72, 73,
-	74, 133, -194, -71, -64, -64, -64, -36, 161, 96,
-	-195, -195, -37, 54, -40, -195, -195, -195, 54, 52,
-	22, 54, 11, 133, 54, 11, -195, -37, -82, -80,
-	103, -40, -195, -195, -195, -195, -195, -62, 30, 33,
-	-2, -194, 33, -194, -58, 54, 12, 105, -47, -46,
-	51, 52, -48, 51, -46, 41, 41, 144, 144, 144,
-	-94, -120, -58, -42, -58, -102, -103, 248, 245, 251,
-	57, -189, 54, -169, 105, 53, 28, -163, -163, 57,
-	57, -148, 29, 72, -154, 229, 63, -151, -151, -152,
-	30, -152, -152, -152, -160, -189, -160, 63, 63, 51,
-	-120, -133, -179, -178, -121, -132, -183, 172, 151, 152,
-	155, 154, 57, 144, 28, 150, 153, 166, 149, -183,
-	172, -115, -116, 146, 22, 144, 28, 166, -133, -111,
-	113, 12, -127, -127, -139, 22, -127, 38, 133, -54,
-	-41, 11, 122, -121, -38, -36, 96, -64, -64, -195,
-	-39, -137, 131, 200, 160, 199, 195, 214, 205, 227,
-	197, 228, 201, -134, -137, -64, -64, -121, -64, -64,
-	269, -85, 104, -40, 102, -95, 51, -96, -73, -75,
-	-74, -194, -2, -91, -125, -94, -85, -100, -40, -40,
-	-40, 53, -40, -194, -194, -194, -195, 54, -85, -58,
-	245, 249, 250, -168, -169, -172, -171, -120, 57, 57,
-	-150, 51, -189, 63, 64, 72, 252, 69, 55, -152,
-	-152, 57, 131, 55, 54, 55, 54, 55, 54, -54,
-	54, 105, -132, -120, -132, -120, -54, -132, -120, -189,
-	-40, -186, -58, -42, -195, -64, -195, -147, -147, -147,
-	-156, -147, 189, -147, 189, -194, -195, -195, -195, 54,
-	19, -195, 54, 19, -194, -35, 267, -40, -185, 276,
-	27, -95, 54, -195, -195, -195, 54, 133, -195, -89,
-	-92, -120, -92, -92, -92, -130, -120, -89, 55, 54,
-	-147, -158, 225, 9, -151, -189, -151, 63, 63, -133,
-	-178, -169, 53, 26, -83, 13, -151, 57, 63, -64,
-	-64, -64, -64, -64, -195, -189, 122, -72, 28, -75,
-	33, -2, -194, -125, -125, 54, 55, -195, -195, -195,
-	-57, -174, -173, 52, 156, 68, -171, -159, 150, 28,
-	149, 252, -152, -152, 55, 55, -92, -194, -84, 14,
-	16, -195, -195, -195, -195, -195, -34, 115, 272, 9,
-	-73, -2, 133, -120, -173, 57, -164, 105, -189, -149,
-	68, 28, 28, 55, -175, -176, 166, -40, -72, -195,
-	270, 48, 273, -96, -195, -125, 63, -189, -182, -195,
-	54, -120, 38, 271, 274, -180, -176, 33, 38, 168,
-	272, 169, 273, -194, 274, -64, 165, -195, -195,
+	-20, -24, -25, -26, -98, -102, -111, -3, -4, 6,
+	7, -30, 9, 10, 30, -16, 136, 137, 139, 138,
+	164, 140, 157, 49, 176, 177, 179, 180, 25, 158,
+	159, 162, 163, 181, 182, 183, 272, 281, 284, -220,
+	8, 261, 53, -219, 293, -2, -7, -8, -9, -85,
+	15, -29, 5, -27, -223, -27, -27, -27, -27, -27,
+	-191, 53, -143, 145, 95, 172, 253, 142, 143, 149,
+	-146, 57, -145, 269, 176, 190, 184, 210, 202, 200,
+	203, 240, 68, 179, 249, 277, 281, 160, 199, 195,
+	278, 193, 27, 289, 215, 290, 274, 194, 155, 154,
+	216, 220, 241, 285, 188, 189, 243, 214, 156, 32,
+	271, 34, 168, 244, 291, 218, 284, 286, 213, 209,
+	212, 187, 208, 38, 222, 221, 223, 239, 205, 279,
+	196, 18, 247, 163, 288, 166, 217, 219, 150, 170,
+	273, 245, 192, 280, 167, 162, 248, 180, 242, 251,
+	37, 227, 186, 153, 177, 292, 174, 282, 283, 287,
+	206, 169, 197, 198, 211, 185, 207, 178, 171, 164,
+	250, 228, 275, 204, 201, 175, 173, 232, 233, 234,
+	235, 272, 246, 229, -131, 145, 147, 143, 143, 144,
+	145, 253, 142, 143, -54, -153, 61, 62, 60, 57,
+	-145, 145, 172, 143, 131, 203, 136, 230, 144, 32,
+	170, -162, 143, 178, -133, 173, 232, 233, 234, 235,
+	57, 242, 241, 236, -153, 178, -158, -158, -158, -158,
+	-158, 182, -153, 57, -99, 280, -100, -153, -54, -220,
+	57, -2, -89, 17, 16, -5, -3, -220, 6, 20,
+	21, -33, 39, 40, -28, -39, 122, -40, -153, -59,
+	97, -64, 29, 61, 60, 57, -145, 23, -78, -63,
+	-60, -76, -77, 131, 132, 120, 121, 128, 98, -216,
+	-217, -68, -66, -67, -69, -146, 59, 69, 63, 64,
+	65, 66, 72, 58, 70, 73, 74, -74, -220, 56,
+	43, 44, 262, 263, 264, 265, 268, 266, 100, 33,
+	252, 260, 259, 258, 256, 257, 254, 255, 148, 253,
+	126, 261, -131, -42, -43, -44, -45, -56, -77, -220,
+	-54, 11, -49, -54, -123, -161, 178, -127, 242, 241,
+	-147, -125, -146, -144, 240, 203, 239, 141, 96, 22,
+	24, 225, 99, 131, 16, 100, 130, 262, 136, 47,
+	254, 255, 252, 264, 265, 253, 230, 29, 10, 25,
+	158, 21, 124, 138, 103, 104, 161, 23, 159, 74,
+	19, 50, 11, 13, 14, 148, 147, 115, 144, 45,
+	8, 56, 26, 112, 41, 28, 43, 113, 17, 256,
+	257, 31, 268, 165, 126, 48, 35, 97, 72, 51,
+	95, 15, 46, 114, 139, 261, 44, 142, 6, 267,
+	30, 157, 42, 143, 231, 102, 146, 73, 5, 149,
+	9, 49, 52, 258, 259, 260, 33, 101, 12, -192,
+	-187, 57, 144, -54, 261, -146, -139, 148, -139, -139,
+	143, -54, -54, -138, 148, 57, -138, -138, -138, -54,
+	133, -54, 57, 30, 253, 57, 170, 143, 171, 145,
+	-159, -220, -147, -159, -159, -159, 174, 175, -159, 243,
+	-134, 237, 51, -159, -153, 11, 22, -220, 52, 54,
+	-99, 22, -220, -103, -220, -2, -112, -221, 55, -90,
+	19, 31, -40, -153, -86, -87, -40, -85, -2, -27,
+	35, -31, 21, 67, 11, -149, 96, 95, 112, -148,
+	22, -151, 60, 61, 62, -146, 133, -40, -61, 115,
+	97, 113, 114, 99, 117, 116, 127, 120, 121, 122,
+	123, 124, 125, 126, 118, 119, 130, 105, 106, 107,
+	108, 109, 110, 111, -132, -220, -77, -220, 134, 135,
+	-210, 71, -64, -64, -64, -64, -64, -64, -220, -2,
+	-72, -40, -220, 60, -64, -220, -220, -220, -220, -220,
+	-220, -220, -220, -81, -40, -220, -224, -220, -224, -224,
+	-224, -224, -224, -224, -224, -220, -220, -220, -220, -55,
+	26, -54, 30, 54, -50, -52, -51, -53, 41, 45,
+	47, 42, 43, 44, 48, -157, 22, -42, -220, -156,
+	166, -155, 22, -153, -54, -49, -222, 54, 11, 52,
+	54, -123, 178, -124, -128, 243, 245, 105, -152, -146,
+	-215, 29, 60, 61, 62, 30, 55, 54, -165, -168,
+	-170, -169, -171, -172, -166, -167, 200, 201, 131, 204,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	30, 160, 197, 198, 199, 94, 216, 217, 218, 219,
+	220, 221, 222, 223, 202, 184, 185, 186, 187, 188,
+	189, 190, 192, 193, 194, 195, 196, 57, -159, 145,
+	-208, 52, 57, 97, 57, -54, -54, -159, 146, -54,
+	23, 51, -54, 57, 57, -154, -153, -144, -159, -159,
+	-159, -159, -159, -159, -159, -159, -159, -159, 244, -136,
+	231, 238, -54, -212, -3, -7, -9, -8, 57, -215,
+	-212, -164, -165, -213, -153, -100, -220, -91, -151, 11,
+	146, -92, -146, -221, 285, 286, 57, 9, 115, 54,
+	18, 133, 54, -88, 24, 25, -89, -221, -33, -65,
+	-146, 63, 66, -32, 42, -54, -40, -40, -70, 72,
+	97, 73, 74, -148, 122, -154, -147, -144, -64, 20,
+	-71, -74, -77, 71, 115, 113, 114, 99, -64, -64,
+	-64, -64, -64, -64, -64, -64, -64, -64, -64, -64,
+	-64, -64, -64, -160, 57, -215, 57, -63, 60, 61,
+	-63, 71, -38, 21, -37, -39, -221, 54, -221, -2,
+	-214, 75, 76, 77, 78, 79, 80, 81, 82, 94,
+	83, 84, 85, 86, 87, 88, 89, 90, 91, 92,
+	93, -37, -37, -40, -40, -78, -146, -153, -78, -37,
+	-31, -79, -80, 101, -78, -221, -37, -38, -37, -37,
+	-93, 29, 166, -54, -122, -126, -78, -43, -44, -44,
+	-43, -44, 41, 41, 41, 46, 41, 46, 41, -51,
+	-153, -221, -57, 49, 147, 50, -220, -155, -93, 52,
+	-42, -54, -127, -124, 54, 244, 246, 247, 51, -40,
+	-178, 130, -193, -194, -195, -147, -215, 63, -187, -188,
+	-196, 150, 153, 149, -189, 144, 28, -183, 72, 97,
+	-179, 228, -173, 53, -173, -173, -173, -173, -177, 203,
+	-177, -177, -177, 53, 53, -173, -173, -173, -181, 53,
+	-181, -181, -182, 53, -182, -150, 52, -54, -206, 272,
+	-207, 57, -159, 23, -159, -140, 141, 138, 139, -203,
+	137, 225, 203, 68, 29, 15, 262, 166, 275, 57,
+	167, -54, -54, -159, -135, 11, 115, 54, -221, 54,
+	-101, -2, -7, -8, -9, -221, 54, 133, 282, 283,
+	-221, 54, 146, -118, 60, 61, 37, -40, -40, -154,
+	-87, -90, -130, 19, 11, 33, 33, -37, 72, 73,
+	74, 133, -220, -220, -71, -64, -64, -64, -36, 161,
+	96, -221, -221, -37, 54, -40, -221, -221, -221, 54,
+	52, 22, 54, 11, 133, 54, 11, -221, -37, -82,
+	-80, 103, -40, -221, -221, -221, -221, -221, -62, 30,
+	33, -2, -220, 33, -220, -58, 54, 12, 105, -47,
+	-46, 51, 52, -48, 51, -46, 41, 41, 144, 144,
+	144, -94, -146, -58, -42, -58, -128, -129, 248, 245,
+	251, 57, -215, 54, -195, 105, 53, 28, -189, -189,
+	57, 57, -174, 29, 72, -180, 229, 63, -177, -177,
+	-178, 30, -178, -178, -178, -186, -215, -186, 63, 63,
+	51, -146, -159, -205, -204, -147, -158, -209, 172, 151,
+	152, 155, 154, 57, 144, 28, 150, 153, 166, 149,
+	-209, 172, -141, -142, 146, 22, 144, 28, 166, -159,
+	-137, 113, 12, -153, -153, -165, 22, -153, -221, 22,
+	-151, -151, -104, -220, 272, -106, -108, 131, 57, 72,
+	161, -104, -146, 283, -117, 261, 147, 38, 133, -54,
+	-41, 11, 122, -147, -38, -64, -36, 96, -64, -64,
+	-221, -39, -163, 131, 200, 160, 199, 195, 214, 205,
+	227, 197, 228, 201, -160, -163, -64, -64, -147, -64,
+	-64, 269, -85, 104, -40, 102, -95, -96, 51, -122,
+	-73, -75, -74, -220, -2, -91, -94, -85, -126, -40,
+	-40, -40, 53, -40, -220, -220, -220, -221, 54, -85,
+	-58, 245, 249, 250, -194, -195, -198, -197, -146, 57,
+	57, -176, 51, -215, 63, 64, 72, 252, 69, 55,
+	-178, -178, 57, 131, 55, 54, 55, 54, 55, 54,
+	-54, 54, 105, -158, -146, -158, -146, -54, -158, -146,
+	-215, -40, -212, -220, 133, -105, -107, -146, 72, 161,
+	-220, -106, -108, -157, -157, -157, -104, 26, -58, -42,
+	-221, -221, -64, -221, -173, -173, -173, -182, -173, 189,
+	-173, 189, -220, -221, -221, -221, 54, 19, -221, 54,
+	19, -220, -35, 267, -40, -211, 276, -211, 27, 277,
+	-95, 51, 54, -221, -221, -221, -221, -89, -92, -92,
+	-92, -92, -156, -146, -89, 55, 54, -173, -184, 225,
+	9, -177, -215, -177, 63, 63, -159, -204, -195, 53,
+	26, -101, -151, -221, 54, -109, -110, -146, 131, 63,
+	-60, -220, 60, 58, -110, -110, -105, -110, -110, -110,
+	143, -83, 13, -177, 57, 63, -64, -64, -64, -64,
+	-64, -221, -215, 122, -72, 28, -97, -220, 51, -75,
+	33, -2, -220, 55, -221, -221, -221, -57, -200, -199,
+	52, 156, 68, -197, -185, 150, 28, 149, 252, -178,
+	-178, 55, 55, -92, -220, -221, -107, -92, -221, -54,
+	-84, 14, 16, -221, -221, -221, -221, -221, -34, 115,
+	272, 9, 278, -91, 152, -73, -2, -199, 57, -190,
+	105, -215, -175, 68, 28, 28, 55, -201, -202, 166,
+	-221, -93, -40, -72, -221, 270, 48, 273, -122, 279,
+	9, -221, -146, -221, 63, -215, -208, -221, 54, -146,
+	-177, 38, 271, 274, 30, -58, -206, -202, 33, -113,
+	57, 38, -122, 168, -115, 291, -114, 272, -58, 169,
+	-119, 147, -116, 287, 289, 288, 290, 273, -220, -120,
+	-220, 63, 292, 287, 16, 16, 289, 16, 274, -64,
+	165, -121, 30, -221, -92, 291, 57, 16, 16, -118,
+	-118, 16, -118, -221, -221, -122, -221, -118, -118, -118,
 }
 var yyDef = [...]int{
 
 	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 23, 24, 544, 0, 301, 301, 301, 301,
-	301, 301, 0, 617, 600, 0, 0, 0, 0, -2,
-	277, 278, 0, 280, 281, 847, 847, 847, 847, 847,
-	0, 0, 0, 0, 37, 38, 845, 1, 3, 552,
-	0, 0, 305, 308, 303, 0, 600, 0, 0, 0,
-	64, 0, 0, 832, 0, 833, 598, 598, 598, 618,
-	619, 622, 623, 745, 746, 747, 748, 749, 750, 751,
-	752, 753, 754, 755, 756, 757, 758, 759, 760, 761,
-	762, 763, 764, 765, 766, 767, 768, 769, 770, 771,
-	772, 773, 774, 775, 776, 777, 778, 779, 780, 781,
-	782, 783, 784, 785, 786, 787, 788, 789, 790, 791,
-	792, 793, 794, 795, 796, 797, 798, 799, 800, 801,
-	802, 803, 804, 805, 806, 807, 808, 809, 810, 811,
-	812, 813, 814, 815, 816, 817, 818, 819, 820, 821,
-	822, 823, 824, 825, 826, 827, 828, 829, 830, 831,
-	834, 835, 836, 837, 838, 839, 840, 841, 0, 0,
-	601, 0, 596, 0, 596, 596, 596, 0, 235, 374,
-	626, 627, 628, 629, 630, 832, 833, 0, 0, 0,
-	0, 848, 848, 848, 848, 0, 848, 0, 265, 254,
-	256, 257, 258, 259, 848, 274, 275, 264, 276, 279,
-	282, 283, 284, 285, 286, 0, 0, 297, 30, 556,
-	0, 0, 544, 32, 0, 301, 306, 307, 311, 309,
-	310, 302, 0, 319, 323, 0, 382, 0, 387, 389,
-	-2, -2, -2, -2, 0, 424, 425, 426, 427, 428,
-	0, 0, 0, 0, 0, 0, 450, 451, 452, 453,
-	454, 455, 520, 525, 526, 527, 528, 529, 530, 531,
-	532, 533, 391, 392, 576, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 511, 0, 484, 484, 484,
-	484, 484, 484, 484, 484, 0, 0, 0, 0, 0,
-	0, 333, 335, 336, 337, 356, 0, 358, 0, 0,
-	45, 49, 0, 823, 583, -2, -2, 0, 0, 624,
-	625, -2, 752, -2, 653, 654, 655, 656, 657, 658,
-	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
-	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
-	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
-	689, 690, 691, 692, 693, 694, 695, 696, 697, 698,
-	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
-	709, 710, 711, 712, 713, 714, 715, 716, 717, 718,
-	719, 720, 721, 722, 723, 724, 725, 726, 727, 728,
-	729, 730, 731, 732, 733, 734, 735, 736, 737, 738,
-	739, 740, 741, 742, 743, 744, 0, 81, 0, 0,
-	848, 0, 71, 0, 0, 0, 0, 0, 848, 0,
-	0, 0, 0, 0, 0, 0, 234, 0, 236, 848,
-	848, 848, 848, 848, 848, 848, 848, 245, 849, 850,
-	246, 247, 248, 848, 848, 250, 0, 0, 266, 0,
-	260, 287, 0, 0, 0, 0, 31, 846, 25, 0,
-	0, 553, 0, 545, 546, 549, 552, 30, 308, 0,
-	313, 312, 304, 0, 320, 0, 0, 0, 324, 0,
-	330, 326, 327, 328, 329, 0, 385, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 409, 410, 411, 412,
-	413, 414, 415, 388, 0, 402, 0, 0, 0, 534,
-	535, 444, 445, 446, 447, 448, 449, 315, 30, 0,
-	422, 0, -2, 0, 0, 0, 0, 0, 0, 0,
-	0, 311, 0, 512, 0, 476, 0, 477, 478, 479,
-	480, 481, 482, 483, 0, 315, 0, 0, 47, 0,
-	373, 0, 0, 0, 0, 0, 0, 362, 0, 0,
-	365, 0, 0, 0, 0, 357, 0, 0, 376, 796,
-	359, 0, 361, -2, 0, 0, 0, 43, 44, 0,
-	50, 823, 52, 53, 0, 0, 0, 165, 591, 592,
-	593, 842, 843, 844, 589, 194, 0, 148, 144, 88,
-	89, 90, 91, 137, 94, 137, 137, 137, 137, 162,
-	162, 162, 162, 120, 121, 122, 123, 124, 0, 0,
-	107, 137, 137, 137, 111, 127, 128, 129, 130, 131,
-	132, 133, 134, 92, 95, 96, 97, 98, 99, 100,
-	101, 139, 139, 139, 141, 141, 620, 66, 0, 74,
-	0, 848, 0, 848, 79, 0, 210, 0, 229, 597,
-	0, 848, 232, 233, 375, 631, 632, 237, 238, 239,
-	240, 241, 242, 243, 244, 249, 253, 251, 267, 261,
-	262, 255, 288, 291, 292, 293, 294, 295, 296, 289,
-	0, 85, 298, 299, 557, 0, 0, 0, 0, 0,
-	548, 550, 551, 556, 33, 311, 0, 537, 0, 0,
-	0, 314, 28, 383, 384, 386, 403, 0, 405, 407,
-	325, 321, 0, 521, -2, 393, 394, 418, 419, 420,
-	0, 0, 0, 0, 416, 398, 0, 429, 430, 431,
-	432, 433, 434, 435, 436, 437, 438, 439, 440, 443,
-	495, 496, 0, 441, 523, 524, 442, 536, 0, 0,
-	316, 317, 421, 0, 575, 30, 457, 633, 634, 635,
-	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
-	646, 647, 648, 649, 650, 651, 652, 0, 0, 0,
-	0, 0, 520, 0, 0, 0, 0, 518, 515, 0,
-	0, 485, 0, 0, 0, 0, 0, 0, 0, 372,
-	380, 580, 0, 334, 352, 354, 0, 349, 363, 364,
-	366, 0, 368, 0, 370, 371, 338, 339, 340, 0,
-	0, 0, 0, 360, 380, 0, 380, 46, 584, 51,
-	0, 0, 56, 57, 585, 586, 587, 0, 80, 195,
-	197, 200, 201, 202, 82, 83, 0, 0, 0, 0,
-	0, 189, 190, 151, 149, 0, 146, 145, 93, 0,
-	162, 162, 114, 115, 165, 0, 165, 165, 165, 0,
-	0, 108, 109, 110, 102, 0, 103, 104, 105, 0,
-	106, 0, 0, 848, 68, 0, 72, 73, 69, 599,
-	70, 847, 0, 0, 612, 211, 602, 603, 604, 605,
-	606, 607, 608, 609, 610, 611, 0, 228, 848, 231,
-	270, 0, 0, 0, 0, 0, 0, 554, 555, 0,
-	547, 26, 0, 594, 595, 538, 539, 331, 404, 406,
-	408, 0, 315, 395, 416, 399, 0, 396, 0, 0,
-	390, 458, 0, 0, 423, -2, 461, 462, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 544, 0, 516,
-	0, 0, 475, 486, 487, 488, 489, 569, 0, 0,
-	-2, 0, 36, 0, 544, 0, 0, 0, 346, 353,
-	0, 0, 347, 0, 348, 367, 369, 0, 0, 0,
-	0, 344, 544, 380, 42, 54, 55, 0, 0, 61,
-	166, 167, 0, 198, 0, 0, 184, 0, 0, 187,
-	188, 158, 0, 150, 87, 147, 0, 165, 165, 116,
-	0, 117, 118, 119, 0, 135, 0, 0, 0, 0,
-	621, 67, 75, 76, 0, 203, 847, 0, 212, 213,
-	214, 215, 216, 217, 218, 219, 220, 221, 222, 847,
-	0, 0, 847, 613, 614, 615, 616, 0, 230, 252,
-	0, 0, 268, 269, 86, 0, 300, 558, 0, 27,
-	380, 0, 322, 522, 0, 397, 0, 417, 400, 459,
-	318, 0, 137, 137, 500, 137, 141, 503, 137, 505,
-	137, 508, 0, 0, 0, 0, 0, 521, 0, 0,
-	0, 513, 474, 519, 0, 577, 0, 569, 559, 571,
-	573, 0, 30, 0, 565, 0, 552, 581, 381, 582,
-	350, 0, 355, 0, 0, 0, 358, 0, 552, 41,
-	58, 59, 60, 196, 199, 0, 191, 137, 185, 186,
-	160, 0, 152, 153, 154, 155, 156, 157, 138, 112,
-	113, 163, 164, 162, 0, 162, 0, 142, 0, 848,
-	0, 0, 204, 0, 205, 207, 208, 209, 0, 271,
-	272, 290, 540, 332, 460, 401, 463, 497, 162, 501,
-	502, 504, 506, 507, 509, 0, 465, 464, 466, 0,
-	0, 469, 0, 0, 0, 0, 0, 517, 34, 0,
-	0, 35, 0, 574, -2, 0, 0, 0, 48, 39,
-	0, 342, 0, 0, 0, 376, 345, 40, 176, 0,
-	193, 168, 161, 0, 165, 136, 165, 0, 0, 65,
-	77, 78, 0, 0, 542, 0, 498, 499, 0, 0,
-	0, 0, 0, 490, 473, 514, 578, 579, 0, 572,
-	0, -2, 0, 567, 566, 0, 351, 377, 378, 379,
-	341, 175, 177, 0, 182, 0, 192, 173, 0, 170,
-	172, 159, 125, 126, 140, 143, 0, 0, 29, 0,
-	0, 510, 467, 468, 470, 471, 0, 0, 0, 0,
-	562, 30, 0, 343, 178, 179, 0, 183, 181, 84,
-	0, 169, 171, 71, 0, 224, 0, 543, 541, 472,
-	0, 0, 0, 570, -2, 568, 180, 174, 74, 223,
-	0, 0, 491, 0, 494, 206, 225, 0, 492, 0,
-	0, 0, 0, 0, 493, 0, 0, 226, 227,
+	21, 22, 23, 24, 0, 29, 30, 622, 0, 378,
+	378, 378, 378, 378, 378, 0, 700, 683, 0, 0,
+	0, 0, -2, 354, 355, 0, 357, 358, 946, 946,
+	946, 946, 946, 0, 0, 0, 0, 0, 0, 0,
+	114, 115, 944, 1, 3, -2, 26, 27, 28, 630,
+	0, 0, 382, 385, 380, 0, 683, 0, 0, 0,
+	141, 0, 0, 931, 0, 932, 681, 681, 681, 701,
+	702, 705, 706, 828, 829, 830, 831, 832, 833, 834,
+	835, 836, 837, 838, 839, 840, 841, 842, 843, 844,
+	845, 846, 847, 848, 849, 850, 851, 852, 853, 854,
+	855, 856, 857, 858, 859, 860, 861, 862, 863, 864,
+	865, 866, 867, 868, 869, 870, 871, 872, 873, 874,
+	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
+	885, 886, 887, 888, 889, 890, 891, 892, 893, 894,
+	895, 896, 897, 898, 899, 900, 901, 902, 903, 904,
+	905, 906, 907, 908, 909, 910, 911, 912, 913, 914,
+	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
+	925, 926, 927, 928, 929, 930, 933, 934, 935, 936,
+	937, 938, 939, 940, 0, 0, 684, 0, 679, 0,
+	679, 679, 679, 0, 312, 451, 709, 710, 711, 712,
+	713, 931, 932, 0, 0, 0, 0, 947, 947, 947,
+	947, 0, 947, 0, 342, 331, 333, 334, 335, 336,
+	947, 351, 352, 341, 353, 356, 359, 360, 361, 362,
+	363, 0, 0, 374, 91, 898, 93, 0, 34, 0,
+	63, 106, 634, 0, 0, 622, 108, 0, 378, 383,
+	384, 388, 386, 387, 379, 0, 396, 400, 0, 459,
+	0, 464, 466, -2, -2, -2, -2, 0, 502, 503,
+	504, 505, 506, 0, 0, 0, 0, 0, 0, 528,
+	529, 530, 531, 532, 533, 598, 603, 604, 605, 606,
+	607, 608, 609, 610, 611, 468, 469, 659, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 589, 0,
+	562, 562, 562, 562, 562, 562, 562, 562, 0, 0,
+	0, 0, 0, 0, 410, 412, 413, 414, 433, 0,
+	435, 0, 0, 122, 126, 0, 922, 666, -2, -2,
+	0, 0, 707, 708, -2, 835, -2, 736, 737, 738,
+	739, 740, 741, 742, 743, 744, 745, 746, 747, 748,
+	749, 750, 751, 752, 753, 754, 755, 756, 757, 758,
+	759, 760, 761, 762, 763, 764, 765, 766, 767, 768,
+	769, 770, 771, 772, 773, 774, 775, 776, 777, 778,
+	779, 780, 781, 782, 783, 784, 785, 786, 787, 788,
+	789, 790, 791, 792, 793, 794, 795, 796, 797, 798,
+	799, 800, 801, 802, 803, 804, 805, 806, 807, 808,
+	809, 810, 811, 812, 813, 814, 815, 816, 817, 818,
+	819, 820, 821, 822, 823, 824, 825, 826, 827, 0,
+	158, 0, 0, 947, 0, 148, 0, 0, 0, 0,
+	0, 947, 0, 0, 0, 0, 0, 0, 0, 311,
+	0, 313, 947, 947, 947, 947, 947, 947, 947, 947,
+	322, 948, 949, 323, 324, 325, 947, 947, 327, 0,
+	0, 343, 0, 337, 364, 0, 0, 0, 0, 0,
+	92, 0, 0, 0, 0, 106, 0, 107, 945, 101,
+	0, 0, 631, 0, 623, 624, 627, 630, 106, 385,
+	0, 390, 389, 381, 0, 397, 0, 0, 0, 401,
+	0, 407, 403, 404, 405, 406, 0, 462, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 487, 488, 489,
+	490, 491, 492, 493, 465, 0, 480, 0, 0, 0,
+	612, 613, 522, 523, 524, 525, 526, 527, 392, 106,
+	0, 500, 0, -2, 0, 0, 0, 0, 0, 0,
+	0, 0, 388, 0, 590, 0, 554, 0, 555, 556,
+	557, 558, 559, 560, 561, 0, 392, 0, 0, 124,
+	0, 450, 0, 0, 0, 0, 0, 0, 439, 0,
+	0, 442, 0, 0, 0, 0, 434, 0, 0, 453,
+	890, 436, 0, 438, -2, 0, 0, 0, 120, 121,
+	0, 127, 922, 129, 130, 0, 0, 0, 242, 674,
+	675, 676, 941, 942, 943, 672, 271, 0, 225, 221,
+	165, 166, 167, 168, 214, 171, 214, 214, 214, 214,
+	239, 239, 239, 239, 197, 198, 199, 200, 201, 0,
+	0, 184, 214, 214, 214, 188, 204, 205, 206, 207,
+	208, 209, 210, 211, 169, 172, 173, 174, 175, 176,
+	177, 178, 216, 216, 216, 218, 218, 703, 143, 0,
+	151, 0, 947, 0, 947, 156, 0, 287, 0, 306,
+	680, 0, 947, 309, 310, 452, 714, 715, 314, 315,
+	316, 317, 318, 319, 320, 321, 326, 330, 328, 344,
+	338, 339, 332, 365, 368, 369, 370, 371, 372, 373,
+	366, 0, 162, 375, 376, 94, 0, 0, 643, 0,
+	0, 0, 419, 0, 0, 64, 65, 635, 0, 0,
+	0, 0, 0, 626, 628, 629, 634, 109, 388, 0,
+	615, 0, 0, 0, 391, 104, 460, 461, 463, 481,
+	0, 483, 485, 402, 398, 0, 599, -2, 470, 0,
+	472, 496, 497, 498, 0, 0, 0, 0, 494, 476,
+	0, 507, 508, 509, 510, 511, 512, 513, 514, 515,
+	516, 517, 518, 521, 573, 574, 0, 519, 601, 602,
+	520, 614, 0, 0, 393, 394, 499, 0, 658, 106,
+	535, 716, 717, 718, 719, 720, 721, 722, 723, 724,
+	725, 726, 727, 728, 729, 730, 731, 732, 733, 734,
+	735, 0, 0, 0, 0, 0, 598, 0, 0, 0,
+	0, 596, 593, 0, 0, 563, 0, 0, 0, 0,
+	0, 0, 0, 449, 457, 663, 0, 411, 429, 431,
+	0, 426, 440, 441, 443, 0, 445, 0, 447, 448,
+	415, 416, 417, 0, 0, 0, 0, 437, 457, 0,
+	457, 123, 667, 128, 0, 0, 133, 134, 668, 669,
+	670, 0, 157, 272, 274, 277, 278, 279, 159, 160,
+	0, 0, 0, 0, 0, 266, 267, 228, 226, 0,
+	223, 222, 170, 0, 239, 239, 191, 192, 242, 0,
+	242, 242, 242, 0, 0, 185, 186, 187, 179, 0,
+	180, 181, 182, 0, 183, 0, 0, 947, 145, 0,
+	149, 150, 146, 682, 147, 946, 0, 0, 695, 288,
+	685, 686, 687, 688, 689, 690, 691, 692, 693, 694,
+	0, 305, 947, 308, 347, 0, 0, 0, 0, 0,
+	0, -2, 98, 99, 100, 0, 0, 0, 36, 36,
+	35, 0, 0, 68, 66, 67, 0, 632, 633, 0,
+	625, 102, 0, 677, 678, 616, 617, 408, 482, 484,
+	486, 0, 392, 0, 473, 494, 477, 0, 474, 0,
+	0, 467, 536, 0, 0, 501, -2, 539, 540, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 622, 0,
+	594, 0, 0, 553, 564, 565, 566, 567, 647, 0,
+	0, -2, 0, 113, 0, 622, 0, 0, 0, 423,
+	430, 0, 0, 424, 0, 425, 444, 446, 0, 0,
+	0, 0, 421, 622, 457, 119, 131, 132, 0, 0,
+	138, 243, 244, 0, 275, 0, 0, 261, 0, 0,
+	264, 265, 235, 0, 227, 164, 224, 0, 242, 242,
+	193, 0, 194, 195, 196, 0, 212, 0, 0, 0,
+	0, 704, 144, 152, 153, 0, 280, 946, 0, 289,
+	290, 291, 292, 293, 294, 295, 296, 297, 298, 299,
+	946, 0, 0, 946, 696, 697, 698, 699, 0, 307,
+	329, 0, 0, 345, 346, 163, 0, 377, 95, 0,
+	645, 644, 31, 0, 0, 39, 55, 57, -2, 433,
+	433, 32, 420, 36, 0, 69, 70, 636, 0, 103,
+	457, 0, 399, 600, 0, 0, 475, 0, 495, 478,
+	537, 395, 0, 214, 214, 578, 214, 218, 581, 214,
+	583, 214, 586, 0, 0, 0, 0, 0, 599, 0,
+	0, 0, 591, 552, 597, 0, 660, 660, 0, 647,
+	637, 654, 656, 0, 106, 0, 0, 630, 664, 458,
+	665, 427, 0, 432, 0, 0, 0, 435, 0, 630,
+	118, 135, 136, 137, 273, 276, 0, 268, 214, 262,
+	263, 237, 0, 229, 230, 231, 232, 233, 234, 215,
+	189, 190, 240, 241, 239, 0, 239, 0, 219, 0,
+	947, 0, 0, 281, 0, 282, 284, 285, 286, 0,
+	348, 349, 367, 0, 0, 0, 41, 43, 0, 0,
+	0, 40, 56, 0, 0, 0, 33, 0, 618, 409,
+	538, 471, 479, 541, 575, 239, 579, 580, 582, 584,
+	585, 587, 0, 543, 542, 544, 0, 0, 547, 0,
+	0, 0, 0, 0, 595, 110, 0, 111, 0, 651,
+	112, 0, 0, 657, -2, 0, 125, 116, 0, 0,
+	0, 0, 453, 422, 117, 253, 0, 270, 245, 238,
+	0, 242, 213, 242, 0, 0, 142, 154, 155, 0,
+	0, 0, 646, 37, 0, 44, 47, 48, 49, 50,
+	51, 0, 53, 54, 45, 46, 0, 59, 60, 61,
+	0, 620, 0, 576, 577, 0, 0, 0, 0, 0,
+	568, 551, 592, 661, 662, 0, 0, 0, 0, 655,
+	0, -2, 0, 428, 454, 455, 456, 418, 252, 254,
+	0, 259, 0, 269, 250, 0, 247, 249, 236, 202,
+	203, 217, 220, 0, 0, 96, 42, 0, 38, 124,
+	105, 0, 0, 588, 545, 546, 548, 549, 0, 0,
+	0, 0, 0, 0, 0, 640, 106, 255, 256, 0,
+	260, 258, 161, 0, 246, 248, 148, 0, 301, 0,
+	52, 239, 621, 619, 550, 0, 0, 0, 648, 649,
+	0, 457, 653, -2, 257, 251, 151, 300, 0, 0,
+	71, 569, 0, 572, 0, 652, 283, 302, 0, 78,
+	73, 570, 457, 0, 83, 80, 72, 0, 650, 0,
+	86, 0, 79, 0, 0, 0, 0, 0, 0, 89,
+	0, 0, 0, 0, 0, 0, 0, 0, 571, 0,
+	0, 62, 0, 87, 0, 84, 85, 0, 0, 74,
+	75, 0, 77, 303, 304, 90, 88, 81, 82, 76,
 }
 var yyTok1 = [...]int{
 
@@ -2573,7 +2958,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 98, 3, 3, 3, 125, 117, 3,
 	53, 55, 122, 120, 54, 121, 133, 123, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 277,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 293,
 	106, 105, 107, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -2612,7 +2997,10 @@ var yyTok2 = [...]int{
 	269, 270, 271, 272, 273, 274,
 }
 var yyTok3 = [...]int{
-	57600, 275, 57601, 276, 0,
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -2954,411 +3342,911 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:325
+//line sql.y:360
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:330
+//line sql.y:365
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:331
+//line sql.y:366
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:335
+//line sql.y:370
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 25:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:361
-		{
-			sel := yyDollar[1].selStmt.(*Select)
-			sel.OrderBy = yyDollar[2].orderBy
-			sel.Limit = yyDollar[3].limit
-			sel.Lock = yyDollar[4].str
-			yyVAL.selStmt = sel
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:394
+		{
+			switch sel := yyDollar[2].selStmt.(type) {
+			case *Select:
+				sel.With = yyDollar[1].with
+			case *Union:
+				sel.With = yyDollar[1].with
+			default:
+				yylex.Error("unsupported statement after WITH clause")
+				return 1
+			}
+			yyVAL.statement = yyDollar[2].selStmt
 		}
 	case 26:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:369
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:407
 		{
-			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+			yyDollar[2].statement.(*Insert).With = yyDollar[1].with
+			yyVAL.statement = yyDollar[2].statement
 		}
 	case 27:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:373
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:412
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+			yyDollar[2].statement.(*Update).With = yyDollar[1].with
+			yyVAL.statement = yyDollar[2].statement
 		}
 	case 28:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:379
-		{
-			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
-		}
-	case 29:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:386
-		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
-		}
-	case 30:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:392
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:417
 		{
-			yyVAL.selStmt = yyDollar[1].selStmt
+			yyDollar[2].statement.(*Delete).With = yyDollar[1].with
+			yyVAL.statement = yyDollar[2].statement
 		}
 	case 31:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:396
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:426
 		{
-			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+			yyVAL.statement = &Copy{Table: yyDollar[2].tableName, Columns: yyDollar[3].columns, Direction: CopyFromStdinStr, Options: yyDollar[6].copyOptions}
 		}
 	case 32:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:402
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:430
 		{
-			yyVAL.selStmt = yyDollar[1].selStmt
+			yyVAL.statement = &Copy{Table: yyDollar[2].tableName, Columns: yyDollar[3].columns, Direction: CopyToStdoutStr, Options: yyDollar[6].copyOptions}
 		}
 	case 33:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:406
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:434
 		{
-			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+			yyVAL.statement = &Copy{Query: yyDollar[3].selStmt, Direction: CopyToStdoutStr, Options: yyDollar[7].copyOptions}
 		}
 	case 34:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:413
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:439
 		{
-			// insert_data returns a *Insert pre-filled with Columns & Values
-			ins := yyDollar[6].ins
-			ins.Action = yyDollar[1].str
-			ins.Comments = yyDollar[2].bytes2
-			ins.Ignore = yyDollar[3].str
-			ins.Table = yyDollar[4].tableName
-			ins.Partitions = yyDollar[5].partitions
-			ins.OnDup = OnDup(yyDollar[7].updateExprs)
-			ins.Returning = yyDollar[8].returning
-			yyVAL.statement = ins
+			yyVAL.columns = nil
 		}
 	case 35:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:426
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:443
 		{
-			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
-			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
-			for _, updateList := range yyDollar[7].updateExprs {
-				cols = append(cols, updateList.Name.Name)
-				vals = append(vals, updateList.Expr)
-			}
-			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
+			yyVAL.columns = yyDollar[2].columns
 		}
 	case 36:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:436
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:448
 		{
-			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Default: true}
+			yyVAL.copyOptions = nil
 		}
 	case 37:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:442
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:452
 		{
-			yyVAL.str = InsertStr
+			yyVAL.copyOptions = yyDollar[2].copyOptions
 		}
 	case 38:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:446
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:456
 		{
-			yyVAL.str = ReplaceStr
+			yyVAL.copyOptions = yyDollar[3].copyOptions
 		}
 	case 39:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:452
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:460
 		{
-			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+			yyVAL.copyOptions = yyDollar[1].copyOptions
 		}
 	case 40:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:458
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:464
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+			yyVAL.copyOptions = yyDollar[2].copyOptions
 		}
 	case 41:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:462
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:470
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+			yyVAL.copyOptions = CopyOptions{yyDollar[1].copyOption}
 		}
 	case 42:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:466
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:474
 		{
-			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+			yyVAL.copyOptions = append(yyDollar[1].copyOptions, yyDollar[3].copyOption)
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:471
+//line sql.y:480
 		{
+			yyVAL.copyOption = &CopyOption{Name: yyDollar[1].colIdent}
 		}
 	case 44:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:472
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:484
 		{
+			yyVAL.copyOption = &CopyOption{Name: yyDollar[1].colIdent, Value: yyDollar[2].expr}
 		}
 	case 45:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:476
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:488
 		{
-			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+			yyVAL.copyOption = &CopyOption{Name: NewColIdent(CopyNullOption), Value: yyDollar[2].expr}
 		}
 	case 46:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:480
-		{
-			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
-		}
-	case 47:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:485
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:492
 		{
-			yyVAL.partitions = nil
+			yyVAL.copyOption = &CopyOption{Name: NewColIdent(CopyEscapeOption), Value: yyDollar[2].expr}
 		}
 	case 48:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:489
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:499
 		{
-			yyVAL.partitions = yyDollar[3].partitions
+			yyVAL.expr = &ColName{Name: yyDollar[1].colIdent}
 		}
 	case 49:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:495
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:503
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
 	case 50:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:499
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:507
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
 	case 51:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:503
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:511
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
+			yyVAL.expr = yyDollar[1].boolVal
 		}
 	case 52:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:507
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:515
 		{
-			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
+			tuple := make(ValTuple, 0, len(yyDollar[2].columns))
+			for _, column := range yyDollar[2].columns {
+				tuple = append(tuple, &ColName{Name: column})
+			}
+			yyVAL.expr = tuple
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:513
+//line sql.y:525
 		{
-			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
 	case 54:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:517
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:529
 		{
-			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
+			yyVAL.expr = NewPgEscapeString(yyDollar[1].bytes)
 		}
 	case 55:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:523
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:536
 		{
-			yyVAL.setExpr = yyDollar[3].setExpr
+			yyVAL.copyOptions = CopyOptions{yyDollar[1].copyOption}
 		}
 	case 56:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:527
+//line sql.y:540
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
+			yyVAL.copyOptions = append(yyDollar[1].copyOptions, yyDollar[2].copyOption)
 		}
 	case 57:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:531
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:546
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
+			yyVAL.copyOption = &CopyOption{Name: NewColIdent(CopyFormatOption), Value: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}}
 		}
 	case 58:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:537
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:550
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
+			name := NewColIdent(string(yyDollar[1].bytes))
+			if name.Lowered() == "csv" {
+				yyVAL.copyOption = &CopyOption{Name: NewColIdent(CopyFormatOption), Value: &ColName{Name: name}}
+			} else {
+				yyVAL.copyOption = &CopyOption{Name: name}
+			}
 		}
 	case 59:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:541
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:559
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
+			yyVAL.copyOption = &CopyOption{Name: NewColIdent(string(yyDollar[1].bytes)), Value: yyDollar[3].expr}
 		}
 	case 60:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:545
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:563
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
+			yyVAL.copyOption = &CopyOption{Name: NewColIdent(CopyNullOption), Value: yyDollar[3].expr}
 		}
 	case 61:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:549
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:567
 		{
-			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
+			yyVAL.copyOption = &CopyOption{Name: NewColIdent(CopyEscapeOption), Value: yyDollar[3].expr}
 		}
 	case 62:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:555
+		yyDollar = yyS[yypt-16 : yypt+1]
+//line sql.y:573
 		{
-			yyVAL.str = SessionStr
+			if !NewColIdent(string(yyDollar[2].bytes)).EqualString("data") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			loadData := yyDollar[3].loadData
+			loadData.Infile = yyDollar[5].optVal
+			loadData.Duplicate = yyDollar[6].str
+			loadData.Table = yyDollar[9].tableName
+			loadData.Partitions = yyDollar[10].partitions
+			loadData.Charset = yyDollar[11].str
+			loadData.FieldsTerminatedBy = yyDollar[12].loadData.FieldsTerminatedBy
+			loadData.EnclosedBy = yyDollar[12].loadData.EnclosedBy
+			loadData.OptionallyEnclosed = yyDollar[12].loadData.OptionallyEnclosed
+			loadData.EscapedBy = yyDollar[12].loadData.EscapedBy
+			loadData.LinesStartingBy = yyDollar[13].loadData.LinesStartingBy
+			loadData.LinesTerminatedBy = yyDollar[13].loadData.LinesTerminatedBy
+			loadData.IgnoreLines = yyDollar[14].optVal
+			loadData.Columns = yyDollar[15].columns
+			loadData.SetExprs = yyDollar[16].updateExprs
+			yyVAL.statement = loadData
 		}
 	case 63:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:559
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:597
 		{
-			yyVAL.str = GlobalStr
+			yyVAL.loadData = &LoadData{}
 		}
 	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:565
+//line sql.y:601
 		{
-			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
-			yyVAL.statement = yyDollar[1].ddl
+			yyDollar[1].loadData.Priority = LowPriorityStr
+			yyVAL.loadData = yyDollar[1].loadData
 		}
 	case 65:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:570
-		{
-			// Change this to an alter statement
-			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:606
+		{
+			switch NewColIdent(string(yyDollar[2].bytes)).Lowered() {
+			case "local":
+				yyDollar[1].loadData.Local = true
+			case "concurrent":
+				yyDollar[1].loadData.Priority = ConcurrentStr
+			default:
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.loadData = yyDollar[1].loadData
 		}
 	case 66:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:575
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:621
 		{
-			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
+			yyVAL.optVal = NewStrVal(yyDollar[1].bytes)
 		}
 	case 67:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:579
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:625
 		{
-			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
+			yyVAL.optVal = NewStrVal(yyDollar[1].bytes)
 		}
 	case 68:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:583
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:630
 		{
-			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
-				Name:   yyDollar[3].colIdent,
-				Type:   yyDollar[4].colIdent,
-				Params: yyDollar[5].vindexParams,
-			}}
+			yyVAL.str = ""
 		}
 	case 69:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:591
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:634
 		{
-			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.str = LoadDataReplaceStr
 		}
 	case 70:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:595
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:638
 		{
-			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+			yyVAL.str = LoadDataIgnoreStr
 		}
 	case 71:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:600
+//line sql.y:643
 		{
-			yyVAL.colIdent = NewColIdent("")
+			yyVAL.loadData = &LoadData{}
 		}
 	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:604
+//line sql.y:647
 		{
-			yyVAL.colIdent = yyDollar[2].colIdent
+			name := NewColIdent(string(yyDollar[1].bytes))
+			if !name.EqualString("fields") && !name.EqualString("columns") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.loadData = yyDollar[2].loadData
 		}
 	case 73:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:610
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:657
 		{
-			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+			yyVAL.loadData = &LoadData{}
 		}
 	case 74:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:615
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:661
 		{
-			var v []VindexParam
-			yyVAL.vindexParams = v
+			yyDollar[1].loadData.FieldsTerminatedBy = yyDollar[4].optVal
+			yyVAL.loadData = yyDollar[1].loadData
 		}
 	case 75:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:620
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:666
 		{
-			yyVAL.vindexParams = yyDollar[2].vindexParams
+			yyDollar[1].loadData.EnclosedBy = yyDollar[4].optVal
+			yyVAL.loadData = yyDollar[1].loadData
 		}
 	case 76:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:626
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:671
 		{
-			yyVAL.vindexParams = make([]VindexParam, 0, 4)
-			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
+			yyDollar[1].loadData.EnclosedBy = yyDollar[5].optVal
+			yyDollar[1].loadData.OptionallyEnclosed = true
+			yyVAL.loadData = yyDollar[1].loadData
 		}
 	case 77:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:631
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:677
+		{
+			yyDollar[1].loadData.EscapedBy = yyDollar[4].optVal
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 78:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:683
+		{
+			yyVAL.loadData = &LoadData{}
+		}
+	case 79:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:687
+		{
+			yyVAL.loadData = yyDollar[2].loadData
+		}
+	case 80:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:692
+		{
+			yyVAL.loadData = &LoadData{}
+		}
+	case 81:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:696
+		{
+			yyDollar[1].loadData.LinesStartingBy = yyDollar[4].optVal
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 82:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:701
+		{
+			yyDollar[1].loadData.LinesTerminatedBy = yyDollar[4].optVal
+			yyVAL.loadData = yyDollar[1].loadData
+		}
+	case 83:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:707
+		{
+			yyVAL.optVal = nil
+		}
+	case 84:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:711
+		{
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 85:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:715
+		{
+			if !NewColIdent(string(yyDollar[3].bytes)).EqualString("rows") {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 86:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:724
+		{
+			yyVAL.columns = nil
+		}
+	case 87:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:728
+		{
+			yyVAL.columns = Columns{}
+		}
+	case 88:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:732
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 89:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:737
+		{
+			yyVAL.updateExprs = nil
+		}
+	case 90:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:741
+		{
+			yyVAL.updateExprs = yyDollar[2].updateExprs
+		}
+	case 91:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:747
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 92:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:751
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 93:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:757
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 94:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:761
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 95:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:767
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Statement: yyDollar[4].statement}
+		}
+	case 96:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:771
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Statement: yyDollar[7].statement}
+		}
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:778
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 101:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:787
+		{
+			sel := yyDollar[1].selStmt.(*Select)
+			sel.OrderBy = yyDollar[2].orderBy
+			sel.Limit = yyDollar[3].limit
+			sel.Lock = yyDollar[4].str
+			yyVAL.selStmt = sel
+		}
+	case 102:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:795
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
+		}
+	case 103:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:799
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+		}
+	case 104:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:805
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 105:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:812
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+		}
+	case 106:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:818
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 107:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:822
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 108:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:828
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 109:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:832
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 110:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:839
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnDup = OnDup(yyDollar[7].updateExprs)
+			ins.Returning = yyDollar[8].returning
+			yyVAL.statement = ins
+		}
+	case 111:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:852
+		{
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnConflict = yyDollar[7].onConflict
+			ins.Returning = yyDollar[8].returning
+			yyVAL.statement = ins
+		}
+	case 112:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:864
+		{
+			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
+			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
+			for _, updateList := range yyDollar[7].updateExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
+		}
+	case 113:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:874
+		{
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Default: true}
+		}
+	case 114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:880
+		{
+			yyVAL.str = InsertStr
+		}
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:884
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 116:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:890
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 117:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:896
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 118:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:900
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+		}
+	case 119:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:904
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:909
+		{
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:910
+		{
+		}
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:914
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 123:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:918
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 124:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:923
+		{
+			yyVAL.partitions = nil
+		}
+	case 125:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:927
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 126:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:933
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
+		}
+	case 127:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:937
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
+		}
+	case 128:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:941
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
+		}
+	case 129:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:945
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:951
+		{
+			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
+		}
+	case 131:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:955
+		{
+			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
+		}
+	case 132:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:961
+		{
+			yyVAL.setExpr = yyDollar[3].setExpr
+		}
+	case 133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:965
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
+		}
+	case 134:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:969
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
+		}
+	case 135:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:975
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
+		}
+	case 136:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:979
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
+		}
+	case 137:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:983
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
+		}
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:987
+		{
+			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
+		}
+	case 139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:993
+		{
+			yyVAL.str = SessionStr
+		}
+	case 140:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:997
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 141:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1003
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 142:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1008
+		{
+			// Change this to an alter statement
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
+		}
+	case 143:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1013
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
+		}
+	case 144:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1017
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
+		}
+	case 145:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1021
+		{
+			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
+				Name:   yyDollar[3].colIdent,
+				Type:   yyDollar[4].colIdent,
+				Params: yyDollar[5].vindexParams,
+			}}
+		}
+	case 146:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1029
+		{
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 147:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1033
+		{
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
+		}
+	case 148:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1038
+		{
+			yyVAL.colIdent = NewColIdent("")
+		}
+	case 149:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1042
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 150:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1048
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 151:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1053
+		{
+			var v []VindexParam
+			yyVAL.vindexParams = v
+		}
+	case 152:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1058
+		{
+			yyVAL.vindexParams = yyDollar[2].vindexParams
+		}
+	case 153:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1064
+		{
+			yyVAL.vindexParams = make([]VindexParam, 0, 4)
+			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
+		}
+	case 154:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1069
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 78:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:637
+//line sql.y:1075
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 79:
+	case 156:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:643
+//line sql.y:1081
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 80:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:650
+//line sql.y:1088
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 81:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:657
+//line sql.y:1095
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 82:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:662
+//line sql.y:1100
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 83:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:666
+//line sql.y:1104
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 84:
+	case 161:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:672
+//line sql.y:1110
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -3368,735 +4256,735 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].optVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 85:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:684
+//line sql.y:1122
 		{
 			yyVAL.columnTypes = ColumnTypes{yyDollar[1].columnType}
 		}
-	case 86:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:688
+//line sql.y:1126
 		{
 			yyVAL.columnTypes = append(yyDollar[1].columnTypes, yyDollar[3].columnType)
 		}
-	case 87:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:694
+//line sql.y:1132
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 92:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:706
+//line sql.y:1144
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 93:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:713
+//line sql.y:1151
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].optVal
 		}
-	case 94:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:718
+//line sql.y:1156
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 95:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:724
+//line sql.y:1162
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 96:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:728
+//line sql.y:1166
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 97:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:732
+//line sql.y:1170
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:736
+//line sql.y:1174
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:740
+//line sql.y:1178
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:744
+//line sql.y:1182
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:748
+//line sql.y:1186
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:754
+//line sql.y:1192
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 103:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:760
+//line sql.y:1198
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 104:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:766
+//line sql.y:1204
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 105:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:772
+//line sql.y:1210
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 106:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:778
+//line sql.y:1216
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 107:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:786
+//line sql.y:1224
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 108:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:790
+//line sql.y:1228
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 109:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:794
+//line sql.y:1232
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 110:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:798
+//line sql.y:1236
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 111:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:802
+//line sql.y:1240
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 112:
+	case 189:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:808
+//line sql.y:1246
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 113:
+	case 190:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:812
+//line sql.y:1250
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 114:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:816
+//line sql.y:1254
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 115:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:820
+//line sql.y:1258
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 116:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:824
+//line sql.y:1262
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 117:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:828
+//line sql.y:1266
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 118:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:832
+//line sql.y:1270
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 119:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:836
+//line sql.y:1274
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 120:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:840
+//line sql.y:1278
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 121:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:844
+//line sql.y:1282
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 122:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:848
+//line sql.y:1286
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:852
+//line sql.y:1290
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 124:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:856
+//line sql.y:1294
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 125:
+	case 202:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:860
+//line sql.y:1298
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 126:
+	case 203:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:865
+//line sql.y:1303
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 127:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:871
+//line sql.y:1309
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 128:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:875
+//line sql.y:1313
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 129:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:879
+//line sql.y:1317
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:883
+//line sql.y:1321
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:887
+//line sql.y:1325
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:891
+//line sql.y:1329
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:895
+//line sql.y:1333
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:899
+//line sql.y:1337
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:905
+//line sql.y:1343
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 136:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:910
+//line sql.y:1348
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 137:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:915
+//line sql.y:1353
 		{
 			yyVAL.optVal = nil
 		}
-	case 138:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:919
+//line sql.y:1357
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 139:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:924
+//line sql.y:1362
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 140:
+	case 217:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:928
+//line sql.y:1366
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 141:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:936
+//line sql.y:1374
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 142:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:940
+//line sql.y:1378
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 143:
+	case 220:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:946
+//line sql.y:1384
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 144:
+	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:954
+//line sql.y:1392
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 145:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:958
+//line sql.y:1396
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 146:
+	case 223:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:963
+//line sql.y:1401
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 147:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:967
+//line sql.y:1405
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 148:
+	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:973
+//line sql.y:1411
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 149:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:977
+//line sql.y:1415
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 150:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:981
+//line sql.y:1419
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 151:
+	case 228:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:986
+//line sql.y:1424
 		{
 			yyVAL.optVal = nil
 		}
-	case 152:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:990
+//line sql.y:1428
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 153:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:994
+//line sql.y:1432
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 154:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:998
+//line sql.y:1436
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
 		}
-	case 155:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1002
+//line sql.y:1440
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 156:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1006
+//line sql.y:1444
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 157:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1010
+//line sql.y:1448
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[2].bytes)
 		}
-	case 158:
+	case 235:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1015
+//line sql.y:1453
 		{
 			yyVAL.optVal = nil
 		}
-	case 159:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1019
+//line sql.y:1457
 		{
 			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
 		}
-	case 160:
+	case 237:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1024
+//line sql.y:1462
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 161:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1028
+//line sql.y:1466
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 162:
+	case 239:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1033
+//line sql.y:1471
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 240:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1037
+//line sql.y:1475
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 164:
+	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1041
+//line sql.y:1479
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 165:
+	case 242:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1046
+//line sql.y:1484
 		{
 			yyVAL.str = ""
 		}
-	case 166:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1050
+//line sql.y:1488
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 167:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1054
+//line sql.y:1492
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 168:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1059
+//line sql.y:1497
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 169:
+	case 246:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1063
+//line sql.y:1501
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 170:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1067
+//line sql.y:1505
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 171:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1071
+//line sql.y:1509
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 172:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1075
+//line sql.y:1513
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 173:
+	case 250:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1080
+//line sql.y:1518
 		{
 			yyVAL.optVal = nil
 		}
-	case 174:
+	case 251:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1084
+//line sql.y:1522
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 175:
+	case 252:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1090
+//line sql.y:1528
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 176:
+	case 253:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1094
+//line sql.y:1532
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 177:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1100
+//line sql.y:1538
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 178:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1104
+//line sql.y:1542
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 179:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1110
+//line sql.y:1548
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 180:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1114
+//line sql.y:1552
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 181:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1119
+//line sql.y:1557
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 182:
+	case 259:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1125
+//line sql.y:1563
 		{
 			yyVAL.str = ""
 		}
-	case 183:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1129
+//line sql.y:1567
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 184:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1135
+//line sql.y:1573
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 185:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1139
+//line sql.y:1577
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
 		}
-	case 186:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1143
+//line sql.y:1581
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
-	case 187:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1147
+//line sql.y:1585
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 188:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1151
+//line sql.y:1589
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
 		}
-	case 189:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1157
+//line sql.y:1595
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1161
+//line sql.y:1599
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 191:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1167
+//line sql.y:1605
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 192:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1171
+//line sql.y:1609
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 193:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1177
+//line sql.y:1615
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
 		}
-	case 194:
+	case 271:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1182
+//line sql.y:1620
 		{
 			yyVAL.str = ""
 		}
-	case 195:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1624
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 196:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1190
+//line sql.y:1628
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 197:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1636
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 198:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1202
+//line sql.y:1640
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 199:
+	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1206
+//line sql.y:1644
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 200:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1212
+//line sql.y:1650
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 
 		}
-	case 201:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1217
+//line sql.y:1655
 		{
 			yyVAL.str = defaultDialect.QuoteHandler().WrapStringLiteral(string(yyDollar[1].bytes))
 		}
-	case 202:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1221
+//line sql.y:1659
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 203:
+	case 280:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1227
+//line sql.y:1665
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 204:
+	case 281:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1231
+//line sql.y:1669
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 205:
+	case 282:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1235
+//line sql.y:1673
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 206:
+	case 283:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1239
+//line sql.y:1677
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4109,9 +4997,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 207:
+	case 284:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1252
+//line sql.y:1690
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4121,71 +5009,71 @@ yydefault:
 				},
 			}
 		}
-	case 208:
+	case 285:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1262
+//line sql.y:1700
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 209:
+	case 286:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1267
+//line sql.y:1705
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 210:
+	case 287:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1272
+//line sql.y:1710
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 211:
+	case 288:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1276
+//line sql.y:1714
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 223:
+	case 300:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1295
+//line sql.y:1733
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 224:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1301
+//line sql.y:1739
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 225:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1305
+//line sql.y:1743
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 226:
+	case 303:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1311
+//line sql.y:1749
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 227:
+	case 304:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1315
+//line sql.y:1753
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 228:
+	case 305:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1321
+//line sql.y:1759
 		{
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 229:
+	case 306:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1327
+//line sql.y:1765
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4193,16 +5081,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
 		}
-	case 230:
+	case 307:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1335
+//line sql.y:1773
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 231:
+	case 308:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1340
+//line sql.y:1778
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -4210,129 +5098,129 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
 		}
-	case 232:
+	case 309:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1348
+//line sql.y:1786
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 233:
+	case 310:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1352
+//line sql.y:1790
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 234:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1358
+//line sql.y:1796
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 235:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1362
+//line sql.y:1800
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 236:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1367
+//line sql.y:1805
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
 		}
-	case 237:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1373
+//line sql.y:1811
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 238:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1377
+//line sql.y:1815
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 239:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1381
+//line sql.y:1819
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 240:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1386
+//line sql.y:1824
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 241:
+	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1390
+//line sql.y:1828
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 242:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1394
+//line sql.y:1832
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 243:
+	case 320:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1398
+//line sql.y:1836
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 244:
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1402
+//line sql.y:1840
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 245:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1406
+//line sql.y:1844
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 246:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1410
+//line sql.y:1848
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 247:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1414
+//line sql.y:1852
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 248:
+	case 325:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1418
+//line sql.y:1856
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 249:
+	case 326:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1422
+//line sql.y:1860
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 250:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1426
+//line sql.y:1864
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 251:
+	case 328:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1430
+//line sql.y:1868
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 252:
+	case 329:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1434
+//line sql.y:1872
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[4].str == "processlist" {
@@ -4342,267 +5230,267 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[4].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 253:
+	case 330:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1444
+//line sql.y:1882
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 254:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1448
+//line sql.y:1886
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 255:
+	case 332:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1452
+//line sql.y:1890
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
 		}
-	case 256:
+	case 333:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1456
+//line sql.y:1894
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 257:
+	case 334:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1460
+//line sql.y:1898
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 258:
+	case 335:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1464
+//line sql.y:1902
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 259:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1468
+//line sql.y:1906
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 260:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1478
+//line sql.y:1916
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 261:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1484
+//line sql.y:1922
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 262:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1488
+//line sql.y:1926
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 263:
+	case 340:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1494
+//line sql.y:1932
 		{
 			yyVAL.str = ""
 		}
-	case 264:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1498
+//line sql.y:1936
 		{
 			yyVAL.str = "extended "
 		}
-	case 265:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1504
+//line sql.y:1942
 		{
 			yyVAL.str = ""
 		}
-	case 266:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1508
+//line sql.y:1946
 		{
 			yyVAL.str = "full "
 		}
-	case 267:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1514
+//line sql.y:1952
 		{
 			yyVAL.str = ""
 		}
-	case 268:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1518
+//line sql.y:1956
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 269:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1522
+//line sql.y:1960
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 270:
+	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1528
+//line sql.y:1966
 		{
 			yyVAL.showFilter = nil
 		}
-	case 271:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1532
+//line sql.y:1970
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1536
+//line sql.y:1974
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 273:
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1542
+//line sql.y:1980
 		{
 			yyVAL.str = ""
 		}
-	case 274:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1546
+//line sql.y:1984
 		{
 			yyVAL.str = SessionStr
 		}
-	case 275:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1550
+//line sql.y:1988
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 276:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1556
+//line sql.y:1994
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 277:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1560
+//line sql.y:1998
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 278:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1566
+//line sql.y:2004
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 279:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1570
+//line sql.y:2008
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 280:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1576
+//line sql.y:2014
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 281:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1582
+//line sql.y:2020
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 282:
+	case 359:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1588
+//line sql.y:2026
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 283:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:2030
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 284:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1596
+//line sql.y:2034
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 285:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1600
+//line sql.y:2038
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 286:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1604
+//line sql.y:2042
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 287:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1610
+//line sql.y:2048
 		{
 			yyVAL.statement = &DeallocatePrepare{PreparedStatementName: yyDollar[3].tableIdent}
 		}
-	case 288:
+	case 365:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1616
+//line sql.y:2054
 		{
 			yyVAL.statement = &Prepare{PreparedStatementName: yyDollar[2].tableIdent, PreparedStatementQuery: yyDollar[4].preparedQuery}
 		}
-	case 289:
+	case 366:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1620
+//line sql.y:2058
 		{
 			yyVAL.statement = &Prepare{PreparedStatementName: yyDollar[2].tableIdent, PreparedStatementQuery: yyDollar[4].preparedQuery}
 		}
-	case 290:
+	case 367:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1624
+//line sql.y:2062
 		{
 			yyVAL.statement = &Prepare{PreparedStatementName: yyDollar[2].tableIdent, ColumnTypes: yyDollar[4].columnTypes, PreparedStatementQuery: yyDollar[7].preparedQuery}
 		}
-	case 291:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1630
+//line sql.y:2068
 		{
 			yyVAL.preparedQuery = yyDollar[1].selStmt.(*Select)
 		}
-	case 292:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1634
+//line sql.y:2072
 		{
 			yyVAL.preparedQuery = yyDollar[1].statement.(*Insert)
 		}
-	case 293:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1638
+//line sql.y:2076
 		{
 			yyVAL.preparedQuery = yyDollar[1].statement.(*Delete)
 		}
-	case 294:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1642
+//line sql.y:2080
 		{
 			yyVAL.preparedQuery = yyDollar[1].statement.(*Update)
 		}
-	case 295:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1646
+//line sql.y:2084
 		{
 			yyVAL.preparedQuery = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 296:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1650
+//line sql.y:2088
 		{
 			statement, err := NewPreparedQueryFromString(string(yyDollar[1].bytes))
 			if statement == nil {
@@ -4615,424 +5503,424 @@ yydefault:
 			}
 			yyVAL.preparedQuery = statement
 		}
-	case 297:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1665
+//line sql.y:2103
 		{
 			yyVAL.statement = &Execute{PreparedStatementName: NewTableIdent(string(yyDollar[2].bytes))}
 		}
-	case 298:
+	case 375:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1669
+//line sql.y:2107
 		{
 			yyVAL.statement = &Execute{PreparedStatementName: NewTableIdent(string(yyDollar[2].bytes)), Using: yyDollar[4].usingInExecuteList}
 		}
-	case 299:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1675
+//line sql.y:2113
 		{
 			yyVAL.usingInExecuteList = UsingInExecuteList{yyDollar[1].tableIdent}
 		}
-	case 300:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1679
+//line sql.y:2117
 		{
 			yyVAL.usingInExecuteList = append(yyDollar[1].usingInExecuteList, yyDollar[3].tableIdent)
 		}
-	case 301:
+	case 378:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1684
+//line sql.y:2122
 		{
 			setAllowComments(yylex, true)
 		}
-	case 302:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1688
+//line sql.y:2126
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 303:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1694
+//line sql.y:2132
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 304:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1698
+//line sql.y:2136
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 305:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1704
+//line sql.y:2142
 		{
 			yyVAL.str = UnionStr
 		}
-	case 306:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1708
+//line sql.y:2146
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 307:
+	case 384:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1712
+//line sql.y:2150
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 308:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1717
+//line sql.y:2155
 		{
 			yyVAL.str = ""
 		}
-	case 309:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1721
+//line sql.y:2159
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 310:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1725
+//line sql.y:2163
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 311:
+	case 388:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1730
+//line sql.y:2168
 		{
 			yyVAL.str = ""
 		}
-	case 312:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1734
+//line sql.y:2172
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 313:
+	case 390:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1739
+//line sql.y:2177
 		{
 			yyVAL.str = ""
 		}
-	case 314:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1743
+//line sql.y:2181
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 315:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1748
+//line sql.y:2186
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 316:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1752
+//line sql.y:2190
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 317:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1758
+//line sql.y:2196
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 318:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1762
+//line sql.y:2200
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 319:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1768
+//line sql.y:2206
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 320:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1772
+//line sql.y:2210
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 321:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1776
+//line sql.y:2214
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 322:
+	case 399:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1780
+//line sql.y:2218
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 323:
+	case 400:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1785
+//line sql.y:2223
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 324:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1789
+//line sql.y:2227
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 325:
+	case 402:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1793
+//line sql.y:2231
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 326:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1799
+//line sql.y:2237
 		{
 			yyVAL.colIdent = NewColIdentWithQuotes(string(yyDollar[1].bytes), '\'')
 		}
-	case 327:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1803
+//line sql.y:2241
 		{
 			yyVAL.colIdent = NewColIdentWithQuotes(string(yyDollar[1].bytes), '"')
 		}
-	case 328:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1807
+//line sql.y:2245
 		{
 			yyVAL.colIdent = NewColIdentWithQuotes(string(yyDollar[1].bytes), '`')
 		}
-	case 331:
+	case 408:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1819
+//line sql.y:2257
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 332:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1823
+//line sql.y:2261
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 333:
+	case 410:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1829
+//line sql.y:2267
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 334:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1833
+//line sql.y:2271
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 337:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1843
+//line sql.y:2281
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 338:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1847
+//line sql.y:2285
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 339:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1851
+//line sql.y:2289
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 340:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1857
+//line sql.y:2295
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 341:
+	case 418:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1861
+//line sql.y:2299
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 342:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1867
+//line sql.y:2305
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 343:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1871
+//line sql.y:2309
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 344:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1877
+//line sql.y:2315
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 345:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1881
+//line sql.y:2319
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 346:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1894
+//line sql.y:2332
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 347:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1898
+//line sql.y:2336
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 348:
+	case 425:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1902
+//line sql.y:2340
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 349:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1906
+//line sql.y:2344
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 350:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1912
+//line sql.y:2350
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 351:
+	case 428:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1914
+//line sql.y:2352
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 352:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1918
+//line sql.y:2356
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 353:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1920
+//line sql.y:2358
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 354:
+	case 431:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1924
+//line sql.y:2362
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 355:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1926
+//line sql.y:2364
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 356:
+	case 433:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1929
+//line sql.y:2367
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 357:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1931
+//line sql.y:2369
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 358:
+	case 435:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1934
+//line sql.y:2372
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 359:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1938
+//line sql.y:2376
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 360:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1942
+//line sql.y:2380
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 362:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1952
+//line sql.y:2390
 		{
 			yyVAL.str = JoinStr
 		}
-	case 363:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1956
+//line sql.y:2394
 		{
 			yyVAL.str = JoinStr
 		}
-	case 364:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1960
+//line sql.y:2398
 		{
 			yyVAL.str = JoinStr
 		}
-	case 365:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1966
+//line sql.y:2404
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 366:
+	case 443:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1972
+//line sql.y:2410
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 367:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1976
+//line sql.y:2414
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 368:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1980
+//line sql.y:2418
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 369:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1984
+//line sql.y:2422
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 370:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1990
+//line sql.y:2428
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 371:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1994
+//line sql.y:2432
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5040,453 +5928,460 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 372:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2004
+//line sql.y:2442
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 373:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2008
+//line sql.y:2446
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 374:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2014
+//line sql.y:2452
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 375:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2018
+//line sql.y:2456
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 376:
+	case 453:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2023
+//line sql.y:2461
 		{
 			yyVAL.indexHints = nil
 		}
-	case 377:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2027
+//line sql.y:2465
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 378:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2031
+//line sql.y:2469
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 379:
+	case 456:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2035
+//line sql.y:2473
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 380:
+	case 457:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2040
+//line sql.y:2478
 		{
 			yyVAL.expr = nil
 		}
-	case 381:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2044
+//line sql.y:2482
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 382:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2050
+//line sql.y:2488
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 383:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2054
+//line sql.y:2492
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 384:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2058
+//line sql.y:2496
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 385:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2062
+//line sql.y:2500
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 386:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2066
+//line sql.y:2504
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 387:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2070
+//line sql.y:2508
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 388:
+	case 465:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2074
+//line sql.y:2512
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 389:
+	case 466:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2080
+//line sql.y:2518
 		{
 			yyVAL.str = ""
 		}
-	case 390:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2084
+//line sql.y:2522
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 391:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2090
+//line sql.y:2528
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 392:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2094
+//line sql.y:2532
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 393:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2100
+//line sql.y:2538
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 394:
+	case 471:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2542
+		{
+			// PostgreSQL's array comparison, ANY/SOME are parsed as regular function calls
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: &FuncExpr{Name: NewColIdent("all"), Exprs: SelectExprs{&AliasedExpr{Expr: yyDollar[5].expr}}}}
+		}
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2104
+//line sql.y:2547
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 395:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2108
+//line sql.y:2551
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 396:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2112
+//line sql.y:2555
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 397:
+	case 475:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2116
+//line sql.y:2559
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 398:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2120
+//line sql.y:2563
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 399:
+	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2124
+//line sql.y:2567
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 400:
+	case 478:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2128
+//line sql.y:2571
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 401:
+	case 479:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2132
+//line sql.y:2575
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 402:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2136
+//line sql.y:2579
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 403:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2142
+//line sql.y:2585
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 404:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2146
+//line sql.y:2589
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 405:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2150
+//line sql.y:2593
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 406:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2154
+//line sql.y:2597
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 407:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2158
+//line sql.y:2601
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 408:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2162
+//line sql.y:2605
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 409:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2168
+//line sql.y:2611
 		{
 			yyVAL.str = EqualStr
 		}
-	case 410:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2172
+//line sql.y:2615
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 411:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2176
+//line sql.y:2619
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 412:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2180
+//line sql.y:2623
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 413:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2184
+//line sql.y:2627
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 414:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2188
+//line sql.y:2631
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 415:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2192
+//line sql.y:2635
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 416:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2197
+//line sql.y:2640
 		{
 			yyVAL.expr = nil
 		}
-	case 417:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2201
+//line sql.y:2644
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 418:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2207
+//line sql.y:2650
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 419:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2211
+//line sql.y:2654
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 420:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2215
+//line sql.y:2658
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 421:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2221
+//line sql.y:2664
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 422:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2227
+//line sql.y:2670
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 423:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2231
+//line sql.y:2674
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 424:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2238
+//line sql.y:2681
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 425:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2242
+//line sql.y:2685
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 426:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2246
+//line sql.y:2689
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 427:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2250
+//line sql.y:2693
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 428:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2254
+//line sql.y:2697
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 429:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2258
+//line sql.y:2701
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 430:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2262
+//line sql.y:2705
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 431:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2266
+//line sql.y:2709
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 432:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2270
+//line sql.y:2713
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 433:
+	case 511:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2274
+//line sql.y:2717
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 434:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2278
+//line sql.y:2721
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 435:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2282
+//line sql.y:2725
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 436:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2286
+//line sql.y:2729
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 437:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2290
+//line sql.y:2733
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 438:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2294
+//line sql.y:2737
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 439:
+	case 517:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2298
+//line sql.y:2741
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 440:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2302
+//line sql.y:2745
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 441:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2306
+//line sql.y:2749
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 442:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2310
+//line sql.y:2753
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 443:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2314
+//line sql.y:2757
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 444:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2318
+//line sql.y:2761
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 445:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2322
+//line sql.y:2765
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 446:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2326
+//line sql.y:2769
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -5494,9 +6389,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 447:
+	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2334
+//line sql.y:2777
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -5510,33 +6405,33 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 448:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2348
+//line sql.y:2791
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 449:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2352
+//line sql.y:2795
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 450:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2356
+//line sql.y:2799
 		{
 			yyVAL.expr = yyDollar[1].intervalExpr
 		}
-	case 451:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2360
+//line sql.y:2803
 		{
 			yyVAL.expr = yyDollar[1].intervalExpr
 		}
-	case 456:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2370
+//line sql.y:2813
 		{
 			if yylex.(*Tokenizer).IsMySQL() {
 				yylex.Error("MySQL don't support PostgreSQL syntax of interval expression")
@@ -5545,9 +6440,9 @@ yydefault:
 			// Postgresql type of interval where interval value is string with values+units
 			yyVAL.intervalExpr = &IntervalExpr{Expr: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 457:
+	case 535:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2381
+//line sql.y:2824
 		{
 			if yylex.(*Tokenizer).IsPostgreSQL() {
 				yylex.Error("PostgreSQL don't support Mysql syntax of interval expression")
@@ -5559,395 +6454,395 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.intervalExpr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: string(yyDollar[3].bytes)}
 		}
-	case 458:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2399
+//line sql.y:2842
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 459:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2403
+//line sql.y:2846
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 460:
+	case 538:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2407
+//line sql.y:2850
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 461:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2417
+//line sql.y:2860
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 462:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2421
+//line sql.y:2864
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 463:
+	case 541:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2425
+//line sql.y:2868
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 464:
+	case 542:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2429
+//line sql.y:2872
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 465:
+	case 543:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2433
+//line sql.y:2876
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 466:
+	case 544:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2437
+//line sql.y:2880
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 467:
+	case 545:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2441
+//line sql.y:2884
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 468:
+	case 546:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2445
+//line sql.y:2888
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 469:
+	case 547:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2449
+//line sql.y:2892
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 470:
+	case 548:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2453
+//line sql.y:2896
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 471:
+	case 549:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2457
+//line sql.y:2900
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 472:
+	case 550:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2461
+//line sql.y:2904
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 473:
+	case 551:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2465
+//line sql.y:2908
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 474:
+	case 552:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2469
+//line sql.y:2912
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 475:
+	case 553:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2473
+//line sql.y:2916
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 476:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2485
+//line sql.y:2928
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 477:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2489
+//line sql.y:2932
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 478:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2493
+//line sql.y:2936
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 479:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2497
+//line sql.y:2940
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 480:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2502
+//line sql.y:2945
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 481:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2507
+//line sql.y:2950
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 482:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2512
+//line sql.y:2955
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 483:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2517
+//line sql.y:2960
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 486:
+	case 564:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2531
+//line sql.y:2974
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 487:
+	case 565:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2535
+//line sql.y:2978
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 488:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2539
+//line sql.y:2982
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 489:
+	case 567:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2543
+//line sql.y:2986
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 490:
+	case 568:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2549
+//line sql.y:2992
 		{
 			yyVAL.str = ""
 		}
-	case 491:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2553
+//line sql.y:2996
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 492:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2557
+//line sql.y:3000
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 493:
+	case 571:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2561
+//line sql.y:3004
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 494:
+	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2565
+//line sql.y:3008
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 495:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2571
+//line sql.y:3014
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 496:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2575
+//line sql.y:3018
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 497:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2581
+//line sql.y:3024
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 498:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2585
+//line sql.y:3028
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 499:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2589
+//line sql.y:3032
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 500:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2593
+//line sql.y:3036
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 501:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2597
+//line sql.y:3040
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 502:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2601
+//line sql.y:3044
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 503:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2607
+//line sql.y:3050
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 504:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2611
+//line sql.y:3054
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 505:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2615
+//line sql.y:3058
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 506:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2619
+//line sql.y:3062
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 507:
+	case 585:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2623
+//line sql.y:3066
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 508:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2627
+//line sql.y:3070
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 509:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2631
+//line sql.y:3074
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 510:
+	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2635
+//line sql.y:3078
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 511:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2640
+//line sql.y:3083
 		{
 			yyVAL.expr = nil
 		}
-	case 512:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2644
+//line sql.y:3087
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 513:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2649
+//line sql.y:3092
 		{
 			yyVAL.str = string("")
 		}
-	case 514:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2653
+//line sql.y:3096
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 515:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2659
+//line sql.y:3102
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 516:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2663
+//line sql.y:3106
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 517:
+	case 595:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2669
+//line sql.y:3112
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 518:
+	case 596:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2674
+//line sql.y:3117
 		{
 			yyVAL.expr = nil
 		}
-	case 519:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2678
+//line sql.y:3121
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 520:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+//line sql.y:3127
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 521:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2688
+//line sql.y:3131
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 522:
+	case 600:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2692
+//line sql.y:3135
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 523:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2698
+//line sql.y:3141
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 524:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2702
+//line sql.y:3145
 		{
 			val, err := NewMySQLDoubleQuotedStrVal(yyDollar[1].bytes)
 			if err != nil {
@@ -5956,57 +6851,57 @@ yydefault:
 			}
 			yyVAL.expr = val
 		}
-	case 525:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2711
+//line sql.y:3154
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 526:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2715
+//line sql.y:3158
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 527:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2719
+//line sql.y:3162
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 528:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2723
+//line sql.y:3166
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 529:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2727
+//line sql.y:3170
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 530:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2731
+//line sql.y:3174
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 531:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2735
+//line sql.y:3178
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 532:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2739
+//line sql.y:3182
 		{
 			yyVAL.expr = NewPgEscapeString(yyDollar[1].bytes)
 		}
-	case 533:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2743
+//line sql.y:3186
 		{
 			result, err := NewDollarExpr(string(yyDollar[1].bytes))
 			if err != nil {
@@ -6015,27 +6910,27 @@ yydefault:
 			}
 			yyVAL.expr = result
 		}
-	case 534:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2752
+//line sql.y:3195
 		{
 			yyVAL.expr = NewCastVal(yyDollar[1].expr, yyDollar[2].bytes)
 		}
-	case 535:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2758
+//line sql.y:3201
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 536:
+	case 614:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2763
+//line sql.y:3206
 		{
 			yyVAL.bytes = append(yyDollar[1].bytes, yyDollar[2].bytes...)
 		}
-	case 537:
+	case 615:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2771
+//line sql.y:3214
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6044,239 +6939,272 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 538:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2780
+//line sql.y:3223
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 539:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2784
+//line sql.y:3227
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 540:
+	case 618:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2789
+//line sql.y:3232
 		{
 			yyVAL.exprs = nil
 		}
-	case 541:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2793
+//line sql.y:3236
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 542:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2798
+//line sql.y:3241
 		{
 			yyVAL.expr = nil
 		}
-	case 543:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2802
+//line sql.y:3245
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 544:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2807
+//line sql.y:3250
 		{
 			yyVAL.orderBy = nil
 		}
-	case 545:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2811
+//line sql.y:3254
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 546:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2817
+//line sql.y:3260
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 547:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2821
+//line sql.y:3264
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 548:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2827
+//line sql.y:3270
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 549:
+	case 627:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2832
+//line sql.y:3275
 		{
 			yyVAL.str = AscScr
 		}
-	case 550:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2836
+//line sql.y:3279
 		{
 			yyVAL.str = AscScr
 		}
-	case 551:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2840
+//line sql.y:3283
 		{
 			yyVAL.str = DescScr
 		}
-	case 552:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2845
+//line sql.y:3288
 		{
 			yyVAL.limit = nil
 		}
-	case 553:
+	case 631:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2849
+//line sql.y:3292
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 554:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2853
+//line sql.y:3296
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 555:
+	case 633:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2857
+//line sql.y:3300
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 556:
+	case 634:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2862
+//line sql.y:3305
 		{
 			yyVAL.str = ""
 		}
-	case 557:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2866
+//line sql.y:3309
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 558:
+	case 636:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2870
+//line sql.y:3313
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 559:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2883
+//line sql.y:3326
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 560:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2887
+//line sql.y:3330
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 561:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2891
+//line sql.y:3334
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 562:
+	case 640:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2896
+//line sql.y:3339
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 563:
+	case 641:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2900
+//line sql.y:3343
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 564:
+	case 642:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2904
+//line sql.y:3347
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 565:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2911
+//line sql.y:3354
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 566:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2915
+//line sql.y:3358
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 567:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2919
+//line sql.y:3362
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 568:
+	case 646:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2923
+//line sql.y:3366
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 569:
+	case 647:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:3371
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 570:
+	case 648:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2932
+//line sql.y:3375
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 571:
+	case 649:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3381
+		{
+			yyDollar[3].onConflict.DoNothing = true
+			yyVAL.onConflict = yyDollar[3].onConflict
+		}
+	case 650:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3386
+		{
+			yyDollar[3].onConflict.Exprs = yyDollar[7].updateExprs
+			yyDollar[3].onConflict.Where = NewWhere(WhereStr, yyDollar[8].expr)
+			yyVAL.onConflict = yyDollar[3].onConflict
+		}
+	case 651:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3393
+		{
+			yyVAL.onConflict = &OnConflict{}
+		}
+	case 652:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3397
+		{
+			yyVAL.onConflict = &OnConflict{Columns: yyDollar[2].columns, TargetWhere: NewWhere(WhereStr, yyDollar[4].expr)}
+		}
+	case 653:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3401
+		{
+			yyVAL.onConflict = &OnConflict{Constraint: yyDollar[3].colIdent}
+		}
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2938
+//line sql.y:3407
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 572:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2942
+//line sql.y:3411
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 573:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2948
+//line sql.y:3417
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 574:
+	case 657:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2952
+//line sql.y:3421
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 575:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2958
+//line sql.y:3427
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 576:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2964
+//line sql.y:3433
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -6284,273 +7212,273 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 577:
+	case 660:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2973
+//line sql.y:3442
 		{
 			yyVAL.returning = nil
 		}
-	case 578:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2978
+//line sql.y:3447
 		{
 			yyVAL.returning = Returning{&StarExpr{}}
 		}
-	case 579:
+	case 662:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2983
+//line sql.y:3452
 		{
 			yyVAL.returning = Returning(yyDollar[2].exprs)
 		}
-	case 580:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2990
+//line sql.y:3459
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 581:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2994
+//line sql.y:3463
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 582:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3000
+//line sql.y:3469
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 583:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3006
+//line sql.y:3475
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 584:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3010
+//line sql.y:3479
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 585:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3016
+//line sql.y:3485
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 586:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3020
+//line sql.y:3489
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 587:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3024
+//line sql.y:3493
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 589:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3031
+//line sql.y:3500
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 591:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3038
+//line sql.y:3507
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 592:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3042
+//line sql.y:3511
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 593:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3046
+//line sql.y:3515
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 596:
+	case 679:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3055
+//line sql.y:3524
 		{
 			yyVAL.byt = 0
 		}
-	case 597:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3057
+//line sql.y:3526
 		{
 			yyVAL.byt = 1
 		}
-	case 598:
+	case 681:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3060
+//line sql.y:3529
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 599:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3062
+//line sql.y:3531
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 600:
+	case 683:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3065
+//line sql.y:3534
 		{
 			yyVAL.str = ""
 		}
-	case 601:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3067
+//line sql.y:3536
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 602:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3071
+//line sql.y:3540
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 603:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3073
+//line sql.y:3542
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 604:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3075
+//line sql.y:3544
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 605:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3077
+//line sql.y:3546
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 606:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3079
+//line sql.y:3548
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 607:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3081
+//line sql.y:3550
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 608:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3083
+//line sql.y:3552
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 609:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3085
+//line sql.y:3554
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 610:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3087
+//line sql.y:3556
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 611:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3089
+//line sql.y:3558
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 612:
+	case 695:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3092
+//line sql.y:3561
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 613:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3094
+//line sql.y:3563
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 614:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3096
+//line sql.y:3565
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 615:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3100
+//line sql.y:3569
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 616:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3102
+//line sql.y:3571
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 617:
+	case 700:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3105
+//line sql.y:3574
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 618:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3107
+//line sql.y:3576
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 619:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3109
+//line sql.y:3578
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 620:
+	case 703:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3112
+//line sql.y:3581
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 621:
+	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3114
+//line sql.y:3583
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 622:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3118
+//line sql.y:3587
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 623:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3122
+//line sql.y:3591
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 625:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3129
+//line sql.y:3598
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 626:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3135
+//line sql.y:3604
 		{
 			if yylex.(*Tokenizer).IsMySQL() && !yylex.(*Tokenizer).dialect.(*mysql.MySQLDialect).IsModeANSIOn() {
 				yylex.Error("MySQL dialect configured ANSI_mode=off and doesn't allow double quoted table identifiers")
@@ -6558,9 +7486,9 @@ yydefault:
 			}
 			yyVAL.tableIdent = NewTableIdentWithQuotes(string(yyDollar[1].bytes), '"')
 		}
-	case 627:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3143
+//line sql.y:3612
 		{
 			if yylex.(*Tokenizer).IsPostgreSQL() {
 				yylex.Error("PostgreSQL dialect doesn't allow to use backtick quotes for table identifiers")
@@ -6568,66 +7496,66 @@ yydefault:
 			}
 			yyVAL.tableIdent = NewTableIdentWithQuotes(string(yyDollar[1].bytes), '`')
 		}
-	case 628:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3151
+//line sql.y:3620
 		{
 			yyVAL.tableIdent = NewTableIdentWithQuotes(string(yyDollar[1].bytes), '\'')
 		}
-	case 629:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3156
+//line sql.y:3625
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 630:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3160
+//line sql.y:3629
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 632:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3167
+//line sql.y:3636
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 845:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3411
+//line sql.y:3896
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 846:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3420
+//line sql.y:3905
 		{
 			decNesting(yylex)
 		}
-	case 847:
+	case 946:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3425
+//line sql.y:3910
 		{
 			forceEOF(yylex)
 		}
-	case 848:
+	case 947:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3430
+//line sql.y:3915
 		{
 			forceEOF(yylex)
 		}
-	case 849:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3434
+//line sql.y:3919
 		{
 			forceEOF(yylex)
 		}
-	case 850:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3438
+//line sql.y:3923
 		{
 			forceEOF(yylex)
 		}
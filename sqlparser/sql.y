@@ -75,6 +75,13 @@ func forceEOF(yylex interface{}) {
   tableNames    TableNames
   indexHints    *IndexHints
   returning     Returning
+  onConflict    *OnConflict
+  with          *With
+  ctes          []*CommonTableExpr
+  cte           *CommonTableExpr
+  copyOptions   CopyOptions
+  copyOption    *CopyOption
+  loadData      *LoadData
   expr          Expr
   exprs         Exprs
   boolVal       BoolVal
@@ -210,6 +217,18 @@ func forceEOF(yylex interface{}) {
 // Postgresql
 %token <bytes> RETURNING
 
+// PostgreSQL ON CONFLICT clause
+%token <bytes> CONFLICT DO NOTHING
+
+// Common table expressions
+%token <bytes> RECURSIVE
+
+// PostgreSQL COPY statement
+%token <bytes> COPY STDIN STDOUT
+
+// MySQL LOAD DATA statement
+%token <bytes> LOAD INFILE LOW_PRIORITY TERMINATED OPTIONALLY ENCLOSED ESCAPED LINES STARTING
+
 %type <statement> command
 %type <selStmt> select_statement base_select union_lhs union_rhs
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
@@ -261,6 +280,22 @@ func forceEOF(yylex interface{}) {
 %type <columns> ins_column_list column_list
 %type <partitions> opt_partition_clause partition_list
 %type <updateExprs> on_dup_opt
+%type <onConflict> on_conflict conflict_target_opt
+%type <with> with_clause
+%type <ctes> common_table_expr_list
+%type <cte> common_table_expr
+%type <statement> cte_statement
+%type <statement> copy_statement
+%type <columns> copy_column_list_opt
+%type <copyOptions> copy_options_opt copy_option_list copy_legacy_option_list
+%type <copyOption> copy_option copy_legacy_option
+%type <expr> copy_option_arg copy_string
+%type <statement> load_data_statement
+%type <loadData> load_data_modifier_list load_data_fields_opt load_data_field_option_list load_data_lines_opt load_data_line_option_list
+%type <str> load_data_duplicate_opt
+%type <optVal> load_data_string load_data_ignore_opt
+%type <columns> load_data_column_list_opt
+%type <updateExprs> load_data_set_opt
 %type <updateExprs> update_list
 %type <setExprs> set_list transaction_chars
 %type <bytes> charset_or_character_set
@@ -355,6 +390,397 @@ command:
 | deallocate_prepare_statement
 | prepare_statement
 | execute_statement
+| with_clause select_statement
+  {
+    switch sel := $2.(type) {
+    case *Select:
+      sel.With = $1
+    case *Union:
+      sel.With = $1
+    default:
+      yylex.Error("unsupported statement after WITH clause")
+      return 1
+    }
+    $$ = $2
+  }
+| with_clause insert_statement
+  {
+    $2.(*Insert).With = $1
+    $$ = $2
+  }
+| with_clause update_statement
+  {
+    $2.(*Update).With = $1
+    $$ = $2
+  }
+| with_clause delete_statement
+  {
+    $2.(*Delete).With = $1
+    $$ = $2
+  }
+| copy_statement
+| load_data_statement
+
+copy_statement:
+  COPY table_name copy_column_list_opt FROM STDIN copy_options_opt
+  {
+    $$ = &Copy{Table: $2, Columns: $3, Direction: CopyFromStdinStr, Options: $6}
+  }
+| COPY table_name copy_column_list_opt TO STDOUT copy_options_opt
+  {
+    $$ = &Copy{Table: $2, Columns: $3, Direction: CopyToStdoutStr, Options: $6}
+  }
+| COPY openb select_statement closeb TO STDOUT copy_options_opt
+  {
+    $$ = &Copy{Query: $3, Direction: CopyToStdoutStr, Options: $7}
+  }
+
+copy_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb column_list closeb
+  {
+    $$ = $2
+  }
+
+copy_options_opt:
+  {
+    $$ = nil
+  }
+| openb copy_option_list closeb
+  {
+    $$ = $2
+  }
+| WITH openb copy_option_list closeb
+  {
+    $$ = $3
+  }
+| copy_legacy_option_list
+  {
+    $$ = $1
+  }
+| WITH copy_legacy_option_list
+  {
+    $$ = $2
+  }
+
+copy_option_list:
+  copy_option
+  {
+    $$ = CopyOptions{$1}
+  }
+| copy_option_list ',' copy_option
+  {
+    $$ = append($1, $3)
+  }
+
+copy_option:
+  sql_id
+  {
+    $$ = &CopyOption{Name: $1}
+  }
+| sql_id copy_option_arg
+  {
+    $$ = &CopyOption{Name: $1, Value: $2}
+  }
+| NULL copy_string
+  {
+    $$ = &CopyOption{Name: NewColIdent(CopyNullOption), Value: $2}
+  }
+| ESCAPE copy_string
+  {
+    $$ = &CopyOption{Name: NewColIdent(CopyEscapeOption), Value: $2}
+  }
+
+copy_option_arg:
+  copy_string
+| sql_id
+  {
+    $$ = &ColName{Name: $1}
+  }
+| BINARY
+  {
+    $$ = &ColName{Name: NewColIdent(string($1))}
+  }
+| INTEGRAL
+  {
+    $$ = NewIntVal($1)
+  }
+| boolean_value
+  {
+    $$ = $1
+  }
+| openb column_list closeb
+  {
+    tuple := make(ValTuple, 0, len($2))
+    for _, column := range $2 {
+      tuple = append(tuple, &ColName{Name: column})
+    }
+    $$ = tuple
+  }
+
+copy_string:
+  SINGLE_QUOTE_STRING
+  {
+    $$ = NewStrVal($1)
+  }
+| PG_ESCAPE_STRING
+  {
+    $$ = NewPgEscapeString($1)
+  }
+
+// options of the syntax used before PostgreSQL 9.0, stored in the same form as options in parentheses
+copy_legacy_option_list:
+  copy_legacy_option
+  {
+    $$ = CopyOptions{$1}
+  }
+| copy_legacy_option_list copy_legacy_option
+  {
+    $$ = append($1, $2)
+  }
+
+copy_legacy_option:
+  BINARY
+  {
+    $$ = &CopyOption{Name: NewColIdent(CopyFormatOption), Value: &ColName{Name: NewColIdent(string($1))}}
+  }
+| ID
+  {
+    name := NewColIdent(string($1))
+    if name.Lowered() == "csv" {
+      $$ = &CopyOption{Name: NewColIdent(CopyFormatOption), Value: &ColName{Name: name}}
+    } else {
+      $$ = &CopyOption{Name: name}
+    }
+  }
+| ID as_opt copy_string
+  {
+    $$ = &CopyOption{Name: NewColIdent(string($1)), Value: $3}
+  }
+| NULL as_opt copy_string
+  {
+    $$ = &CopyOption{Name: NewColIdent(CopyNullOption), Value: $3}
+  }
+| ESCAPE as_opt copy_string
+  {
+    $$ = &CopyOption{Name: NewColIdent(CopyEscapeOption), Value: $3}
+  }
+
+load_data_statement:
+  LOAD ID load_data_modifier_list INFILE load_data_string load_data_duplicate_opt INTO TABLE table_name opt_partition_clause charset_opt load_data_fields_opt load_data_lines_opt load_data_ignore_opt load_data_column_list_opt load_data_set_opt
+  {
+    if !NewColIdent(string($2)).EqualString("data") {
+      yylex.Error("syntax error")
+      return 1
+    }
+    loadData := $3
+    loadData.Infile = $5
+    loadData.Duplicate = $6
+    loadData.Table = $9
+    loadData.Partitions = $10
+    loadData.Charset = $11
+    loadData.FieldsTerminatedBy = $12.FieldsTerminatedBy
+    loadData.EnclosedBy = $12.EnclosedBy
+    loadData.OptionallyEnclosed = $12.OptionallyEnclosed
+    loadData.EscapedBy = $12.EscapedBy
+    loadData.LinesStartingBy = $13.LinesStartingBy
+    loadData.LinesTerminatedBy = $13.LinesTerminatedBy
+    loadData.IgnoreLines = $14
+    loadData.Columns = $15
+    loadData.SetExprs = $16
+    $$ = loadData
+  }
+
+load_data_modifier_list:
+  {
+    $$ = &LoadData{}
+  }
+| load_data_modifier_list LOW_PRIORITY
+  {
+    $1.Priority = LowPriorityStr
+    $$ = $1
+  }
+| load_data_modifier_list ID
+  {
+    switch NewColIdent(string($2)).Lowered() {
+    case "local":
+      $1.Local = true
+    case "concurrent":
+      $1.Priority = ConcurrentStr
+    default:
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = $1
+  }
+
+load_data_string:
+  SINGLE_QUOTE_STRING
+  {
+    $$ = NewStrVal($1)
+  }
+| DOUBLE_QUOTE_STRING
+  {
+    $$ = NewStrVal($1)
+  }
+
+load_data_duplicate_opt:
+  {
+    $$ = ""
+  }
+| REPLACE
+  {
+    $$ = LoadDataReplaceStr
+  }
+| IGNORE
+  {
+    $$ = LoadDataIgnoreStr
+  }
+
+load_data_fields_opt:
+  {
+    $$ = &LoadData{}
+  }
+| ID load_data_field_option_list
+  {
+    name := NewColIdent(string($1))
+    if !name.EqualString("fields") && !name.EqualString("columns") {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = $2
+  }
+
+load_data_field_option_list:
+  {
+    $$ = &LoadData{}
+  }
+| load_data_field_option_list TERMINATED BY load_data_string
+  {
+    $1.FieldsTerminatedBy = $4
+    $$ = $1
+  }
+| load_data_field_option_list ENCLOSED BY load_data_string
+  {
+    $1.EnclosedBy = $4
+    $$ = $1
+  }
+| load_data_field_option_list OPTIONALLY ENCLOSED BY load_data_string
+  {
+    $1.EnclosedBy = $5
+    $1.OptionallyEnclosed = true
+    $$ = $1
+  }
+| load_data_field_option_list ESCAPED BY load_data_string
+  {
+    $1.EscapedBy = $4
+    $$ = $1
+  }
+
+load_data_lines_opt:
+  {
+    $$ = &LoadData{}
+  }
+| LINES load_data_line_option_list
+  {
+    $$ = $2
+  }
+
+load_data_line_option_list:
+  {
+    $$ = &LoadData{}
+  }
+| load_data_line_option_list STARTING BY load_data_string
+  {
+    $1.LinesStartingBy = $4
+    $$ = $1
+  }
+| load_data_line_option_list TERMINATED BY load_data_string
+  {
+    $1.LinesTerminatedBy = $4
+    $$ = $1
+  }
+
+load_data_ignore_opt:
+  {
+    $$ = nil
+  }
+| IGNORE INTEGRAL LINES
+  {
+    $$ = NewIntVal($2)
+  }
+| IGNORE INTEGRAL ID
+  {
+    if !NewColIdent(string($3)).EqualString("rows") {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = NewIntVal($2)
+  }
+
+load_data_column_list_opt:
+  {
+    $$ = nil
+  }
+| openb closeb
+  {
+    $$ = Columns{}
+  }
+| openb column_list closeb
+  {
+    $$ = $2
+  }
+
+load_data_set_opt:
+  {
+    $$ = nil
+  }
+| SET update_list
+  {
+    $$ = $2
+  }
+
+with_clause:
+  WITH common_table_expr_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE common_table_expr_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+common_table_expr_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| common_table_expr_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id AS openb cte_statement closeb
+  {
+    $$ = &CommonTableExpr{Name: $1, Statement: $4}
+  }
+| table_id openb ins_column_list closeb AS openb cte_statement closeb
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $3, Statement: $7}
+  }
+
+// PostgreSQL allows data-modifying statements in WITH clause
+cte_statement:
+  select_statement
+  {
+    $$ = $1
+  }
+| insert_statement
+| update_statement
+| delete_statement
 
 select_statement:
   base_select order_by_opt limit_opt lock_opt
@@ -422,6 +848,18 @@ insert_statement:
     ins.Returning = $8
     $$ = ins
   }
+| insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause insert_data on_conflict returning_opt
+  {
+    ins := $6
+    ins.Action = $1
+    ins.Comments = $2
+    ins.Ignore = $3
+    ins.Table = $4
+    ins.Partitions = $5
+    ins.OnConflict = $7
+    ins.Returning = $8
+    $$ = ins
+  }
 | insert_or_replace comment_opt ignore_opt into_table_name opt_partition_clause SET update_list on_dup_opt
   {
     cols := make(Columns, 0, len($7))
@@ -2100,6 +2538,11 @@ condition:
   {
     $$ = &ComparisonExpr{Left: $1, Operator: $2, Right: $3}
   }
+| value_expression compare ALL openb value_expression closeb
+  {
+    // PostgreSQL's array comparison, ANY/SOME are parsed as regular function calls
+    $$ = &ComparisonExpr{Left: $1, Operator: $2, Right: &FuncExpr{Name: NewColIdent("all"), Exprs: SelectExprs{&AliasedExpr{Expr: $5}}}}
+  }
 | value_expression IN col_tuple
   {
     $$ = &ComparisonExpr{Left: $1, Operator: InStr, Right: $3}
@@ -2933,6 +3376,32 @@ on_dup_opt:
     $$ = $5
   }
 
+on_conflict:
+  ON CONFLICT conflict_target_opt DO NOTHING
+  {
+    $3.DoNothing = true
+    $$ = $3
+  }
+| ON CONFLICT conflict_target_opt DO UPDATE SET update_list where_expression_opt
+  {
+    $3.Exprs = $7
+    $3.Where = NewWhere(WhereStr, $8)
+    $$ = $3
+  }
+
+conflict_target_opt:
+  {
+    $$ = &OnConflict{}
+  }
+| openb ins_column_list closeb where_expression_opt
+  {
+    $$ = &OnConflict{Columns: $2, TargetWhere: NewWhere(WhereStr, $4)}
+  }
+| ON CONSTRAINT sql_id
+  {
+    $$ = &OnConflict{Constraint: $3}
+  }
+
 tuple_list:
   tuple_or_empty
   {
@@ -3315,12 +3784,17 @@ non_reserved_keyword:
 | COMMENT_KEYWORD
 | COMMIT
 | COMMITTED
+| CONFLICT
+| COPY
 | DATE
 | DATETIME
 | DECIMAL
+| DO
 | DOUBLE
 | DUPLICATE
+| ENCLOSED
 | ENUM
+| ESCAPED
 | EXPANSION
 | FLOAT_TYPE
 | FOREIGN
@@ -3328,6 +3802,7 @@ non_reserved_keyword:
 | GEOMETRY
 | GEOMETRYCOLLECTION
 | GLOBAL
+| INFILE
 | INT
 | INTEGER
 | ISOLATION
@@ -3338,7 +3813,10 @@ non_reserved_keyword:
 | LAST_INSERT_ID
 | LESS
 | LEVEL
+| LINES
 | LINESTRING
+| LOAD
+| LOW_PRIORITY
 | LONGBLOB
 | LONGTEXT
 | MEDIUMBLOB
@@ -3350,10 +3828,12 @@ non_reserved_keyword:
 | MULTIPOLYGON
 | NAMES
 | NCHAR
+| NOTHING
 | NUMERIC
 | OFFSET
 | ONLY
 | OPTIMIZE
+| OPTIONALLY
 | PARTITION
 | POINT
 | POLYGON
@@ -3362,6 +3842,7 @@ non_reserved_keyword:
 | QUERY
 | READ
 | REAL
+| RECURSIVE
 | REORGANIZE
 | REPAIR
 | REPEATABLE
@@ -3373,7 +3854,11 @@ non_reserved_keyword:
 | SMALLINT
 | SPATIAL
 | START
+| STARTING
 | STATUS
+| STDIN
+| STDOUT
+| TERMINATED
 | TEXT
 | THAN
 | TIME
@@ -200,11 +200,11 @@ var keywords = map[string]int{
 	"each":                UNUSED,
 	"else":                ELSE,
 	"elseif":              UNUSED,
-	"enclosed":            UNUSED,
+	"enclosed":            ENCLOSED,
 	"end":                 END,
 	"enum":                ENUM,
 	"escape":              ESCAPE,
-	"escaped":             UNUSED,
+	"escaped":             ESCAPED,
 	"exists":              EXISTS,
 	"exit":                UNUSED,
 	"explain":             EXPLAIN,
@@ -239,7 +239,7 @@ var keywords = map[string]int{
 	"ignore":              IGNORE,
 	"in":                  IN,
 	"index":               INDEX,
-	"infile":              UNUSED,
+	"infile":              INFILE,
 	"inout":               UNUSED,
 	"inner":               INNER,
 	"insensitive":         UNUSED,
@@ -273,9 +273,9 @@ var keywords = map[string]int{
 	"like":                LIKE,
 	"limit":               LIMIT,
 	"linear":              UNUSED,
-	"lines":               UNUSED,
+	"lines":               LINES,
 	"linestring":          LINESTRING,
-	"load":                UNUSED,
+	"load":                LOAD,
 	"localtime":           LOCALTIME,
 	"localtimestamp":      LOCALTIMESTAMP,
 	"lock":                LOCK,
@@ -283,7 +283,7 @@ var keywords = map[string]int{
 	"longblob":            LONGBLOB,
 	"longtext":            LONGTEXT,
 	"loop":                UNUSED,
-	"low_priority":        UNUSED,
+	"low_priority":        LOW_PRIORITY,
 	"master_bind":         UNUSED,
 	"match":               MATCH,
 	"maxvalue":            MAXVALUE,
@@ -316,7 +316,7 @@ var keywords = map[string]int{
 	"optimize":            OPTIMIZE,
 	"optimizer_costs":     UNUSED,
 	"option":              UNUSED,
-	"optionally":          UNUSED,
+	"optionally":          OPTIONALLY,
 	"or":                  OR,
 	"order":               ORDER,
 	"out":                 UNUSED,
@@ -336,6 +336,7 @@ var keywords = map[string]int{
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          UNUSED,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
@@ -381,14 +382,14 @@ var keywords = map[string]int{
 	"sql_small_result":    UNUSED,
 	"ssl":                 UNUSED,
 	"start":               START,
-	"starting":            UNUSED,
+	"starting":            STARTING,
 	"status":              STATUS,
 	"stored":              UNUSED,
 	"straight_join":       STRAIGHT_JOIN,
 	"stream":              STREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
-	"terminated":          UNUSED,
+	"terminated":          TERMINATED,
 	"text":                TEXT,
 	"than":                THAN,
 	"then":                THEN,
@@ -441,6 +442,12 @@ var keywords = map[string]int{
 	"year_month":          YEAR_MONTH,
 	"zerofill":            ZEROFILL,
 	"returning":           RETURNING,
+	"conflict":            CONFLICT,
+	"copy":                COPY,
+	"stdin":               STDIN,
+	"stdout":              STDOUT,
+	"do":                  DO,
+	"nothing":             NOTHING,
 	"deallocate":          DEALLOCATE,
 	"prepare":             PREPARE,
 	"execute":             EXECUTE,